@@ -2,24 +2,37 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"syscall"
 	"time"
 
-	"github.com/go-playground/validator/v10"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/sirupsen/logrus"
 
 	"github.com/popeskul/awesome-blog/backend/internal/config"
 	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/handlers"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/middleware"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+	"github.com/popeskul/awesome-blog/backend/internal/feed"
 	"github.com/popeskul/awesome-blog/backend/internal/hash"
 	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/internal/jobs"
+	"github.com/popeskul/awesome-blog/backend/internal/mail"
+	"github.com/popeskul/awesome-blog/backend/internal/newsletter"
+	"github.com/popeskul/awesome-blog/backend/internal/outbox"
 	"github.com/popeskul/awesome-blog/backend/internal/server"
+	"github.com/popeskul/awesome-blog/backend/internal/sessions"
+	"github.com/popeskul/awesome-blog/backend/internal/sitemap"
+	"github.com/popeskul/awesome-blog/backend/internal/spam"
 	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+	"github.com/popeskul/awesome-blog/backend/internal/webhooks"
 	"github.com/popeskul/awesome-blog/backend/pkg/db"
 	"github.com/popeskul/awesome-blog/backend/pkg/migrator"
 )
@@ -50,30 +63,221 @@ func main() {
 	}()
 
 	postRepo := postgres.NewPostRepository(database, logger)
+	tagRepo := postgres.NewTagRepository(database, logger)
 	commentRepo := postgres.NewCommentRepository(database, logger)
 	userRepo := postgres.NewUserRepository(database, logger)
 	sessionRepo := postgres.NewSessionRepository(database, logger)
+	spamRepo := postgres.NewSpamRepository(database, logger)
+	reactionRepo := postgres.NewReactionRepository(database, logger)
+	bookmarkRepo := postgres.NewBookmarkRepository(database, logger)
+	readingListRepo := postgres.NewReadingListRepository(database, logger)
+	profileRepo := postgres.NewProfileRepository(database, logger)
+	followRepo := postgres.NewFollowRepository(database, logger)
+	notificationRepo := postgres.NewNotificationRepository(database, logger)
+	eventRepo := postgres.NewEventRepository(database, logger)
+	webhookRepo := postgres.NewWebhookRepository(database, logger)
+	outboxRepo := postgres.NewOutboxRepository(database, logger)
+	jobRepo := postgres.NewJobRepository(database, logger)
+	newsletterRepo := postgres.NewNewsletterRepository(database, logger)
+	feedRepo := postgres.NewFeedRepository(database, logger)
+	unitOfWork := postgres.NewUnitOfWork(database)
 
 	hashService := &hash.BcryptHashService{}
 	validatorService := validator.New()
 
-	postUseCase := usecase.NewPostUseCase(postRepo, userRepo, logger)
-	commentUseCase := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, logger)
+	spamCheckers := []spam.SpamChecker{spam.NewHeuristicChecker(cfg.Spam.MaxLinks, cfg.Spam.Blocklist)}
+	var spamTrainer spam.Trainer
+	if cfg.Spam.Bayes.Enabled {
+		bayes := spam.NewBayesChecker(spamRepo, spam.BayesOptions{
+			MinSamples:          cfg.Spam.Bayes.MinSamples,
+			SpamThreshold:       cfg.Spam.Bayes.SpamThreshold,
+			SuspiciousThreshold: cfg.Spam.Bayes.SuspiciousThreshold,
+		})
+		spamCheckers = append(spamCheckers, bayes)
+		spamTrainer = bayes
+	}
+	if cfg.Spam.Akismet.APIKey != "" {
+		spamCheckers = append(spamCheckers, spam.NewAkismetChecker(
+			cfg.Spam.Akismet.BaseURL, cfg.Spam.Akismet.APIKey, cfg.Spam.Akismet.Site, cfg.Spam.Akismet.Timeout,
+		))
+	}
+	spamChecker := spam.NewChain(logger, spamCheckers...)
+
+	listener, err := db.NewListener(cfg.Database, logger, postgres.StreamEventsChannel, postgres.StreamSignalsChannel)
+	if err != nil {
+		logger.Fatalf("Failed to listen for stream events: %v", err)
+	}
+	defer listener.Close()
+
+	broker := events.NewPostgresBroker(eventRepo, listener.Notify, postgres.StreamSignalsChannel, logger, cfg.Stream.Retention)
+	brokerCtx, stopBroker := context.WithCancel(context.Background())
+	brokerDone := make(chan struct{})
+	go func() {
+		defer close(brokerDone)
+		if err := broker.Run(brokerCtx); err != nil {
+			logger.Errorf("Stream broker stopped: %v", err)
+		}
+	}()
+
+	// Jobs are registered with the runner below; it starts once they all are.
+	runner := jobs.NewRunner(jobRepo, logger, jobs.Options{
+		Queues:       cfg.Jobs.Queues,
+		PollInterval: cfg.Jobs.PollInterval,
+		Timeout:      cfg.Jobs.Timeout,
+		MaxAttempts:  cfg.Jobs.MaxAttempts,
+		BackoffBase:  cfg.Jobs.BackoffBase,
+		BackoffMax:   cfg.Jobs.BackoffMax,
+		Retention:    cfg.Jobs.Retention,
+	})
+
+	// Webhook deliveries are sent as jobs, one per attempt.
+	dispatcher := webhooks.NewDispatcher(webhookRepo, unitOfWork, runner, logger, webhooks.Options{
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		BackoffBase: cfg.Webhooks.BackoffBase,
+		BackoffMax:  cfg.Webhooks.BackoffMax,
+		Timeout:     cfg.Webhooks.Timeout,
+	})
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepo, userRepo, unitOfWork, dispatcher, logger)
+
+	// Post and comment events are stored with the change they describe and
+	// relayed to streams and webhooks afterwards. The consumer names are
+	// persisted; don't rename them.
+	publisher := outbox.NewPublisher(outboxRepo)
+	relay := outbox.NewRelay(unitOfWork, outboxRepo, logger, outbox.Options{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		Retention:    cfg.Outbox.Retention,
+	})
+	relay.Register("stream", broker)
+	relay.Register("webhooks", webhookUseCase)
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		relay.Run(relayCtx)
+	}()
+
+	// Email is sent through the job runner so that a failing mail server
+	// never fails a request. Without a mail driver the newsletter is off and
+	// notification email preferences are only stored.
+	var mailer mail.Mailer
+	switch cfg.Mail.Driver {
+	case "smtp":
+		mailer = mail.NewSMTPMailer(mail.SMTPOptions{
+			Host:     cfg.Mail.SMTP.Host,
+			Port:     cfg.Mail.SMTP.Port,
+			Username: cfg.Mail.SMTP.Username,
+			Password: cfg.Mail.SMTP.Password,
+			From:     cfg.Mail.From,
+			Timeout:  cfg.Mail.SMTP.Timeout,
+		}, logger)
+	case "file":
+		fileMailer, err := mail.NewFileMailer(cfg.Mail.Dir, cfg.Mail.From)
+		if err != nil {
+			logger.Fatalf("Failed to initialize the file mailer: %v", err)
+		}
+		mailer = fileMailer
+	case "":
+	default:
+		logger.Fatalf("Unknown mail driver %q", cfg.Mail.Driver)
+	}
+
+	signer := newsletter.NewSigner(cfg.Newsletter.Secret)
+	var emailer *newsletter.Emailer
+	var notificationEmailer usecase.NotificationEmailer
+	var newsletterEmailer usecase.NewsletterEmailer
+	if mailer != nil {
+		emailer = newsletter.NewEmailer(mail.NewQueue(runner, mailer), signer, newsletter.Options{
+			SiteName:   cfg.Site.Name,
+			SiteURL:    cfg.Site.URL,
+			APIURL:     cfg.Site.APIURL,
+			ConfirmTTL: cfg.Newsletter.ConfirmTTL,
+		})
+		notificationEmailer = emailer
+		newsletterEmailer = emailer
+	}
+
+	notificationUseCase := usecase.NewNotificationUseCase(notificationRepo, userRepo, notificationEmailer, logger, broker)
+	postUseCase := usecase.NewPostUseCase(postRepo, userRepo, reactionRepo, bookmarkRepo, tagRepo, unitOfWork, logger, publisher)
+	commentUseCase := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, reactionRepo, unitOfWork, logger, cfg, spamChecker, notificationUseCase, publisher)
+	moderationUseCase := usecase.NewModerationUseCase(commentRepo, postRepo, userRepo, unitOfWork, logger, spamTrainer, notificationUseCase, publisher)
+	reactionUseCase := usecase.NewReactionUseCase(reactionRepo, postRepo, commentRepo, logger, cfg)
+	bookmarkUseCase := usecase.NewBookmarkUseCase(bookmarkRepo, postRepo, logger)
+	readingListUseCase := usecase.NewReadingListUseCase(readingListRepo, postRepo, logger)
+	profileUseCase := usecase.NewProfileUseCase(profileRepo, userRepo, postRepo, followRepo, logger)
+	followUseCase := usecase.NewFollowUseCase(followRepo, userRepo, reactionRepo, bookmarkRepo, logger, notificationUseCase)
 	userUseCase := usecase.NewUserUseCase(userRepo, logger, hashService)
-	authUseCase := usecase.NewAuthUseCase(userRepo, sessionRepo, logger, cfg, hashService)
+	streamUseCase := usecase.NewStreamUseCase(broker, logger, cfg)
+	presenceUseCase := usecase.NewPresenceUseCase(postRepo, userRepo, broker, logger, cfg)
+	authUseCase := usecase.NewAuthUseCase(userRepo, sessionRepo, logger, cfg, hashService, spamChecker)
+	jobUseCase := usecase.NewJobUseCase(jobRepo, userRepo, logger)
+	newsletterUseCase := usecase.NewNewsletterUseCase(newsletterRepo, userRepo, unitOfWork, logger, newsletterEmailer, signer)
+	feedUseCase := usecase.NewFeedUseCase(feedRepo, userRepo, logger, cfg.Feeds.Limit)
+	sitemapUseCase := usecase.NewSitemapUseCase(postRepo, logger, sitemap.MaxURLs)
+
+	sweeper := sessions.NewSweeper(sessionRepo, logger, cfg.Sessions.SweepBatchSize)
+	runner.Register(sessions.KindSweep, jobs.DefaultQueue, sweeper.Sweep)
+	if err := runner.Schedule(sessions.KindSweep, cfg.Sessions.SweepSchedule, sessions.KindSweep, nil); err != nil {
+		logger.Fatalf("Failed to schedule the session sweeper: %v", err)
+	}
+
+	if emailer != nil {
+		digest := newsletter.NewDigest(newsletterRepo, unitOfWork, emailer, logger, newsletter.DigestOptions{
+			BatchSize: cfg.Newsletter.BatchSize,
+			MaxPosts:  cfg.Newsletter.DigestMaxPosts,
+		})
+		runner.Register(newsletter.KindDigest, jobs.DefaultQueue, digest.Run)
+		if err := runner.Schedule(newsletter.KindDigest, cfg.Newsletter.DigestSchedule, newsletter.KindDigest, nil); err != nil {
+			logger.Fatalf("Failed to schedule the newsletter digest: %v", err)
+		}
+	}
 
-	postHandler := handlers.NewPostHandler(postUseCase, logger, validatorService)
-	commentHandler := handlers.NewCommentHandler(commentUseCase, logger, validatorService)
+	runnerCtx, stopRunner := context.WithCancel(context.Background())
+	runnerDone := make(chan struct{})
+	go func() {
+		defer close(runnerDone)
+		runner.Run(runnerCtx)
+	}()
+
+	postHandler := handlers.NewPostHandler(postUseCase, logger, validatorService, cfg.Concurrency.RequireIfMatch)
+	commentHandler := handlers.NewCommentHandler(commentUseCase, logger, validatorService, cfg.Concurrency.RequireIfMatch)
+	moderationHandler := handlers.NewModerationHandler(moderationUseCase, logger, validatorService)
+	reactionHandler := handlers.NewReactionHandler(reactionUseCase, logger)
+	bookmarkHandler := handlers.NewBookmarkHandler(bookmarkUseCase, logger, validatorService)
+	readingListHandler := handlers.NewReadingListHandler(readingListUseCase, logger, validatorService)
+	authorHandler := handlers.NewAuthorHandler(profileUseCase, postUseCase, logger, validatorService)
+	followHandler := handlers.NewFollowHandler(followUseCase, logger)
+	notificationHandler := handlers.NewNotificationHandler(notificationUseCase, logger, validatorService)
+	streamHandler := handlers.NewStreamHandler(streamUseCase, logger, cfg.Stream.Heartbeat)
+	presenceHandler := handlers.NewPresenceHandler(presenceUseCase, logger, cfg.Presence)
+	webhookHandler := handlers.NewWebhookHandler(webhookUseCase, logger, validatorService)
+	jobHandler := handlers.NewJobHandler(jobUseCase, logger)
+	newsletterHandler := handlers.NewNewsletterHandler(newsletterUseCase, logger, validatorService)
+	feedHandler := handlers.NewFeedHandler(feedUseCase, logger, feed.Options{
+		SiteName:      cfg.Site.Name,
+		SiteURL:       cfg.Site.URL,
+		ExcerptLength: cfg.Feeds.ExcerptLength,
+	}, cfg.Site.APIURL, cfg.Feeds.Content == "excerpt")
+	sitemapHandler := handlers.NewSitemapHandler(sitemapUseCase, logger, cfg.Site.URL, cfg.Site.APIURL, sitemap.RobotsOptions{
+		Allow:       cfg.Robots.Allow,
+		Disallow:    cfg.Robots.Disallow,
+		DisallowAll: cfg.Robots.DisallowAll,
+	})
 	userHandler := handlers.NewUserHandler(userUseCase, logger, validatorService)
 	authHandler := handlers.NewAuthHandler(authUseCase, userUseCase, logger, validatorService)
 
-	handler := handlers.NewHandler(postHandler, commentHandler, userHandler, authHandler)
+	handler := handlers.NewHandler(postHandler, commentHandler, moderationHandler, reactionHandler, bookmarkHandler, readingListHandler, authorHandler, followHandler, notificationHandler, streamHandler, presenceHandler, webhookHandler, jobHandler, newsletterHandler, feedHandler, sitemapHandler, userHandler, authHandler)
+
+	validatorMiddleware, err := middleware.NewOpenAPIValidator(logger)
+	if err != nil {
+		logger.Fatalf("Failed to initialize the request validator: %v", err)
+	}
 
 	logger.Info("Starting server...")
 
 	staticPath := filepath.Join("/app", "static")
 
-	srv := server.NewServer(cfg, logger, handler, staticPath)
+	srv := server.NewServer(cfg, logger, handler, validatorMiddleware, sessions.NewChecker(sessionRepo, cfg.Sessions.CheckCacheTTL), staticPath)
 
 	go func() {
 		if err = srv.Run(); err != nil {
@@ -81,6 +285,12 @@ func main() {
 		}
 	}()
 
+	go func() {
+		if err := srv.RunMetrics(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("Metrics server stopped: %v", err)
+		}
+	}()
+
 	logger.Info("Server started")
 
 	quit := make(chan os.Signal, 1)
@@ -90,6 +300,17 @@ func main() {
 
 	logger.Info("Server is shutting down...")
 
+	stopRelay()
+	<-relayDone
+
+	// Open streams only end when the broker stops, so stop it before waiting
+	// for requests to finish.
+	stopBroker()
+	<-brokerDone
+
+	stopRunner()
+	<-runnerDone
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -1,6 +1,6 @@
 // Package api provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package api
 
 import (
@@ -8,7 +8,9 @@ import (
 	"compress/gzip"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -18,19 +20,110 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 )
 
 const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
-// Defines values for GetApiV1PostsParamsSort.
+// Defines values for CommentStatus.
 const (
-	GetApiV1PostsParamsSortCreatedAtAsc  GetApiV1PostsParamsSort = "created_at_asc"
-	GetApiV1PostsParamsSortCreatedAtDesc GetApiV1PostsParamsSort = "created_at_desc"
-	GetApiV1PostsParamsSortTitleAsc      GetApiV1PostsParamsSort = "title_asc"
-	GetApiV1PostsParamsSortTitleDesc     GetApiV1PostsParamsSort = "title_desc"
+	CommentStatusApproved CommentStatus = "approved"
+	CommentStatusPending  CommentStatus = "pending"
+	CommentStatusRejected CommentStatus = "rejected"
+	CommentStatusSpam     CommentStatus = "spam"
+)
+
+// Defines values for JSONPatchOperationOp.
+const (
+	Add     JSONPatchOperationOp = "add"
+	Copy    JSONPatchOperationOp = "copy"
+	Move    JSONPatchOperationOp = "move"
+	Remove  JSONPatchOperationOp = "remove"
+	Replace JSONPatchOperationOp = "replace"
+	Test    JSONPatchOperationOp = "test"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+)
+
+// Defines values for NotificationType.
+const (
+	NotificationTypeComment    NotificationType = "comment"
+	NotificationTypeFollow     NotificationType = "follow"
+	NotificationTypeMention    NotificationType = "mention"
+	NotificationTypeModeration NotificationType = "moderation"
+	NotificationTypeReply      NotificationType = "reply"
+)
+
+// Defines values for PostStatus.
+const (
+	Archived  PostStatus = "archived"
+	Draft     PostStatus = "draft"
+	Published PostStatus = "published"
+)
+
+// Defines values for PresenceMessageType.
+const (
+	PresenceMessageTypeClosing     PresenceMessageType = "closing"
+	PresenceMessageTypeError       PresenceMessageType = "error"
+	PresenceMessageTypePong        PresenceMessageType = "pong"
+	PresenceMessageTypePostUpdated PresenceMessageType = "post.updated"
+	PresenceMessageTypePresence    PresenceMessageType = "presence"
+)
+
+// Defines values for ReadingListVisibility.
+const (
+	Private ReadingListVisibility = "private"
+	Shared  ReadingListVisibility = "shared"
+)
+
+// Defines values for SubscriberStatus.
+const (
+	SubscriberStatusConfirmed    SubscriberStatus = "confirmed"
+	SubscriberStatusPending      SubscriberStatus = "pending"
+	SubscriberStatusUnsubscribed SubscriberStatus = "unsubscribed"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeCommentCreated WebhookEventType = "comment.created"
+	WebhookEventTypeCommentDeleted WebhookEventType = "comment.deleted"
+	WebhookEventTypeCommentUpdated WebhookEventType = "comment.updated"
+	WebhookEventTypePostDeleted    WebhookEventType = "post.deleted"
+	WebhookEventTypePostPublished  WebhookEventType = "post.published"
+	WebhookEventTypePostUpdated    WebhookEventType = "post.updated"
+)
+
+// Defines values for GetApiV1AuthorsUsernamePostsParamsSort.
+const (
+	GetApiV1AuthorsUsernamePostsParamsSortCreatedAtAsc  GetApiV1AuthorsUsernamePostsParamsSort = "created_at_asc"
+	GetApiV1AuthorsUsernamePostsParamsSortCreatedAtDesc GetApiV1AuthorsUsernamePostsParamsSort = "created_at_desc"
+	GetApiV1AuthorsUsernamePostsParamsSortPopular       GetApiV1AuthorsUsernamePostsParamsSort = "popular"
+	GetApiV1AuthorsUsernamePostsParamsSortTitleAsc      GetApiV1AuthorsUsernamePostsParamsSort = "title_asc"
+	GetApiV1AuthorsUsernamePostsParamsSortTitleDesc     GetApiV1AuthorsUsernamePostsParamsSort = "title_desc"
+)
+
+// Defines values for GetApiV1ModerationCommentsParamsSort.
+const (
+	GetApiV1ModerationCommentsParamsSortCreatedAtAsc  GetApiV1ModerationCommentsParamsSort = "created_at_asc"
+	GetApiV1ModerationCommentsParamsSortCreatedAtDesc GetApiV1ModerationCommentsParamsSort = "created_at_desc"
 )
 
 // Defines values for GetApiV1PostsPostIdCommentsParamsSort.
@@ -41,103 +134,466 @@ const (
 
 // Defines values for GetApiV1UsersParamsSort.
 const (
-	CreatedAtAsc  GetApiV1UsersParamsSort = "created_at_asc"
-	CreatedAtDesc GetApiV1UsersParamsSort = "created_at_desc"
-	UsernameAsc   GetApiV1UsersParamsSort = "username_asc"
-	UsernameDesc  GetApiV1UsersParamsSort = "username_desc"
+	GetApiV1UsersParamsSortCreatedAtAsc  GetApiV1UsersParamsSort = "created_at_asc"
+	GetApiV1UsersParamsSortCreatedAtDesc GetApiV1UsersParamsSort = "created_at_desc"
+	GetApiV1UsersParamsSortUsernameAsc   GetApiV1UsersParamsSort = "username_asc"
+	GetApiV1UsersParamsSortUsernameDesc  GetApiV1UsersParamsSort = "username_desc"
 )
 
+// Author Public view of a user, their profile along with counts
+type Author = entity.Author
+
+// AuthorSummary Public summary of a post or comment author; only present with include=author
+type AuthorSummary = entity.AuthorSummary
+
+// Bookmark defines model for Bookmark.
+type Bookmark = entity.Bookmark
+
 // Comment defines model for Comment.
-type Comment struct {
-	AuthorId  openapi_types.UUID `json:"authorId"`
-	Content   string             `json:"content"`
-	CreatedAt time.Time          `json:"createdAt"`
-	Id        openapi_types.UUID `json:"id"`
-	PostId    openapi_types.UUID `json:"postId"`
-	UpdatedAt time.Time          `json:"updatedAt"`
-}
+type Comment = entity.Comment
+
+// CommentMergePatch defines model for CommentMergePatch.
+type CommentMergePatch = json.RawMessage
+
+// CommentStatus Moderation status of a comment
+type CommentStatus string
+
+// Event defines model for Event.
+type Event = entity.Event
 
-// NewComment defines model for NewComment.
-type NewComment struct {
-	AuthorId openapi_types.UUID `json:"authorId"`
-	Content  string             `json:"content"`
-	PostId   openapi_types.UUID `json:"postId"`
+// FeedPage defines model for FeedPage.
+type FeedPage = entity.CursorPage[entity.Post]
+
+// FieldError defines model for FieldError.
+type FieldError = problem.FieldError
+
+// JSONPatch RFC 6902 operations, applied in order and all or nothing
+type JSONPatch = json.RawMessage
+
+// JSONPatchOperation defines model for JSONPatchOperation.
+type JSONPatchOperation struct {
+	// From JSON Pointer to the source of move and copy
+	From *string              `json:"from,omitempty"`
+	Op   JSONPatchOperationOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the target
+	Path string `json:"path"`
+
+	// Value The value of add, replace and test
+	Value *interface{} `json:"value,omitempty"`
 }
 
+// JSONPatchOperationOp defines model for JSONPatchOperation.Op.
+type JSONPatchOperationOp string
+
+// Job defines model for Job.
+type Job = entity.Job
+
+// JobStatus defines model for JobStatus.
+type JobStatus string
+
+// ModerationDecision defines model for ModerationDecision.
+type ModerationDecision = entity.ModerationDecision
+
+// NewBookmark defines model for NewBookmark.
+type NewBookmark = entity.NewBookmark
+
+// NewComment The post is taken from the path and the author from the bearer token.
+type NewComment = entity.NewComment
+
 // NewPost defines model for NewPost.
-type NewPost struct {
-	AuthorId openapi_types.UUID `json:"authorId"`
-	Content  string             `json:"content"`
-	Title    string             `json:"title"`
-}
+type NewPost = entity.NewPost
+
+// NewReadingList defines model for NewReadingList.
+type NewReadingList = entity.NewReadingList
+
+// NewSubscription Set authorId or tag to follow one author or tag; leave both out for the whole blog.
+type NewSubscription = entity.NewSubscription
 
 // NewUser defines model for NewUser.
-type NewUser struct {
-	Email    openapi_types.Email `json:"email"`
-	Password string              `json:"password"`
-	Username string              `json:"username"`
-}
+type NewUser = entity.NewUser
 
-// Pagination defines model for Pagination.
-type Pagination struct {
-	// Limit Number of items per page
-	Limit int `json:"limit"`
+// NewWebhook defines model for NewWebhook.
+type NewWebhook = entity.NewWebhook
 
-	// Offset Number of items to skip before starting to collect the result set
-	Offset int `json:"offset"`
+// Notification defines model for Notification.
+type Notification = entity.Notification
 
-	// Page Current page number
-	Page int `json:"page"`
+// NotificationPreference defines model for NotificationPreference.
+type NotificationPreference = entity.NotificationPreference
 
-	// Sort Sorting order
-	Sort string `json:"sort"`
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences = entity.UpdateNotificationPreferences
 
-	// Total Total number of items
-	Total int `json:"total"`
-}
+// NotificationType defines model for NotificationType.
+type NotificationType string
+
+// Pagination defines model for Pagination.
+type Pagination = entity.Pagination
 
 // Post defines model for Post.
-type Post struct {
-	AuthorId  openapi_types.UUID `json:"authorId"`
-	Content   string             `json:"content"`
-	CreatedAt time.Time          `json:"createdAt"`
-	Id        openapi_types.UUID `json:"id"`
-	Title     string             `json:"title"`
-	UpdatedAt time.Time          `json:"updatedAt"`
+type Post = entity.Post
+
+// PostMergePatch defines model for PostMergePatch.
+type PostMergePatch = json.RawMessage
+
+// PostModeration defines model for PostModeration.
+type PostModeration = entity.PostModeration
+
+// PostStatus Only published posts are shown to anyone but their author
+type PostStatus string
+
+// Presence defines model for Presence.
+type Presence = entity.Presence
+
+// PresenceMessage defines model for PresenceMessage.
+type PresenceMessage struct {
+	// Data The updated post
+	Data    *map[string]interface{} `json:"data,omitempty"`
+	Error   *string                 `json:"error,omitempty"`
+	Type    PresenceMessageType     `json:"type"`
+	Viewers *[]Presence             `json:"viewers,omitempty"`
 }
 
+// PresenceMessageType defines model for PresenceMessage.Type.
+type PresenceMessageType string
+
+// Problem An RFC 7807 problem, the body of every error response. The type is
+// about:blank when the status says it all, otherwise one of:
+//
+//   - /problems/validation-failed: the request or the resource it would
+//     produce is invalid; errors lists the invalid fields
+//   - /problems/version-mismatch: the If-Match ETag is stale
+//   - /problems/precondition-required: If-Match is missing
+//   - /problems/field-not-writable: the caller may not change a field
+//   - /problems/edit-window-expired: the comment can no longer be edited
+//   - /problems/comments-closed: the post no longer takes comments
+//   - /problems/spam-detected: the content was rejected as spam
+//   - /problems/user-exists: the username or email is taken
+//   - /problems/job-not-retryable: only failed jobs can be retried
+//   - /problems/patch-conflict: a JSON Patch operation doesn't apply
+type Problem = problem.Details
+
+// Profile defines model for Profile.
+type Profile = entity.Profile
+
+// ReactionSummary defines model for ReactionSummary.
+type ReactionSummary = entity.ReactionSummary
+
+// ReadingList defines model for ReadingList.
+type ReadingList = entity.ReadingList
+
+// ReadingListItem defines model for ReadingListItem.
+type ReadingListItem = entity.ReadingListItem
+
+// ReadingListOrder defines model for ReadingListOrder.
+type ReadingListOrder = entity.ReadingListOrder
+
+// ReadingListVisibility Shared lists can be read by anyone with the share link
+type ReadingListVisibility string
+
+// Subscriber defines model for Subscriber.
+type Subscriber = entity.Subscriber
+
+// SubscriberStatus defines model for SubscriberStatus.
+type SubscriberStatus string
+
+// UpdateComment defines model for UpdateComment.
+type UpdateComment = entity.UpdateComment
+
 // UpdatePost defines model for UpdatePost.
-type UpdatePost struct {
-	Content *string `json:"content,omitempty"`
-	Title   *string `json:"title,omitempty"`
-}
+type UpdatePost = entity.Post
 
 // UpdateUser defines model for UpdateUser.
-type UpdateUser struct {
-	Email    *openapi_types.Email `json:"email,omitempty"`
-	Password *string              `json:"password,omitempty"`
-	Username *string              `json:"username,omitempty"`
-}
+type UpdateUser = entity.UpdateUser
+
+// UpdateWebhook defines model for UpdateWebhook.
+type UpdateWebhook = entity.UpdateWebhook
 
 // User defines model for User.
-type User struct {
-	CreatedAt time.Time           `json:"createdAt"`
-	Email     openapi_types.Email `json:"email"`
-	Id        openapi_types.UUID  `json:"id"`
-	UpdatedAt time.Time           `json:"updatedAt"`
-	Username  string              `json:"username"`
+type User = entity.User
+
+// UserMergePatch defines model for UserMergePatch.
+type UserMergePatch = json.RawMessage
+
+// Webhook defines model for Webhook.
+type Webhook = entity.Webhook
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery = entity.WebhookDelivery
+
+// WebhookDeliveryStatus defines model for WebhookDeliveryStatus.
+type WebhookDeliveryStatus string
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// CommentFields defines model for CommentFields.
+type CommentFields = string
+
+// CommentInclude defines model for CommentInclude.
+type CommentInclude = string
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// IncludeTotal defines model for IncludeTotal.
+type IncludeTotal = bool
+
+// NotificationId defines model for NotificationId.
+type NotificationId = openapi_types.UUID
+
+// PostFields defines model for PostFields.
+type PostFields = string
+
+// PostInclude defines model for PostInclude.
+type PostInclude = string
+
+// ReactionKind defines model for ReactionKind.
+type ReactionKind = string
+
+// ReadingListId defines model for ReadingListId.
+type ReadingListId = openapi_types.UUID
+
+// UserFields defines model for UserFields.
+type UserFields = string
+
+// Username defines model for Username.
+type Username = string
+
+// PreconditionFailed An RFC 7807 problem, the body of every error response. The type is
+// about:blank when the status says it all, otherwise one of:
+//
+//   - /problems/validation-failed: the request or the resource it would
+//     produce is invalid; errors lists the invalid fields
+//   - /problems/version-mismatch: the If-Match ETag is stale
+//   - /problems/precondition-required: If-Match is missing
+//   - /problems/field-not-writable: the caller may not change a field
+//   - /problems/edit-window-expired: the comment can no longer be edited
+//   - /problems/comments-closed: the post no longer takes comments
+//   - /problems/spam-detected: the content was rejected as spam
+//   - /problems/user-exists: the username or email is taken
+//   - /problems/job-not-retryable: only failed jobs can be retried
+//   - /problems/patch-conflict: a JSON Patch operation doesn't apply
+type PreconditionFailed = Problem
+
+// PreconditionRequired An RFC 7807 problem, the body of every error response. The type is
+// about:blank when the status says it all, otherwise one of:
+//
+//   - /problems/validation-failed: the request or the resource it would
+//     produce is invalid; errors lists the invalid fields
+//   - /problems/version-mismatch: the If-Match ETag is stale
+//   - /problems/precondition-required: If-Match is missing
+//   - /problems/field-not-writable: the caller may not change a field
+//   - /problems/edit-window-expired: the comment can no longer be edited
+//   - /problems/comments-closed: the post no longer takes comments
+//   - /problems/spam-detected: the content was rejected as spam
+//   - /problems/user-exists: the username or email is taken
+//   - /problems/job-not-retryable: only failed jobs can be retried
+//   - /problems/patch-conflict: a JSON Patch operation doesn't apply
+type PreconditionRequired = Problem
+
+// GetApiV1AdminJobsParams defines parameters for GetApiV1AdminJobs.
+type GetApiV1AdminJobsParams struct {
+	Status *JobStatus `form:"status,omitempty" json:"status,omitempty"`
+	Queue  *string    `form:"queue,omitempty" json:"queue,omitempty"`
+	Page   *int       `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int       `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiV1AdminWebhooksWebhookIdDeliveriesParams defines parameters for GetApiV1AdminWebhooksWebhookIdDeliveries.
+type GetApiV1AdminWebhooksWebhookIdDeliveriesParams struct {
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page   *int                   `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int                   `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int                   `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetApiV1AuthorsUsernamePostsParams defines parameters for GetApiV1AuthorsUsernamePosts.
+type GetApiV1AuthorsUsernamePostsParams struct {
+	Page   *int                                    `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int                                    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int                                    `form:"offset,omitempty" json:"offset,omitempty"`
+	Sort   *GetApiV1AuthorsUsernamePostsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor from nextCursor or prevCursor of a previous page. It replaces page and offset, and must be used with the sort it came from.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Count the total number of items, which is left out otherwise
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+
+	// Fields Comma-separated post attributes to return; only their columns are read. excerpt is a plain-text preview of the content of at most 200 characters and is only returned when asked for, so listings can ask for it instead of content. Each post then carries only these attributes.
+	Fields *PostFields `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Include Comma-separated related resources to embed in each post: author, comment_count and tags. Without the parameter only tags are embedded; an empty value embeds nothing.
+	Include *PostInclude `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// GetApiV1AuthorsUsernamePostsParamsSort defines parameters for GetApiV1AuthorsUsernamePosts.
+type GetApiV1AuthorsUsernamePostsParamsSort string
+
+// DeleteApiV1CommentsCommentIdParams defines parameters for DeleteApiV1CommentsCommentId.
+type DeleteApiV1CommentsCommentIdParams struct {
+	// IfMatch The ETag the resource was read with. Only the version it starts with is compared. The write fails with 412 when someone else changed the resource since, and with 428 when the header is missing and the server requires it. "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetApiV1CommentsCommentIdParams defines parameters for GetApiV1CommentsCommentId.
+type GetApiV1CommentsCommentIdParams struct {
+	// IfNoneMatch ETags the client already has. The response is 304 Not Modified when one of them names the body that would be sent.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PatchApiV1CommentsCommentIdParams defines parameters for PatchApiV1CommentsCommentId.
+type PatchApiV1CommentsCommentIdParams struct {
+	// IfMatch The ETag the resource was read with. Only the version it starts with is compared. The write fails with 412 when someone else changed the resource since, and with 428 when the header is missing and the server requires it. "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutApiV1CommentsCommentIdParams defines parameters for PutApiV1CommentsCommentId.
+type PutApiV1CommentsCommentIdParams struct {
+	// IfMatch The ETag the resource was read with. Only the version it starts with is compared. The write fails with 412 when someone else changed the resource since, and with 428 when the header is missing and the server requires it. "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetApiV1FeedParams defines parameters for GetApiV1Feed.
+type GetApiV1FeedParams struct {
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetApiV1MeBookmarksParams defines parameters for GetApiV1MeBookmarks.
+type GetApiV1MeBookmarksParams struct {
+	Page   *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque cursor from nextCursor or prevCursor of a previous page. It replaces page and offset, and must be used with the sort it came from.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Count the total number of items, which is left out otherwise
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// GetApiV1ModerationCommentsParams defines parameters for GetApiV1ModerationComments.
+type GetApiV1ModerationCommentsParams struct {
+	// Status Comments with this status are listed, pending by default
+	Status *CommentStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page   *int           `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int           `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int           `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sorting order for comments
+	Sort *GetApiV1ModerationCommentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor from nextCursor or prevCursor of a previous page. It replaces page and offset, and must be used with the sort it came from.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Count the total number of items, which is left out otherwise
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
+// GetApiV1ModerationCommentsParamsSort defines parameters for GetApiV1ModerationComments.
+type GetApiV1ModerationCommentsParamsSort string
+
+// GetApiV1NewsletterConfirmParams defines parameters for GetApiV1NewsletterConfirm.
+type GetApiV1NewsletterConfirmParams struct {
+	Token string `form:"token" json:"token"`
+}
+
+// GetApiV1NewsletterUnsubscribeParams defines parameters for GetApiV1NewsletterUnsubscribe.
+type GetApiV1NewsletterUnsubscribeParams struct {
+	Token string `form:"token" json:"token"`
+}
+
+// PostApiV1NewsletterUnsubscribeParams defines parameters for PostApiV1NewsletterUnsubscribe.
+type PostApiV1NewsletterUnsubscribeParams struct {
+	Token string `form:"token" json:"token"`
+}
+
+// GetApiV1NotificationsParams defines parameters for GetApiV1Notifications.
+type GetApiV1NotificationsParams struct {
+	Page   *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Unread Only return notifications that have not been read yet
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
 }
 
 // GetApiV1PostsParams defines parameters for GetApiV1Posts.
 type GetApiV1PostsParams struct {
-	Page   *int                     `form:"page,omitempty" json:"page,omitempty"`
-	Limit  *int                     `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset *int                     `form:"offset,omitempty" json:"offset,omitempty"`
-	Sort   *GetApiV1PostsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page   *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Sorting order for posts: a comma-separated list of field_asc or field_desc terms, most significant first, over created_at, updated_at, title and reactions. popular is short for reactions_desc,created_at_desc. Posts with equal keys are ordered by id.
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Author Only posts by the author with this username
+	Author *string `form:"author,omitempty" json:"author,omitempty"`
+
+	// CreatedAfter Only posts created at or after this time
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Only posts created before this time
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Status Only posts with this status. Drafts and archived posts are only listed for their author.
+	Status *PostStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Tag Only posts with this tag
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Q Only posts whose title starts with this text, ignoring case
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Cursor Opaque cursor from nextCursor or prevCursor of a previous page. It replaces page and offset, and must be used with the sort it came from.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Count the total number of items, which is left out otherwise
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+
+	// Fields Comma-separated post attributes to return; only their columns are read. excerpt is a plain-text preview of the content of at most 200 characters and is only returned when asked for, so listings can ask for it instead of content. Each post then carries only these attributes.
+	Fields *PostFields `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Include Comma-separated related resources to embed in each post: author, comment_count and tags. Without the parameter only tags are embedded; an empty value embeds nothing.
+	Include *PostInclude `form:"include,omitempty" json:"include,omitempty"`
+}
+
+// DeleteApiV1PostsPostIdParams defines parameters for DeleteApiV1PostsPostId.
+type DeleteApiV1PostsPostIdParams struct {
+	// IfMatch The ETag the resource was read with. Only the version it starts with is compared. The write fails with 412 when someone else changed the resource since, and with 428 when the header is missing and the server requires it. "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetApiV1PostsPostIdParams defines parameters for GetApiV1PostsPostId.
+type GetApiV1PostsPostIdParams struct {
+	// Include Comma-separated related resources to embed in each post: author, comment_count and tags. Without the parameter only tags are embedded; an empty value embeds nothing.
+	Include *PostInclude `form:"include,omitempty" json:"include,omitempty"`
+
+	// IfNoneMatch ETags the client already has. The response is 304 Not Modified when one of them names the body that would be sent.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PatchApiV1PostsPostIdParams defines parameters for PatchApiV1PostsPostId.
+type PatchApiV1PostsPostIdParams struct {
+	// IfMatch The ETag the resource was read with. Only the version it starts with is compared. The write fails with 412 when someone else changed the resource since, and with 428 when the header is missing and the server requires it. "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
-// GetApiV1PostsParamsSort defines parameters for GetApiV1Posts.
-type GetApiV1PostsParamsSort string
+// PutApiV1PostsPostIdParams defines parameters for PutApiV1PostsPostId.
+type PutApiV1PostsPostIdParams struct {
+	// IfMatch The ETag the resource was read with. Only the version it starts with is compared. The write fails with 412 when someone else changed the resource since, and with 428 when the header is missing and the server requires it. "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
 
 // GetApiV1PostsPostIdCommentsParams defines parameters for GetApiV1PostsPostIdComments.
 type GetApiV1PostsPostIdCommentsParams struct {
@@ -147,48 +603,259 @@ type GetApiV1PostsPostIdCommentsParams struct {
 
 	// Sort Sorting order for comments
 	Sort *GetApiV1PostsPostIdCommentsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor from nextCursor or prevCursor of a previous page. It replaces page and offset, and must be used with the sort it came from.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Count the total number of items, which is left out otherwise
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+
+	// Fields Comma-separated comment attributes to return; only their columns are read
+	Fields *CommentFields `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Include Related resources to embed in each comment; only author is supported
+	Include *CommentInclude `form:"include,omitempty" json:"include,omitempty"`
 }
 
 // GetApiV1PostsPostIdCommentsParamsSort defines parameters for GetApiV1PostsPostIdComments.
 type GetApiV1PostsPostIdCommentsParamsSort string
 
+// GetApiV1StreamParams defines parameters for GetApiV1Stream.
+type GetApiV1StreamParams struct {
+	// Topics Comma separated list of topics
+	Topics      string  `form:"topics" json:"topics"`
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // GetApiV1UsersParams defines parameters for GetApiV1Users.
 type GetApiV1UsersParams struct {
 	Page   *int                     `form:"page,omitempty" json:"page,omitempty"`
 	Limit  *int                     `form:"limit,omitempty" json:"limit,omitempty"`
 	Offset *int                     `form:"offset,omitempty" json:"offset,omitempty"`
 	Sort   *GetApiV1UsersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor from nextCursor or prevCursor of a previous page. It replaces page and offset, and must be used with the sort it came from.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Count the total number of items, which is left out otherwise
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+
+	// Fields Comma-separated user attributes to return; only their columns are read
+	Fields *UserFields `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // GetApiV1UsersParamsSort defines parameters for GetApiV1Users.
 type GetApiV1UsersParamsSort string
 
 // PostAuthLoginJSONBody defines parameters for PostAuthLogin.
-type PostAuthLoginJSONBody struct {
-	Password string `json:"password"`
-	Username string `json:"username"`
-}
+type PostAuthLoginJSONBody = entity.LoginCredentials
+
+// PostApiV1AdminWebhooksJSONRequestBody defines body for PostApiV1AdminWebhooks for application/json ContentType.
+type PostApiV1AdminWebhooksJSONRequestBody = NewWebhook
+
+// PutApiV1AdminWebhooksWebhookIdJSONRequestBody defines body for PutApiV1AdminWebhooksWebhookId for application/json ContentType.
+type PutApiV1AdminWebhooksWebhookIdJSONRequestBody = UpdateWebhook
+
+// PatchApiV1CommentsCommentIdApplicationJSONPatchPlusJSONRequestBody defines body for PatchApiV1CommentsCommentId for application/json-patch+json ContentType.
+type PatchApiV1CommentsCommentIdApplicationJSONPatchPlusJSONRequestBody = JSONPatch
+
+// PatchApiV1CommentsCommentIdApplicationMergePatchPlusJSONRequestBody defines body for PatchApiV1CommentsCommentId for application/merge-patch+json ContentType.
+type PatchApiV1CommentsCommentIdApplicationMergePatchPlusJSONRequestBody = CommentMergePatch
+
+// PutApiV1CommentsCommentIdJSONRequestBody defines body for PutApiV1CommentsCommentId for application/json ContentType.
+type PutApiV1CommentsCommentIdJSONRequestBody = UpdateComment
+
+// PutApiV1MeNotificationPreferencesJSONRequestBody defines body for PutApiV1MeNotificationPreferences for application/json ContentType.
+type PutApiV1MeNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// PutApiV1MeProfileJSONRequestBody defines body for PutApiV1MeProfile for application/json ContentType.
+type PutApiV1MeProfileJSONRequestBody = Profile
+
+// PostApiV1MeReadingListsJSONRequestBody defines body for PostApiV1MeReadingLists for application/json ContentType.
+type PostApiV1MeReadingListsJSONRequestBody = NewReadingList
+
+// PutApiV1MeReadingListsListIdJSONRequestBody defines body for PutApiV1MeReadingListsListId for application/json ContentType.
+type PutApiV1MeReadingListsListIdJSONRequestBody = NewReadingList
+
+// PutApiV1MeReadingListsListIdOrderJSONRequestBody defines body for PutApiV1MeReadingListsListIdOrder for application/json ContentType.
+type PutApiV1MeReadingListsListIdOrderJSONRequestBody = ReadingListOrder
+
+// PostApiV1ModerationCommentsJSONRequestBody defines body for PostApiV1ModerationComments for application/json ContentType.
+type PostApiV1ModerationCommentsJSONRequestBody = ModerationDecision
+
+// PostApiV1NewsletterSubscriptionsJSONRequestBody defines body for PostApiV1NewsletterSubscriptions for application/json ContentType.
+type PostApiV1NewsletterSubscriptionsJSONRequestBody = NewSubscription
 
 // PostApiV1PostsJSONRequestBody defines body for PostApiV1Posts for application/json ContentType.
 type PostApiV1PostsJSONRequestBody = NewPost
 
+// PatchApiV1PostsPostIdApplicationJSONPatchPlusJSONRequestBody defines body for PatchApiV1PostsPostId for application/json-patch+json ContentType.
+type PatchApiV1PostsPostIdApplicationJSONPatchPlusJSONRequestBody = JSONPatch
+
+// PatchApiV1PostsPostIdApplicationMergePatchPlusJSONRequestBody defines body for PatchApiV1PostsPostId for application/merge-patch+json ContentType.
+type PatchApiV1PostsPostIdApplicationMergePatchPlusJSONRequestBody = PostMergePatch
+
 // PutApiV1PostsPostIdJSONRequestBody defines body for PutApiV1PostsPostId for application/json ContentType.
 type PutApiV1PostsPostIdJSONRequestBody = UpdatePost
 
+// PutApiV1PostsPostIdBookmarkJSONRequestBody defines body for PutApiV1PostsPostIdBookmark for application/json ContentType.
+type PutApiV1PostsPostIdBookmarkJSONRequestBody = NewBookmark
+
 // PostApiV1PostsPostIdCommentsJSONRequestBody defines body for PostApiV1PostsPostIdComments for application/json ContentType.
 type PostApiV1PostsPostIdCommentsJSONRequestBody = NewComment
 
+// PutApiV1PostsPostIdModerationJSONRequestBody defines body for PutApiV1PostsPostIdModeration for application/json ContentType.
+type PutApiV1PostsPostIdModerationJSONRequestBody = PostModeration
+
+// PatchApiV1UsersUserIdApplicationJSONPatchPlusJSONRequestBody defines body for PatchApiV1UsersUserId for application/json-patch+json ContentType.
+type PatchApiV1UsersUserIdApplicationJSONPatchPlusJSONRequestBody = JSONPatch
+
+// PatchApiV1UsersUserIdApplicationMergePatchPlusJSONRequestBody defines body for PatchApiV1UsersUserId for application/merge-patch+json ContentType.
+type PatchApiV1UsersUserIdApplicationMergePatchPlusJSONRequestBody = UserMergePatch
+
 // PutApiV1UsersUserIdJSONRequestBody defines body for PutApiV1UsersUserId for application/json ContentType.
 type PutApiV1UsersUserIdJSONRequestBody = UpdateUser
 
 // PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
-type PostAuthLoginJSONRequestBody PostAuthLoginJSONBody
+type PostAuthLoginJSONRequestBody = PostAuthLoginJSONBody
 
 // PostAuthRegisterJSONRequestBody defines body for PostAuthRegister for application/json ContentType.
 type PostAuthRegisterJSONRequestBody = NewUser
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get background jobs
+	// (GET /api/v1/admin/jobs)
+	GetApiV1AdminJobs(w http.ResponseWriter, r *http.Request, params GetApiV1AdminJobsParams)
+	// Get a background job
+	// (GET /api/v1/admin/jobs/{jobId})
+	GetApiV1AdminJobsJobId(w http.ResponseWriter, r *http.Request, jobId openapi_types.UUID)
+	// Retry a failed job
+	// (POST /api/v1/admin/jobs/{jobId}/retry)
+	PostApiV1AdminJobsJobIdRetry(w http.ResponseWriter, r *http.Request, jobId openapi_types.UUID)
+	// List webhook subscriptions
+	// (GET /api/v1/admin/webhooks)
+	GetApiV1AdminWebhooks(w http.ResponseWriter, r *http.Request)
+	// Create a webhook subscription
+	// (POST /api/v1/admin/webhooks)
+	PostApiV1AdminWebhooks(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook subscription and its delivery log
+	// (DELETE /api/v1/admin/webhooks/{webhookId})
+	DeleteApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
+	// Get a webhook subscription
+	// (GET /api/v1/admin/webhooks/{webhookId})
+	GetApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
+	// Update a webhook subscription
+	// (PUT /api/v1/admin/webhooks/{webhookId})
+	PutApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
+	// Get the delivery log of a webhook
+	// (GET /api/v1/admin/webhooks/{webhookId}/deliveries)
+	GetApiV1AdminWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, params GetApiV1AdminWebhooksWebhookIdDeliveriesParams)
+	// Queue a delivery again
+	// (POST /api/v1/admin/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+	PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, deliveryId openapi_types.UUID)
+	// Send a test event
+	// (POST /api/v1/admin/webhooks/{webhookId}/test)
+	PostApiV1AdminWebhooksWebhookIdTest(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
+	// Get a public author page
+	// (GET /api/v1/authors/{username})
+	GetApiV1AuthorsUsername(w http.ResponseWriter, r *http.Request, username Username)
+	// Unfollow an author
+	// (DELETE /api/v1/authors/{username}/follow)
+	DeleteApiV1AuthorsUsernameFollow(w http.ResponseWriter, r *http.Request, username Username)
+	// Follow an author
+	// (PUT /api/v1/authors/{username}/follow)
+	PutApiV1AuthorsUsernameFollow(w http.ResponseWriter, r *http.Request, username Username)
+	// Get the posts of an author
+	// (GET /api/v1/authors/{username}/posts)
+	GetApiV1AuthorsUsernamePosts(w http.ResponseWriter, r *http.Request, username Username, params GetApiV1AuthorsUsernamePostsParams)
+	// Delete a comment
+	// (DELETE /api/v1/comments/{commentId})
+	DeleteApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params DeleteApiV1CommentsCommentIdParams)
+	// Get a specific comment
+	// (GET /api/v1/comments/{commentId})
+	GetApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params GetApiV1CommentsCommentIdParams)
+	// Patch a comment
+	// (PATCH /api/v1/comments/{commentId})
+	PatchApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params PatchApiV1CommentsCommentIdParams)
+	// Update a comment
+	// (PUT /api/v1/comments/{commentId})
+	PutApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params PutApiV1CommentsCommentIdParams)
+	// Remove a reaction from a comment
+	// (DELETE /api/v1/comments/{commentId}/reactions/{kind})
+	DeleteApiV1CommentsCommentIdReactionsKind(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, kind ReactionKind)
+	// React to a comment
+	// (PUT /api/v1/comments/{commentId}/reactions/{kind})
+	PutApiV1CommentsCommentIdReactionsKind(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, kind ReactionKind)
+	// Get the home feed
+	// (GET /api/v1/feed)
+	GetApiV1Feed(w http.ResponseWriter, r *http.Request, params GetApiV1FeedParams)
+	// Get the current user's bookmarks
+	// (GET /api/v1/me/bookmarks)
+	GetApiV1MeBookmarks(w http.ResponseWriter, r *http.Request, params GetApiV1MeBookmarksParams)
+	// Get the current user's notification preferences
+	// (GET /api/v1/me/notification-preferences)
+	GetApiV1MeNotificationPreferences(w http.ResponseWriter, r *http.Request)
+	// Update the current user's notification preferences
+	// (PUT /api/v1/me/notification-preferences)
+	PutApiV1MeNotificationPreferences(w http.ResponseWriter, r *http.Request)
+	// Get the current user's profile
+	// (GET /api/v1/me/profile)
+	GetApiV1MeProfile(w http.ResponseWriter, r *http.Request)
+	// Replace the current user's profile
+	// (PUT /api/v1/me/profile)
+	PutApiV1MeProfile(w http.ResponseWriter, r *http.Request)
+	// Get the current user's reading lists
+	// (GET /api/v1/me/reading-lists)
+	GetApiV1MeReadingLists(w http.ResponseWriter, r *http.Request)
+	// Create a reading list
+	// (POST /api/v1/me/reading-lists)
+	PostApiV1MeReadingLists(w http.ResponseWriter, r *http.Request)
+	// Delete a reading list
+	// (DELETE /api/v1/me/reading-lists/{listId})
+	DeleteApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId)
+	// Get one of the current user's reading lists with its items
+	// (GET /api/v1/me/reading-lists/{listId})
+	GetApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId)
+	// Rename a reading list or change its visibility
+	// (PUT /api/v1/me/reading-lists/{listId})
+	PutApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId)
+	// Remove a post from a reading list
+	// (DELETE /api/v1/me/reading-lists/{listId}/items/{postId})
+	DeleteApiV1MeReadingListsListIdItemsPostId(w http.ResponseWriter, r *http.Request, listId ReadingListId, postId openapi_types.UUID)
+	// Add a post to the end of a reading list
+	// (PUT /api/v1/me/reading-lists/{listId}/items/{postId})
+	PutApiV1MeReadingListsListIdItemsPostId(w http.ResponseWriter, r *http.Request, listId ReadingListId, postId openapi_types.UUID)
+	// Reorder a reading list
+	// (PUT /api/v1/me/reading-lists/{listId}/order)
+	PutApiV1MeReadingListsListIdOrder(w http.ResponseWriter, r *http.Request, listId ReadingListId)
+	// Get the comment moderation queue
+	// (GET /api/v1/moderation/comments)
+	GetApiV1ModerationComments(w http.ResponseWriter, r *http.Request, params GetApiV1ModerationCommentsParams)
+	// Approve, reject or mark comments as spam in bulk
+	// (POST /api/v1/moderation/comments)
+	PostApiV1ModerationComments(w http.ResponseWriter, r *http.Request)
+	// Confirm a newsletter subscription
+	// (GET /api/v1/newsletter/confirm)
+	GetApiV1NewsletterConfirm(w http.ResponseWriter, r *http.Request, params GetApiV1NewsletterConfirmParams)
+	// Subscribe to the newsletter
+	// (POST /api/v1/newsletter/subscriptions)
+	PostApiV1NewsletterSubscriptions(w http.ResponseWriter, r *http.Request)
+	// Check an unsubscribe link
+	// (GET /api/v1/newsletter/unsubscribe)
+	GetApiV1NewsletterUnsubscribe(w http.ResponseWriter, r *http.Request, params GetApiV1NewsletterUnsubscribeParams)
+	// Unsubscribe from the newsletter
+	// (POST /api/v1/newsletter/unsubscribe)
+	PostApiV1NewsletterUnsubscribe(w http.ResponseWriter, r *http.Request, params PostApiV1NewsletterUnsubscribeParams)
+	// Get the current user's notifications
+	// (GET /api/v1/notifications)
+	GetApiV1Notifications(w http.ResponseWriter, r *http.Request, params GetApiV1NotificationsParams)
+	// Mark all notifications as read
+	// (POST /api/v1/notifications/read-all)
+	PostApiV1NotificationsReadAll(w http.ResponseWriter, r *http.Request)
+	// Mark a notification as read
+	// (POST /api/v1/notifications/{notificationId}/read)
+	PostApiV1NotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId NotificationId)
 	// Get all posts
 	// (GET /api/v1/posts)
 	GetApiV1Posts(w http.ResponseWriter, r *http.Request, params GetApiV1PostsParams)
@@ -197,19 +864,46 @@ type ServerInterface interface {
 	PostApiV1Posts(w http.ResponseWriter, r *http.Request)
 	// Delete a post
 	// (DELETE /api/v1/posts/{postId})
-	DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID)
+	DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params DeleteApiV1PostsPostIdParams)
 	// Get a specific post
 	// (GET /api/v1/posts/{postId})
-	GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID)
+	GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params GetApiV1PostsPostIdParams)
+	// Patch a post
+	// (PATCH /api/v1/posts/{postId})
+	PatchApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params PatchApiV1PostsPostIdParams)
 	// Update a post
 	// (PUT /api/v1/posts/{postId})
-	PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID)
+	PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params PutApiV1PostsPostIdParams)
+	// Remove a bookmark
+	// (DELETE /api/v1/posts/{postId}/bookmark)
+	DeleteApiV1PostsPostIdBookmark(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID)
+	// Bookmark a post
+	// (PUT /api/v1/posts/{postId}/bookmark)
+	PutApiV1PostsPostIdBookmark(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID)
 	// Get comments for a specific post
 	// (GET /api/v1/posts/{postId}/comments)
 	GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params GetApiV1PostsPostIdCommentsParams)
 	// Add a comment to a post
 	// (POST /api/v1/posts/{postId}/comments)
 	PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID)
+	// Set the comment moderation mode of a post
+	// (PUT /api/v1/posts/{postId}/moderation)
+	PutApiV1PostsPostIdModeration(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID)
+	// Join the live presence of a post
+	// (GET /api/v1/posts/{postId}/presence)
+	GetApiV1PostsPostIdPresence(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID)
+	// Remove a reaction from a post
+	// (DELETE /api/v1/posts/{postId}/reactions/{kind})
+	DeleteApiV1PostsPostIdReactionsKind(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, kind ReactionKind)
+	// React to a post
+	// (PUT /api/v1/posts/{postId}/reactions/{kind})
+	PutApiV1PostsPostIdReactionsKind(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, kind ReactionKind)
+	// Get a shared reading list
+	// (GET /api/v1/reading-lists/shared/{token})
+	GetApiV1ReadingListsSharedToken(w http.ResponseWriter, r *http.Request, token string)
+	// Subscribe to real-time updates
+	// (GET /api/v1/stream)
+	GetApiV1Stream(w http.ResponseWriter, r *http.Request, params GetApiV1StreamParams)
 	// Get all users
 	// (GET /api/v1/users)
 	GetApiV1Users(w http.ResponseWriter, r *http.Request, params GetApiV1UsersParams)
@@ -219,6 +913,9 @@ type ServerInterface interface {
 	// Get a specific user
 	// (GET /api/v1/users/{userId})
 	GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	// Patch a user
+	// (PATCH /api/v1/users/{userId})
+	PatchApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
 	// Update a user
 	// (PUT /api/v1/users/{userId})
 	PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
@@ -240,115 +937,458 @@ type ServerInterface interface {
 
 type Unimplemented struct{}
 
-// Get all posts
-// (GET /api/v1/posts)
-func (_ Unimplemented) GetApiV1Posts(w http.ResponseWriter, r *http.Request, params GetApiV1PostsParams) {
+// Get background jobs
+// (GET /api/v1/admin/jobs)
+func (_ Unimplemented) GetApiV1AdminJobs(w http.ResponseWriter, r *http.Request, params GetApiV1AdminJobsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create a new post
-// (POST /api/v1/posts)
-func (_ Unimplemented) PostApiV1Posts(w http.ResponseWriter, r *http.Request) {
+// Get a background job
+// (GET /api/v1/admin/jobs/{jobId})
+func (_ Unimplemented) GetApiV1AdminJobsJobId(w http.ResponseWriter, r *http.Request, jobId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a post
-// (DELETE /api/v1/posts/{postId})
-func (_ Unimplemented) DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+// Retry a failed job
+// (POST /api/v1/admin/jobs/{jobId}/retry)
+func (_ Unimplemented) PostApiV1AdminJobsJobIdRetry(w http.ResponseWriter, r *http.Request, jobId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get a specific post
-// (GET /api/v1/posts/{postId})
-func (_ Unimplemented) GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+// List webhook subscriptions
+// (GET /api/v1/admin/webhooks)
+func (_ Unimplemented) GetApiV1AdminWebhooks(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update a post
-// (PUT /api/v1/posts/{postId})
-func (_ Unimplemented) PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+// Create a webhook subscription
+// (POST /api/v1/admin/webhooks)
+func (_ Unimplemented) PostApiV1AdminWebhooks(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get comments for a specific post
-// (GET /api/v1/posts/{postId}/comments)
-func (_ Unimplemented) GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params GetApiV1PostsPostIdCommentsParams) {
+// Delete a webhook subscription and its delivery log
+// (DELETE /api/v1/admin/webhooks/{webhookId})
+func (_ Unimplemented) DeleteApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Add a comment to a post
-// (POST /api/v1/posts/{postId}/comments)
-func (_ Unimplemented) PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+// Get a webhook subscription
+// (GET /api/v1/admin/webhooks/{webhookId})
+func (_ Unimplemented) GetApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get all users
-// (GET /api/v1/users)
-func (_ Unimplemented) GetApiV1Users(w http.ResponseWriter, r *http.Request, params GetApiV1UsersParams) {
+// Update a webhook subscription
+// (PUT /api/v1/admin/webhooks/{webhookId})
+func (_ Unimplemented) PutApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete a user
-// (DELETE /api/v1/users/{userId})
-func (_ Unimplemented) DeleteApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+// Get the delivery log of a webhook
+// (GET /api/v1/admin/webhooks/{webhookId}/deliveries)
+func (_ Unimplemented) GetApiV1AdminWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, params GetApiV1AdminWebhooksWebhookIdDeliveriesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get a specific user
-// (GET /api/v1/users/{userId})
-func (_ Unimplemented) GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+// Queue a delivery again
+// (POST /api/v1/admin/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+func (_ Unimplemented) PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, deliveryId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update a user
-// (PUT /api/v1/users/{userId})
-func (_ Unimplemented) PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+// Send a test event
+// (POST /api/v1/admin/webhooks/{webhookId}/test)
+func (_ Unimplemented) PostApiV1AdminWebhooksWebhookIdTest(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Login
-// (POST /auth/login)
-func (_ Unimplemented) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+// Get a public author page
+// (GET /api/v1/authors/{username})
+func (_ Unimplemented) GetApiV1AuthorsUsername(w http.ResponseWriter, r *http.Request, username Username) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Logout user
-// (POST /auth/logout)
-func (_ Unimplemented) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+// Unfollow an author
+// (DELETE /api/v1/authors/{username}/follow)
+func (_ Unimplemented) DeleteApiV1AuthorsUsernameFollow(w http.ResponseWriter, r *http.Request, username Username) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Get current user
-// (GET /auth/me)
-func (_ Unimplemented) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+// Follow an author
+// (PUT /api/v1/authors/{username}/follow)
+func (_ Unimplemented) PutApiV1AuthorsUsernameFollow(w http.ResponseWriter, r *http.Request, username Username) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Register a new user
-// (POST /auth/register)
-func (_ Unimplemented) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+// Get the posts of an author
+// (GET /api/v1/authors/{username}/posts)
+func (_ Unimplemented) GetApiV1AuthorsUsernamePosts(w http.ResponseWriter, r *http.Request, username Username, params GetApiV1AuthorsUsernamePostsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Delete a comment
+// (DELETE /api/v1/comments/{commentId})
+func (_ Unimplemented) DeleteApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params DeleteApiV1CommentsCommentIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// GetApiV1Posts operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1Posts(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// Get a specific comment
+// (GET /api/v1/comments/{commentId})
+func (_ Unimplemented) GetApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params GetApiV1CommentsCommentIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// Patch a comment
+// (PATCH /api/v1/comments/{commentId})
+func (_ Unimplemented) PatchApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params PatchApiV1CommentsCommentIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a comment
+// (PUT /api/v1/comments/{commentId})
+func (_ Unimplemented) PutApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params PutApiV1CommentsCommentIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a reaction from a comment
+// (DELETE /api/v1/comments/{commentId}/reactions/{kind})
+func (_ Unimplemented) DeleteApiV1CommentsCommentIdReactionsKind(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, kind ReactionKind) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// React to a comment
+// (PUT /api/v1/comments/{commentId}/reactions/{kind})
+func (_ Unimplemented) PutApiV1CommentsCommentIdReactionsKind(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, kind ReactionKind) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the home feed
+// (GET /api/v1/feed)
+func (_ Unimplemented) GetApiV1Feed(w http.ResponseWriter, r *http.Request, params GetApiV1FeedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's bookmarks
+// (GET /api/v1/me/bookmarks)
+func (_ Unimplemented) GetApiV1MeBookmarks(w http.ResponseWriter, r *http.Request, params GetApiV1MeBookmarksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's notification preferences
+// (GET /api/v1/me/notification-preferences)
+func (_ Unimplemented) GetApiV1MeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the current user's notification preferences
+// (PUT /api/v1/me/notification-preferences)
+func (_ Unimplemented) PutApiV1MeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's profile
+// (GET /api/v1/me/profile)
+func (_ Unimplemented) GetApiV1MeProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the current user's profile
+// (PUT /api/v1/me/profile)
+func (_ Unimplemented) PutApiV1MeProfile(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's reading lists
+// (GET /api/v1/me/reading-lists)
+func (_ Unimplemented) GetApiV1MeReadingLists(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a reading list
+// (POST /api/v1/me/reading-lists)
+func (_ Unimplemented) PostApiV1MeReadingLists(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a reading list
+// (DELETE /api/v1/me/reading-lists/{listId})
+func (_ Unimplemented) DeleteApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get one of the current user's reading lists with its items
+// (GET /api/v1/me/reading-lists/{listId})
+func (_ Unimplemented) GetApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename a reading list or change its visibility
+// (PUT /api/v1/me/reading-lists/{listId})
+func (_ Unimplemented) PutApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a post from a reading list
+// (DELETE /api/v1/me/reading-lists/{listId}/items/{postId})
+func (_ Unimplemented) DeleteApiV1MeReadingListsListIdItemsPostId(w http.ResponseWriter, r *http.Request, listId ReadingListId, postId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a post to the end of a reading list
+// (PUT /api/v1/me/reading-lists/{listId}/items/{postId})
+func (_ Unimplemented) PutApiV1MeReadingListsListIdItemsPostId(w http.ResponseWriter, r *http.Request, listId ReadingListId, postId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reorder a reading list
+// (PUT /api/v1/me/reading-lists/{listId}/order)
+func (_ Unimplemented) PutApiV1MeReadingListsListIdOrder(w http.ResponseWriter, r *http.Request, listId ReadingListId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the comment moderation queue
+// (GET /api/v1/moderation/comments)
+func (_ Unimplemented) GetApiV1ModerationComments(w http.ResponseWriter, r *http.Request, params GetApiV1ModerationCommentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve, reject or mark comments as spam in bulk
+// (POST /api/v1/moderation/comments)
+func (_ Unimplemented) PostApiV1ModerationComments(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirm a newsletter subscription
+// (GET /api/v1/newsletter/confirm)
+func (_ Unimplemented) GetApiV1NewsletterConfirm(w http.ResponseWriter, r *http.Request, params GetApiV1NewsletterConfirmParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Subscribe to the newsletter
+// (POST /api/v1/newsletter/subscriptions)
+func (_ Unimplemented) PostApiV1NewsletterSubscriptions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Check an unsubscribe link
+// (GET /api/v1/newsletter/unsubscribe)
+func (_ Unimplemented) GetApiV1NewsletterUnsubscribe(w http.ResponseWriter, r *http.Request, params GetApiV1NewsletterUnsubscribeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unsubscribe from the newsletter
+// (POST /api/v1/newsletter/unsubscribe)
+func (_ Unimplemented) PostApiV1NewsletterUnsubscribe(w http.ResponseWriter, r *http.Request, params PostApiV1NewsletterUnsubscribeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's notifications
+// (GET /api/v1/notifications)
+func (_ Unimplemented) GetApiV1Notifications(w http.ResponseWriter, r *http.Request, params GetApiV1NotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark all notifications as read
+// (POST /api/v1/notifications/read-all)
+func (_ Unimplemented) PostApiV1NotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark a notification as read
+// (POST /api/v1/notifications/{notificationId}/read)
+func (_ Unimplemented) PostApiV1NotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId NotificationId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all posts
+// (GET /api/v1/posts)
+func (_ Unimplemented) GetApiV1Posts(w http.ResponseWriter, r *http.Request, params GetApiV1PostsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new post
+// (POST /api/v1/posts)
+func (_ Unimplemented) PostApiV1Posts(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a post
+// (DELETE /api/v1/posts/{postId})
+func (_ Unimplemented) DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params DeleteApiV1PostsPostIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a specific post
+// (GET /api/v1/posts/{postId})
+func (_ Unimplemented) GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params GetApiV1PostsPostIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Patch a post
+// (PATCH /api/v1/posts/{postId})
+func (_ Unimplemented) PatchApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params PatchApiV1PostsPostIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a post
+// (PUT /api/v1/posts/{postId})
+func (_ Unimplemented) PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params PutApiV1PostsPostIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a bookmark
+// (DELETE /api/v1/posts/{postId}/bookmark)
+func (_ Unimplemented) DeleteApiV1PostsPostIdBookmark(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Bookmark a post
+// (PUT /api/v1/posts/{postId}/bookmark)
+func (_ Unimplemented) PutApiV1PostsPostIdBookmark(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comments for a specific post
+// (GET /api/v1/posts/{postId}/comments)
+func (_ Unimplemented) GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params GetApiV1PostsPostIdCommentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add a comment to a post
+// (POST /api/v1/posts/{postId}/comments)
+func (_ Unimplemented) PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Set the comment moderation mode of a post
+// (PUT /api/v1/posts/{postId}/moderation)
+func (_ Unimplemented) PutApiV1PostsPostIdModeration(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Join the live presence of a post
+// (GET /api/v1/posts/{postId}/presence)
+func (_ Unimplemented) GetApiV1PostsPostIdPresence(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a reaction from a post
+// (DELETE /api/v1/posts/{postId}/reactions/{kind})
+func (_ Unimplemented) DeleteApiV1PostsPostIdReactionsKind(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, kind ReactionKind) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// React to a post
+// (PUT /api/v1/posts/{postId}/reactions/{kind})
+func (_ Unimplemented) PutApiV1PostsPostIdReactionsKind(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, kind ReactionKind) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a shared reading list
+// (GET /api/v1/reading-lists/shared/{token})
+func (_ Unimplemented) GetApiV1ReadingListsSharedToken(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Subscribe to real-time updates
+// (GET /api/v1/stream)
+func (_ Unimplemented) GetApiV1Stream(w http.ResponseWriter, r *http.Request, params GetApiV1StreamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get all users
+// (GET /api/v1/users)
+func (_ Unimplemented) GetApiV1Users(w http.ResponseWriter, r *http.Request, params GetApiV1UsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a user
+// (DELETE /api/v1/users/{userId})
+func (_ Unimplemented) DeleteApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a specific user
+// (GET /api/v1/users/{userId})
+func (_ Unimplemented) GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Patch a user
+// (PATCH /api/v1/users/{userId})
+func (_ Unimplemented) PatchApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a user
+// (PUT /api/v1/users/{userId})
+func (_ Unimplemented) PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Login
+// (POST /auth/login)
+func (_ Unimplemented) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Logout user
+// (POST /auth/logout)
+func (_ Unimplemented) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get current user
+// (GET /auth/me)
+func (_ Unimplemented) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a new user
+// (POST /auth/register)
+func (_ Unimplemented) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetApiV1AdminJobs operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminJobs(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiV1PostsParams
+	var params GetApiV1AdminJobsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "queue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "queue", r.URL.Query(), &params.Queue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queue", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -374,227 +1414,242 @@ func (siw *ServerInterfaceWrapper) GetApiV1Posts(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1Posts(w, r, params)
+		siw.Handler.GetApiV1AdminJobs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// PostApiV1Posts operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1Posts(w http.ResponseWriter, r *http.Request) {
+// GetApiV1AdminJobsJobId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminJobsJobId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "jobId" -------------
+	var jobId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", chi.URLParam(r, "jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiV1Posts(w, r)
+		siw.Handler.GetApiV1AdminJobsJobId(w, r, jobId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteApiV1PostsPostId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PostApiV1AdminJobsJobIdRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AdminJobsJobIdRetry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "postId" -------------
-	var postId openapi_types.UUID
+	// ------------- Path parameter "jobId" -------------
+	var jobId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "jobId", chi.URLParam(r, "jobId"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteApiV1PostsPostId(w, r, postId)
+		siw.Handler.PostApiV1AdminJobsJobIdRetry(w, r, jobId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetApiV1PostsPostId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request) {
+// GetApiV1AdminWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminWebhooks(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
-	var err error
-
-	// ------------- Path parameter "postId" -------------
-	var postId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
-		return
-	}
-
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1PostsPostId(w, r, postId)
+		siw.Handler.GetApiV1AdminWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// PutApiV1PostsPostId operation middleware
-func (siw *ServerInterfaceWrapper) PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// ------------- Path parameter "postId" -------------
-	var postId openapi_types.UUID
+// PostApiV1AdminWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AdminWebhooks(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
-		return
-	}
+	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutApiV1PostsPostId(w, r, postId)
+		siw.Handler.PostApiV1AdminWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetApiV1PostsPostIdComments operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// DeleteApiV1AdminWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "postId" -------------
-	var postId openapi_types.UUID
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiV1PostsPostIdCommentsParams
+	r = r.WithContext(ctx)
 
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1AdminWebhooksWebhookId(w, r, webhookId)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+// GetApiV1AdminWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "offset" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1PostsPostIdComments(w, r, postId, params)
+		siw.Handler.GetApiV1AdminWebhooksWebhookId(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// PostApiV1PostsPostIdComments operation middleware
-func (siw *ServerInterfaceWrapper) PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PutApiV1AdminWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "postId" -------------
-	var postId openapi_types.UUID
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiV1PostsPostIdComments(w, r, postId)
+		siw.Handler.PutApiV1AdminWebhooksWebhookId(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetApiV1Users operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1Users(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetApiV1AdminWebhooksWebhookIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AdminWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiV1UsersParams
+	var params GetApiV1AdminWebhooksWebhookIdDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -620,374 +1675,8055 @@ func (siw *ServerInterfaceWrapper) GetApiV1Users(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1Users(w, r, params)
+		siw.Handler.GetApiV1AdminWebhooksWebhookIdDeliveries(w, r, webhookId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// DeleteApiV1UsersUserId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiV1UsersUserId(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId openapi_types.UUID
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteApiV1UsersUserId(w, r, userId)
+		siw.Handler.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w, r, webhookId, deliveryId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetApiV1UsersUserId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PostApiV1AdminWebhooksWebhookIdTest operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1AdminWebhooksWebhookIdTest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId openapi_types.UUID
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiV1UsersUserId(w, r, userId)
+		siw.Handler.PostApiV1AdminWebhooksWebhookIdTest(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// PutApiV1UsersUserId operation middleware
-func (siw *ServerInterfaceWrapper) PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// GetApiV1AuthorsUsername operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AuthorsUsername(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userId openapi_types.UUID
+	// ------------- Path parameter "username" -------------
+	var username Username
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutApiV1UsersUserId(w, r, userId)
+		siw.Handler.GetApiV1AuthorsUsername(w, r, username)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// PostAuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// DeleteApiV1AuthorsUsernameFollow operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1AuthorsUsernameFollow(w http.ResponseWriter, r *http.Request) {
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthLogin(w, r)
-	}))
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Path parameter "username" -------------
+	var username Username
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-// PostAuthLogout operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthLogout(w, r)
+		siw.Handler.DeleteApiV1AuthorsUsernameFollow(w, r, username)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-// GetAuthMe operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthMe(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// PutApiV1AuthorsUsernameFollow operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1AuthorsUsernameFollow(w http.ResponseWriter, r *http.Request) {
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuthMe(w, r)
-	}))
+	// ------------- Path parameter "username" -------------
+	var username Username
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// PostAuthRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAuthRegister(w, r)
+		siw.Handler.PutApiV1AuthorsUsernameFollow(w, r, username)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
+	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// GetApiV1AuthorsUsernamePosts operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1AuthorsUsernamePosts(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "username" -------------
+	var username Username
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "username", chi.URLParam(r, "username"), &username, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1AuthorsUsernamePostsParams
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "page" -------------
 
-type RequiredParamError struct {
-	ParamName string
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// ------------- Optional query parameter "offset" -------------
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional query parameter "sort" -------------
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type TooManyValuesForParamError struct {
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1AuthorsUsernamePosts(w, r, username, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1CommentsCommentId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiV1CommentsCommentIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1CommentsCommentId(w, r, commentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1CommentsCommentId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1CommentsCommentIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1CommentsCommentId(w, r, commentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchApiV1CommentsCommentId operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchApiV1CommentsCommentIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchApiV1CommentsCommentId(w, r, commentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1CommentsCommentId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutApiV1CommentsCommentIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1CommentsCommentId(w, r, commentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1CommentsCommentIdReactionsKind operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1CommentsCommentIdReactionsKind(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind ReactionKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", chi.URLParam(r, "kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1CommentsCommentIdReactionsKind(w, r, commentId, kind)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1CommentsCommentIdReactionsKind operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1CommentsCommentIdReactionsKind(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind ReactionKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", chi.URLParam(r, "kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1CommentsCommentIdReactionsKind(w, r, commentId, kind)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1Feed operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Feed(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1FeedParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Feed(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1MeBookmarks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1MeBookmarks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1MeBookmarksParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1MeBookmarks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1MeNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1MeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1MeNotificationPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1MeNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1MeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1MeNotificationPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1MeProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1MeProfile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1MeProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1MeProfile operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1MeProfile(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1MeProfile(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1MeReadingLists operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1MeReadingLists(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1MeReadingLists(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiV1MeReadingLists operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1MeReadingLists(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1MeReadingLists(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1MeReadingListsListId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "listId" -------------
+	var listId ReadingListId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "listId", chi.URLParam(r, "listId"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "listId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1MeReadingListsListId(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1MeReadingListsListId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "listId" -------------
+	var listId ReadingListId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "listId", chi.URLParam(r, "listId"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "listId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1MeReadingListsListId(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1MeReadingListsListId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "listId" -------------
+	var listId ReadingListId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "listId", chi.URLParam(r, "listId"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "listId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1MeReadingListsListId(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1MeReadingListsListIdItemsPostId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1MeReadingListsListIdItemsPostId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "listId" -------------
+	var listId ReadingListId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "listId", chi.URLParam(r, "listId"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "listId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1MeReadingListsListIdItemsPostId(w, r, listId, postId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1MeReadingListsListIdItemsPostId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1MeReadingListsListIdItemsPostId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "listId" -------------
+	var listId ReadingListId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "listId", chi.URLParam(r, "listId"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "listId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1MeReadingListsListIdItemsPostId(w, r, listId, postId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1MeReadingListsListIdOrder operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1MeReadingListsListIdOrder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "listId" -------------
+	var listId ReadingListId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "listId", chi.URLParam(r, "listId"), &listId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "listId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1MeReadingListsListIdOrder(w, r, listId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1ModerationComments operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ModerationComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1ModerationCommentsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ModerationComments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiV1ModerationComments operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1ModerationComments(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1ModerationComments(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1NewsletterConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1NewsletterConfirm(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1NewsletterConfirmParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1NewsletterConfirm(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiV1NewsletterSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1NewsletterSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1NewsletterSubscriptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1NewsletterUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1NewsletterUnsubscribe(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1NewsletterUnsubscribeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1NewsletterUnsubscribe(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiV1NewsletterUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1NewsletterUnsubscribe(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostApiV1NewsletterUnsubscribeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1NewsletterUnsubscribe(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1Notifications operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Notifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1NotificationsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", r.URL.Query(), &params.Unread)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unread", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Notifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiV1NotificationsReadAll operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1NotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1NotificationsReadAll(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiV1NotificationsNotificationIdRead operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1NotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "notificationId" -------------
+	var notificationId NotificationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationId", chi.URLParam(r, "notificationId"), &notificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "notificationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1NotificationsNotificationIdRead(w, r, notificationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1Posts operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Posts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1PostsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author", r.URL.Query(), &params.Author)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Posts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiV1Posts operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1Posts(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1Posts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1PostsPostId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteApiV1PostsPostIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1PostsPostId(w, r, postId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1PostsPostId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1PostsPostIdParams
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1PostsPostId(w, r, postId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchApiV1PostsPostId operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiV1PostsPostId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchApiV1PostsPostIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchApiV1PostsPostId(w, r, postId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1PostsPostId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutApiV1PostsPostIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1PostsPostId(w, r, postId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1PostsPostIdBookmark operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1PostsPostIdBookmark(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1PostsPostIdBookmark(w, r, postId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1PostsPostIdBookmark operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1PostsPostIdBookmark(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1PostsPostIdBookmark(w, r, postId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1PostsPostIdComments operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1PostsPostIdCommentsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1PostsPostIdComments(w, r, postId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiV1PostsPostIdComments operation middleware
+func (siw *ServerInterfaceWrapper) PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiV1PostsPostIdComments(w, r, postId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1PostsPostIdModeration operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1PostsPostIdModeration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1PostsPostIdModeration(w, r, postId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1PostsPostIdPresence operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1PostsPostIdPresence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1PostsPostIdPresence(w, r, postId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1PostsPostIdReactionsKind operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1PostsPostIdReactionsKind(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind ReactionKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", chi.URLParam(r, "kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1PostsPostIdReactionsKind(w, r, postId, kind)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1PostsPostIdReactionsKind operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1PostsPostIdReactionsKind(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "postId" -------------
+	var postId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "postId", chi.URLParam(r, "postId"), &postId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "postId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "kind" -------------
+	var kind ReactionKind
+
+	err = runtime.BindStyledParameterWithOptions("simple", "kind", chi.URLParam(r, "kind"), &kind, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1PostsPostIdReactionsKind(w, r, postId, kind)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1ReadingListsSharedToken operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1ReadingListsSharedToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1ReadingListsSharedToken(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1Stream operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Stream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1StreamParams
+
+	// ------------- Required query parameter "topics" -------------
+
+	if paramValue := r.URL.Query().Get("topics"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "topics"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "topics", r.URL.Query(), &params.Topics)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "topics", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Stream(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1Users operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1Users(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiV1UsersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_total", r.URL.Query(), &params.IncludeTotal)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_total", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1Users(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiV1UsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiV1UsersUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiV1UsersUserId(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiV1UsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiV1UsersUserId(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchApiV1UsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiV1UsersUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchApiV1UsersUserId(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiV1UsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiV1UsersUserId(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthMe operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
 	ParamName string
 	Count     int
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/jobs", wrapper.GetApiV1AdminJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/jobs/{jobId}", wrapper.GetApiV1AdminJobsJobId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/jobs/{jobId}/retry", wrapper.PostApiV1AdminJobsJobIdRetry)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/webhooks", wrapper.GetApiV1AdminWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/webhooks", wrapper.PostApiV1AdminWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/admin/webhooks/{webhookId}", wrapper.DeleteApiV1AdminWebhooksWebhookId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/webhooks/{webhookId}", wrapper.GetApiV1AdminWebhooksWebhookId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/admin/webhooks/{webhookId}", wrapper.PutApiV1AdminWebhooksWebhookId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/admin/webhooks/{webhookId}/deliveries", wrapper.GetApiV1AdminWebhooksWebhookIdDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver", wrapper.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/admin/webhooks/{webhookId}/test", wrapper.PostApiV1AdminWebhooksWebhookIdTest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/authors/{username}", wrapper.GetApiV1AuthorsUsername)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/authors/{username}/follow", wrapper.DeleteApiV1AuthorsUsernameFollow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/authors/{username}/follow", wrapper.PutApiV1AuthorsUsernameFollow)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/authors/{username}/posts", wrapper.GetApiV1AuthorsUsernamePosts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/comments/{commentId}", wrapper.DeleteApiV1CommentsCommentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/comments/{commentId}", wrapper.GetApiV1CommentsCommentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/v1/comments/{commentId}", wrapper.PatchApiV1CommentsCommentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/comments/{commentId}", wrapper.PutApiV1CommentsCommentId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/comments/{commentId}/reactions/{kind}", wrapper.DeleteApiV1CommentsCommentIdReactionsKind)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/comments/{commentId}/reactions/{kind}", wrapper.PutApiV1CommentsCommentIdReactionsKind)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/feed", wrapper.GetApiV1Feed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/me/bookmarks", wrapper.GetApiV1MeBookmarks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/me/notification-preferences", wrapper.GetApiV1MeNotificationPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/me/notification-preferences", wrapper.PutApiV1MeNotificationPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/me/profile", wrapper.GetApiV1MeProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/me/profile", wrapper.PutApiV1MeProfile)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/me/reading-lists", wrapper.GetApiV1MeReadingLists)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/me/reading-lists", wrapper.PostApiV1MeReadingLists)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/me/reading-lists/{listId}", wrapper.DeleteApiV1MeReadingListsListId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/me/reading-lists/{listId}", wrapper.GetApiV1MeReadingListsListId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/me/reading-lists/{listId}", wrapper.PutApiV1MeReadingListsListId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/me/reading-lists/{listId}/items/{postId}", wrapper.DeleteApiV1MeReadingListsListIdItemsPostId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/me/reading-lists/{listId}/items/{postId}", wrapper.PutApiV1MeReadingListsListIdItemsPostId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/me/reading-lists/{listId}/order", wrapper.PutApiV1MeReadingListsListIdOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/moderation/comments", wrapper.GetApiV1ModerationComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/moderation/comments", wrapper.PostApiV1ModerationComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/newsletter/confirm", wrapper.GetApiV1NewsletterConfirm)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/newsletter/subscriptions", wrapper.PostApiV1NewsletterSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/newsletter/unsubscribe", wrapper.GetApiV1NewsletterUnsubscribe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/newsletter/unsubscribe", wrapper.PostApiV1NewsletterUnsubscribe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/notifications", wrapper.GetApiV1Notifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/notifications/read-all", wrapper.PostApiV1NotificationsReadAll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/notifications/{notificationId}/read", wrapper.PostApiV1NotificationsNotificationIdRead)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/posts", wrapper.GetApiV1Posts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/posts", wrapper.PostApiV1Posts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/posts/{postId}", wrapper.DeleteApiV1PostsPostId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/posts/{postId}", wrapper.GetApiV1PostsPostId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/v1/posts/{postId}", wrapper.PatchApiV1PostsPostId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/posts/{postId}", wrapper.PutApiV1PostsPostId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/posts/{postId}/bookmark", wrapper.DeleteApiV1PostsPostIdBookmark)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/posts/{postId}/bookmark", wrapper.PutApiV1PostsPostIdBookmark)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/posts/{postId}/comments", wrapper.GetApiV1PostsPostIdComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/posts/{postId}/comments", wrapper.PostApiV1PostsPostIdComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/posts/{postId}/moderation", wrapper.PutApiV1PostsPostIdModeration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/posts/{postId}/presence", wrapper.GetApiV1PostsPostIdPresence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/posts/{postId}/reactions/{kind}", wrapper.DeleteApiV1PostsPostIdReactionsKind)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/posts/{postId}/reactions/{kind}", wrapper.PutApiV1PostsPostIdReactionsKind)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/reading-lists/shared/{token}", wrapper.GetApiV1ReadingListsSharedToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/stream", wrapper.GetApiV1Stream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/users", wrapper.GetApiV1Users)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/users/{userId}", wrapper.DeleteApiV1UsersUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/users/{userId}", wrapper.GetApiV1UsersUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/v1/users/{userId}", wrapper.PatchApiV1UsersUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/users/{userId}", wrapper.PutApiV1UsersUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/login", wrapper.PostAuthLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/logout", wrapper.PostAuthLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/me", wrapper.GetAuthMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/register", wrapper.PostAuthRegister)
+	})
+
+	return r
+}
+
+type PreconditionFailedApplicationProblemPlusJSONResponse Problem
+
+type PreconditionRequiredApplicationProblemPlusJSONResponse Problem
+
+type GetApiV1AdminJobsRequestObject struct {
+	Params GetApiV1AdminJobsParams
+}
+
+type GetApiV1AdminJobsResponseObject interface {
+	VisitGetApiV1AdminJobsResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1AdminJobs200JSONResponse entity.Response[entity.Job]
+
+func (response GetApiV1AdminJobs200JSONResponse) VisitGetApiV1AdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminJobs400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminJobs400ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminJobs401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminJobs401ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminJobs403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminJobs403ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminJobsJobIdRequestObject struct {
+	JobId openapi_types.UUID `json:"jobId"`
+}
+
+type GetApiV1AdminJobsJobIdResponseObject interface {
+	VisitGetApiV1AdminJobsJobIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1AdminJobsJobId200JSONResponse Job
+
+func (response GetApiV1AdminJobsJobId200JSONResponse) VisitGetApiV1AdminJobsJobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminJobsJobId401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminJobsJobId401ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminJobsJobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminJobsJobId403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminJobsJobId403ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminJobsJobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminJobsJobId404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminJobsJobId404ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminJobsJobIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminJobsJobIdRetryRequestObject struct {
+	JobId openapi_types.UUID `json:"jobId"`
+}
+
+type PostApiV1AdminJobsJobIdRetryResponseObject interface {
+	VisitPostApiV1AdminJobsJobIdRetryResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1AdminJobsJobIdRetry202JSONResponse Job
+
+func (response PostApiV1AdminJobsJobIdRetry202JSONResponse) VisitPostApiV1AdminJobsJobIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminJobsJobIdRetry401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminJobsJobIdRetry401ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminJobsJobIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminJobsJobIdRetry403ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminJobsJobIdRetry403ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminJobsJobIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminJobsJobIdRetry404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminJobsJobIdRetry404ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminJobsJobIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminJobsJobIdRetry409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminJobsJobIdRetry409ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminJobsJobIdRetryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksRequestObject struct {
+}
+
+type GetApiV1AdminWebhooksResponseObject interface {
+	VisitGetApiV1AdminWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1AdminWebhooks200JSONResponse []Webhook
+
+func (response GetApiV1AdminWebhooks200JSONResponse) VisitGetApiV1AdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooks401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooks401ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooks403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooks403ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksRequestObject struct {
+	Body *PostApiV1AdminWebhooksJSONRequestBody
+}
+
+type PostApiV1AdminWebhooksResponseObject interface {
+	VisitPostApiV1AdminWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1AdminWebhooks201JSONResponse Webhook
+
+func (response PostApiV1AdminWebhooks201JSONResponse) VisitPostApiV1AdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooks400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooks400ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooks401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooks401ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooks403ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooks403ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1AdminWebhooksWebhookIdRequestObject struct {
+	WebhookId openapi_types.UUID `json:"webhookId"`
+}
+
+type DeleteApiV1AdminWebhooksWebhookIdResponseObject interface {
+	VisitDeleteApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1AdminWebhooksWebhookId204Response struct {
+}
+
+func (response DeleteApiV1AdminWebhooksWebhookId204Response) VisitDeleteApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiV1AdminWebhooksWebhookId401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1AdminWebhooksWebhookId401ApplicationProblemPlusJSONResponse) VisitDeleteApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1AdminWebhooksWebhookId403ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1AdminWebhooksWebhookId403ApplicationProblemPlusJSONResponse) VisitDeleteApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1AdminWebhooksWebhookId404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1AdminWebhooksWebhookId404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookIdRequestObject struct {
+	WebhookId openapi_types.UUID `json:"webhookId"`
+}
+
+type GetApiV1AdminWebhooksWebhookIdResponseObject interface {
+	VisitGetApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1AdminWebhooksWebhookId200JSONResponse Webhook
+
+func (response GetApiV1AdminWebhooksWebhookId200JSONResponse) VisitGetApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookId401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooksWebhookId401ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookId403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooksWebhookId403ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookId404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooksWebhookId404ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1AdminWebhooksWebhookIdRequestObject struct {
+	WebhookId openapi_types.UUID `json:"webhookId"`
+	Body      *PutApiV1AdminWebhooksWebhookIdJSONRequestBody
+}
+
+type PutApiV1AdminWebhooksWebhookIdResponseObject interface {
+	VisitPutApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1AdminWebhooksWebhookId200JSONResponse Webhook
+
+func (response PutApiV1AdminWebhooksWebhookId200JSONResponse) VisitPutApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1AdminWebhooksWebhookId400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1AdminWebhooksWebhookId400ApplicationProblemPlusJSONResponse) VisitPutApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1AdminWebhooksWebhookId401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1AdminWebhooksWebhookId401ApplicationProblemPlusJSONResponse) VisitPutApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1AdminWebhooksWebhookId403ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1AdminWebhooksWebhookId403ApplicationProblemPlusJSONResponse) VisitPutApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1AdminWebhooksWebhookId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1AdminWebhooksWebhookId404ApplicationProblemPlusJSONResponse) VisitPutApiV1AdminWebhooksWebhookIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookIdDeliveriesRequestObject struct {
+	WebhookId openapi_types.UUID `json:"webhookId"`
+	Params    GetApiV1AdminWebhooksWebhookIdDeliveriesParams
+}
+
+type GetApiV1AdminWebhooksWebhookIdDeliveriesResponseObject interface {
+	VisitGetApiV1AdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1AdminWebhooksWebhookIdDeliveries200JSONResponse entity.Response[entity.WebhookDelivery]
+
+func (response GetApiV1AdminWebhooksWebhookIdDeliveries200JSONResponse) VisitGetApiV1AdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookIdDeliveries400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooksWebhookIdDeliveries400ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookIdDeliveries401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooksWebhookIdDeliveries401ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookIdDeliveries403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooksWebhookIdDeliveries403ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AdminWebhooksWebhookIdDeliveries404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AdminWebhooksWebhookIdDeliveries404ApplicationProblemPlusJSONResponse) VisitGetApiV1AdminWebhooksWebhookIdDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequestObject struct {
+	WebhookId  openapi_types.UUID `json:"webhookId"`
+	DeliveryId openapi_types.UUID `json:"deliveryId"`
+}
+
+type PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponseObject interface {
+	VisitPostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver202JSONResponse WebhookDelivery
+
+func (response PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver202JSONResponse) VisitPostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver401ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver403ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver403ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver404ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksWebhookIdTestRequestObject struct {
+	WebhookId openapi_types.UUID `json:"webhookId"`
+}
+
+type PostApiV1AdminWebhooksWebhookIdTestResponseObject interface {
+	VisitPostApiV1AdminWebhooksWebhookIdTestResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1AdminWebhooksWebhookIdTest200JSONResponse WebhookDelivery
+
+func (response PostApiV1AdminWebhooksWebhookIdTest200JSONResponse) VisitPostApiV1AdminWebhooksWebhookIdTestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksWebhookIdTest401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooksWebhookIdTest401ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksWebhookIdTestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksWebhookIdTest403ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooksWebhookIdTest403ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksWebhookIdTestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1AdminWebhooksWebhookIdTest404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1AdminWebhooksWebhookIdTest404ApplicationProblemPlusJSONResponse) VisitPostApiV1AdminWebhooksWebhookIdTestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AuthorsUsernameRequestObject struct {
+	Username Username `json:"username"`
+}
+
+type GetApiV1AuthorsUsernameResponseObject interface {
+	VisitGetApiV1AuthorsUsernameResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1AuthorsUsername200JSONResponse Author
+
+func (response GetApiV1AuthorsUsername200JSONResponse) VisitGetApiV1AuthorsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AuthorsUsername404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AuthorsUsername404ApplicationProblemPlusJSONResponse) VisitGetApiV1AuthorsUsernameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1AuthorsUsernameFollowRequestObject struct {
+	Username Username `json:"username"`
+}
+
+type DeleteApiV1AuthorsUsernameFollowResponseObject interface {
+	VisitDeleteApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1AuthorsUsernameFollow204Response struct {
+}
+
+func (response DeleteApiV1AuthorsUsernameFollow204Response) VisitDeleteApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiV1AuthorsUsernameFollow400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1AuthorsUsernameFollow400ApplicationProblemPlusJSONResponse) VisitDeleteApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1AuthorsUsernameFollow401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1AuthorsUsernameFollow401ApplicationProblemPlusJSONResponse) VisitDeleteApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1AuthorsUsernameFollow404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1AuthorsUsernameFollow404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1AuthorsUsernameFollowRequestObject struct {
+	Username Username `json:"username"`
+}
+
+type PutApiV1AuthorsUsernameFollowResponseObject interface {
+	VisitPutApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1AuthorsUsernameFollow204Response struct {
+}
+
+func (response PutApiV1AuthorsUsernameFollow204Response) VisitPutApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutApiV1AuthorsUsernameFollow400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1AuthorsUsernameFollow400ApplicationProblemPlusJSONResponse) VisitPutApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1AuthorsUsernameFollow401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1AuthorsUsernameFollow401ApplicationProblemPlusJSONResponse) VisitPutApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1AuthorsUsernameFollow404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1AuthorsUsernameFollow404ApplicationProblemPlusJSONResponse) VisitPutApiV1AuthorsUsernameFollowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AuthorsUsernamePostsRequestObject struct {
+	Username Username `json:"username"`
+	Params   GetApiV1AuthorsUsernamePostsParams
+}
+
+type GetApiV1AuthorsUsernamePostsResponseObject interface {
+	VisitGetApiV1AuthorsUsernamePostsResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1AuthorsUsernamePosts200JSONResponse entity.Response[entity.Post]
+
+func (response GetApiV1AuthorsUsernamePosts200JSONResponse) VisitGetApiV1AuthorsUsernamePostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AuthorsUsernamePosts400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AuthorsUsernamePosts400ApplicationProblemPlusJSONResponse) VisitGetApiV1AuthorsUsernamePostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1AuthorsUsernamePosts404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1AuthorsUsernamePosts404ApplicationProblemPlusJSONResponse) VisitGetApiV1AuthorsUsernamePostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentIdRequestObject struct {
+	CommentId openapi_types.UUID `json:"commentId"`
+	Params    DeleteApiV1CommentsCommentIdParams
+}
+
+type DeleteApiV1CommentsCommentIdResponseObject interface {
+	VisitDeleteApiV1CommentsCommentIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1CommentsCommentId204Response struct {
+}
+
+func (response DeleteApiV1CommentsCommentId204Response) VisitDeleteApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiV1CommentsCommentId401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1CommentsCommentId401ApplicationProblemPlusJSONResponse) VisitDeleteApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentId403ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1CommentsCommentId403ApplicationProblemPlusJSONResponse) VisitDeleteApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentId404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1CommentsCommentId404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentId412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteApiV1CommentsCommentId412ApplicationProblemPlusJSONResponse) VisitDeleteApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentId428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteApiV1CommentsCommentId428ApplicationProblemPlusJSONResponse) VisitDeleteApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1CommentsCommentIdRequestObject struct {
+	CommentId openapi_types.UUID `json:"commentId"`
+	Params    GetApiV1CommentsCommentIdParams
+}
+
+type GetApiV1CommentsCommentIdResponseObject interface {
+	VisitGetApiV1CommentsCommentIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1CommentsCommentId200ResponseHeaders struct {
+	ETag string
+}
+
+type GetApiV1CommentsCommentId200JSONResponse struct {
+	Body    Comment
+	Headers GetApiV1CommentsCommentId200ResponseHeaders
+}
+
+func (response GetApiV1CommentsCommentId200JSONResponse) VisitGetApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApiV1CommentsCommentId304ResponseHeaders struct {
+	ETag string
+}
+
+type GetApiV1CommentsCommentId304Response struct {
+	Headers GetApiV1CommentsCommentId304ResponseHeaders
+}
+
+func (response GetApiV1CommentsCommentId304Response) VisitGetApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetApiV1CommentsCommentId404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1CommentsCommentId404ApplicationProblemPlusJSONResponse) VisitGetApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1CommentsCommentIdRequestObject struct {
+	CommentId                         openapi_types.UUID `json:"commentId"`
+	Params                            PatchApiV1CommentsCommentIdParams
+	ApplicationJSONPatchPlusJSONBody  *PatchApiV1CommentsCommentIdApplicationJSONPatchPlusJSONRequestBody
+	ApplicationMergePatchPlusJSONBody *PatchApiV1CommentsCommentIdApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchApiV1CommentsCommentIdResponseObject interface {
+	VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error
+}
+
+type PatchApiV1CommentsCommentId200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchApiV1CommentsCommentId200JSONResponse struct {
+	Body    Comment
+	Headers PatchApiV1CommentsCommentId200ResponseHeaders
+}
+
+func (response PatchApiV1CommentsCommentId200JSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchApiV1CommentsCommentId400ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1CommentsCommentId400ApplicationProblemPlusJSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1CommentsCommentId401ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1CommentsCommentId401ApplicationProblemPlusJSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1CommentsCommentId403ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1CommentsCommentId403ApplicationProblemPlusJSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1CommentsCommentId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1CommentsCommentId404ApplicationProblemPlusJSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1CommentsCommentId409ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1CommentsCommentId409ApplicationProblemPlusJSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1CommentsCommentId412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchApiV1CommentsCommentId412ApplicationProblemPlusJSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1CommentsCommentId415ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1CommentsCommentId415ApplicationProblemPlusJSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1CommentsCommentId428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PatchApiV1CommentsCommentId428ApplicationProblemPlusJSONResponse) VisitPatchApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentIdRequestObject struct {
+	CommentId openapi_types.UUID `json:"commentId"`
+	Params    PutApiV1CommentsCommentIdParams
+	Body      *PutApiV1CommentsCommentIdJSONRequestBody
+}
+
+type PutApiV1CommentsCommentIdResponseObject interface {
+	VisitPutApiV1CommentsCommentIdResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1CommentsCommentId200ResponseHeaders struct {
+	ETag string
+}
+
+type PutApiV1CommentsCommentId200JSONResponse struct {
+	Body    Comment
+	Headers PutApiV1CommentsCommentId200ResponseHeaders
+}
+
+func (response PutApiV1CommentsCommentId200JSONResponse) VisitPutApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutApiV1CommentsCommentId400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1CommentsCommentId400ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentId401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1CommentsCommentId401ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentId403ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1CommentsCommentId403ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1CommentsCommentId404ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentId412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PutApiV1CommentsCommentId412ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentId428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PutApiV1CommentsCommentId428ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentIdReactionsKindRequestObject struct {
+	CommentId openapi_types.UUID `json:"commentId"`
+	Kind      ReactionKind       `json:"kind"`
+}
+
+type DeleteApiV1CommentsCommentIdReactionsKindResponseObject interface {
+	VisitDeleteApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1CommentsCommentIdReactionsKind200JSONResponse ReactionSummary
+
+func (response DeleteApiV1CommentsCommentIdReactionsKind200JSONResponse) VisitDeleteApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentIdReactionsKind400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1CommentsCommentIdReactionsKind400ApplicationProblemPlusJSONResponse) VisitDeleteApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentIdReactionsKind401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1CommentsCommentIdReactionsKind401ApplicationProblemPlusJSONResponse) VisitDeleteApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1CommentsCommentIdReactionsKind404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1CommentsCommentIdReactionsKind404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentIdReactionsKindRequestObject struct {
+	CommentId openapi_types.UUID `json:"commentId"`
+	Kind      ReactionKind       `json:"kind"`
+}
+
+type PutApiV1CommentsCommentIdReactionsKindResponseObject interface {
+	VisitPutApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1CommentsCommentIdReactionsKind200JSONResponse ReactionSummary
+
+func (response PutApiV1CommentsCommentIdReactionsKind200JSONResponse) VisitPutApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentIdReactionsKind400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1CommentsCommentIdReactionsKind400ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentIdReactionsKind401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1CommentsCommentIdReactionsKind401ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1CommentsCommentIdReactionsKind404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1CommentsCommentIdReactionsKind404ApplicationProblemPlusJSONResponse) VisitPutApiV1CommentsCommentIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1FeedRequestObject struct {
+	Params GetApiV1FeedParams
+}
+
+type GetApiV1FeedResponseObject interface {
+	VisitGetApiV1FeedResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1Feed200JSONResponse FeedPage
+
+func (response GetApiV1Feed200JSONResponse) VisitGetApiV1FeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Feed400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Feed400ApplicationProblemPlusJSONResponse) VisitGetApiV1FeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Feed401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Feed401ApplicationProblemPlusJSONResponse) VisitGetApiV1FeedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeBookmarksRequestObject struct {
+	Params GetApiV1MeBookmarksParams
+}
+
+type GetApiV1MeBookmarksResponseObject interface {
+	VisitGetApiV1MeBookmarksResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1MeBookmarks200JSONResponse entity.Response[entity.Bookmark]
+
+func (response GetApiV1MeBookmarks200JSONResponse) VisitGetApiV1MeBookmarksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeBookmarks400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1MeBookmarks400ApplicationProblemPlusJSONResponse) VisitGetApiV1MeBookmarksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeBookmarks401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1MeBookmarks401ApplicationProblemPlusJSONResponse) VisitGetApiV1MeBookmarksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeNotificationPreferencesRequestObject struct {
+}
+
+type GetApiV1MeNotificationPreferencesResponseObject interface {
+	VisitGetApiV1MeNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1MeNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response GetApiV1MeNotificationPreferences200JSONResponse) VisitGetApiV1MeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeNotificationPreferences401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1MeNotificationPreferences401ApplicationProblemPlusJSONResponse) VisitGetApiV1MeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeNotificationPreferencesRequestObject struct {
+	Body *PutApiV1MeNotificationPreferencesJSONRequestBody
+}
+
+type PutApiV1MeNotificationPreferencesResponseObject interface {
+	VisitPutApiV1MeNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1MeNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response PutApiV1MeNotificationPreferences200JSONResponse) VisitPutApiV1MeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeNotificationPreferences400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeNotificationPreferences400ApplicationProblemPlusJSONResponse) VisitPutApiV1MeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeNotificationPreferences401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeNotificationPreferences401ApplicationProblemPlusJSONResponse) VisitPutApiV1MeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeProfileRequestObject struct {
+}
+
+type GetApiV1MeProfileResponseObject interface {
+	VisitGetApiV1MeProfileResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1MeProfile200JSONResponse Profile
+
+func (response GetApiV1MeProfile200JSONResponse) VisitGetApiV1MeProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeProfile401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1MeProfile401ApplicationProblemPlusJSONResponse) VisitGetApiV1MeProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeProfileRequestObject struct {
+	Body *PutApiV1MeProfileJSONRequestBody
+}
+
+type PutApiV1MeProfileResponseObject interface {
+	VisitPutApiV1MeProfileResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1MeProfile200JSONResponse Profile
+
+func (response PutApiV1MeProfile200JSONResponse) VisitPutApiV1MeProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeProfile400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeProfile400ApplicationProblemPlusJSONResponse) VisitPutApiV1MeProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeProfile401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeProfile401ApplicationProblemPlusJSONResponse) VisitPutApiV1MeProfileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeReadingListsRequestObject struct {
+}
+
+type GetApiV1MeReadingListsResponseObject interface {
+	VisitGetApiV1MeReadingListsResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1MeReadingLists200JSONResponse []ReadingList
+
+func (response GetApiV1MeReadingLists200JSONResponse) VisitGetApiV1MeReadingListsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeReadingLists401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1MeReadingLists401ApplicationProblemPlusJSONResponse) VisitGetApiV1MeReadingListsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1MeReadingListsRequestObject struct {
+	Body *PostApiV1MeReadingListsJSONRequestBody
+}
+
+type PostApiV1MeReadingListsResponseObject interface {
+	VisitPostApiV1MeReadingListsResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1MeReadingLists201JSONResponse ReadingList
+
+func (response PostApiV1MeReadingLists201JSONResponse) VisitPostApiV1MeReadingListsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1MeReadingLists400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1MeReadingLists400ApplicationProblemPlusJSONResponse) VisitPostApiV1MeReadingListsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1MeReadingLists401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1MeReadingLists401ApplicationProblemPlusJSONResponse) VisitPostApiV1MeReadingListsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1MeReadingListsListIdRequestObject struct {
+	ListId ReadingListId `json:"listId"`
+}
+
+type DeleteApiV1MeReadingListsListIdResponseObject interface {
+	VisitDeleteApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1MeReadingListsListId204Response struct {
+}
+
+func (response DeleteApiV1MeReadingListsListId204Response) VisitDeleteApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiV1MeReadingListsListId401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1MeReadingListsListId401ApplicationProblemPlusJSONResponse) VisitDeleteApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1MeReadingListsListId404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1MeReadingListsListId404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeReadingListsListIdRequestObject struct {
+	ListId ReadingListId `json:"listId"`
+}
+
+type GetApiV1MeReadingListsListIdResponseObject interface {
+	VisitGetApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1MeReadingListsListId200JSONResponse ReadingList
+
+func (response GetApiV1MeReadingListsListId200JSONResponse) VisitGetApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeReadingListsListId401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1MeReadingListsListId401ApplicationProblemPlusJSONResponse) VisitGetApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1MeReadingListsListId404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1MeReadingListsListId404ApplicationProblemPlusJSONResponse) VisitGetApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListIdRequestObject struct {
+	ListId ReadingListId `json:"listId"`
+	Body   *PutApiV1MeReadingListsListIdJSONRequestBody
+}
+
+type PutApiV1MeReadingListsListIdResponseObject interface {
+	VisitPutApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1MeReadingListsListId200JSONResponse ReadingList
+
+func (response PutApiV1MeReadingListsListId200JSONResponse) VisitPutApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListId400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeReadingListsListId400ApplicationProblemPlusJSONResponse) VisitPutApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListId401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeReadingListsListId401ApplicationProblemPlusJSONResponse) VisitPutApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeReadingListsListId404ApplicationProblemPlusJSONResponse) VisitPutApiV1MeReadingListsListIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1MeReadingListsListIdItemsPostIdRequestObject struct {
+	ListId ReadingListId      `json:"listId"`
+	PostId openapi_types.UUID `json:"postId"`
+}
+
+type DeleteApiV1MeReadingListsListIdItemsPostIdResponseObject interface {
+	VisitDeleteApiV1MeReadingListsListIdItemsPostIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1MeReadingListsListIdItemsPostId204Response struct {
+}
+
+func (response DeleteApiV1MeReadingListsListIdItemsPostId204Response) VisitDeleteApiV1MeReadingListsListIdItemsPostIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiV1MeReadingListsListIdItemsPostId401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1MeReadingListsListIdItemsPostId401ApplicationProblemPlusJSONResponse) VisitDeleteApiV1MeReadingListsListIdItemsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1MeReadingListsListIdItemsPostId404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1MeReadingListsListIdItemsPostId404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1MeReadingListsListIdItemsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListIdItemsPostIdRequestObject struct {
+	ListId ReadingListId      `json:"listId"`
+	PostId openapi_types.UUID `json:"postId"`
+}
+
+type PutApiV1MeReadingListsListIdItemsPostIdResponseObject interface {
+	VisitPutApiV1MeReadingListsListIdItemsPostIdResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1MeReadingListsListIdItemsPostId200JSONResponse ReadingList
+
+func (response PutApiV1MeReadingListsListIdItemsPostId200JSONResponse) VisitPutApiV1MeReadingListsListIdItemsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListIdItemsPostId401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeReadingListsListIdItemsPostId401ApplicationProblemPlusJSONResponse) VisitPutApiV1MeReadingListsListIdItemsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListIdItemsPostId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeReadingListsListIdItemsPostId404ApplicationProblemPlusJSONResponse) VisitPutApiV1MeReadingListsListIdItemsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListIdOrderRequestObject struct {
+	ListId ReadingListId `json:"listId"`
+	Body   *PutApiV1MeReadingListsListIdOrderJSONRequestBody
+}
+
+type PutApiV1MeReadingListsListIdOrderResponseObject interface {
+	VisitPutApiV1MeReadingListsListIdOrderResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1MeReadingListsListIdOrder200JSONResponse ReadingList
+
+func (response PutApiV1MeReadingListsListIdOrder200JSONResponse) VisitPutApiV1MeReadingListsListIdOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListIdOrder400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeReadingListsListIdOrder400ApplicationProblemPlusJSONResponse) VisitPutApiV1MeReadingListsListIdOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListIdOrder401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeReadingListsListIdOrder401ApplicationProblemPlusJSONResponse) VisitPutApiV1MeReadingListsListIdOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1MeReadingListsListIdOrder404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1MeReadingListsListIdOrder404ApplicationProblemPlusJSONResponse) VisitPutApiV1MeReadingListsListIdOrderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1ModerationCommentsRequestObject struct {
+	Params GetApiV1ModerationCommentsParams
+}
+
+type GetApiV1ModerationCommentsResponseObject interface {
+	VisitGetApiV1ModerationCommentsResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1ModerationComments200JSONResponse entity.Response[entity.Comment]
+
+func (response GetApiV1ModerationComments200JSONResponse) VisitGetApiV1ModerationCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1ModerationComments401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1ModerationComments401ApplicationProblemPlusJSONResponse) VisitGetApiV1ModerationCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1ModerationComments403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1ModerationComments403ApplicationProblemPlusJSONResponse) VisitGetApiV1ModerationCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1ModerationCommentsRequestObject struct {
+	Body *PostApiV1ModerationCommentsJSONRequestBody
+}
+
+type PostApiV1ModerationCommentsResponseObject interface {
+	VisitPostApiV1ModerationCommentsResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1ModerationComments200JSONResponse struct {
+	// Updated Number of comments whose status changed
+	Updated int `json:"updated"`
+}
+
+func (response PostApiV1ModerationComments200JSONResponse) VisitPostApiV1ModerationCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1ModerationComments400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1ModerationComments400ApplicationProblemPlusJSONResponse) VisitPostApiV1ModerationCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1ModerationComments401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1ModerationComments401ApplicationProblemPlusJSONResponse) VisitPostApiV1ModerationCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1ModerationComments403ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1ModerationComments403ApplicationProblemPlusJSONResponse) VisitPostApiV1ModerationCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1NewsletterConfirmRequestObject struct {
+	Params GetApiV1NewsletterConfirmParams
+}
+
+type GetApiV1NewsletterConfirmResponseObject interface {
+	VisitGetApiV1NewsletterConfirmResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1NewsletterConfirm200JSONResponse Subscriber
+
+func (response GetApiV1NewsletterConfirm200JSONResponse) VisitGetApiV1NewsletterConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1NewsletterConfirm400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1NewsletterConfirm400ApplicationProblemPlusJSONResponse) VisitGetApiV1NewsletterConfirmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1NewsletterSubscriptionsRequestObject struct {
+	Body *PostApiV1NewsletterSubscriptionsJSONRequestBody
+}
+
+type PostApiV1NewsletterSubscriptionsResponseObject interface {
+	VisitPostApiV1NewsletterSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1NewsletterSubscriptions202Response struct {
+}
+
+func (response PostApiV1NewsletterSubscriptions202Response) VisitPostApiV1NewsletterSubscriptionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type PostApiV1NewsletterSubscriptions400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1NewsletterSubscriptions400ApplicationProblemPlusJSONResponse) VisitPostApiV1NewsletterSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1NewsletterSubscriptions503ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1NewsletterSubscriptions503ApplicationProblemPlusJSONResponse) VisitPostApiV1NewsletterSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1NewsletterUnsubscribeRequestObject struct {
+	Params GetApiV1NewsletterUnsubscribeParams
+}
+
+type GetApiV1NewsletterUnsubscribeResponseObject interface {
+	VisitGetApiV1NewsletterUnsubscribeResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1NewsletterUnsubscribe200JSONResponse Subscriber
+
+func (response GetApiV1NewsletterUnsubscribe200JSONResponse) VisitGetApiV1NewsletterUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1NewsletterUnsubscribe400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1NewsletterUnsubscribe400ApplicationProblemPlusJSONResponse) VisitGetApiV1NewsletterUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1NewsletterUnsubscribeRequestObject struct {
+	Params PostApiV1NewsletterUnsubscribeParams
+}
+
+type PostApiV1NewsletterUnsubscribeResponseObject interface {
+	VisitPostApiV1NewsletterUnsubscribeResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1NewsletterUnsubscribe204Response struct {
+}
+
+func (response PostApiV1NewsletterUnsubscribe204Response) VisitPostApiV1NewsletterUnsubscribeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostApiV1NewsletterUnsubscribe400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1NewsletterUnsubscribe400ApplicationProblemPlusJSONResponse) VisitPostApiV1NewsletterUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1NotificationsRequestObject struct {
+	Params GetApiV1NotificationsParams
+}
+
+type GetApiV1NotificationsResponseObject interface {
+	VisitGetApiV1NotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1Notifications200JSONResponse entity.Response[entity.Notification]
+
+func (response GetApiV1Notifications200JSONResponse) VisitGetApiV1NotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Notifications400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Notifications400ApplicationProblemPlusJSONResponse) VisitGetApiV1NotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Notifications401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Notifications401ApplicationProblemPlusJSONResponse) VisitGetApiV1NotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1NotificationsReadAllRequestObject struct {
+}
+
+type PostApiV1NotificationsReadAllResponseObject interface {
+	VisitPostApiV1NotificationsReadAllResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1NotificationsReadAll200JSONResponse struct {
+	// Updated Number of notifications that were unread
+	Updated int `json:"updated"`
+}
+
+func (response PostApiV1NotificationsReadAll200JSONResponse) VisitPostApiV1NotificationsReadAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1NotificationsReadAll401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1NotificationsReadAll401ApplicationProblemPlusJSONResponse) VisitPostApiV1NotificationsReadAllResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1NotificationsNotificationIdReadRequestObject struct {
+	NotificationId NotificationId `json:"notificationId"`
+}
+
+type PostApiV1NotificationsNotificationIdReadResponseObject interface {
+	VisitPostApiV1NotificationsNotificationIdReadResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1NotificationsNotificationIdRead204Response struct {
+}
+
+func (response PostApiV1NotificationsNotificationIdRead204Response) VisitPostApiV1NotificationsNotificationIdReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostApiV1NotificationsNotificationIdRead401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1NotificationsNotificationIdRead401ApplicationProblemPlusJSONResponse) VisitPostApiV1NotificationsNotificationIdReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1NotificationsNotificationIdRead404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1NotificationsNotificationIdRead404ApplicationProblemPlusJSONResponse) VisitPostApiV1NotificationsNotificationIdReadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsRequestObject struct {
+	Params GetApiV1PostsParams
+}
+
+type GetApiV1PostsResponseObject interface {
+	VisitGetApiV1PostsResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1Posts200JSONResponse entity.Response[entity.Post]
+
+func (response GetApiV1Posts200JSONResponse) VisitGetApiV1PostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Posts400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Posts400ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1PostsRequestObject struct {
+	Body *PostApiV1PostsJSONRequestBody
+}
+
+type PostApiV1PostsResponseObject interface {
+	VisitPostApiV1PostsResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1Posts201JSONResponse Post
+
+func (response PostApiV1Posts201JSONResponse) VisitPostApiV1PostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1PostsPostIdRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Params DeleteApiV1PostsPostIdParams
+}
+
+type DeleteApiV1PostsPostIdResponseObject interface {
+	VisitDeleteApiV1PostsPostIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1PostsPostId204Response struct {
+}
+
+func (response DeleteApiV1PostsPostId204Response) VisitDeleteApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiV1PostsPostId404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1PostsPostId404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1PostsPostId412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteApiV1PostsPostId412ApplicationProblemPlusJSONResponse) VisitDeleteApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1PostsPostId428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteApiV1PostsPostId428ApplicationProblemPlusJSONResponse) VisitDeleteApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Params GetApiV1PostsPostIdParams
+}
+
+type GetApiV1PostsPostIdResponseObject interface {
+	VisitGetApiV1PostsPostIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1PostsPostId200ResponseHeaders struct {
+	ETag string
+}
+
+type GetApiV1PostsPostId200JSONResponse struct {
+	Body    Post
+	Headers GetApiV1PostsPostId200ResponseHeaders
+}
+
+func (response GetApiV1PostsPostId200JSONResponse) VisitGetApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetApiV1PostsPostId304ResponseHeaders struct {
+	ETag string
+}
+
+type GetApiV1PostsPostId304Response struct {
+	Headers GetApiV1PostsPostId304ResponseHeaders
+}
+
+func (response GetApiV1PostsPostId304Response) VisitGetApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetApiV1PostsPostId400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1PostsPostId400ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostId404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1PostsPostId404ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1PostsPostIdRequestObject struct {
+	PostId                            openapi_types.UUID `json:"postId"`
+	Params                            PatchApiV1PostsPostIdParams
+	ApplicationJSONPatchPlusJSONBody  *PatchApiV1PostsPostIdApplicationJSONPatchPlusJSONRequestBody
+	ApplicationMergePatchPlusJSONBody *PatchApiV1PostsPostIdApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchApiV1PostsPostIdResponseObject interface {
+	VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error
+}
+
+type PatchApiV1PostsPostId200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchApiV1PostsPostId200JSONResponse struct {
+	Body    Post
+	Headers PatchApiV1PostsPostId200ResponseHeaders
+}
+
+func (response PatchApiV1PostsPostId200JSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchApiV1PostsPostId400ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1PostsPostId400ApplicationProblemPlusJSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1PostsPostId401ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1PostsPostId401ApplicationProblemPlusJSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1PostsPostId403ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1PostsPostId403ApplicationProblemPlusJSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1PostsPostId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1PostsPostId404ApplicationProblemPlusJSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1PostsPostId409ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1PostsPostId409ApplicationProblemPlusJSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1PostsPostId412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchApiV1PostsPostId412ApplicationProblemPlusJSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1PostsPostId415ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1PostsPostId415ApplicationProblemPlusJSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1PostsPostId428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PatchApiV1PostsPostId428ApplicationProblemPlusJSONResponse) VisitPatchApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Params PutApiV1PostsPostIdParams
+	Body   *PutApiV1PostsPostIdJSONRequestBody
+}
+
+type PutApiV1PostsPostIdResponseObject interface {
+	VisitPutApiV1PostsPostIdResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1PostsPostId200ResponseHeaders struct {
+	ETag string
+}
+
+type PutApiV1PostsPostId200JSONResponse struct {
+	Body    Post
+	Headers PutApiV1PostsPostId200ResponseHeaders
+}
+
+func (response PutApiV1PostsPostId200JSONResponse) VisitPutApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutApiV1PostsPostId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostId404ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostId412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PutApiV1PostsPostId412ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostId428ApplicationProblemPlusJSONResponse struct {
+	PreconditionRequiredApplicationProblemPlusJSONResponse
+}
+
+func (response PutApiV1PostsPostId428ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1PostsPostIdBookmarkRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+}
+
+type DeleteApiV1PostsPostIdBookmarkResponseObject interface {
+	VisitDeleteApiV1PostsPostIdBookmarkResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1PostsPostIdBookmark204Response struct {
+}
+
+func (response DeleteApiV1PostsPostIdBookmark204Response) VisitDeleteApiV1PostsPostIdBookmarkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiV1PostsPostIdBookmark401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1PostsPostIdBookmark401ApplicationProblemPlusJSONResponse) VisitDeleteApiV1PostsPostIdBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdBookmarkRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Body   *PutApiV1PostsPostIdBookmarkJSONRequestBody
+}
+
+type PutApiV1PostsPostIdBookmarkResponseObject interface {
+	VisitPutApiV1PostsPostIdBookmarkResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1PostsPostIdBookmark200JSONResponse Bookmark
+
+func (response PutApiV1PostsPostIdBookmark200JSONResponse) VisitPutApiV1PostsPostIdBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdBookmark400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdBookmark400ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdBookmark401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdBookmark401ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdBookmark404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdBookmark404ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdCommentsRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Params GetApiV1PostsPostIdCommentsParams
+}
+
+type GetApiV1PostsPostIdCommentsResponseObject interface {
+	VisitGetApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1PostsPostIdComments200JSONResponse entity.Response[entity.Comment]
+
+func (response GetApiV1PostsPostIdComments200JSONResponse) VisitGetApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdComments400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1PostsPostIdComments400ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdComments404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1PostsPostIdComments404ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1PostsPostIdCommentsRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Body   *PostApiV1PostsPostIdCommentsJSONRequestBody
+}
+
+type PostApiV1PostsPostIdCommentsResponseObject interface {
+	VisitPostApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error
+}
+
+type PostApiV1PostsPostIdComments201JSONResponse Comment
+
+func (response PostApiV1PostsPostIdComments201JSONResponse) VisitPostApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1PostsPostIdComments403ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1PostsPostIdComments403ApplicationProblemPlusJSONResponse) VisitPostApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1PostsPostIdComments404ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1PostsPostIdComments404ApplicationProblemPlusJSONResponse) VisitPostApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiV1PostsPostIdComments422ApplicationProblemPlusJSONResponse Problem
+
+func (response PostApiV1PostsPostIdComments422ApplicationProblemPlusJSONResponse) VisitPostApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdModerationRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Body   *PutApiV1PostsPostIdModerationJSONRequestBody
+}
+
+type PutApiV1PostsPostIdModerationResponseObject interface {
+	VisitPutApiV1PostsPostIdModerationResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1PostsPostIdModeration204Response struct {
+}
+
+func (response PutApiV1PostsPostIdModeration204Response) VisitPutApiV1PostsPostIdModerationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutApiV1PostsPostIdModeration400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdModeration400ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdModerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdModeration401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdModeration401ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdModerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdModeration403ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdModeration403ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdModerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdModeration404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdModeration404ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdModerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdPresenceRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+}
+
+type GetApiV1PostsPostIdPresenceResponseObject interface {
+	VisitGetApiV1PostsPostIdPresenceResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1PostsPostIdPresence101JSONResponse PresenceMessage
+
+func (response GetApiV1PostsPostIdPresence101JSONResponse) VisitGetApiV1PostsPostIdPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(101)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdPresence400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1PostsPostIdPresence400ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsPostIdPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdPresence401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1PostsPostIdPresence401ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsPostIdPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdPresence404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1PostsPostIdPresence404ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsPostIdPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1PostsPostIdPresence503ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1PostsPostIdPresence503ApplicationProblemPlusJSONResponse) VisitGetApiV1PostsPostIdPresenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1PostsPostIdReactionsKindRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Kind   ReactionKind       `json:"kind"`
+}
+
+type DeleteApiV1PostsPostIdReactionsKindResponseObject interface {
+	VisitDeleteApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1PostsPostIdReactionsKind200JSONResponse ReactionSummary
+
+func (response DeleteApiV1PostsPostIdReactionsKind200JSONResponse) VisitDeleteApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1PostsPostIdReactionsKind400ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1PostsPostIdReactionsKind400ApplicationProblemPlusJSONResponse) VisitDeleteApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1PostsPostIdReactionsKind401ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1PostsPostIdReactionsKind401ApplicationProblemPlusJSONResponse) VisitDeleteApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1PostsPostIdReactionsKind404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1PostsPostIdReactionsKind404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdReactionsKindRequestObject struct {
+	PostId openapi_types.UUID `json:"postId"`
+	Kind   ReactionKind       `json:"kind"`
+}
+
+type PutApiV1PostsPostIdReactionsKindResponseObject interface {
+	VisitPutApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1PostsPostIdReactionsKind200JSONResponse ReactionSummary
+
+func (response PutApiV1PostsPostIdReactionsKind200JSONResponse) VisitPutApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdReactionsKind400ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdReactionsKind400ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdReactionsKind401ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdReactionsKind401ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1PostsPostIdReactionsKind404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1PostsPostIdReactionsKind404ApplicationProblemPlusJSONResponse) VisitPutApiV1PostsPostIdReactionsKindResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1ReadingListsSharedTokenRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetApiV1ReadingListsSharedTokenResponseObject interface {
+	VisitGetApiV1ReadingListsSharedTokenResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1ReadingListsSharedToken200JSONResponse ReadingList
+
+func (response GetApiV1ReadingListsSharedToken200JSONResponse) VisitGetApiV1ReadingListsSharedTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1ReadingListsSharedToken404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1ReadingListsSharedToken404ApplicationProblemPlusJSONResponse) VisitGetApiV1ReadingListsSharedTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1StreamRequestObject struct {
+	Params GetApiV1StreamParams
+}
+
+type GetApiV1StreamResponseObject interface {
+	VisitGetApiV1StreamResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1Stream200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetApiV1Stream200TexteventStreamResponse) VisitGetApiV1StreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetApiV1Stream400ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Stream400ApplicationProblemPlusJSONResponse) VisitGetApiV1StreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Stream401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Stream401ApplicationProblemPlusJSONResponse) VisitGetApiV1StreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Stream403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Stream403ApplicationProblemPlusJSONResponse) VisitGetApiV1StreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1UsersRequestObject struct {
+	Params GetApiV1UsersParams
+}
+
+type GetApiV1UsersResponseObject interface {
+	VisitGetApiV1UsersResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1Users200JSONResponse entity.Response[entity.User]
+
+func (response GetApiV1Users200JSONResponse) VisitGetApiV1UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Users401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Users401ApplicationProblemPlusJSONResponse) VisitGetApiV1UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1Users403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1Users403ApplicationProblemPlusJSONResponse) VisitGetApiV1UsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1UsersUserIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type DeleteApiV1UsersUserIdResponseObject interface {
+	VisitDeleteApiV1UsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiV1UsersUserId204Response struct {
+}
+
+func (response DeleteApiV1UsersUserId204Response) VisitDeleteApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiV1UsersUserId403ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1UsersUserId403ApplicationProblemPlusJSONResponse) VisitDeleteApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiV1UsersUserId404ApplicationProblemPlusJSONResponse Problem
+
+func (response DeleteApiV1UsersUserId404ApplicationProblemPlusJSONResponse) VisitDeleteApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1UsersUserIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+type GetApiV1UsersUserIdResponseObject interface {
+	VisitGetApiV1UsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type GetApiV1UsersUserId200JSONResponse User
+
+func (response GetApiV1UsersUserId200JSONResponse) VisitGetApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1UsersUserId403ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1UsersUserId403ApplicationProblemPlusJSONResponse) VisitGetApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiV1UsersUserId404ApplicationProblemPlusJSONResponse Problem
+
+func (response GetApiV1UsersUserId404ApplicationProblemPlusJSONResponse) VisitGetApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1UsersUserIdRequestObject struct {
+	UserId                            openapi_types.UUID `json:"userId"`
+	ApplicationJSONPatchPlusJSONBody  *PatchApiV1UsersUserIdApplicationJSONPatchPlusJSONRequestBody
+	ApplicationMergePatchPlusJSONBody *PatchApiV1UsersUserIdApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchApiV1UsersUserIdResponseObject interface {
+	VisitPatchApiV1UsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PatchApiV1UsersUserId200JSONResponse User
+
+func (response PatchApiV1UsersUserId200JSONResponse) VisitPatchApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1UsersUserId400ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1UsersUserId400ApplicationProblemPlusJSONResponse) VisitPatchApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1UsersUserId401ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1UsersUserId401ApplicationProblemPlusJSONResponse) VisitPatchApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1UsersUserId403ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1UsersUserId403ApplicationProblemPlusJSONResponse) VisitPatchApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1UsersUserId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1UsersUserId404ApplicationProblemPlusJSONResponse) VisitPatchApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1UsersUserId409ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1UsersUserId409ApplicationProblemPlusJSONResponse) VisitPatchApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchApiV1UsersUserId415ApplicationProblemPlusJSONResponse Problem
+
+func (response PatchApiV1UsersUserId415ApplicationProblemPlusJSONResponse) VisitPatchApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1UsersUserIdRequestObject struct {
+	UserId openapi_types.UUID `json:"userId"`
+	Body   *PutApiV1UsersUserIdJSONRequestBody
+}
+
+type PutApiV1UsersUserIdResponseObject interface {
+	VisitPutApiV1UsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PutApiV1UsersUserId200JSONResponse User
+
+func (response PutApiV1UsersUserId200JSONResponse) VisitPutApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1UsersUserId403ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1UsersUserId403ApplicationProblemPlusJSONResponse) VisitPutApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutApiV1UsersUserId404ApplicationProblemPlusJSONResponse Problem
+
+func (response PutApiV1UsersUserId404ApplicationProblemPlusJSONResponse) VisitPutApiV1UsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthLoginRequestObject struct {
+	Body *PostAuthLoginJSONRequestBody
+}
+
+type PostAuthLoginResponseObject interface {
+	VisitPostAuthLoginResponse(w http.ResponseWriter) error
+}
+
+type PostAuthLogin200JSONResponse struct {
+	// Token JWT token for authentication
+	Token string `json:"token"`
+}
+
+func (response PostAuthLogin200JSONResponse) VisitPostAuthLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthLogin400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostAuthLogin400ApplicationProblemPlusJSONResponse) VisitPostAuthLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthLogin401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostAuthLogin401ApplicationProblemPlusJSONResponse) VisitPostAuthLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthLogoutRequestObject struct {
+}
+
+type PostAuthLogoutResponseObject interface {
+	VisitPostAuthLogoutResponse(w http.ResponseWriter) error
+}
+
+type PostAuthLogout200Response struct {
+}
+
+func (response PostAuthLogout200Response) VisitPostAuthLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAuthLogout401ApplicationProblemPlusJSONResponse Problem
+
+func (response PostAuthLogout401ApplicationProblemPlusJSONResponse) VisitPostAuthLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthMeRequestObject struct {
+}
+
+type GetAuthMeResponseObject interface {
+	VisitGetAuthMeResponse(w http.ResponseWriter) error
+}
+
+type GetAuthMe200JSONResponse User
+
+func (response GetAuthMe200JSONResponse) VisitGetAuthMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthMe401ApplicationProblemPlusJSONResponse Problem
+
+func (response GetAuthMe401ApplicationProblemPlusJSONResponse) VisitGetAuthMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRegisterRequestObject struct {
+	Body *PostAuthRegisterJSONRequestBody
+}
+
+type PostAuthRegisterResponseObject interface {
+	VisitPostAuthRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostAuthRegister201JSONResponse User
+
+func (response PostAuthRegister201JSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRegister400ApplicationProblemPlusJSONResponse Problem
+
+func (response PostAuthRegister400ApplicationProblemPlusJSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRegister409ApplicationProblemPlusJSONResponse Problem
+
+func (response PostAuthRegister409ApplicationProblemPlusJSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRegister422ApplicationProblemPlusJSONResponse Problem
+
+func (response PostAuthRegister422ApplicationProblemPlusJSONResponse) VisitPostAuthRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Get background jobs
+	// (GET /api/v1/admin/jobs)
+	GetApiV1AdminJobs(ctx context.Context, request GetApiV1AdminJobsRequestObject) (GetApiV1AdminJobsResponseObject, error)
+	// Get a background job
+	// (GET /api/v1/admin/jobs/{jobId})
+	GetApiV1AdminJobsJobId(ctx context.Context, request GetApiV1AdminJobsJobIdRequestObject) (GetApiV1AdminJobsJobIdResponseObject, error)
+	// Retry a failed job
+	// (POST /api/v1/admin/jobs/{jobId}/retry)
+	PostApiV1AdminJobsJobIdRetry(ctx context.Context, request PostApiV1AdminJobsJobIdRetryRequestObject) (PostApiV1AdminJobsJobIdRetryResponseObject, error)
+	// List webhook subscriptions
+	// (GET /api/v1/admin/webhooks)
+	GetApiV1AdminWebhooks(ctx context.Context, request GetApiV1AdminWebhooksRequestObject) (GetApiV1AdminWebhooksResponseObject, error)
+	// Create a webhook subscription
+	// (POST /api/v1/admin/webhooks)
+	PostApiV1AdminWebhooks(ctx context.Context, request PostApiV1AdminWebhooksRequestObject) (PostApiV1AdminWebhooksResponseObject, error)
+	// Delete a webhook subscription and its delivery log
+	// (DELETE /api/v1/admin/webhooks/{webhookId})
+	DeleteApiV1AdminWebhooksWebhookId(ctx context.Context, request DeleteApiV1AdminWebhooksWebhookIdRequestObject) (DeleteApiV1AdminWebhooksWebhookIdResponseObject, error)
+	// Get a webhook subscription
+	// (GET /api/v1/admin/webhooks/{webhookId})
+	GetApiV1AdminWebhooksWebhookId(ctx context.Context, request GetApiV1AdminWebhooksWebhookIdRequestObject) (GetApiV1AdminWebhooksWebhookIdResponseObject, error)
+	// Update a webhook subscription
+	// (PUT /api/v1/admin/webhooks/{webhookId})
+	PutApiV1AdminWebhooksWebhookId(ctx context.Context, request PutApiV1AdminWebhooksWebhookIdRequestObject) (PutApiV1AdminWebhooksWebhookIdResponseObject, error)
+	// Get the delivery log of a webhook
+	// (GET /api/v1/admin/webhooks/{webhookId}/deliveries)
+	GetApiV1AdminWebhooksWebhookIdDeliveries(ctx context.Context, request GetApiV1AdminWebhooksWebhookIdDeliveriesRequestObject) (GetApiV1AdminWebhooksWebhookIdDeliveriesResponseObject, error)
+	// Queue a delivery again
+	// (POST /api/v1/admin/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+	PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx context.Context, request PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequestObject) (PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponseObject, error)
+	// Send a test event
+	// (POST /api/v1/admin/webhooks/{webhookId}/test)
+	PostApiV1AdminWebhooksWebhookIdTest(ctx context.Context, request PostApiV1AdminWebhooksWebhookIdTestRequestObject) (PostApiV1AdminWebhooksWebhookIdTestResponseObject, error)
+	// Get a public author page
+	// (GET /api/v1/authors/{username})
+	GetApiV1AuthorsUsername(ctx context.Context, request GetApiV1AuthorsUsernameRequestObject) (GetApiV1AuthorsUsernameResponseObject, error)
+	// Unfollow an author
+	// (DELETE /api/v1/authors/{username}/follow)
+	DeleteApiV1AuthorsUsernameFollow(ctx context.Context, request DeleteApiV1AuthorsUsernameFollowRequestObject) (DeleteApiV1AuthorsUsernameFollowResponseObject, error)
+	// Follow an author
+	// (PUT /api/v1/authors/{username}/follow)
+	PutApiV1AuthorsUsernameFollow(ctx context.Context, request PutApiV1AuthorsUsernameFollowRequestObject) (PutApiV1AuthorsUsernameFollowResponseObject, error)
+	// Get the posts of an author
+	// (GET /api/v1/authors/{username}/posts)
+	GetApiV1AuthorsUsernamePosts(ctx context.Context, request GetApiV1AuthorsUsernamePostsRequestObject) (GetApiV1AuthorsUsernamePostsResponseObject, error)
+	// Delete a comment
+	// (DELETE /api/v1/comments/{commentId})
+	DeleteApiV1CommentsCommentId(ctx context.Context, request DeleteApiV1CommentsCommentIdRequestObject) (DeleteApiV1CommentsCommentIdResponseObject, error)
+	// Get a specific comment
+	// (GET /api/v1/comments/{commentId})
+	GetApiV1CommentsCommentId(ctx context.Context, request GetApiV1CommentsCommentIdRequestObject) (GetApiV1CommentsCommentIdResponseObject, error)
+	// Patch a comment
+	// (PATCH /api/v1/comments/{commentId})
+	PatchApiV1CommentsCommentId(ctx context.Context, request PatchApiV1CommentsCommentIdRequestObject) (PatchApiV1CommentsCommentIdResponseObject, error)
+	// Update a comment
+	// (PUT /api/v1/comments/{commentId})
+	PutApiV1CommentsCommentId(ctx context.Context, request PutApiV1CommentsCommentIdRequestObject) (PutApiV1CommentsCommentIdResponseObject, error)
+	// Remove a reaction from a comment
+	// (DELETE /api/v1/comments/{commentId}/reactions/{kind})
+	DeleteApiV1CommentsCommentIdReactionsKind(ctx context.Context, request DeleteApiV1CommentsCommentIdReactionsKindRequestObject) (DeleteApiV1CommentsCommentIdReactionsKindResponseObject, error)
+	// React to a comment
+	// (PUT /api/v1/comments/{commentId}/reactions/{kind})
+	PutApiV1CommentsCommentIdReactionsKind(ctx context.Context, request PutApiV1CommentsCommentIdReactionsKindRequestObject) (PutApiV1CommentsCommentIdReactionsKindResponseObject, error)
+	// Get the home feed
+	// (GET /api/v1/feed)
+	GetApiV1Feed(ctx context.Context, request GetApiV1FeedRequestObject) (GetApiV1FeedResponseObject, error)
+	// Get the current user's bookmarks
+	// (GET /api/v1/me/bookmarks)
+	GetApiV1MeBookmarks(ctx context.Context, request GetApiV1MeBookmarksRequestObject) (GetApiV1MeBookmarksResponseObject, error)
+	// Get the current user's notification preferences
+	// (GET /api/v1/me/notification-preferences)
+	GetApiV1MeNotificationPreferences(ctx context.Context, request GetApiV1MeNotificationPreferencesRequestObject) (GetApiV1MeNotificationPreferencesResponseObject, error)
+	// Update the current user's notification preferences
+	// (PUT /api/v1/me/notification-preferences)
+	PutApiV1MeNotificationPreferences(ctx context.Context, request PutApiV1MeNotificationPreferencesRequestObject) (PutApiV1MeNotificationPreferencesResponseObject, error)
+	// Get the current user's profile
+	// (GET /api/v1/me/profile)
+	GetApiV1MeProfile(ctx context.Context, request GetApiV1MeProfileRequestObject) (GetApiV1MeProfileResponseObject, error)
+	// Replace the current user's profile
+	// (PUT /api/v1/me/profile)
+	PutApiV1MeProfile(ctx context.Context, request PutApiV1MeProfileRequestObject) (PutApiV1MeProfileResponseObject, error)
+	// Get the current user's reading lists
+	// (GET /api/v1/me/reading-lists)
+	GetApiV1MeReadingLists(ctx context.Context, request GetApiV1MeReadingListsRequestObject) (GetApiV1MeReadingListsResponseObject, error)
+	// Create a reading list
+	// (POST /api/v1/me/reading-lists)
+	PostApiV1MeReadingLists(ctx context.Context, request PostApiV1MeReadingListsRequestObject) (PostApiV1MeReadingListsResponseObject, error)
+	// Delete a reading list
+	// (DELETE /api/v1/me/reading-lists/{listId})
+	DeleteApiV1MeReadingListsListId(ctx context.Context, request DeleteApiV1MeReadingListsListIdRequestObject) (DeleteApiV1MeReadingListsListIdResponseObject, error)
+	// Get one of the current user's reading lists with its items
+	// (GET /api/v1/me/reading-lists/{listId})
+	GetApiV1MeReadingListsListId(ctx context.Context, request GetApiV1MeReadingListsListIdRequestObject) (GetApiV1MeReadingListsListIdResponseObject, error)
+	// Rename a reading list or change its visibility
+	// (PUT /api/v1/me/reading-lists/{listId})
+	PutApiV1MeReadingListsListId(ctx context.Context, request PutApiV1MeReadingListsListIdRequestObject) (PutApiV1MeReadingListsListIdResponseObject, error)
+	// Remove a post from a reading list
+	// (DELETE /api/v1/me/reading-lists/{listId}/items/{postId})
+	DeleteApiV1MeReadingListsListIdItemsPostId(ctx context.Context, request DeleteApiV1MeReadingListsListIdItemsPostIdRequestObject) (DeleteApiV1MeReadingListsListIdItemsPostIdResponseObject, error)
+	// Add a post to the end of a reading list
+	// (PUT /api/v1/me/reading-lists/{listId}/items/{postId})
+	PutApiV1MeReadingListsListIdItemsPostId(ctx context.Context, request PutApiV1MeReadingListsListIdItemsPostIdRequestObject) (PutApiV1MeReadingListsListIdItemsPostIdResponseObject, error)
+	// Reorder a reading list
+	// (PUT /api/v1/me/reading-lists/{listId}/order)
+	PutApiV1MeReadingListsListIdOrder(ctx context.Context, request PutApiV1MeReadingListsListIdOrderRequestObject) (PutApiV1MeReadingListsListIdOrderResponseObject, error)
+	// Get the comment moderation queue
+	// (GET /api/v1/moderation/comments)
+	GetApiV1ModerationComments(ctx context.Context, request GetApiV1ModerationCommentsRequestObject) (GetApiV1ModerationCommentsResponseObject, error)
+	// Approve, reject or mark comments as spam in bulk
+	// (POST /api/v1/moderation/comments)
+	PostApiV1ModerationComments(ctx context.Context, request PostApiV1ModerationCommentsRequestObject) (PostApiV1ModerationCommentsResponseObject, error)
+	// Confirm a newsletter subscription
+	// (GET /api/v1/newsletter/confirm)
+	GetApiV1NewsletterConfirm(ctx context.Context, request GetApiV1NewsletterConfirmRequestObject) (GetApiV1NewsletterConfirmResponseObject, error)
+	// Subscribe to the newsletter
+	// (POST /api/v1/newsletter/subscriptions)
+	PostApiV1NewsletterSubscriptions(ctx context.Context, request PostApiV1NewsletterSubscriptionsRequestObject) (PostApiV1NewsletterSubscriptionsResponseObject, error)
+	// Check an unsubscribe link
+	// (GET /api/v1/newsletter/unsubscribe)
+	GetApiV1NewsletterUnsubscribe(ctx context.Context, request GetApiV1NewsletterUnsubscribeRequestObject) (GetApiV1NewsletterUnsubscribeResponseObject, error)
+	// Unsubscribe from the newsletter
+	// (POST /api/v1/newsletter/unsubscribe)
+	PostApiV1NewsletterUnsubscribe(ctx context.Context, request PostApiV1NewsletterUnsubscribeRequestObject) (PostApiV1NewsletterUnsubscribeResponseObject, error)
+	// Get the current user's notifications
+	// (GET /api/v1/notifications)
+	GetApiV1Notifications(ctx context.Context, request GetApiV1NotificationsRequestObject) (GetApiV1NotificationsResponseObject, error)
+	// Mark all notifications as read
+	// (POST /api/v1/notifications/read-all)
+	PostApiV1NotificationsReadAll(ctx context.Context, request PostApiV1NotificationsReadAllRequestObject) (PostApiV1NotificationsReadAllResponseObject, error)
+	// Mark a notification as read
+	// (POST /api/v1/notifications/{notificationId}/read)
+	PostApiV1NotificationsNotificationIdRead(ctx context.Context, request PostApiV1NotificationsNotificationIdReadRequestObject) (PostApiV1NotificationsNotificationIdReadResponseObject, error)
+	// Get all posts
+	// (GET /api/v1/posts)
+	GetApiV1Posts(ctx context.Context, request GetApiV1PostsRequestObject) (GetApiV1PostsResponseObject, error)
+	// Create a new post
+	// (POST /api/v1/posts)
+	PostApiV1Posts(ctx context.Context, request PostApiV1PostsRequestObject) (PostApiV1PostsResponseObject, error)
+	// Delete a post
+	// (DELETE /api/v1/posts/{postId})
+	DeleteApiV1PostsPostId(ctx context.Context, request DeleteApiV1PostsPostIdRequestObject) (DeleteApiV1PostsPostIdResponseObject, error)
+	// Get a specific post
+	// (GET /api/v1/posts/{postId})
+	GetApiV1PostsPostId(ctx context.Context, request GetApiV1PostsPostIdRequestObject) (GetApiV1PostsPostIdResponseObject, error)
+	// Patch a post
+	// (PATCH /api/v1/posts/{postId})
+	PatchApiV1PostsPostId(ctx context.Context, request PatchApiV1PostsPostIdRequestObject) (PatchApiV1PostsPostIdResponseObject, error)
+	// Update a post
+	// (PUT /api/v1/posts/{postId})
+	PutApiV1PostsPostId(ctx context.Context, request PutApiV1PostsPostIdRequestObject) (PutApiV1PostsPostIdResponseObject, error)
+	// Remove a bookmark
+	// (DELETE /api/v1/posts/{postId}/bookmark)
+	DeleteApiV1PostsPostIdBookmark(ctx context.Context, request DeleteApiV1PostsPostIdBookmarkRequestObject) (DeleteApiV1PostsPostIdBookmarkResponseObject, error)
+	// Bookmark a post
+	// (PUT /api/v1/posts/{postId}/bookmark)
+	PutApiV1PostsPostIdBookmark(ctx context.Context, request PutApiV1PostsPostIdBookmarkRequestObject) (PutApiV1PostsPostIdBookmarkResponseObject, error)
+	// Get comments for a specific post
+	// (GET /api/v1/posts/{postId}/comments)
+	GetApiV1PostsPostIdComments(ctx context.Context, request GetApiV1PostsPostIdCommentsRequestObject) (GetApiV1PostsPostIdCommentsResponseObject, error)
+	// Add a comment to a post
+	// (POST /api/v1/posts/{postId}/comments)
+	PostApiV1PostsPostIdComments(ctx context.Context, request PostApiV1PostsPostIdCommentsRequestObject) (PostApiV1PostsPostIdCommentsResponseObject, error)
+	// Set the comment moderation mode of a post
+	// (PUT /api/v1/posts/{postId}/moderation)
+	PutApiV1PostsPostIdModeration(ctx context.Context, request PutApiV1PostsPostIdModerationRequestObject) (PutApiV1PostsPostIdModerationResponseObject, error)
+	// Join the live presence of a post
+	// (GET /api/v1/posts/{postId}/presence)
+	GetApiV1PostsPostIdPresence(ctx context.Context, request GetApiV1PostsPostIdPresenceRequestObject) (GetApiV1PostsPostIdPresenceResponseObject, error)
+	// Remove a reaction from a post
+	// (DELETE /api/v1/posts/{postId}/reactions/{kind})
+	DeleteApiV1PostsPostIdReactionsKind(ctx context.Context, request DeleteApiV1PostsPostIdReactionsKindRequestObject) (DeleteApiV1PostsPostIdReactionsKindResponseObject, error)
+	// React to a post
+	// (PUT /api/v1/posts/{postId}/reactions/{kind})
+	PutApiV1PostsPostIdReactionsKind(ctx context.Context, request PutApiV1PostsPostIdReactionsKindRequestObject) (PutApiV1PostsPostIdReactionsKindResponseObject, error)
+	// Get a shared reading list
+	// (GET /api/v1/reading-lists/shared/{token})
+	GetApiV1ReadingListsSharedToken(ctx context.Context, request GetApiV1ReadingListsSharedTokenRequestObject) (GetApiV1ReadingListsSharedTokenResponseObject, error)
+	// Subscribe to real-time updates
+	// (GET /api/v1/stream)
+	GetApiV1Stream(ctx context.Context, request GetApiV1StreamRequestObject) (GetApiV1StreamResponseObject, error)
+	// Get all users
+	// (GET /api/v1/users)
+	GetApiV1Users(ctx context.Context, request GetApiV1UsersRequestObject) (GetApiV1UsersResponseObject, error)
+	// Delete a user
+	// (DELETE /api/v1/users/{userId})
+	DeleteApiV1UsersUserId(ctx context.Context, request DeleteApiV1UsersUserIdRequestObject) (DeleteApiV1UsersUserIdResponseObject, error)
+	// Get a specific user
+	// (GET /api/v1/users/{userId})
+	GetApiV1UsersUserId(ctx context.Context, request GetApiV1UsersUserIdRequestObject) (GetApiV1UsersUserIdResponseObject, error)
+	// Patch a user
+	// (PATCH /api/v1/users/{userId})
+	PatchApiV1UsersUserId(ctx context.Context, request PatchApiV1UsersUserIdRequestObject) (PatchApiV1UsersUserIdResponseObject, error)
+	// Update a user
+	// (PUT /api/v1/users/{userId})
+	PutApiV1UsersUserId(ctx context.Context, request PutApiV1UsersUserIdRequestObject) (PutApiV1UsersUserIdResponseObject, error)
+	// Login
+	// (POST /auth/login)
+	PostAuthLogin(ctx context.Context, request PostAuthLoginRequestObject) (PostAuthLoginResponseObject, error)
+	// Logout user
+	// (POST /auth/logout)
+	PostAuthLogout(ctx context.Context, request PostAuthLogoutRequestObject) (PostAuthLogoutResponseObject, error)
+	// Get current user
+	// (GET /auth/me)
+	GetAuthMe(ctx context.Context, request GetAuthMeRequestObject) (GetAuthMeResponseObject, error)
+	// Register a new user
+	// (POST /auth/register)
+	PostAuthRegister(ctx context.Context, request PostAuthRegisterRequestObject) (PostAuthRegisterResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetApiV1AdminJobs operation middleware
+func (sh *strictHandler) GetApiV1AdminJobs(w http.ResponseWriter, r *http.Request, params GetApiV1AdminJobsParams) {
+	var request GetApiV1AdminJobsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1AdminJobs(ctx, request.(GetApiV1AdminJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1AdminJobs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1AdminJobsResponseObject); ok {
+		if err := validResponse.VisitGetApiV1AdminJobsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1AdminJobsJobId operation middleware
+func (sh *strictHandler) GetApiV1AdminJobsJobId(w http.ResponseWriter, r *http.Request, jobId openapi_types.UUID) {
+	var request GetApiV1AdminJobsJobIdRequestObject
+
+	request.JobId = jobId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1AdminJobsJobId(ctx, request.(GetApiV1AdminJobsJobIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1AdminJobsJobId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1AdminJobsJobIdResponseObject); ok {
+		if err := validResponse.VisitGetApiV1AdminJobsJobIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1AdminJobsJobIdRetry operation middleware
+func (sh *strictHandler) PostApiV1AdminJobsJobIdRetry(w http.ResponseWriter, r *http.Request, jobId openapi_types.UUID) {
+	var request PostApiV1AdminJobsJobIdRetryRequestObject
+
+	request.JobId = jobId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1AdminJobsJobIdRetry(ctx, request.(PostApiV1AdminJobsJobIdRetryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1AdminJobsJobIdRetry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1AdminJobsJobIdRetryResponseObject); ok {
+		if err := validResponse.VisitPostApiV1AdminJobsJobIdRetryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1AdminWebhooks operation middleware
+func (sh *strictHandler) GetApiV1AdminWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request GetApiV1AdminWebhooksRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1AdminWebhooks(ctx, request.(GetApiV1AdminWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1AdminWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1AdminWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetApiV1AdminWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1AdminWebhooks operation middleware
+func (sh *strictHandler) PostApiV1AdminWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request PostApiV1AdminWebhooksRequestObject
+
+	var body PostApiV1AdminWebhooksJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1AdminWebhooks(ctx, request.(PostApiV1AdminWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1AdminWebhooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1AdminWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostApiV1AdminWebhooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1AdminWebhooksWebhookId operation middleware
+func (sh *strictHandler) DeleteApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
+	var request DeleteApiV1AdminWebhooksWebhookIdRequestObject
+
+	request.WebhookId = webhookId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1AdminWebhooksWebhookId(ctx, request.(DeleteApiV1AdminWebhooksWebhookIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1AdminWebhooksWebhookId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1AdminWebhooksWebhookIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1AdminWebhooksWebhookIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1AdminWebhooksWebhookId operation middleware
+func (sh *strictHandler) GetApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
+	var request GetApiV1AdminWebhooksWebhookIdRequestObject
+
+	request.WebhookId = webhookId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1AdminWebhooksWebhookId(ctx, request.(GetApiV1AdminWebhooksWebhookIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1AdminWebhooksWebhookId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1AdminWebhooksWebhookIdResponseObject); ok {
+		if err := validResponse.VisitGetApiV1AdminWebhooksWebhookIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1AdminWebhooksWebhookId operation middleware
+func (sh *strictHandler) PutApiV1AdminWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
+	var request PutApiV1AdminWebhooksWebhookIdRequestObject
+
+	request.WebhookId = webhookId
+
+	var body PutApiV1AdminWebhooksWebhookIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1AdminWebhooksWebhookId(ctx, request.(PutApiV1AdminWebhooksWebhookIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1AdminWebhooksWebhookId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1AdminWebhooksWebhookIdResponseObject); ok {
+		if err := validResponse.VisitPutApiV1AdminWebhooksWebhookIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1AdminWebhooksWebhookIdDeliveries operation middleware
+func (sh *strictHandler) GetApiV1AdminWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, params GetApiV1AdminWebhooksWebhookIdDeliveriesParams) {
+	var request GetApiV1AdminWebhooksWebhookIdDeliveriesRequestObject
+
+	request.WebhookId = webhookId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1AdminWebhooksWebhookIdDeliveries(ctx, request.(GetApiV1AdminWebhooksWebhookIdDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1AdminWebhooksWebhookIdDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1AdminWebhooksWebhookIdDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetApiV1AdminWebhooksWebhookIdDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver operation middleware
+func (sh *strictHandler) PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, deliveryId openapi_types.UUID) {
+	var request PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequestObject
+
+	request.WebhookId = webhookId
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx, request.(PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponseObject); ok {
+		if err := validResponse.VisitPostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1AdminWebhooksWebhookIdTest operation middleware
+func (sh *strictHandler) PostApiV1AdminWebhooksWebhookIdTest(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
+	var request PostApiV1AdminWebhooksWebhookIdTestRequestObject
+
+	request.WebhookId = webhookId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1AdminWebhooksWebhookIdTest(ctx, request.(PostApiV1AdminWebhooksWebhookIdTestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1AdminWebhooksWebhookIdTest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1AdminWebhooksWebhookIdTestResponseObject); ok {
+		if err := validResponse.VisitPostApiV1AdminWebhooksWebhookIdTestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1AuthorsUsername operation middleware
+func (sh *strictHandler) GetApiV1AuthorsUsername(w http.ResponseWriter, r *http.Request, username Username) {
+	var request GetApiV1AuthorsUsernameRequestObject
+
+	request.Username = username
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1AuthorsUsername(ctx, request.(GetApiV1AuthorsUsernameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1AuthorsUsername")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1AuthorsUsernameResponseObject); ok {
+		if err := validResponse.VisitGetApiV1AuthorsUsernameResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1AuthorsUsernameFollow operation middleware
+func (sh *strictHandler) DeleteApiV1AuthorsUsernameFollow(w http.ResponseWriter, r *http.Request, username Username) {
+	var request DeleteApiV1AuthorsUsernameFollowRequestObject
+
+	request.Username = username
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1AuthorsUsernameFollow(ctx, request.(DeleteApiV1AuthorsUsernameFollowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1AuthorsUsernameFollow")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1AuthorsUsernameFollowResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1AuthorsUsernameFollowResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1AuthorsUsernameFollow operation middleware
+func (sh *strictHandler) PutApiV1AuthorsUsernameFollow(w http.ResponseWriter, r *http.Request, username Username) {
+	var request PutApiV1AuthorsUsernameFollowRequestObject
+
+	request.Username = username
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1AuthorsUsernameFollow(ctx, request.(PutApiV1AuthorsUsernameFollowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1AuthorsUsernameFollow")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1AuthorsUsernameFollowResponseObject); ok {
+		if err := validResponse.VisitPutApiV1AuthorsUsernameFollowResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1AuthorsUsernamePosts operation middleware
+func (sh *strictHandler) GetApiV1AuthorsUsernamePosts(w http.ResponseWriter, r *http.Request, username Username, params GetApiV1AuthorsUsernamePostsParams) {
+	var request GetApiV1AuthorsUsernamePostsRequestObject
+
+	request.Username = username
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1AuthorsUsernamePosts(ctx, request.(GetApiV1AuthorsUsernamePostsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1AuthorsUsernamePosts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1AuthorsUsernamePostsResponseObject); ok {
+		if err := validResponse.VisitGetApiV1AuthorsUsernamePostsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1CommentsCommentId operation middleware
+func (sh *strictHandler) DeleteApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params DeleteApiV1CommentsCommentIdParams) {
+	var request DeleteApiV1CommentsCommentIdRequestObject
+
+	request.CommentId = commentId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1CommentsCommentId(ctx, request.(DeleteApiV1CommentsCommentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1CommentsCommentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1CommentsCommentIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1CommentsCommentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1CommentsCommentId operation middleware
+func (sh *strictHandler) GetApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params GetApiV1CommentsCommentIdParams) {
+	var request GetApiV1CommentsCommentIdRequestObject
+
+	request.CommentId = commentId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1CommentsCommentId(ctx, request.(GetApiV1CommentsCommentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1CommentsCommentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1CommentsCommentIdResponseObject); ok {
+		if err := validResponse.VisitGetApiV1CommentsCommentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchApiV1CommentsCommentId operation middleware
+func (sh *strictHandler) PatchApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params PatchApiV1CommentsCommentIdParams) {
+	var request PatchApiV1CommentsCommentIdRequestObject
+
+	request.CommentId = commentId
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json-patch+json") {
+
+		var body PatchApiV1CommentsCommentIdApplicationJSONPatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationJSONPatchPlusJSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/merge-patch+json") {
+
+		var body PatchApiV1CommentsCommentIdApplicationMergePatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationMergePatchPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchApiV1CommentsCommentId(ctx, request.(PatchApiV1CommentsCommentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchApiV1CommentsCommentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchApiV1CommentsCommentIdResponseObject); ok {
+		if err := validResponse.VisitPatchApiV1CommentsCommentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1CommentsCommentId operation middleware
+func (sh *strictHandler) PutApiV1CommentsCommentId(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, params PutApiV1CommentsCommentIdParams) {
+	var request PutApiV1CommentsCommentIdRequestObject
+
+	request.CommentId = commentId
+	request.Params = params
+
+	var body PutApiV1CommentsCommentIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1CommentsCommentId(ctx, request.(PutApiV1CommentsCommentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1CommentsCommentId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1CommentsCommentIdResponseObject); ok {
+		if err := validResponse.VisitPutApiV1CommentsCommentIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1CommentsCommentIdReactionsKind operation middleware
+func (sh *strictHandler) DeleteApiV1CommentsCommentIdReactionsKind(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, kind ReactionKind) {
+	var request DeleteApiV1CommentsCommentIdReactionsKindRequestObject
+
+	request.CommentId = commentId
+	request.Kind = kind
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1CommentsCommentIdReactionsKind(ctx, request.(DeleteApiV1CommentsCommentIdReactionsKindRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1CommentsCommentIdReactionsKind")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1CommentsCommentIdReactionsKindResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1CommentsCommentIdReactionsKindResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1CommentsCommentIdReactionsKind operation middleware
+func (sh *strictHandler) PutApiV1CommentsCommentIdReactionsKind(w http.ResponseWriter, r *http.Request, commentId openapi_types.UUID, kind ReactionKind) {
+	var request PutApiV1CommentsCommentIdReactionsKindRequestObject
+
+	request.CommentId = commentId
+	request.Kind = kind
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1CommentsCommentIdReactionsKind(ctx, request.(PutApiV1CommentsCommentIdReactionsKindRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1CommentsCommentIdReactionsKind")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1CommentsCommentIdReactionsKindResponseObject); ok {
+		if err := validResponse.VisitPutApiV1CommentsCommentIdReactionsKindResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1Feed operation middleware
+func (sh *strictHandler) GetApiV1Feed(w http.ResponseWriter, r *http.Request, params GetApiV1FeedParams) {
+	var request GetApiV1FeedRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1Feed(ctx, request.(GetApiV1FeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1Feed")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1FeedResponseObject); ok {
+		if err := validResponse.VisitGetApiV1FeedResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1MeBookmarks operation middleware
+func (sh *strictHandler) GetApiV1MeBookmarks(w http.ResponseWriter, r *http.Request, params GetApiV1MeBookmarksParams) {
+	var request GetApiV1MeBookmarksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1MeBookmarks(ctx, request.(GetApiV1MeBookmarksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1MeBookmarks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1MeBookmarksResponseObject); ok {
+		if err := validResponse.VisitGetApiV1MeBookmarksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1MeNotificationPreferences operation middleware
+func (sh *strictHandler) GetApiV1MeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	var request GetApiV1MeNotificationPreferencesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1MeNotificationPreferences(ctx, request.(GetApiV1MeNotificationPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1MeNotificationPreferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1MeNotificationPreferencesResponseObject); ok {
+		if err := validResponse.VisitGetApiV1MeNotificationPreferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1MeNotificationPreferences operation middleware
+func (sh *strictHandler) PutApiV1MeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	var request PutApiV1MeNotificationPreferencesRequestObject
+
+	var body PutApiV1MeNotificationPreferencesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1MeNotificationPreferences(ctx, request.(PutApiV1MeNotificationPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1MeNotificationPreferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1MeNotificationPreferencesResponseObject); ok {
+		if err := validResponse.VisitPutApiV1MeNotificationPreferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1MeProfile operation middleware
+func (sh *strictHandler) GetApiV1MeProfile(w http.ResponseWriter, r *http.Request) {
+	var request GetApiV1MeProfileRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1MeProfile(ctx, request.(GetApiV1MeProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1MeProfile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1MeProfileResponseObject); ok {
+		if err := validResponse.VisitGetApiV1MeProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1MeProfile operation middleware
+func (sh *strictHandler) PutApiV1MeProfile(w http.ResponseWriter, r *http.Request) {
+	var request PutApiV1MeProfileRequestObject
+
+	var body PutApiV1MeProfileJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1MeProfile(ctx, request.(PutApiV1MeProfileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1MeProfile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1MeProfileResponseObject); ok {
+		if err := validResponse.VisitPutApiV1MeProfileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1MeReadingLists operation middleware
+func (sh *strictHandler) GetApiV1MeReadingLists(w http.ResponseWriter, r *http.Request) {
+	var request GetApiV1MeReadingListsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1MeReadingLists(ctx, request.(GetApiV1MeReadingListsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1MeReadingLists")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1MeReadingListsResponseObject); ok {
+		if err := validResponse.VisitGetApiV1MeReadingListsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1MeReadingLists operation middleware
+func (sh *strictHandler) PostApiV1MeReadingLists(w http.ResponseWriter, r *http.Request) {
+	var request PostApiV1MeReadingListsRequestObject
+
+	var body PostApiV1MeReadingListsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1MeReadingLists(ctx, request.(PostApiV1MeReadingListsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1MeReadingLists")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1MeReadingListsResponseObject); ok {
+		if err := validResponse.VisitPostApiV1MeReadingListsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1MeReadingListsListId operation middleware
+func (sh *strictHandler) DeleteApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId) {
+	var request DeleteApiV1MeReadingListsListIdRequestObject
+
+	request.ListId = listId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1MeReadingListsListId(ctx, request.(DeleteApiV1MeReadingListsListIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1MeReadingListsListId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1MeReadingListsListIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1MeReadingListsListIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1MeReadingListsListId operation middleware
+func (sh *strictHandler) GetApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId) {
+	var request GetApiV1MeReadingListsListIdRequestObject
+
+	request.ListId = listId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1MeReadingListsListId(ctx, request.(GetApiV1MeReadingListsListIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1MeReadingListsListId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1MeReadingListsListIdResponseObject); ok {
+		if err := validResponse.VisitGetApiV1MeReadingListsListIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1MeReadingListsListId operation middleware
+func (sh *strictHandler) PutApiV1MeReadingListsListId(w http.ResponseWriter, r *http.Request, listId ReadingListId) {
+	var request PutApiV1MeReadingListsListIdRequestObject
+
+	request.ListId = listId
+
+	var body PutApiV1MeReadingListsListIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1MeReadingListsListId(ctx, request.(PutApiV1MeReadingListsListIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1MeReadingListsListId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1MeReadingListsListIdResponseObject); ok {
+		if err := validResponse.VisitPutApiV1MeReadingListsListIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1MeReadingListsListIdItemsPostId operation middleware
+func (sh *strictHandler) DeleteApiV1MeReadingListsListIdItemsPostId(w http.ResponseWriter, r *http.Request, listId ReadingListId, postId openapi_types.UUID) {
+	var request DeleteApiV1MeReadingListsListIdItemsPostIdRequestObject
+
+	request.ListId = listId
+	request.PostId = postId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1MeReadingListsListIdItemsPostId(ctx, request.(DeleteApiV1MeReadingListsListIdItemsPostIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1MeReadingListsListIdItemsPostId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1MeReadingListsListIdItemsPostIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1MeReadingListsListIdItemsPostIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1MeReadingListsListIdItemsPostId operation middleware
+func (sh *strictHandler) PutApiV1MeReadingListsListIdItemsPostId(w http.ResponseWriter, r *http.Request, listId ReadingListId, postId openapi_types.UUID) {
+	var request PutApiV1MeReadingListsListIdItemsPostIdRequestObject
+
+	request.ListId = listId
+	request.PostId = postId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1MeReadingListsListIdItemsPostId(ctx, request.(PutApiV1MeReadingListsListIdItemsPostIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1MeReadingListsListIdItemsPostId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1MeReadingListsListIdItemsPostIdResponseObject); ok {
+		if err := validResponse.VisitPutApiV1MeReadingListsListIdItemsPostIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1MeReadingListsListIdOrder operation middleware
+func (sh *strictHandler) PutApiV1MeReadingListsListIdOrder(w http.ResponseWriter, r *http.Request, listId ReadingListId) {
+	var request PutApiV1MeReadingListsListIdOrderRequestObject
+
+	request.ListId = listId
+
+	var body PutApiV1MeReadingListsListIdOrderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1MeReadingListsListIdOrder(ctx, request.(PutApiV1MeReadingListsListIdOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1MeReadingListsListIdOrder")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1MeReadingListsListIdOrderResponseObject); ok {
+		if err := validResponse.VisitPutApiV1MeReadingListsListIdOrderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1ModerationComments operation middleware
+func (sh *strictHandler) GetApiV1ModerationComments(w http.ResponseWriter, r *http.Request, params GetApiV1ModerationCommentsParams) {
+	var request GetApiV1ModerationCommentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1ModerationComments(ctx, request.(GetApiV1ModerationCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1ModerationComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1ModerationCommentsResponseObject); ok {
+		if err := validResponse.VisitGetApiV1ModerationCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1ModerationComments operation middleware
+func (sh *strictHandler) PostApiV1ModerationComments(w http.ResponseWriter, r *http.Request) {
+	var request PostApiV1ModerationCommentsRequestObject
+
+	var body PostApiV1ModerationCommentsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1ModerationComments(ctx, request.(PostApiV1ModerationCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1ModerationComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1ModerationCommentsResponseObject); ok {
+		if err := validResponse.VisitPostApiV1ModerationCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1NewsletterConfirm operation middleware
+func (sh *strictHandler) GetApiV1NewsletterConfirm(w http.ResponseWriter, r *http.Request, params GetApiV1NewsletterConfirmParams) {
+	var request GetApiV1NewsletterConfirmRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1NewsletterConfirm(ctx, request.(GetApiV1NewsletterConfirmRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1NewsletterConfirm")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1NewsletterConfirmResponseObject); ok {
+		if err := validResponse.VisitGetApiV1NewsletterConfirmResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1NewsletterSubscriptions operation middleware
+func (sh *strictHandler) PostApiV1NewsletterSubscriptions(w http.ResponseWriter, r *http.Request) {
+	var request PostApiV1NewsletterSubscriptionsRequestObject
+
+	var body PostApiV1NewsletterSubscriptionsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1NewsletterSubscriptions(ctx, request.(PostApiV1NewsletterSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1NewsletterSubscriptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1NewsletterSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitPostApiV1NewsletterSubscriptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1NewsletterUnsubscribe operation middleware
+func (sh *strictHandler) GetApiV1NewsletterUnsubscribe(w http.ResponseWriter, r *http.Request, params GetApiV1NewsletterUnsubscribeParams) {
+	var request GetApiV1NewsletterUnsubscribeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1NewsletterUnsubscribe(ctx, request.(GetApiV1NewsletterUnsubscribeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1NewsletterUnsubscribe")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1NewsletterUnsubscribeResponseObject); ok {
+		if err := validResponse.VisitGetApiV1NewsletterUnsubscribeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1NewsletterUnsubscribe operation middleware
+func (sh *strictHandler) PostApiV1NewsletterUnsubscribe(w http.ResponseWriter, r *http.Request, params PostApiV1NewsletterUnsubscribeParams) {
+	var request PostApiV1NewsletterUnsubscribeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1NewsletterUnsubscribe(ctx, request.(PostApiV1NewsletterUnsubscribeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1NewsletterUnsubscribe")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1NewsletterUnsubscribeResponseObject); ok {
+		if err := validResponse.VisitPostApiV1NewsletterUnsubscribeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1Notifications operation middleware
+func (sh *strictHandler) GetApiV1Notifications(w http.ResponseWriter, r *http.Request, params GetApiV1NotificationsParams) {
+	var request GetApiV1NotificationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1Notifications(ctx, request.(GetApiV1NotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1Notifications")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1NotificationsResponseObject); ok {
+		if err := validResponse.VisitGetApiV1NotificationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1NotificationsReadAll operation middleware
+func (sh *strictHandler) PostApiV1NotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+	var request PostApiV1NotificationsReadAllRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1NotificationsReadAll(ctx, request.(PostApiV1NotificationsReadAllRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1NotificationsReadAll")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1NotificationsReadAllResponseObject); ok {
+		if err := validResponse.VisitPostApiV1NotificationsReadAllResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1NotificationsNotificationIdRead operation middleware
+func (sh *strictHandler) PostApiV1NotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId NotificationId) {
+	var request PostApiV1NotificationsNotificationIdReadRequestObject
+
+	request.NotificationId = notificationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1NotificationsNotificationIdRead(ctx, request.(PostApiV1NotificationsNotificationIdReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1NotificationsNotificationIdRead")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1NotificationsNotificationIdReadResponseObject); ok {
+		if err := validResponse.VisitPostApiV1NotificationsNotificationIdReadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1Posts operation middleware
+func (sh *strictHandler) GetApiV1Posts(w http.ResponseWriter, r *http.Request, params GetApiV1PostsParams) {
+	var request GetApiV1PostsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1Posts(ctx, request.(GetApiV1PostsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1Posts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1PostsResponseObject); ok {
+		if err := validResponse.VisitGetApiV1PostsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1Posts operation middleware
+func (sh *strictHandler) PostApiV1Posts(w http.ResponseWriter, r *http.Request) {
+	var request PostApiV1PostsRequestObject
+
+	var body PostApiV1PostsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1Posts(ctx, request.(PostApiV1PostsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1Posts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1PostsResponseObject); ok {
+		if err := validResponse.VisitPostApiV1PostsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1PostsPostId operation middleware
+func (sh *strictHandler) DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params DeleteApiV1PostsPostIdParams) {
+	var request DeleteApiV1PostsPostIdRequestObject
+
+	request.PostId = postId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1PostsPostId(ctx, request.(DeleteApiV1PostsPostIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1PostsPostId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1PostsPostIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1PostsPostIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1PostsPostId operation middleware
+func (sh *strictHandler) GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params GetApiV1PostsPostIdParams) {
+	var request GetApiV1PostsPostIdRequestObject
+
+	request.PostId = postId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1PostsPostId(ctx, request.(GetApiV1PostsPostIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1PostsPostId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1PostsPostIdResponseObject); ok {
+		if err := validResponse.VisitGetApiV1PostsPostIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchApiV1PostsPostId operation middleware
+func (sh *strictHandler) PatchApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params PatchApiV1PostsPostIdParams) {
+	var request PatchApiV1PostsPostIdRequestObject
+
+	request.PostId = postId
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json-patch+json") {
+
+		var body PatchApiV1PostsPostIdApplicationJSONPatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationJSONPatchPlusJSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/merge-patch+json") {
+
+		var body PatchApiV1PostsPostIdApplicationMergePatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationMergePatchPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchApiV1PostsPostId(ctx, request.(PatchApiV1PostsPostIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchApiV1PostsPostId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchApiV1PostsPostIdResponseObject); ok {
+		if err := validResponse.VisitPatchApiV1PostsPostIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1PostsPostId operation middleware
+func (sh *strictHandler) PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params PutApiV1PostsPostIdParams) {
+	var request PutApiV1PostsPostIdRequestObject
+
+	request.PostId = postId
+	request.Params = params
+
+	var body PutApiV1PostsPostIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1PostsPostId(ctx, request.(PutApiV1PostsPostIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1PostsPostId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1PostsPostIdResponseObject); ok {
+		if err := validResponse.VisitPutApiV1PostsPostIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1PostsPostIdBookmark operation middleware
+func (sh *strictHandler) DeleteApiV1PostsPostIdBookmark(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	var request DeleteApiV1PostsPostIdBookmarkRequestObject
+
+	request.PostId = postId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1PostsPostIdBookmark(ctx, request.(DeleteApiV1PostsPostIdBookmarkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1PostsPostIdBookmark")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1PostsPostIdBookmarkResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1PostsPostIdBookmarkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1PostsPostIdBookmark operation middleware
+func (sh *strictHandler) PutApiV1PostsPostIdBookmark(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	var request PutApiV1PostsPostIdBookmarkRequestObject
+
+	request.PostId = postId
+
+	var body PutApiV1PostsPostIdBookmarkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1PostsPostIdBookmark(ctx, request.(PutApiV1PostsPostIdBookmarkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1PostsPostIdBookmark")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1PostsPostIdBookmarkResponseObject); ok {
+		if err := validResponse.VisitPutApiV1PostsPostIdBookmarkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1PostsPostIdComments operation middleware
+func (sh *strictHandler) GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, params GetApiV1PostsPostIdCommentsParams) {
+	var request GetApiV1PostsPostIdCommentsRequestObject
+
+	request.PostId = postId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1PostsPostIdComments(ctx, request.(GetApiV1PostsPostIdCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1PostsPostIdComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1PostsPostIdCommentsResponseObject); ok {
+		if err := validResponse.VisitGetApiV1PostsPostIdCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiV1PostsPostIdComments operation middleware
+func (sh *strictHandler) PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	var request PostApiV1PostsPostIdCommentsRequestObject
+
+	request.PostId = postId
+
+	var body PostApiV1PostsPostIdCommentsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiV1PostsPostIdComments(ctx, request.(PostApiV1PostsPostIdCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiV1PostsPostIdComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostApiV1PostsPostIdCommentsResponseObject); ok {
+		if err := validResponse.VisitPostApiV1PostsPostIdCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1PostsPostIdModeration operation middleware
+func (sh *strictHandler) PutApiV1PostsPostIdModeration(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	var request PutApiV1PostsPostIdModerationRequestObject
+
+	request.PostId = postId
+
+	var body PutApiV1PostsPostIdModerationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1PostsPostIdModeration(ctx, request.(PutApiV1PostsPostIdModerationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1PostsPostIdModeration")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1PostsPostIdModerationResponseObject); ok {
+		if err := validResponse.VisitPutApiV1PostsPostIdModerationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// GetApiV1PostsPostIdPresence operation middleware
+func (sh *strictHandler) GetApiV1PostsPostIdPresence(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID) {
+	var request GetApiV1PostsPostIdPresenceRequestObject
+
+	request.PostId = postId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1PostsPostIdPresence(ctx, request.(GetApiV1PostsPostIdPresenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1PostsPostIdPresence")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1PostsPostIdPresenceResponseObject); ok {
+		if err := validResponse.VisitGetApiV1PostsPostIdPresenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// DeleteApiV1PostsPostIdReactionsKind operation middleware
+func (sh *strictHandler) DeleteApiV1PostsPostIdReactionsKind(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, kind ReactionKind) {
+	var request DeleteApiV1PostsPostIdReactionsKindRequestObject
+
+	request.PostId = postId
+	request.Kind = kind
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1PostsPostIdReactionsKind(ctx, request.(DeleteApiV1PostsPostIdReactionsKindRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1PostsPostIdReactionsKind")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1PostsPostIdReactionsKindResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1PostsPostIdReactionsKindResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// PutApiV1PostsPostIdReactionsKind operation middleware
+func (sh *strictHandler) PutApiV1PostsPostIdReactionsKind(w http.ResponseWriter, r *http.Request, postId openapi_types.UUID, kind ReactionKind) {
+	var request PutApiV1PostsPostIdReactionsKindRequestObject
+
+	request.PostId = postId
+	request.Kind = kind
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1PostsPostIdReactionsKind(ctx, request.(PutApiV1PostsPostIdReactionsKindRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1PostsPostIdReactionsKind")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1PostsPostIdReactionsKindResponseObject); ok {
+		if err := validResponse.VisitPutApiV1PostsPostIdReactionsKindResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// GetApiV1ReadingListsSharedToken operation middleware
+func (sh *strictHandler) GetApiV1ReadingListsSharedToken(w http.ResponseWriter, r *http.Request, token string) {
+	var request GetApiV1ReadingListsSharedTokenRequestObject
+
+	request.Token = token
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1ReadingListsSharedToken(ctx, request.(GetApiV1ReadingListsSharedTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1ReadingListsSharedToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1ReadingListsSharedTokenResponseObject); ok {
+		if err := validResponse.VisitGetApiV1ReadingListsSharedTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// GetApiV1Stream operation middleware
+func (sh *strictHandler) GetApiV1Stream(w http.ResponseWriter, r *http.Request, params GetApiV1StreamParams) {
+	var request GetApiV1StreamRequestObject
 
-	if r == nil {
-		r = chi.NewRouter()
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1Stream(ctx, request.(GetApiV1StreamRequestObject))
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1Stream")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1StreamResponseObject); ok {
+		if err := validResponse.VisitGetApiV1StreamResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+}
+
+// GetApiV1Users operation middleware
+func (sh *strictHandler) GetApiV1Users(w http.ResponseWriter, r *http.Request, params GetApiV1UsersParams) {
+	var request GetApiV1UsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1Users(ctx, request.(GetApiV1UsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1Users")
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/posts", wrapper.GetApiV1Posts)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/posts", wrapper.PostApiV1Posts)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/posts/{postId}", wrapper.DeleteApiV1PostsPostId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/posts/{postId}", wrapper.GetApiV1PostsPostId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/posts/{postId}", wrapper.PutApiV1PostsPostId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/posts/{postId}/comments", wrapper.GetApiV1PostsPostIdComments)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/posts/{postId}/comments", wrapper.PostApiV1PostsPostIdComments)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/users", wrapper.GetApiV1Users)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/users/{userId}", wrapper.DeleteApiV1UsersUserId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/users/{userId}", wrapper.GetApiV1UsersUserId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/api/v1/users/{userId}", wrapper.PutApiV1UsersUserId)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/login", wrapper.PostAuthLogin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/logout", wrapper.PostAuthLogout)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/auth/me", wrapper.GetAuthMe)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/auth/register", wrapper.PostAuthRegister)
-	})
+	response, err := handler(r.Context(), w, r, request)
 
-	return r
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1UsersResponseObject); ok {
+		if err := validResponse.VisitGetApiV1UsersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiV1UsersUserId operation middleware
+func (sh *strictHandler) DeleteApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	var request DeleteApiV1UsersUserIdRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiV1UsersUserId(ctx, request.(DeleteApiV1UsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiV1UsersUserId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteApiV1UsersUserIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiV1UsersUserIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApiV1UsersUserId operation middleware
+func (sh *strictHandler) GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	var request GetApiV1UsersUserIdRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiV1UsersUserId(ctx, request.(GetApiV1UsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiV1UsersUserId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetApiV1UsersUserIdResponseObject); ok {
+		if err := validResponse.VisitGetApiV1UsersUserIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchApiV1UsersUserId operation middleware
+func (sh *strictHandler) PatchApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	var request PatchApiV1UsersUserIdRequestObject
+
+	request.UserId = userId
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json-patch+json") {
+
+		var body PatchApiV1UsersUserIdApplicationJSONPatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationJSONPatchPlusJSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/merge-patch+json") {
+
+		var body PatchApiV1UsersUserIdApplicationMergePatchPlusJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.ApplicationMergePatchPlusJSONBody = &body
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchApiV1UsersUserId(ctx, request.(PatchApiV1UsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchApiV1UsersUserId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchApiV1UsersUserIdResponseObject); ok {
+		if err := validResponse.VisitPatchApiV1UsersUserIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutApiV1UsersUserId operation middleware
+func (sh *strictHandler) PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
+	var request PutApiV1UsersUserIdRequestObject
+
+	request.UserId = userId
+
+	var body PutApiV1UsersUserIdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutApiV1UsersUserId(ctx, request.(PutApiV1UsersUserIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutApiV1UsersUserId")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutApiV1UsersUserIdResponseObject); ok {
+		if err := validResponse.VisitPutApiV1UsersUserIdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuthLogin operation middleware
+func (sh *strictHandler) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
+	var request PostAuthLoginRequestObject
+
+	var body PostAuthLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthLogin(ctx, request.(PostAuthLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthLogin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAuthLoginResponseObject); ok {
+		if err := validResponse.VisitPostAuthLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuthLogout operation middleware
+func (sh *strictHandler) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+	var request PostAuthLogoutRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthLogout(ctx, request.(PostAuthLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthLogout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAuthLogoutResponseObject); ok {
+		if err := validResponse.VisitPostAuthLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthMe operation middleware
+func (sh *strictHandler) GetAuthMe(w http.ResponseWriter, r *http.Request) {
+	var request GetAuthMeRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthMe(ctx, request.(GetAuthMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthMe")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthMeResponseObject); ok {
+		if err := validResponse.VisitGetAuthMeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuthRegister operation middleware
+func (sh *strictHandler) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
+	var request PostAuthRegisterRequestObject
+
+	var body PostAuthRegisterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthRegister(ctx, request.(PostAuthRegisterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthRegister")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAuthRegisterResponseObject); ok {
+		if err := validResponse.VisitPostAuthRegisterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xajVPbOBb/V3S63sztnkPskKQlMze7lF57oYXSAuWuwHWFrSQC2/JKciFk+N9vJPk7",
-	"TmLna4fpznSmwZbee/q9D/30rAm0qRdQH/uCw94EcnuEPaR+HlDPw76QP/ED8gIXy58oFCPK+g7sQau1",
-	"i9ud7ssGfrV307Bazm4DtTvdRrvV7Vpt62XbNE1oQJv6QsmBZyPCAeEAAVvL3pGvGUYCO/tyQMtsWQ1T",
-	"/jszzZ769xUakEhtnY6JX7VNs4FbezeNtuW0G+il1W20291up9OWyqS2gHJRx7owcOarfzJgwGiAmSCY",
-	"5xGYwAFlHpJTw5A40IBiHGDYg1ww4g/l1GTxE+gR/wP2h2IEe1bZyBSHjFxpXEMQD5cJJ9VsiCGpMDSD",
-	"RjUjngzI8O8hYdiBvUuopEb60sUbKWbZdWbVXSeS6c0ttoU05hjfbykG6wXNtiKist8KTpiH/wycTyjf",
-	"BMjeGAwI4wJIkyTQgggpHf4buy4FF5S5ztbgjHRPoIce4nGtTseYP6+ArRZSB9pzjlkBWuwh4sIeFNT7",
-	"Vf7csaknoxBxfk+ZRDz5acCQY+YjDxfHT8EWSc1gpp+URVaiKTM6ozODyCs5WgjMfNiD//v7L//c+fkS",
-	"NR6vf9I/9xtfo59XV0707NcXf/nbz79chabZ6l7/JIegxuPVlZN7PnllPL0oLUHJenOe6pg5s3ZzZkmL",
-	"9htfzcbet8b1P14szJBEh5FglKy+zI0naEh8JAj1C550iUcE7FmmAelgwLGAPVPKGmIVS5wyiWxU8r4h",
-	"8c3B3JbmUYFcOc+ccmMkcgLlUEYCrRUeh94NZoAOABHY4yDADCg9ibnEF3iImbQ3tmWREEEBvyMBuMED",
-	"yjDgAjFB/KF8blPXxbYAYoQBwzx0BZAiy7Tp5RZ1HYSMYV8oI4GvFJfO1hgVZ59SbQllTnZeJps1gsV5",
-	"Z/JxpC5ZZ4neYloradFSjMgHCY6RkaWRsaXCuWaWVFaHfwgutNwmsCZiNGfzqMOLztXbkshbfgP2iH+S",
-	"caxV9PMWdtkZy9zg9rlo0X9uqHM31GmHTbuqQtma4c061Wxh3arBopYoPTXihGzoGLYd2kRyCZVyp6ql",
-	"S+722A4ZEeNTe4Q9jfhrjBhm+6G0bwJv1F9v4zUfXpzJ7VeNhr3obWroSIgAPknBxB/QaTqwf9IHA8qA",
-	"h3xJ4YbgxqVDVQy5ER8AuQGQ7wC5Lp4tkfv3mFMPg9dyyv5JHxrwO2ZcC7Z2zB11EqQB9lFAYA/uqkcK",
-	"4JFaWBMFpPndaip18sFQczIZbopMKo7wDov9gHyxTtQoOZ0hDwvMOOxdTiCR2n4PMRtDA0YRHLEU3avR",
-	"ix6g0BXZypphOuVCYo5TJsWsLialSNNyZohJ6kMJMS5TochXXsFMqqi8HURQYj/0ZNxm1CClpYSQS6dH",
-	"b/Vv9eJ6OiWuZU7wgPpch2/LNAs7JAoCl9jKw81brs8MqfH5chPkThYvGB7AHvxrM23KNaOOXDNzBol6",
-	"A0qAJriL5kqukJZsxBgal9Vw+SgP7gfCheTRWp/K4NDzEBvryAXIdeN32qjpAJfKcxEuSwrm4jV1xrWQ",
-	"m7fCuIfxlK9ZgoX4acph1trUpjrzsMnnIIoywEPbxpwPQtcd54qgSvBs+bu8luGVInygJAAEfHyvYFbT",
-	"c3WlOdHtniedGC4WeNoFb9Tz1AkncYeorNjI+pWpNfHQPKjZdFzUnZrOmPZ0FivEtP1FxAzYnjnDpwIM",
-	"aOg79YDVgAAUgWpUKM1/NGbmdoLWwQIRl9dCPV8RAA+wTQbETsANwrKyEP6x4K6/BmXOZZXK0JY8GjGy",
-	"zSeVXn+SVDMrVTMmXtU4kY6Ng3jOVIykdKJiu2UzQZUlNpaxHt6WCjSNdZG4ROYMkUsQukV8zE59tzL3",
-	"q8zp1kbdcg2WOHAvf8gvoWtatE/FCLMNLd3ayNKvjQJVX9D5jxv8qoDO4P7ZMliJxsdfQ6eYvLHkOaLO",
-	"CSCxdmlmEEtQNaGMJlQ4PjyTzaA2w1j++8Fva/68/RvMheyCQ1cSkus6d/3gV02qAZ9DvfDZTb8CyHG2",
-	"wfn2HSfFGAhaxv5UZ20h1ztXo2Yn9A/GqdbSCAsjTGs0wuL2bjQg+TPmVPMI3Nr51jJbbitqhuvweQYf",
-	"ILZhpLWikddzaMyyLcykLFTiPurj0kotTK2vVnWLO5zp1FxNa07kf9V7b6rEnasp85hLxaArYS5hLHvD",
-	"zTq5hnrNOjVj1WZdGMXA4n3k2YFcszCuo2bN/iJasd2lvDGdb1FwzG8jrhIPhS5jHBVzu4zPJiqWPDTM",
-	"CIjVnZxewlhbT/M5xXGt5ukqQZ00T3U4q60mFKOmS4dEoTbndByK0Qc1bIUAqn17JtOSW0FKkUqk12jm",
-	"3nCofL1zzrXOtYfz5QQKeodlJODx4ejmnU0+ksP++WPfOiZ93vc/d+yDfrd/F/zny8Hh3g4eHz46F33y",
-	"kfQfjm6PzOOz/+5+fHN33yf35MZ7K76eqsHf0bv28PO7PVc+Rxdvzf4tfTg++1fr6Paoc/SmPx582jkd",
-	"uO8f7j8fnh7h9+/ftj6dtQf3wRE+HOx2Tz7edceHX74h5xPn9x1b9bJmcbnI/mJgH16cAfVKt25CMcK+",
-	"iHCocjdoOrlOk3wCOsILyZLLjQ/JiCQnqC72C5NCjiv36ix73LG0aIgdIOeqVLdKUt3X3QnyiGsmujaq",
-	"mOY6rGcSm1CMjjDc4Bek6pv5WsFQbcHoonABEYaHhAt9o2y+mz/HI9e/f65QFRc0zqpvqTW7Zs/hyt0a",
-	"YjIOjwX3K3LRFgdKdKEiTOTPjden/wcAAP//NBFksCQ3AAA=",
+	"H4sIAAAAAAAC/+x9C3PbNrbwX8Hlt99su5eSZcdOE2fu3PWmTddpk3hjZ3O/xrk1REISagrgAqAVbeL/",
+	"/s05APiQKIqSbclpPZOZWCSI13ni4Dw+B5Ecp1IwYXRw+DkYMRozhX/+cEaH8H/MdKR4argUwWFwNmLk",
+	"iinNpSByQMyIEcW0zFTEyEAmiZywmPSnhJIR1SPfpC/jaUi0JNyQaETFkGky4WZEFKMR9KxDEsnxmAlD",
+	"IpkJo0PSl/JyTNWlDgkXUZLFjFARkwFnSayJkbJLTpmIocs+jS4JF+R40HktBeu8oiYahUQq99D9nox4",
+	"NCJSJFMYLKWKaWKK9XTPRRAG7BMdpwkLDoPz4FFn/Hz3l0//GP3y6JdPL/emPXYeBGGgoxEbU9gbM02h",
+	"pTaKi2FwfX0dBilVdMyM28TndlEvcNLzuwmvaUcz+MiwON8Daozi/czABCVRzGRKPLMzNyPGFYlkko2F",
+	"JlTB/tO4MnEeh5EUhgkT0syMpDqOw0gxGOHIBGHAYeh/ZUxNgzAQdAzf2G2tLC6lxjAFbf/3Gx5/cV1+",
+	"8V1+SaU28B9VTMAf2lCT6S85SF3LLyzmhsXuvyPzJZ/KlyyN7V/ffhPe+RDf/uVPQTgHsdCD6Nji2DyM",
+	"3rIEYePRHEHCxn0WA3YxGo082ByE7JwI10RnaSqVYVXw2PcL4OBQfSEg7Mff/veCtWRKSzW/hjcp/VfG",
+	"SISvyUDJMRHsk7HNgU5Sxa78rwGh+JvLTJOUDlmXHBuiWJrQiNknSIlyMNDMhPj3ONOG9BnJNIstZQNh",
+	"aakMkjwdMxzVkljduu3UGokrDI4HSMn1XAn4VZUfTahG4sAJdckbRz45/+KGaEOVcbyIa88W4i6BHieK",
+	"G0YGlCeuxf7uHpmMmCBajpkUjLBEM8fQ4urYmouI2b2xn+49sZ9CK8tlYcAx15qLIbbDHWPqiimi2L8y",
+	"DuyJmy45D/5yHpAxrJxpQsV0ZYaFG24HLXbc88Wlew4sdcG+w55bHholHBlXAhs+BeZv91AxnUqhGSz2",
+	"UW+fvJaGvJIxH3AW2w2BfbRiYkxgajqXGMSMqCETmSUx4JZmwtx4yYV8WLZuS4hn0tCkjnFnwuBEDTQg",
+	"Ihv3GdION2ysvazhmiRsYIjMDJFmxNSEa9ZM+b9ih5XJxWxAs8QEhwOaaJbTfV/KhFGBs30tDR/wiML0",
+	"jmP4CIdIqRkVI4hqozBwaBYHh0ZlrDzkQKoxNcFhkGU8rmU1J1K3FmvAxleXaV3CPkVMpQa2kZI0oVx0",
+	"DPtkLHdiE69dOLEBP6khYxhsr9cDulQ0AkmM5MW1HcyO7LGP6ksWk4FUqJ4kXBsuhppEFF/BC2ATXGgD",
+	"jEQO/GBd8gNwflyZgY4iqhRnOl+QZqUVz6Itj0PDTcJCt8JbEM/YXy5BXb+FJHWS09BhWXx6HYvFXpI6",
+	"UYb43SCpNzXaIqEN2LdQYs+in1ouwQGOh05y56ror6iKWt5Mh7pL3nMzAlIGpMv1PAdxOrSYi/3GLH5G",
+	"qCBsnJopuaJJ5l5oIqQZcTGcxQg3dGXkEDpdU0/4prrDtkMECEBw8ctv/7JIt3jr4PgTF3GNhpFzcaCQ",
+	"AR9mCnfcfkMuuYh1ZcEJv8w5YZVNQdtG5lQ7t5iL4c8c1EVoUozzeED3D54y2mHsCe08iuh+58nTfdaJ",
+	"+999x3Yf9x4dHCyYR2J7uxmbfKeZassmM83UzVV/6AVW4HnK2hzFd/SFjSlPvigJFG/79BTquEGbloso",
+	"+Z37tgo1I8f1QPFDrYQg12Hg9RAEw4likRQxBzi8oDxhiDOOm8GfNE0TJyh3UiX7CRv/528agPa5NMyf",
+	"FBsEh8H/2SnO0Dv2rd45sV/ZweeV1YqO6hVI1BmRhFCXLZ1dc1U2uA4rs3+b78Hm5p9PimuvqMaAq3YZ",
+	"FTUYIey6hBGP7NFnjhJOsn7CI+KFOkVSCB3Cp0oOeMIITaQYWm3a2giCMEiVTJky3MKVXlFD1TuV1CBB",
+	"GPS5rH0ec50mdPraIeHce2fWUCilSi24MGzIVNGEi2FDGx634Bhh8JvkAhWBcmMgoY7hY1b3BciuhnG1",
+	"jDhNfubi0u5RbDGHJieVvZvflgqE8HtyyabWvCOYmUh1SRwpiixJaD9hnhRdZ7L/G4sMdJaVqHxupAnr",
+	"a25YPWsvqPxDgDtW4gDFymehNAeS0sZ+nJ1fGHzqDGXHPWTCcDPtHvlTev6uw8dwmnecchQcBkNuRlm/",
+	"G8nxTipTpi+zZIdOGJwOO/1EDnfAMMVEvAPgUIImO7EcUy527Bi4PjvOaTYeUzVdSBnavneHc1A6pSqs",
+	"RdiFExKpYhoe2iOt1RL+Kzc55By2QizByJhUH+7sRLHouia4KttG7xg57qZiGMwQS3Bm2XQcHAa7e4/Y",
+	"/sHj7zrsydN+Z3cvftSh+wePO/t7jx/v7u9+t9/r9crQszz+upGEZ1SMMTfG6+3AZeyq4KxJhDREM0Ok",
+	"YEFY0E2meB3FzFD8usPM9duSxBuIoRHhV0Fcj1B3jr9/c0o9dlsBZXGmac3KhDT1LAJQfqnIgjau7XF7",
+	"SLRqOgMX910+lpt5WFpzO2Dlu3fncHJmzoqa9TnwJ7b2BJxrGcHZiGt7NHeMqFvZgMNgr7e32+nBv7Ne",
+	"7xD//RKEgbXS5tYM5B4HBz32ZL/X67C9p/3O/m6836Hf7T7u7O8/fnxwsL/fs4N70LadrD2HBoegECl5",
+	"hcpwfrhcNMN5npRrLU3oV6W767C0ty0wsaS8jbn4mYkhQH63ruXqZOV3fJbVvR8xMEq5U5sVJsDm+owJ",
+	"Yj8CYc+NJrkEmTU++d6PTG3/otI5qLEJ1cZ1HoQtF9CSsfp7gnoDsZ+EGaEpiKFJm+M5KwhbdN6ereQm",
+	"j2U448/UJazxKNv8oaPmU9v4uoLVbbHCWZHnN+tHyTTJUiIFYVdMTXPNXpaB+aw4qaBW4re3YlI3QQj4",
+	"zMfZuIzNuXpaJ+9ynuppokRK+f7kSF0miPI2tOO/nituiv2+YmrITrwxfUZellgA/ZSzgF6vVyeOmhYH",
+	"R73uWzp5xbSmQxYU45/m2FUF+SsZM4XnRWI3uAJT2G0BEPwQpEyApSUIyyxVMZgE/qlTOg4+zk04DH64",
+	"cmu7sZIQU0PrKRwwp7hIloqULd6FEYVwtPiSmCXMkmnNiWWG5XBhHu8HYc35ysiUR7VKi33wudg7qU23",
+	"sMrgT4ew/idOiVnktzK1aO+fFJ/4J8VX5QXnn34M26iZdh2uqdvl1RUaC+Y7J6cXjMUngNpzCOWxA+9h",
+	"2qqMbg1UKYpMuLgcXa6j44AtuQ12CfP+4J7ABD5uYLvA2PeDUlLNbxgaAmsOntTk7hvYhHAry1+evnmN",
+	"13MVwyMYjz/sfqyj17HjQhUDX8l2VPeNypKao9k/acJjy6WgQWlufSXRnFwMsLj3GfjZ9bshi9kugaiz",
+	"qnVLO3vbUGQJB+m7A4fznatdb8jDBQAQTurvZN++eE4eP+3tEQAydc41aBC09x1Swd0zXGrQJHFMcmRZ",
+	"eiuayYd+4/ufo6Cl0qimj3m8VHI8vzrEvhOJu+QVEmdKlQMyllfWNyGS6bQOr2RaZsg0ttILPsM/0MUB",
+	"kMA+8L0wbWplmoVt4xS/ceDY/dbP1lA1ZKaCqztAPTu9WiUN7o4WuGHBK5TTcRx6/wxcPU54Fs9lGrgZ",
+	"f6wRdy9lfx4E1Bg2To2uNyyuIboHXHA9Wu2blrr/pbuWmnsB542c9c29HdNPR42rTOk0kbTcdbFp/8pY",
+	"Vm+vUJlYZY3tlP6Xsn8Dhb9O5tsFhP7Kza+1pGXnKFDdKr/Cm2nfgHR3LvuKXStRPi7ccn0hLPPTWRQx",
+	"FuPTgb0UqqP5QlP+nkXcH6DKJpXI0ahTkAs97TjWMHYrW8fHeStE5Plkzr7yAawC3qR+l6dQUo6WktWY",
+	"fjq2jXfhHDLmwv+c1Zpm8Ks0YOjn3g4ranb4zpHkNZssNmR6q+QNT2VueeWhNrGukuGv/sQENjxDL5mw",
+	"joHWucGMcp80Z//OX/YZVSh8L5noVu8UGo2DcxjddOBdYgRbbOl5y1I45UlCBfFH1OJIaFVYTcd26cvt",
+	"PnNYbaf8sS2oN2ZgeM0mJ85Ifsv23fGUDLjSBncMAI7OP8Fh8HeWJJK8lyqJFxlNb9362U5Swk4UohLd",
+	"aObR33vsRFSzDheaCc0Nv2LPSJzZ63NmG8RKpql1pPB8s4SvB8uxtcxE5w+bbjsrne4dHCzpdQYxbSe1",
+	"NrPWuHoideXlnSFqyV2nhtvS8eq7EQZXXPM+T2CU5UZXP/o/i49mN3SFG7eZFW1iB0+zfgmXZ1H7lPlL",
+	"4eMYTngGPKOli89A07d96949IwmjV4z0JRz5M4PGMeCTk5FMGIFpzfB59PPBQzaNmfpr6co4QHKDhcob",
+	"8gQ3RqmlfRLOYcbcp8ZGrqxCpDPQt0O1Bn8FHJuAP3hNzbB6DxMjx3+FPx00Uqr1RKoYTTpaT3oq/o8W",
+	"9/CLd79GFvsRPgc0Sd4MgsMPZWeyD7Tz74/BdVh5dtT5ZfbZ+Xk82+qvf/qP//uX/z7Per29xx+D64+z",
+	"XimvGYtBx0DPjw4wcmvYECRLU/8kYdBfSCiJ+ZBbf07ndF7uv6wL5EuqYM2TsOwiB0ugnX+fn8eVWX5+",
+	"El7/admt/2LMfFQdg3b+fdT5pdd5+mvn43/+qdW9tPON8eDKl9IamRG3NoHE71l/JGWNwg3HhStWcX2v",
+	"+BWVLx+vfOhaK+OVGxKt1GfQ2XXjmSYMNIsUq1GeT/lQaKcr46FZPyOC2dgN619exZ3dx3UooaokVuuu",
+	"MgtilQT5tPL1twau3/O7h2/pJqIWwl4QzB9K0A92MpJAzdZ9OKIYWATbXb7haHNtm59D2ymia1xHMeNY",
+	"5fxKBJuU70XhXg1k67i4bSsvR9/k6nul2+myOatETPBmlaX7C64miivjgaW42sunaWrtGDRe49LpdRUn",
+	"NojZJ4oNmGIiqrmJYuNavDhKtCSaibgKe3vXAkfxacrA62JG3pbAxMVRms53/BNjaVOf7v6m3IJEDNZZ",
+	"O8qtANfB1c44XE2tqt/mLYFXz8M3rb5sJX8WLOp6iTGtPFS73XuHpthFa9noJp7NXIMXTgVwZzHNfXXx",
+	"8k04Gi5YZK0584QOuchlS0kLTviYG3vOtvGpwWEPlKAhQ/GucamewfxKza9AQ3j7jXF+u73enCrsupwl",
+	"t9fVkD+SMoWxsbVOAn4uyzoxkuhLnpI+G0jFrC8NF3h6i2SSsMh4//osQVfU2tFSd906E26SKQWCCN66",
+	"gMXar7VDhxmFR9qZ4PVhrTCoj5Q8q4+PXOirnMc/LnEVclttgZNvsJt9OxopIdGdE8SmbHMt/C9Xcbis",
+	"M/XdVxfKIrxwiaejowNUM9HdMf8Q38NWPiPSuYGDvkaFFNMxhMMDBjJtdK3ELEczNpH6rFlaN7nuV2L0",
+	"yt50vTravVs3UhvmWeMrsiw0d3aBIyb8VlqnhDwarWzb6e2urw/fvQtmGxvzz7ldIkazg8ZsEAVy5TEG",
+	"eA9jIwwEu1+G5o36llraqzqW4t7c2Ku0wUB+s0vszVjNYZSWzqO3emeSI+Jcrwtivuax7ca+q7j2Qh+s",
+	"SlFQFINDry+ympso26LB4xUaIJ8vHde1JVLAy0xbT7NhIvs0AaXLWI8Br9DKlJVUVuuZmUi9yPOyJU6V",
+	"VrwR7Cq7BjujW5BC9Bk47AThXLw38HP/GmnUXpbpkZwIe/86lYKRvo2X56oIIfD7Fis6gA0oj0JVNOJX",
+	"C3wvTlB+1J21IykEi4rsF7dpLwHyqRxgQMA5DIg54sLHm8QWrRIQVllnyVU+D0gqWYDtvFsyMb+zd49q",
+	"bqRXhVdovQNvjV3QcmZ/cz/njsUWennNeWIXy53xwmbOlTOVYujoeBGAAQ2YqnLJ5pBqN+iy0z6+rHPS",
+	"80HZ8zYlQcDP8Lsnve+IcxMNizQ6cuBkLS4uT8ljE/RY05A+F7QvM3PYT6i4LPQSZ7TUdKoJN+AxGhaJ",
+	"bNwtyuG5OBcd4v1T9c5V7qbbsY5Uh+7QigofkaoSI064y/FzLghMPs7gmSZcYD/P7Kw15maxFnf3ximN",
+	"M2NbxaMz5hozJtmh85B1G1ivYV0Jq36ZlsLqOx4ch6Qc7e6yNVW/w1l0hDQdyBcFEtGOGdEkYYqM6RTD",
+	"Rp2eQ+2sq10AG+lMuIjlpMM+pXbgctxURAURkkAAPFOkz1z8VLUX11h3rPA5LBTL4lPw89G5jKt+D75j",
+	"nZgZDOU4rGTWsekHbJAHoZpA0+rHwHc67BPAyH7qGRGAG81+uZtR9cPfZB83TzGjpnb38KxgMYf8Jvs2",
+	"G08fMMYoPrvqFIDTiaQYJDwyh4RaB3VUkwoXaBJLpsWfDTpCTzH3ygzXWWDF/+FTmlBrJMitqTKyR8go",
+	"T3niZlNx6j0roXyBzrXnKkTw+bGPK3geoopS0JYfVLd13C65qteEPHChDXWydXEkgF8QZuayIKoseoem",
+	"HH3VZeWQXKeLVgf5+9nZiec2kYwrvvz7vdqzbq5gzhiqRlKZcsB8CUDE2aOLCZdCCvLlLBQf1YHevT0m",
+	"uU2V8JgJwwdTtNY1jdjAJ5de/rnO/FnGbWbLUIXvEcX1RuMUTmzujlnL11qpBzB/R/BecfTLAmlFfpR4",
+	"rAaVoU81090FGQpmcmDYRZYGL63aeUPkOSnyNjCR0gSXpC5YloLAJSOpmDtqXErnchVUHSXrCKxduo9l",
+	"81sl+8eYfip3vttbeDgsVJlSzo+l19+t9FeLZ3euvs6ai6qI7fLSYPo9zYcjM8gS9OnAbFeHu3vW3YAB",
+	"LeOjjzVOsb6LJZlaSpxwkaExN4Lh5YRz8p8DhZ3Q3G0iF7Gut5dity7P5zOX42yhmXSxAWGJ+7jL8IOz",
+	"a3eImYXNJpBhsR/jGgbWlrbNfEtbif1ybjTDxrXhjosy88iJaH2I1SOq2Bk4hddlhytZf6Ug2Da2Kn1w",
+	"SxbHW/b/xEX69bstqoxyM6vhRh1GZ1Fg3h0njlfOOcW9Ma7m/vEusrXM3v9500c+lTBfxsogwF3ZJBje",
+	"4C3qHBzsomrU4x/w/J5i4id7MgfaCXOfDrhxcRez7WN7ml0O3FRW3ss3bh6b28x/Vkh/9jRQcJriHElt",
+	"jnproSzSVUNbknBxWU54oPgVNahtY1e1piDn/9uvgymmw1Tj1ehrndu59h67vL3hc/m9QbH2Ww5O9NPP",
+	"YxFvwm9LELpz1JzbkcPPNfkzcryA1Qjtv6lHMOvOU5/DaUNBVzeKhKrO/84hYIer8blYP7ppzEXlkNO0",
+	"r5sKYXrrc/LbuPKhzs0deI1qozowYbEkl4yl8G5878KY7s0dq8WaOwitWIY7D8EWX0uwRWtWt5kgCjtW",
+	"iziK+xM48XMdU8ptDO6ruwydcAsP/e6sIsA2Fj1Rw4Sc9tOQV7GeS63i7qdk4pNsFzrW4hGXBZHlU96e",
+	"DmtXVOhfbmnOVwOvemk85vVexvkGtJ3/JmK8ZpMg+x3CleZKcgl8LRF8MwxLM9XkxrQCAtwMso0eFyv6",
+	"J63Ff9c44blP/jZthfm3wODn7ghburOsbr1bh51bQqjl6Tc7K26My7uBvnc3aBtIwMRy8NaZe1vCN6Ha",
+	"uExAq4zdnIhJsE/rdNqUnsk7uhSn8Pk9bHcYmwFUcS6b2BfrGDCxSfF9GTbLMjFV92r1eLlZvNs0ojeZ",
+	"RcppmGJG680hc8xqNstm2aXw9vNs1rp1ahZlipvpKeCMJd+/YaYciGqAXzZvzguPJC/fn/nqKyga8G2B",
+	"NXDtbKtvcDGQNS5fJ8c2lpQKOuRiiGkarB9mngJV2wJ0IOygBNW5+MG6UoGZkwo9YcpX6/PeCtYNRtu0",
+	"dU/3D777tkuOKl4f58IWxCucF+Cwl9oqJRiz64KTCp8XH8sQh/DQD3wu9nu9wvh60eAXcYFeFL6o2pwj",
+	"2LmwHjJdsr+3Z72Hxoy68OwmFyZbhMkbfI4sUpO/wUYenRwHJTf2YLfb6/ZsBkEmaMqDw+ARPrI59RDc",
+	"3vkF1Q5wbMKnw9q4LzaBDYU21vwUEpnay17wfeKJQdj0p94nBuCIScvARJW7NQHfCX5k5ijl/9w9gmFf",
+	"wqjVKqQfPtcWAMoZS7uCMKXkc9dhfY8+m1xDpab6D10sV03Fu1rn/vpOfCRYXS+99t0UgWTz/dR183Gm",
+	"ttBer9dQjGe+CM8NstdC+rwaLS2txEY22hiLli2NG2/dUj8UGfzuPH3tdY07isZqf0hi12Gw37jnt18A",
+	"ybE1R5oS4z7dRpIS5eHMdjc5s3fCOtnzf9tAiP3eo00OjxyIwMGsSO5bFo3Ii8pC8cNHoB/tfWmAlWEx",
+	"56GSmYgdfK/DGs668/k32T+Or0scdglXfAntF7DGao2x31zL9eu+3ZQpLKX6+qpiv8n+A9IpP4v9Tc7i",
+	"peyjm/cAEHd1pKczaL8E63fQW9p7DMyrF/8ASWz1n99kn9Ah5cKqWpQMFNMjW8UI6qXaQ8W8VgFXLjUE",
+	"9BYH3hYV7d01FQEYXW7YB0K6D4QE4z/ddHVGoBlf7st5Zq9E0UgkhLpvF9CzO/ovPiMgPGxB4SVK/3vf",
+	"1Q3FziqGwhoHorm99PMKiUxiOO/gUeeBslbWi1DhdQhDdCnZoC672y1GH2L9x7yrvvUROXlzekbk4Fxc",
+	"fD4PeHwehOQcQWr/yo1K9iecTM6D64v8xK59HXt9CJFbHXLxPx0H8I439FzYWKBi3DgErbmfMEIjJbV2",
+	"MT16rgc07bjP0TCGJoC5Zmd8zLSh4/TikLwT/BMxfJxH5TjhNvcR5G6jJlPs4pBc6BHdO3j8XxcuO6Y9",
+	"dNsi/Z/I318dPe+c/v1o7+Ax9EKg5wu4e34UGT8y/mRd+xTi7eyDC+cuX3iX4dUmWGGOxJTsffpU1Ma3",
+	"zs5glXA7xeIusYVpcwnt6v26CCjslX2yOMhpguqDHAyeETowLs8FFtqSgs2AAAah8bnIhOEJFjcHKOQD",
+	"w/JHVMTWNtKkE1S4DhqI/ibj6a1J6FKyuuvr61k94nqO1e3e2siVYWs5GnHEscXD56Rgww/MdCVm+hxh",
+	"R2gtQ20Q1Dufc2v9tWW3CbMRLFUq+R6fz9PJ+5KtfwZ392sy1rjJeZvzA5y3oo56MKx5trO4sADX0KjL",
+	"jS6YcyLxemO5QaMFTvU2wQ9BW37gRF8zhlrrQz0rbHPMn1Qw8AZH/TSrQfqTbCnS377mUfX1aqV89Dap",
+	"fPiLyQfl44Hk1yF5i983U4B82Le7t2q65Cxa2vN/S2NGTuXfFyNtjiGFt3JpusBv5OEe9FbvQWd2edt3",
+	"ojPT2eL9aIlGH25JH2TIbauNFbsSOM1gusZcN1hVjux89p3ZGy73a/Et16nNCSmvWKk6mQ3mclazkNBE",
+	"S1fsmcYlgui2NG7VSKHv81m+zee4ecFU7bvYuXt74zbHpedx0r97uILbKmvIwbAmb8DbZ4gA8/3g/XNb",
+	"hmCYNg00zwQGoV24L7rQ/MJdESg+HBlCJ3SKphVbiUW7xFWRVHFhT1+Z/s+YNhsk8493f8RsokTMDFhS",
+	"3qcPtPhVimkgF0ItKJFGqlSI+6t3PvtwmOuGsxxIWefIq4nLiuBS0xGdRSNCLaXZZH80jhXTuuGsZ8d+",
+	"VwQyzZBW3YYVTXbyD++UVOwsa9HDvdk4RtiBFyNEjXUPveEjX/QPT5nNaLDjKnK0vOWogvKF/fY2AVpz",
+	"P/I6z2lp5+oiAzZ8yIFJY14RCw6YCAbZa5ZcsftxtrlXyLnEMCXcFlLhkDW4zg3D1YFeeKAXbYmZcJs6",
+	"lhIhOzKtkfGZ2R7GvnjA098Jnr6Yw9JmbmqzoS71VK4i5Ql+tD5O/g5tjGEpIL6mhlPdEFgOqDxAXoWq",
+	"+Jzi1/P9YWDQr7T0t3uRyjRLqKqNA1sKoeeZ0sjYlrY8ttoWVlBq0x4wBpP86rat3QjBNq23PhHcXFJC",
+	"9sm4vaorZaWl8n5eAgvP0CF7Rmhfl+q8owdUtSZXOXJ0HZNwGECFm3bzgpZcZrp2bi7pUO3k1rM7w0Zu",
+	"0dhsmdz27Mw+SHHWtHy/lXOfrQlTN9ULFB/MufM5r2Xa1v/IJdzSz/2H8/KkYKgtc4TUmDyiUvc3smwu",
+	"Y4gDTMXfUt9yi/YeVAQji7UeZEnyBzRnvJYW2Xzl90G5wMAWKMVDp+rrv7u3qMsc3lBHIy/U8MJ554fB",
+	"/t6T1T59u5bzYO7QVdq5Rq3ud0eCr6VgC8nw9iwvz/3+LkadOE9o7xzRoU+o8LGob9dsB9tgx4/qGMdZ",
+	"QRhEG54kGAySl1OBHUc36eNBBzbD1ge5ySy2T3ozJHAdNlOBNSvplEV8wKMyLaQ+r8+MXIT1MO2rc2AK",
+	"IFejA1MNfPfo6eNv4Y64Ur4DXz1+2tv71teHcyN1CWaULsf3u9yutsxKHHovfsfu4DoAfOZdvlwWQ9EZ",
+	"LLgCXRFK8uJdKI3DotVQMo3e9X4KpeLdeE1FMpEwrdGR043GNTEq04bVe9HD2n7/4rmtX14HUWZF7AYk",
+	"ObGDXYeVLseAWjN9NidLFa6aTiVp6kpMqpTRasPegi24JG7FrPpzI2a1UfX+FU0AM1lsFxL68lF+WUUZ",
+	"qaLGzh9OuTvzG+L4H2I1NUbxfmbY4nJU90bv6z3dznZVykKFhHWHXX9dVtSOGhRK5g30092DTS8RtRWu",
+	"iWAcix9TgszRLV3MSNsNKtFWuld06Fojfy7knVwF5EWhnH8augSzybQs3jG19jBTLG4h6ef4/12I/Mw8",
+	"CPyydLsziVxNNH5r0viWy7bXrLBV6Xb7kQel/VXX/mCNUu++EEfbVfnMeYEvZ95cGN5PKU+ptbeqltUk",
+	"TdzAX6uSM2/DtOn/HqxU5VNfrvuVmDoezV3NzAcr1soRILkEXmJv3slLie18hjpiMwboGWyO2TiVtu6/",
+	"YmN5hdf0eTUyWzwSNC/cNiwaSlzaxxqHoSZztq/4pX+ytc0WytKWPG2LstQvBVdyp5a12TJpNWid76u/",
+	"rfKqUZFdoHyA2Kwrg7gUUGI8R6dLLuI/ngfDciPekhQ1Y3nFylSJHvTLdfIqccO3Yug8b4pUF3TMECwu",
+	"iw4ZZApPIGwwYJHpttePHwj8gcAfCHw9AqcR1tioF/IDZusa1Dr7outR1YJeUwrUupHpEKrP5cmduucC",
+	"auGQwnujCM2p+EOAu3BkGxiJVnwuMvbsXNiMNHLMDSj0s54cdadrf9X2grG4Xe5bO/A6qWpX9Xy6SzqH",
+	"9Z5YZ9457IF3ztN3a6cZB16piN21bZPwOvFtIzlmZMDc5556xmynL+XlmKrLpeHPeUOf6JnRaGRlJTc6",
+	"LztWj9Kv2N/ycRZLv93wdlz9ig574W35/eV99sLb8gG8Iwe7LfrAeSBvO3TZz2OLbmQFXW2Pcd3PQOV1",
+	"2FdZYP9Zlze3ys2ENHzg1tFJFRswxUTUkNvhuRSGcmFNmcIoWwGc+bDBvDNM29fE316XGp+UBr5Dublo",
+	"yHp7VLGU8r78DpBBNKyt+Tom4Rp0MwCtdueExWe6ZhCveWlQQdEPed0oe2rj4ihN/Q/H8FxA0/XH1lbn",
+	"RizZ3A3/rSHrdjjpV0oyzkC6EtVUOWqq5IAnrMRAF3HAE9fyDpHID1GzR/mrr5+hpcVS6vmXO1O6oFWq",
+	"GIkSGCbukp+5uNRknGnsjYyMSeHgAv/rJt5WBt6avIxeUUPVO5W4qj/6cGcnikXXNUE1y7bRO0aOuynW",
+	"SepzCeHBihumCe3LzJAfJd6Gg+bZp9rmuIi5ThM6fW2V7TM5DsJAy4jTBFeM6InaXGnwknrnyjpOWF9z",
+	"w0ptYCKlCba/oK2g4ubY6HIKuAdp1dKvkBZdDepGeqxyRmUr93ewjtNCBRPOA3nS40wJl0oZ8NyMGFcE",
+	"D1ZNmuVbOw52tJHM6KUB22RHd81tPavfA/9VswvyKTwWJNqoAdGaLNTZEt4zBsdOP5EgDK645n2e4KIC",
+	"l7SgPa96zSYVmG42DfXc0IvR5x7koy4D/6tC5jwddHUFDTxr5zP81z4Kq4rnP+O3Kwf2lro4jltGQFVQ",
+	"5D4lkt7oRUplF26ax3kWzZfo9psAfG8bHOcBjdbNmidFXqCiSX4WNwRW91h0qDkdUWX9a3BiXOsM42z0",
+	"CHQnIy+ZeEbG9BLacJMn7rEloRS7kpeuUBQ2bTrq3BEu36nIx12I71bib4X+7sFpRT2wgxuyg7cMsHhG",
+	"qoDZwdpUkfpL6NxKJ9lBdrHzOZU3VVGOoaMTeRuEHt6Oq07qJ3ObefX26/0frOciiwv3hQc8v7GzGQDQ",
+	"O5rNso+l3mbuc5qmjCp0XHIyjxoyhjdSRGw1AfYHQfHtSKh6V7I/LO1IZfF3TRo6imNPAS4qCdQeOZij",
+	"pFZCQqrYZVZuqrpRRzJv8MtNK34rY6ed5j3W37hA8S7YhFhgbF6RgxhBHLtwxo/stb67yE8tV82lH2Gf",
+	"aGSSKXLaB1G4nii0O95EtXm0YR6KsfwWMf/Gu1DPk2ite6n2Xttc+0z9cG60N/whSZnASfanxHtlhSU5",
+	"514vyr22WrEMN6NykYw/qofbzBFfKvSyt5gDfjZRAeTahHj0DvLhbTTZ3RZ98Z4XARDbdMVz09iCJ17B",
+	"GShHvAOMA9dtNvnjBSQ6xirVbCbwte7L7M7OxZO3uSurY/DruxxEbnkudhfI3YfBgLBoGzPcotlu0N7l",
+	"qljk9yzi2lLP7Udwe9PZTAhylWfkjebcurNxn2FoapQTykhq5sWny0AU1HH20ko+5CN8nOUPtSUZ7H5g",
+	"ogq+VbNfnIPmgReswQuOLMWFRDEANsG0FuqyQCaqiU7pGA4I/Syp1tERbKITZgxTO5jjQo0X+k+cUTVk",
+	"xt80JFxcknJ2DDW2/AcdJxd7UbzOB3zuxmsVX4N3CY1GjE0aLU5tebs+q03kf1quyOr2ZqsEJpUPaEew",
+	"NaYQdVAhlBSosbicXwl9ym10Q7kVPnQ+3uWqDiRLUSmgJOZDZv324SCbJzIFNJuMgEhACQnhkRRFVL/y",
+	"DwwdhueCitj2rzFYrYSdsP4ueS3NCK+vNMEMurZyeYHWmsiUCfDiO8NKLxaN4LkPRj0XkxHDCFSXnBVe",
+	"+MVMqCY0gePY1G9dnzXXPy/I4rSyj3dWCf20CtIWMnGv1md/hvBxP7eI6rPlZg82y9B/wD3guenDJw1C",
+	"kwdim7piqpEAc97irXIFjS0ivUzkWNaSe5e+yDm5tdBY+uuSt6VSR3lbVaGRgUuD6DOECUtVIdFcRIxY",
+	"dIioEExZgsIvfYAwV0ROxDNXS4nCQdRRqi7ivk/enJ6FZDLi0YjYOk1JUoFxzhx8eZiGaM6Cxt6VNux3",
+	"J37OFkCsBPNtOvAvl0AjFl2ChJhF0vKhZobsWqEGOUq0JSnIAvqkd/AEREYnSnhU2Z3QCoX+1KJwlHBU",
+	"ozDJyEgKmdlNBaNsp4RJHbzks5l/um04/VawsOZysjSP+D4jRmmexR3qIu5YCqpYGlBbabyspPDrSs+t",
+	"4PaVV8ioidmyHtQzG2cJhF4xeE76jGHGhZhMcby6yWQCGtRPZkATXURb9aVMGBXbLQtcBv22rXnluWwx",
+	"uLZKZw8BtncaU6kXszi8lu3QJCmfvBZJoPKHcAV1lCTBrVJVC1NXDeuYMAWaqWMJt2jrqiwYjSMsJtS6",
+	"R35VOPIK7Dqg51R3L1/KQuz4XP7psq/Fq6LK60ofby2YVru1r3bR0un81f0C2MZvicubtu4tscWcaqxp",
+	"Hdq0Kyy2oJLY7173mb83xf06dCmRaEcz2BKDhjYrIDE0FC5AwVBkf0CfxDA11qF1M9N8KBAswviULliC",
+	"vLgwDb13Lv6NpcNcQWKXdatLXAkxtGmNpDLuks29x0HDmRvYLkFA2uM2+1dGE3LJpva+Hpdoq1JwZ7oq",
+	"LobzOmbhmjXTUmoMU9Dyf79xE//yTdHXl2K1X3CsL/lCvv31G6qjLzDYt9+Eq3/z7ede+Oj62z/VZ1yr",
+	"UXXT+dRVJQ+HrKg2W7dw235ZWqiFo7rVEYpWfe/+xjUxfOGQ+Y5A66DWzQ/2qeO6WGc6fTaQirWeiW1+",
+	"u1OZ9THpku8VHRiNVEFVNOJXLh+3Q2f41GWZGNikr1w5cHZvx9sEaKnsatJi7oYu8nSxb4qBx/TTz0wM",
+	"gbUf9FbcKrxLtDxDG6qqM2CfTEj4UEjoiURUL4LmvxbNZ+/g4KFq4UPVwoeqhfejauGAJ4apkIDMDX19",
+	"dWKrdNcdkdcp1JQkxTKXnCC8qnhH11iWajYbRl2MWRPk4WVktTbgWuHD/gJ0XkFfNRgHgbAoKuFuYgZu",
+	"u/Yibu7iwosbPZCdSP3VJWjP441T2SLO+F4iTEVS36PCgo384C5LCsKub6qe4FZkmZdeOVTvAbHfrLCh",
+	"p747rmpoCxGlPLq0iPDcblbnDHInulKHcCAIfbXDMPfVFzEcS3Rz+UOMncOxufYehH5sV48l1/5g/6C6",
+	"hjM3EQqDYYJmb+r2DgRQFsueTfZ395oLHX4FEm3NcoW5leUDMOfgMDBMG1wjqq47+an0iiYZTDCGgy8y",
+	"RGyubPqkpi/SrJ9wPWJx8RWN49IXgAA7ndIXQ4mLuuNSijBscCiyJHHl8YEiSN/6oNkH16ucxrdXRbFR",
+	"JDzUT3yon3iP6ifWaLMPxRP/iMUTc92kKYL3dyR420PWJm9tb2vYkCC5/Rp1D8fotnXOlhhm8moOa5Q1",
+	"85+uX9asRKR5IYA7KXW0qfwpfhU+h8pXltDUJSvpl4oy1CYn8cu0eGCTk2BaLWmd7lCzt1VihTQNqUm+",
+	"OgRYN2eXBLrCOPriVpIRw+iYjBkz7o6jtTk536xrx+fviK2XxwkX4bqmV1t1yC2j6x/L4+bkBmlVcvAt",
+	"lxE1yRhm80PYIAxfEDeP6euS9yMm7BHCpjEG9oKzsmn3wtIR488aIi3yzAtFXKBi3sIGxhu52Oe5xE4W",
+	"Z4O4J+zkIcnD2kkeYva1Z3lo0bPdg/b+Ae6DG7oIlGSW9Qr4cEt1v9es9+286+9ROW87w+vwlvZFSDxk",
+	"b2R3dje2Ox9n/TUs40Em4xgGMAdkcMDMkGzraNwgvRzuXjckLLitJCcPHixfTaYW78SSS4rt3/0t9lz5",
+	"Gq8Cc+XLRrvP3wvWxjfmCXQGCR0O7ZUeoB6mdYggXtJqcyOWWPfGUj4YVA7d5d6F0wEvntk6NbYDrl3q",
+	"CBY3xC1+TTrgTY6UjTJ2lbNkzhFvyzupNMkH1aFOdVgtLVxTiWQax7VuRo+2UK/Z15WSOvdd5jpnGNu3",
+	"2e7tbWFTco7ls9usk/vU4b+tab3ssF6w1FKW0xnvDagImAPJuT64kAEqYs+VpdJdgqXDsNL9iOELSKnP",
+	"dPGdrS+Iv4eJ7NOEaGa/QJNgK9tfkf7qd8mqYduCw8Bt6ypZ+dE7oNicViy6LjKtELMIwu2n0B9XZ/TH",
+	"u3h/7XPxWLIrOyGhxpXT4FdmVjxdnPEP/rR5m5fxsFQxzUS0OEvMe9Y/ldElM4SJOJVcGKJHcgJcZzKS",
+	"oDVA0kaOViUCCkFxU9Elf1NyopnS5wIvriIqYK3aTfzIYYWds718JCnVpVohxDoxnkOeh1RJIyOZkJRy",
+	"RS6sbTMk51mv9yjC1vgnu+iei3PxHHN0kDHTmg6ZPjwXhHTIxedz5DfnwSE5R6WCnQdh8Sc8des5D8gX",
+	"ch64JZ0H1xfP7KULzocQ4hEK3DWgVd0Iqfs0JFToCYbPofY900raVjDvU8wH1DxvDzQ7dZgv7HFwSD50",
+	"u92P1xdkMmICkvcUWZWdz0ltbwAqx6dsj3DMh3efu93uteuuIBqu7T1EaHdBS1y9VJqMZIL2ZCqITGz6",
+	"nnQKUCeKJbK4kIFQxomy+1ozIaaUVHYm7k942u12YY9QlHLP29wu1fUC6hHu/oJ+SpdDNgeTVah0Ad9I",
+	"CsEwXtA5nsztg/c+l6rcjx5lRpNYTgQA9Mili7F3txpzFo1hZD95eGG7BZFF0H4DARVyAkr5uYi5djPx",
+	"2IMT9Se43V7vCfkmlQmPpuSKywTJ6dumtEclfeDE0/+GPDdmbaW7txmF4dbyym5sbSK+Cbcuac4zteBu",
+	"nrtsQVKDgKKlqTjt5+GSbePJ2s4KIsag6cyq10DKq8nml5L7lPZXaCxExGwnkvMg5Z3Pl1zMRvS08hfx",
+	"XdyKv8hbP5+fuIjv8yXf8voQuA5cxl1XMMGRTh0+1Of5j1xKspJIqS9n0tssC7gUcDeco9AlF/EDK1rL",
+	"uyffQ1eOaMadsomUaWRNARMesSL5oaZjhgDB6B4hySBTeKnFBgMWmVYWgAdyfiDnB3JeiZxptMgiWC19",
+	"ZCtB7nzGw+j1wiP1kZhKUabqkS2HIi7dWYnGvrimzRqz2A2nXDvpFD84cxkhF5L108GTx3Hvye6TJ/vR",
+	"d/Hjg6d0b8Ao7UUHBzTu7R7Uk/T9Sne6chHZe1jDpy4m0IJ8YdUebRSji9OxW9NB55QJQ364gtkS+0WX",
+	"/ECjEaTzFYZEVCluo094HKLnKIAut7TYVrZopIhLabaxS2iGIQkxNbRLjuOEuUE0GcIizsXFIQGzx0Vu",
+	"mEq4YC6X8IBNiEaPa41WmjOZ8ig3cqAifHFY5PkuPT/0+vHFoTOqaqBJICR4Udfy0E0AuswD3Ir71lwZ",
+	"d99mmqnDMTus5Em7OKy6zVVehj4/vybU2qvwwI9cn5Li3I7pY+1W8jhPl0+1cSBRLGKYncYeGs7Fz1Sb",
+	"Du535/h7bxkzEnd4gonxqCZjrjWLCyPbny2ETjHU6lyg1o8XQ9KWAZMT4ZwE0fxQdGENEC66E12KpxDe",
+	"iTOxsK1kiaHkwqGVYpqZC7cIJ/jcuwtiALTnggttGI0PvQkIps/yA5acWL9EgWg5Jf52GnpygzTYMU6x",
+	"RZsqVJTM5+HCCVY93xDpwlpEWJSVx3fSnjH6VGQWrEVXFaAHN2OtkMRnB+HSKZhGO8aGM6jNZI5gdt1t",
+	"7z7D7jiY3Kobtnmd5ghzfdkJuWBmNNw4M53MjGcLW7j1qExODoiWYwbMkiWaVSe7stdKJR+9YjTBdF2e",
+	"K1ckFsxhsTPzUTzmqDIn0y45AQ4dES7s0QDTEfbtHuJKuCb0ivKE9pNSsmdng/d3E3qxqvQOp7JYMfqD",
+	"eQa3culd5j6cuT1t7fobBj493q+0+tN7Bje5Id8nr2FAJ+8yfHsOwG7NDS43Y8oTlDvjv8Kf4OwXrOZ6",
+	"oyRuL+x84USzeEQPITsobs1dz3L35rO8bbfYvVtziwXEefCJvQWfWNjILTrEWt73h/OmQJl900KFkC7O",
+	"7d+ssrDzGf47br72mHWrsqrOiI01S66YS/kJE22+5ECV4B0O12QxaclXa6wmme/7joNhYQ0NqdA27m5D",
+	"HXyMJGMq6JAVqXG3YI/B3VnT+JcnSfNzr1VknfJgHVcqZcwwsZF3G2nAU/QiASsD4WaJDvvVIeyKytDX",
+	"oAO1zRKh6wsyOXq1eeAeSPRmJDqTT82v4I7zqcEwthigRw6S1xicyZTmYzMWU7+NzUWhiryiP/Xii5xQ",
+	"rSdSxc7n2/WIRrKTd2fNKdG+GnaxZmK0O04+VuYz7jGympXIf3sZxxqZT23GsYfMYQ+Zw26Xj39NmcPu",
+	"ffqvdTJ45dKwNjolimQm8oy0zwhtf64iJ0oOeMKsXLKRYMSMlMyGI+JPdGO2k9pmi11TfndCqkGKlLTV",
+	"tVVK1FUL3n7Ldev/KMp3baK0B038Zpp4ngrNzh1NO5kZ7SRyyMWS2mKZGf2MzW5AaqnTlaFP+LOn4v9Y",
+	"gkDrfF01/haffZ43uhYf19wDV6vX+ZZh0ePHVnZR3LbnisXwmya63OquTKK3zHXsJelhwKYvR/0fI/6G",
+	"vzx+9+/j3df8WB+LtwfR8+PHx5fp//zz+cunXTZ9+e/4/TF/w48/vfrtVe/12f979Ob7y8kxn/D++IX5",
+	"5RQbX9Ef94dvf3yawHP6/kXv+Df56fXZD3uvfnt18Or74+ngH93TQfLTp8nbl6ev2E8/vdj7x9n+YJK+",
+	"Yi8Hjx6fvLl8PH35z19p/A+tJwdR0GD/d9OfFa8v35+5KCYM7c/MCPYw8tGXzfhg+2xTy/A0Z2HEEtr2",
+	"7ua3F7ngZxCV6KDJ4crymgqLkplpxaOgXT3CL4ILFJqSmKVBZtvYnPUTFdrlzvLzMWusB5iZ0SsWbOtk",
+	"WzGrfUWVXstVXkt7rdiQa8PUctR861vevrK6hmBdkgqjvQK7+6DA1qG5x4vt23Bqmf/TTeusaIEFTyB7",
+	"+5KAE+2UGOq9rzabk8LSojMyLEtMMeNtbuHqqj1lOQ40MpPr/z8AFYfQ5mWJAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,9 +8,24 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
+	Server      ServerConfig      `mapstructure:"server"`
+	Database    DatabaseConfig    `mapstructure:"database"`
+	JWT         JWTConfig         `mapstructure:"jwt"`
+	Comments    CommentsConfig    `mapstructure:"comments"`
+	Spam        SpamConfig        `mapstructure:"spam"`
+	Reactions   ReactionsConfig   `mapstructure:"reactions"`
+	Stream      StreamConfig      `mapstructure:"stream"`
+	Presence    PresenceConfig    `mapstructure:"presence"`
+	Webhooks    WebhooksConfig    `mapstructure:"webhooks"`
+	Outbox      OutboxConfig      `mapstructure:"outbox"`
+	Jobs        JobsConfig        `mapstructure:"jobs"`
+	Sessions    SessionsConfig    `mapstructure:"sessions"`
+	Site        SiteConfig        `mapstructure:"site"`
+	Mail        MailConfig        `mapstructure:"mail"`
+	Newsletter  NewsletterConfig  `mapstructure:"newsletter"`
+	Feeds       FeedsConfig       `mapstructure:"feeds"`
+	Robots      RobotsConfig      `mapstructure:"robots"`
+	Concurrency ConcurrencyConfig `mapstructure:"concurrency"`
 }
 
 type ServerConfig struct {
@@ -38,6 +53,181 @@ type JWTConfig struct {
 	SecretKey string `mapstructure:"secret_key"`
 }
 
+type CommentsConfig struct {
+	// EditWindow is how long after creation an author may still edit a comment.
+	// Zero disables the limit.
+	EditWindow time.Duration `mapstructure:"edit_window"`
+	// Moderation is the global moderation mode (open, moderated or closed)
+	// used by posts without an override of their own.
+	Moderation string `mapstructure:"moderation"`
+	// TrustedAfter is the number of approved comments after which an author
+	// skips the queue on moderated posts. Zero queues every non-moderator.
+	TrustedAfter int `mapstructure:"trusted_after"`
+}
+
+type SpamConfig struct {
+	// MaxLinks is how many links a comment may contain before it is held
+	// for review. Zero disables the check.
+	MaxLinks int `mapstructure:"max_links"`
+	// Blocklist holds words and email domains that get content rejected.
+	Blocklist []string      `mapstructure:"blocklist"`
+	Bayes     BayesConfig   `mapstructure:"bayes"`
+	Akismet   AkismetConfig `mapstructure:"akismet"`
+}
+
+type BayesConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MinSamples is how many spam and ham decisions are needed before the
+	// classifier starts voting.
+	MinSamples          int     `mapstructure:"min_samples"`
+	SpamThreshold       float64 `mapstructure:"spam_threshold"`
+	SuspiciousThreshold float64 `mapstructure:"suspicious_threshold"`
+}
+
+type AkismetConfig struct {
+	// APIKey enables the Akismet check when set.
+	APIKey  string        `mapstructure:"api_key"`
+	Site    string        `mapstructure:"site"`
+	BaseURL string        `mapstructure:"base_url"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type ReactionsConfig struct {
+	// Kinds lists the reactions users may leave on posts and comments.
+	Kinds []string `mapstructure:"kinds"`
+}
+
+type StreamConfig struct {
+	// Heartbeat is how often an idle stream gets a ping so that proxies keep
+	// the connection open.
+	Heartbeat time.Duration `mapstructure:"heartbeat"`
+	// Retention is how long events are kept for clients resuming with
+	// Last-Event-ID.
+	Retention time.Duration `mapstructure:"retention"`
+	// MaxTopics limits how many topics one connection may subscribe to.
+	MaxTopics int `mapstructure:"max_topics"`
+}
+
+type PresenceConfig struct {
+	// TTL is how long a connection stays listed without a heartbeat. Each
+	// connection sends one every third of it.
+	TTL time.Duration `mapstructure:"ttl"`
+	// MessageRate and MessageBurst limit how many messages per second a
+	// single connection may send. A connection going over them is closed.
+	MessageRate  float64 `mapstructure:"message_rate"`
+	MessageBurst int     `mapstructure:"message_burst"`
+	// MaxMessageBytes caps the size of one incoming message.
+	MaxMessageBytes int `mapstructure:"max_message_bytes"`
+}
+
+// WebhooksConfig sets the retries of webhook deliveries. The deliveries run
+// on the default jobs queue; the jobs settings don't apply to their attempts.
+type WebhooksConfig struct {
+	// MaxAttempts is how many times a delivery is tried before it is marked
+	// dead.
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// BackoffBase doubles after every failed attempt, up to BackoffMax.
+	BackoffBase time.Duration `mapstructure:"backoff_base"`
+	BackoffMax  time.Duration `mapstructure:"backoff_max"`
+	Timeout     time.Duration `mapstructure:"timeout"`
+}
+
+type OutboxConfig struct {
+	// PollInterval is how often the relay looks for new events.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	// Retention is how long relayed events are kept.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+type JobsConfig struct {
+	// Queues maps each queue to how many of its jobs run at once.
+	Queues map[string]int `mapstructure:"queues"`
+	// PollInterval is how often idle queues are checked for due jobs.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	// Timeout bounds a single attempt of a job.
+	Timeout     time.Duration `mapstructure:"timeout"`
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	// BackoffBase doubles after every failed attempt, up to BackoffMax.
+	BackoffBase time.Duration `mapstructure:"backoff_base"`
+	BackoffMax  time.Duration `mapstructure:"backoff_max"`
+	// Retention is how long succeeded jobs are kept.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+type SessionsConfig struct {
+	// MaxPerUser caps the sessions a user can have; logging in once more
+	// ends the oldest one.
+	MaxPerUser int `mapstructure:"max_per_user"`
+	// SweepSchedule is the cron schedule of the expired session sweeper.
+	SweepSchedule  string `mapstructure:"sweep_schedule"`
+	SweepBatchSize int    `mapstructure:"sweep_batch_size"`
+	// CheckCacheTTL is how long a replica trusts that a session is still
+	// active, and so how long an ended session keeps working. Zero checks
+	// every request.
+	CheckCacheTTL time.Duration `mapstructure:"check_cache_ttl"`
+}
+
+type SiteConfig struct {
+	Name string `mapstructure:"name"`
+	// URL is where the frontend is served; links to posts point there.
+	URL string `mapstructure:"url"`
+	// APIURL is where this API is reachable from the outside, for links
+	// that have to hit it directly, such as newsletter confirmations.
+	APIURL string `mapstructure:"api_url"`
+}
+
+type MailConfig struct {
+	// Driver is "smtp", "file" or empty, which disables outgoing mail.
+	Driver string `mapstructure:"driver"`
+	From   string `mapstructure:"from"`
+	// Dir is where the file driver writes messages.
+	Dir  string     `mapstructure:"dir"`
+	SMTP SMTPConfig `mapstructure:"smtp"`
+}
+
+type SMTPConfig struct {
+	Host     string        `mapstructure:"host"`
+	Port     int           `mapstructure:"port"`
+	Username string        `mapstructure:"username"`
+	Password string        `mapstructure:"password"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+type NewsletterConfig struct {
+	// Secret signs confirmation and unsubscribe links. It has to be set,
+	// from NEWSLETTER_SECRET, whenever there is a mail driver, and must not
+	// be the JWT secret.
+	Secret     string        `mapstructure:"secret"`
+	ConfirmTTL time.Duration `mapstructure:"confirm_ttl"`
+	// DigestSchedule is the cron schedule of the digest email.
+	DigestSchedule string `mapstructure:"digest_schedule"`
+	DigestMaxPosts int    `mapstructure:"digest_max_posts"`
+	BatchSize      int    `mapstructure:"batch_size"`
+}
+
+type FeedsConfig struct {
+	// Limit is how many of the newest posts a feed carries.
+	Limit int `mapstructure:"limit"`
+	// Content is "full" or "excerpt"; clients can ask for the other one.
+	Content       string `mapstructure:"content"`
+	ExcerptLength int    `mapstructure:"excerpt_length"`
+}
+
+type RobotsConfig struct {
+	Allow    []string `mapstructure:"allow"`
+	Disallow []string `mapstructure:"disallow"`
+	// DisallowAll keeps every crawler out, e.g. on a staging server.
+	DisallowAll bool `mapstructure:"disallow_all"`
+}
+
+type ConcurrencyConfig struct {
+	// RequireIfMatch rejects updates and deletes of posts and comments that
+	// don't send the ETag they were read with, instead of letting the last
+	// write win.
+	RequireIfMatch bool `mapstructure:"require_if_match"`
+}
+
 func LoadConfig(configPaths []string) (*Config, error) {
 	v := viper.New()
 	v.SetConfigName("config")
@@ -50,6 +240,57 @@ func LoadConfig(configPaths []string) (*Config, error) {
 	v.AutomaticEnv()
 
 	v.SetDefault("jwt.secret_key", "default-secret")
+	v.SetDefault("comments.edit_window", "15m")
+	v.SetDefault("comments.moderation", "open")
+	v.SetDefault("comments.trusted_after", 3)
+	v.SetDefault("spam.max_links", 3)
+	v.SetDefault("spam.bayes.enabled", true)
+	v.SetDefault("spam.bayes.min_samples", 20)
+	v.SetDefault("spam.bayes.spam_threshold", 0.99)
+	v.SetDefault("spam.bayes.suspicious_threshold", 0.8)
+	v.SetDefault("spam.akismet.base_url", "https://rest.akismet.com")
+	v.SetDefault("spam.akismet.timeout", "3s")
+	v.SetDefault("reactions.kinds", []string{"like", "love", "laugh", "insightful"})
+	v.SetDefault("stream.heartbeat", "15s")
+	v.SetDefault("stream.retention", "1h")
+	v.SetDefault("stream.max_topics", 20)
+	v.SetDefault("presence.ttl", "30s")
+	v.SetDefault("presence.message_rate", 5)
+	v.SetDefault("presence.message_burst", 10)
+	v.SetDefault("presence.max_message_bytes", 4096)
+	v.SetDefault("webhooks.max_attempts", 8)
+	v.SetDefault("webhooks.backoff_base", "30s")
+	v.SetDefault("webhooks.backoff_max", "6h")
+	v.SetDefault("webhooks.timeout", "10s")
+	v.SetDefault("outbox.poll_interval", "1s")
+	v.SetDefault("outbox.batch_size", 100)
+	v.SetDefault("outbox.retention", "24h")
+	v.SetDefault("jobs.queues", map[string]int{"default": 5})
+	v.SetDefault("jobs.poll_interval", "2s")
+	v.SetDefault("jobs.timeout", "5m")
+	v.SetDefault("jobs.max_attempts", 10)
+	v.SetDefault("jobs.backoff_base", "10s")
+	v.SetDefault("jobs.backoff_max", "1h")
+	v.SetDefault("jobs.retention", "168h")
+	v.SetDefault("sessions.max_per_user", 10)
+	v.SetDefault("sessions.sweep_schedule", "*/10 * * * *")
+	v.SetDefault("sessions.sweep_batch_size", 1000)
+	v.SetDefault("sessions.check_cache_ttl", "10s")
+	v.SetDefault("site.name", "Awesome Blog")
+	v.SetDefault("site.url", "http://localhost:3000")
+	v.SetDefault("site.api_url", "http://localhost:8080")
+	v.SetDefault("mail.from", "Awesome Blog <no-reply@localhost>")
+	v.SetDefault("mail.smtp.port", 587)
+	v.SetDefault("mail.smtp.timeout", "10s")
+	v.SetDefault("newsletter.confirm_ttl", "72h")
+	v.SetDefault("newsletter.digest_schedule", "0 8 * * *")
+	v.SetDefault("newsletter.digest_max_posts", 10)
+	v.SetDefault("newsletter.batch_size", 100)
+	v.SetDefault("feeds.limit", 20)
+	v.SetDefault("feeds.content", "full")
+	v.SetDefault("feeds.excerpt_length", 280)
+	v.SetDefault("robots.disallow", []string{"/api/", "/auth/", "/swagger/"})
+	v.SetDefault("concurrency.require_if_match", false)
 
 	if err := v.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("error reading config file, %w", err)
@@ -61,10 +302,59 @@ func LoadConfig(configPaths []string) (*Config, error) {
 	}
 
 	c.JWT.SecretKey = v.GetString("JWT_SECRET_KEY")
+	if password := v.GetString("SMTP_PASSWORD"); password != "" {
+		c.Mail.SMTP.Password = password
+	}
+	if secret := v.GetString("NEWSLETTER_SECRET"); secret != "" {
+		c.Newsletter.Secret = secret
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
 
 	return &c, nil
 }
 
+// Validate reports settings the server can't run with.
+func (c *Config) Validate() error {
+	durations := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"stream.heartbeat", c.Stream.Heartbeat},
+		{"stream.retention", c.Stream.Retention},
+		{"presence.ttl", c.Presence.TTL},
+		{"jobs.poll_interval", c.Jobs.PollInterval},
+		{"jobs.timeout", c.Jobs.Timeout},
+	}
+	for _, d := range durations {
+		if d.value <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", d.name, d.value)
+		}
+	}
+
+	switch c.Comments.Moderation {
+	case "open", "moderated", "closed":
+	default:
+		return fmt.Errorf("comments.moderation must be open, moderated or closed, got %q", c.Comments.Moderation)
+	}
+	if c.Comments.TrustedAfter < 0 {
+		return fmt.Errorf("comments.trusted_after must not be negative, got %d", c.Comments.TrustedAfter)
+	}
+
+	if c.Mail.Driver != "" {
+		if c.Newsletter.Secret == "" {
+			return fmt.Errorf("newsletter.secret must be set when mail is on")
+		}
+		if c.Newsletter.Secret == c.JWT.SecretKey {
+			return fmt.Errorf("newsletter.secret must differ from the JWT secret")
+		}
+	}
+
+	return nil
+}
+
 func (d *DatabaseConfig) DSN() string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s",
 		d.User, d.Password, d.Host, d.Port, d.DBName, d.SSLMode)
@@ -1,14 +1,14 @@
 package handlers
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
-	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/gen/api"
 	"github.com/popeskul/awesome-blog/backend/internal/usecase"
 	"github.com/popeskul/awesome-blog/backend/internal/validator"
 )
@@ -34,95 +34,78 @@ func NewAuthHandler(
 	}
 }
 
-func (h *AuthHandler) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
-	var credentials entity.LoginCredentials
-	if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
-		h.logger.WithError(err).Error("Failed to decode request body")
-		respondError(w, http.StatusBadRequest, "Invalid request payload")
-		return
-	}
-
-	if err := h.validator.Struct(&credentials); err != nil {
+func (h *AuthHandler) PostAuthLogin(ctx context.Context, request api.PostAuthLoginRequestObject) (api.PostAuthLoginResponseObject, error) {
+	credentials := request.Body
+	if err := h.validator.Struct(credentials); err != nil {
 		h.logger.WithError(err).Error("Failed to validate request body")
-		respondError(w, http.StatusBadRequest, "Validation failed "+err.Error())
-		return
+		return nil, errorValidation(http.StatusBadRequest, err)
 	}
 
-	ctx := r.Context()
 	token, err := h.authUseCase.Authenticate(ctx, credentials.Username, credentials.Password)
 	if err != nil {
 		h.logger.WithError(err).Error("Authentication failed")
-		respondError(w, http.StatusUnauthorized, "Invalid credentials")
-		return
+		return nil, errorStatus(http.StatusUnauthorized, "Invalid credentials")
 	}
 
-	respondJSON(w, http.StatusOK, map[string]string{"token": token})
+	return api.PostAuthLogin200JSONResponse{Token: token}, nil
 }
 
-func (h *AuthHandler) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
-	var newUser entity.NewUser
-	if err := json.NewDecoder(r.Body).Decode(&newUser); err != nil {
-		h.logger.WithError(err).Error("Failed to decode request body")
-		respondError(w, http.StatusBadRequest, "Invalid request payload")
-		return
-	}
-
-	if err := h.validator.Struct(&newUser); err != nil {
+func (h *AuthHandler) PostAuthRegister(ctx context.Context, request api.PostAuthRegisterRequestObject) (api.PostAuthRegisterResponseObject, error) {
+	newUser := request.Body
+	if err := h.validator.Struct(newUser); err != nil {
 		h.logger.WithError(err).Error("Failed to validate request body")
-		respondError(w, http.StatusBadRequest, "Validation failed "+err.Error())
-		return
+		return nil, errorValidation(http.StatusBadRequest, err)
 	}
 
-	ctx := r.Context()
-	createdUser, err := h.authUseCase.Register(ctx, newUser)
+	r := httpRequest(ctx)
+	newUser.IP = clientIP(r)
+	newUser.UserAgent = r.UserAgent()
+
+	createdUser, err := h.authUseCase.Register(ctx, *newUser)
+	if errors.Is(err, usecase.ErrSpamDetected) {
+		h.logger.WithField("username", newUser.Username).Warn("Registration rejected as spam")
+		return nil, errorUsecase(err, "Failed to register user")
+	}
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to register user")
-		respondError(w, http.StatusInternalServerError, fmt.Errorf("failed to register user: %w", err).Error())
-		return
+		return nil, errorUsecase(err, "Failed to register user")
 	}
 
-	respondJSON(w, http.StatusCreated, createdUser)
+	return api.PostAuthRegister201JSONResponse(*createdUser), nil
 }
 
-func (h *AuthHandler) GetAuthMe(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
+func (h *AuthHandler) GetAuthMe(ctx context.Context, request api.GetAuthMeRequestObject) (api.GetAuthMeResponseObject, error) {
 	userIDRaw := ctx.Value("user_id")
 
 	userID, ok := userIDRaw.(uuid.UUID)
 	if !ok {
 		h.logger.WithField("user_id_raw", userIDRaw).Error("Failed to get user ID from context")
-		respondError(w, http.StatusUnauthorized, "Unauthorized")
-		return
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
 	}
 
 	user, err := h.userUseCase.GetUserByID(ctx, userID)
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to get user information")
-		respondError(w, http.StatusInternalServerError, "Failed to get user information")
-		return
+		return nil, errorUsecase(err, "Failed to get user information")
 	}
 
-	respondJSON(w, http.StatusOK, user)
+	return api.GetAuthMe200JSONResponse(*user), nil
 }
 
-func (h *AuthHandler) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+func (h *AuthHandler) PostAuthLogout(ctx context.Context, request api.PostAuthLogoutRequestObject) (api.PostAuthLogoutResponseObject, error) {
 	sessionID, ok := ctx.Value("session_id").(uuid.UUID)
 	if !ok {
 		h.logger.Error("Failed to get session ID from context")
-		respondError(w, http.StatusUnauthorized, "Unauthorized")
-		return
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
 	}
 
 	h.logger.WithField("logout_session_id", sessionID).Info("Attempting to logout")
 
 	if err := h.authUseCase.Logout(ctx, sessionID); err != nil {
 		h.logger.WithError(err).Error("Failed to logout")
-		respondError(w, http.StatusInternalServerError, "Failed to logout")
-		return
+		return nil, errorUsecase(err, "Failed to logout")
 	}
 
 	h.logger.WithField("logout_session_id", sessionID).Info("Logout successful")
-	respondJSON(w, http.StatusOK, map[string]string{"message": "Logged out successfully"})
+	return api.PostAuthLogout200Response{}, nil
 }
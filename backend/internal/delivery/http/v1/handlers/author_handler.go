@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+type AuthorHandler struct {
+	profileUseCase usecase.UseCaseProfile
+	postUseCase    usecase.UseCasePost
+	logger         *logrus.Logger
+	validator      validator.Validator
+}
+
+func NewAuthorHandler(profileUseCase usecase.UseCaseProfile, postUseCase usecase.UseCasePost, logger *logrus.Logger, validator validator.Validator) *AuthorHandler {
+	return &AuthorHandler{
+		profileUseCase: profileUseCase,
+		postUseCase:    postUseCase,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+func (h *AuthorHandler) GetApiV1AuthorsUsername(ctx context.Context, request api.GetApiV1AuthorsUsernameRequestObject) (api.GetApiV1AuthorsUsernameResponseObject, error) {
+	author, err := h.profileUseCase.GetAuthor(ctx, request.Username)
+	if err != nil {
+		h.logger.WithError(err).WithField("username", request.Username).Error("Failed to get author")
+		return nil, errorUsecase(err, "Failed to get author")
+	}
+
+	return api.GetApiV1AuthorsUsername200JSONResponse(*author), nil
+}
+
+func (h *AuthorHandler) GetApiV1AuthorsUsernamePosts(ctx context.Context, request api.GetApiV1AuthorsUsernamePostsRequestObject) (api.GetApiV1AuthorsUsernamePostsResponseObject, error) {
+	username, params := request.Username, request.Params
+
+	paginationFromParams, err := entity.NewPaginationFromParams(entity.RemoteParams{
+		Page:         params.Page,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+		Sort:         (*string)(params.Sort),
+		Cursor:       params.Cursor,
+		IncludeTotal: params.IncludeTotal,
+	})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get pagination from params")
+		return nil, errorStatus(http.StatusBadRequest, "Invalid request parameters")
+	}
+
+	include, err := entity.ParsePostInclude(params.Include)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to parse include")
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
+	}
+
+	paginationFromParams.Fields, err = entity.ParseFields(params.Fields, entity.PostFields)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to parse fields")
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
+	}
+
+	author, err := h.profileUseCase.GetAuthor(ctx, username)
+	if err != nil {
+		h.logger.WithError(err).WithField("username", username).Error("Failed to get author")
+		return nil, errorUsecase(err, "Failed to get author")
+	}
+
+	viewerId, _ := ctx.Value("user_id").(uuid.UUID)
+
+	result, err := h.postUseCase.GetPostsByAuthor(ctx, author.Id, viewerId, paginationFromParams, include)
+	if err != nil {
+		h.logger.WithError(err).WithField("username", username).Error("Failed to get author posts")
+		return nil, errorUsecase(err, "Failed to get posts")
+	}
+
+	return page[entity.Post]{result}, nil
+}
+
+func (h *AuthorHandler) GetApiV1MeProfile(ctx context.Context, request api.GetApiV1MeProfileRequestObject) (api.GetApiV1MeProfileResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	profile, err := h.profileUseCase.GetProfile(ctx, userId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get profile")
+		return nil, errorUsecase(err, "Failed to get profile")
+	}
+
+	return api.GetApiV1MeProfile200JSONResponse(*profile), nil
+}
+
+func (h *AuthorHandler) PutApiV1MeProfile(ctx context.Context, request api.PutApiV1MeProfileRequestObject) (api.PutApiV1MeProfileResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	update := entity.UpdateProfile{
+		DisplayName: request.Body.DisplayName,
+		Bio:         request.Body.Bio,
+		AvatarURL:   request.Body.AvatarURL,
+		Website:     request.Body.Website,
+		SocialLinks: request.Body.SocialLinks,
+	}
+
+	if err := h.validator.Struct(&update); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	profile, err := h.profileUseCase.UpdateProfile(ctx, userId, &update)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to update profile")
+		return nil, errorUsecase(err, "Failed to update profile")
+	}
+
+	return api.PutApiV1MeProfile200JSONResponse(*profile), nil
+}
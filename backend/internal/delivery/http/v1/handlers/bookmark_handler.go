@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+type BookmarkHandler struct {
+	bookmarkUseCase usecase.UseCaseBookmark
+	logger          *logrus.Logger
+	validator       validator.Validator
+}
+
+func NewBookmarkHandler(bookmarkUseCase usecase.UseCaseBookmark, logger *logrus.Logger, validator validator.Validator) *BookmarkHandler {
+	return &BookmarkHandler{
+		bookmarkUseCase: bookmarkUseCase,
+		logger:          logger,
+		validator:       validator,
+	}
+}
+
+func (h *BookmarkHandler) GetApiV1MeBookmarks(ctx context.Context, request api.GetApiV1MeBookmarksRequestObject) (api.GetApiV1MeBookmarksResponseObject, error) {
+	params := request.Params
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	paginationFromParams, err := entity.NewPaginationFromParams(entity.RemoteParams{
+		Page:         params.Page,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+		Cursor:       params.Cursor,
+		IncludeTotal: params.IncludeTotal,
+	})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get pagination from params")
+		return nil, errorStatus(http.StatusBadRequest, "Invalid request params")
+	}
+
+	result, err := h.bookmarkUseCase.GetBookmarks(ctx, userId, paginationFromParams)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get bookmarks")
+		return nil, errorUsecase(err, "Failed to get bookmarks")
+	}
+
+	return api.GetApiV1MeBookmarks200JSONResponse(*result), nil
+}
+
+func (h *BookmarkHandler) PutApiV1PostsPostIdBookmark(ctx context.Context, request api.PutApiV1PostsPostIdBookmarkRequestObject) (api.PutApiV1PostsPostIdBookmarkResponseObject, error) {
+	postId := request.PostId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	// The body is optional; a bookmark without a note is the common case.
+	var bookmark entity.NewBookmark
+	if request.Body != nil {
+		bookmark = *request.Body
+	}
+
+	if err := h.validator.Struct(&bookmark); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	bookmark.UserId = userId
+	bookmark.PostId = postId
+
+	created, err := h.bookmarkUseCase.AddBookmark(ctx, &bookmark)
+	if err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to add bookmark")
+		return nil, errorUsecase(err, "Failed to add bookmark")
+	}
+
+	return api.PutApiV1PostsPostIdBookmark200JSONResponse(*created), nil
+}
+
+func (h *BookmarkHandler) DeleteApiV1PostsPostIdBookmark(ctx context.Context, request api.DeleteApiV1PostsPostIdBookmarkRequestObject) (api.DeleteApiV1PostsPostIdBookmarkResponseObject, error) {
+	postId := request.PostId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.bookmarkUseCase.RemoveBookmark(ctx, userId, postId); err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to remove bookmark")
+		return nil, errorUsecase(err, "Failed to remove bookmark")
+	}
+
+	return api.DeleteApiV1PostsPostIdBookmark204Response{}, nil
+}
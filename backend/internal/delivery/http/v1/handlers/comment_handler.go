@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	"encoding/json"
+	"context"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -9,6 +9,7 @@ import (
 
 	"github.com/popeskul/awesome-blog/backend/gen/api"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/patch"
 	"github.com/popeskul/awesome-blog/backend/internal/usecase"
 	"github.com/popeskul/awesome-blog/backend/internal/validator"
 )
@@ -17,67 +18,250 @@ type CommentHandler struct {
 	commentUseCase usecase.UseCaseComment
 	logger         *logrus.Logger
 	validator      validator.Validator
+	// requireIfMatch rejects updates and deletes without an If-Match header.
+	requireIfMatch bool
 }
 
-func NewCommentHandler(commentUseCase usecase.UseCaseComment, logger *logrus.Logger, validator validator.Validator) *CommentHandler {
+func NewCommentHandler(commentUseCase usecase.UseCaseComment, logger *logrus.Logger, validator validator.Validator, requireIfMatch bool) *CommentHandler {
 	return &CommentHandler{
 		commentUseCase: commentUseCase,
 		logger:         logger,
 		validator:      validator,
+		requireIfMatch: requireIfMatch,
 	}
 }
 
-func (h *CommentHandler) GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId uuid.UUID, params api.GetApiV1PostsPostIdCommentsParams) {
-	ctx := r.Context()
+func (h *CommentHandler) GetApiV1PostsPostIdComments(ctx context.Context, request api.GetApiV1PostsPostIdCommentsRequestObject) (api.GetApiV1PostsPostIdCommentsResponseObject, error) {
+	postId, params := request.PostId, request.Params
 
 	h.logger.WithField("params.Sort", params.Sort).Info("GetApiV1PostsPostIdComments")
 
 	paginationFromParams, err := entity.NewPaginationFromParams(entity.RemoteParams{
-		Page:   params.Page,
-		Limit:  params.Limit,
-		Offset: params.Offset,
-		Sort:   (*string)(params.Sort),
+		Page:         params.Page,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+		Sort:         (*string)(params.Sort),
+		Cursor:       params.Cursor,
+		IncludeTotal: params.IncludeTotal,
 	})
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to get pagination from params")
-		respondError(w, http.StatusBadRequest, "Invalid request params")
-		return
+		return nil, errorStatus(http.StatusBadRequest, "Invalid request params")
 	}
 
-	result, err := h.commentUseCase.GetComments(ctx, postId, paginationFromParams)
+	include, err := entity.ParseCommentInclude(params.Include)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to parse include")
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
+	}
+
+	paginationFromParams.Fields, err = entity.ParseFields(params.Fields, entity.CommentFields)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to parse fields")
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
+	}
+
+	// Anonymous readers only see approved comments; signed-in authors also see
+	// their own comments that are still waiting for review.
+	viewerId, _ := ctx.Value("user_id").(uuid.UUID)
+
+	result, err := h.commentUseCase.GetComments(ctx, postId, viewerId, paginationFromParams, include)
 	if err != nil {
 		h.logger.WithError(err).WithField("postId", postId).Error("Failed to get comments")
-		respondError(w, http.StatusInternalServerError, "Failed to get comments")
-		return
+		return nil, errorUsecase(err, "Failed to get comments")
 	}
 
-	respondJSON(w, http.StatusOK, result)
+	return page[entity.Comment]{result}, nil
 }
 
-func (h *CommentHandler) PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId uuid.UUID) {
-	var newComment entity.NewComment
-	if err := json.NewDecoder(r.Body).Decode(&newComment); err != nil {
-		h.logger.WithError(err).Error("Failed to decode request body")
-		respondError(w, http.StatusBadRequest, "Invalid request payload")
-		return
+func (h *CommentHandler) PostApiV1PostsPostIdComments(ctx context.Context, request api.PostApiV1PostsPostIdCommentsRequestObject) (api.PostApiV1PostsPostIdCommentsResponseObject, error) {
+	postId := request.PostId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
 	}
 
-	if err := h.validator.Struct(&newComment); err != nil {
+	r := httpRequest(ctx)
+	newComment := request.Body
+	newComment.PostId = postId
+	newComment.AuthorId = userId
+	newComment.IP = clientIP(r)
+	newComment.UserAgent = r.UserAgent()
+
+	if err := h.validator.Struct(newComment); err != nil {
 		h.logger.WithError(err).Error("Failed to validate request body")
-		respondError(w, http.StatusBadRequest, "Validation failed "+err.Error())
-		return
+		return nil, errorValidation(http.StatusBadRequest, err)
 	}
 
-	newComment.PostId = postId
+	createdComment, err := h.commentUseCase.CreateComment(ctx, newComment)
+	if err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to create comment")
+		return nil, errorUsecase(err, "Failed to create comment")
+	}
+
+	return api.PostApiV1PostsPostIdComments201JSONResponse(*createdComment), nil
+}
 
-	ctx := r.Context()
+func (h *CommentHandler) GetApiV1CommentsCommentId(ctx context.Context, request api.GetApiV1CommentsCommentIdRequestObject) (api.GetApiV1CommentsCommentIdResponseObject, error) {
+	commentId := request.CommentId
+	viewerId, _ := ctx.Value("user_id").(uuid.UUID)
 
-	createdComment, err := h.commentUseCase.CreateComment(ctx, &newComment)
+	foundComment, err := h.commentUseCase.GetCommentByID(ctx, commentId, viewerId)
 	if err != nil {
-		h.logger.WithError(err).WithField("postId", postId).Error("Failed to create comment")
-		respondError(w, http.StatusInternalServerError, "Failed to create comment")
-		return
+		h.logger.WithError(err).WithField("commentId", commentId).Error("Failed to get comment")
+		return nil, errorUsecase(err, "Failed to get comment")
+	}
+
+	etag, err := entityTag(foundComment.Version, foundComment)
+	if err != nil {
+		return nil, err
+	}
+	if notModified(request.Params.IfNoneMatch, etag) {
+		return api.GetApiV1CommentsCommentId304Response{
+			Headers: api.GetApiV1CommentsCommentId304ResponseHeaders{ETag: etag},
+		}, nil
+	}
+
+	return api.GetApiV1CommentsCommentId200JSONResponse{
+		Body:    *foundComment,
+		Headers: api.GetApiV1CommentsCommentId200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+func (h *CommentHandler) PutApiV1CommentsCommentId(ctx context.Context, request api.PutApiV1CommentsCommentIdRequestObject) (api.PutApiV1CommentsCommentIdResponseObject, error) {
+	commentId := request.CommentId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	version, err := expectedVersion(request.Params.IfMatch, h.requireIfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	updateComment := request.Body
+	updateComment.Id = commentId
+	updateComment.AuthorId = userId
+	updateComment.Version = version
+
+	if err := h.validator.Struct(updateComment); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	if err := h.commentUseCase.UpdateComment(ctx, updateComment); err != nil {
+		h.logger.WithError(err).WithField("commentId", commentId).Error("Failed to update comment")
+		return nil, errorUsecase(err, "Failed to update comment")
+	}
+
+	updatedComment, err := h.commentUseCase.GetCommentByID(ctx, commentId, userId)
+	if err != nil {
+		h.logger.WithError(err).WithField("commentId", commentId).Error("Failed to get updated comment")
+		return nil, errorUsecase(err, "Failed to update comment")
+	}
+
+	etag, err := entityTag(updatedComment.Version, updatedComment)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PutApiV1CommentsCommentId200JSONResponse{
+		Body:    *updatedComment,
+		Headers: api.PutApiV1CommentsCommentId200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+func (h *CommentHandler) PatchApiV1CommentsCommentId(ctx context.Context, request api.PatchApiV1CommentsCommentIdRequestObject) (api.PatchApiV1CommentsCommentIdResponseObject, error) {
+	commentId := request.CommentId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	version, err := expectedVersion(request.Params.IfMatch, h.requireIfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := readPatch(request.ApplicationMergePatchPlusJSONBody, request.ApplicationJSONPatchPlusJSONBody)
+	if err != nil {
+		return nil, errorUsecase(err, "Failed to read patch")
+	}
+
+	current, err := h.commentUseCase.GetCommentByID(ctx, commentId, userId)
+	if err != nil {
+		h.logger.WithError(err).WithField("commentId", commentId).Error("Failed to get comment")
+		return nil, errorUsecase(err, "Failed to get comment")
+	}
+
+	// Without If-Match the patch still only applies to the version it was
+	// applied to here.
+	if version == entity.AnyVersion {
+		version = current.Version
+	}
+
+	var patched entity.Comment
+	changed, err := patch.Apply(p, current, &patched)
+	if err != nil {
+		h.logger.WithError(err).WithField("commentId", commentId).Error("Failed to apply patch")
+		return nil, errorUsecase(err, "Failed to apply patch")
+	}
+
+	updateComment := entity.UpdateComment{
+		Id:       commentId,
+		AuthorId: userId,
+		Content:  patched.Content,
+		Version:  version,
+	}
+
+	if err := h.validator.Struct(&updateComment); err != nil {
+		h.logger.WithError(err).Error("Failed to validate patched comment")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	if err := h.commentUseCase.PatchComment(ctx, &updateComment, changed); err != nil {
+		h.logger.WithError(err).WithField("commentId", commentId).Error("Failed to patch comment")
+		return nil, errorUsecase(err, "Failed to patch comment")
+	}
+
+	updatedComment, err := h.commentUseCase.GetCommentByID(ctx, commentId, userId)
+	if err != nil {
+		h.logger.WithError(err).WithField("commentId", commentId).Error("Failed to get patched comment")
+		return nil, errorUsecase(err, "Failed to patch comment")
+	}
+
+	etag, err := entityTag(updatedComment.Version, updatedComment)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PatchApiV1CommentsCommentId200JSONResponse{
+		Body:    *updatedComment,
+		Headers: api.PatchApiV1CommentsCommentId200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+func (h *CommentHandler) DeleteApiV1CommentsCommentId(ctx context.Context, request api.DeleteApiV1CommentsCommentIdRequestObject) (api.DeleteApiV1CommentsCommentIdResponseObject, error) {
+	commentId := request.CommentId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	version, err := expectedVersion(request.Params.IfMatch, h.requireIfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.commentUseCase.DeleteComment(ctx, commentId, userId, version); err != nil {
+		h.logger.WithError(err).WithField("commentId", commentId).Error("Failed to delete comment")
+		return nil, errorUsecase(err, "Failed to delete comment")
 	}
 
-	respondJSON(w, http.StatusCreated, createdComment)
+	return api.DeleteApiV1CommentsCommentId204Response{}, nil
 }
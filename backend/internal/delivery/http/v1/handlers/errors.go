@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/patch"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+// errorProblem is how an error of the usecases is answered.
+type errorProblem struct {
+	err    error
+	status int
+	// typ is the problem type, problem.BlankType when the status says it all.
+	typ string
+}
+
+// errorProblems maps the sentinel errors of the usecases, entities and
+// patches to HTTP. The first one an error wraps wins.
+var errorProblems = []errorProblem{
+	{usecase.ErrPostNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrCommentNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrUserNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrReadingListNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrNotificationNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrWebhookNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrWebhookDeliveryNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrJobNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrSitemapNotFound, http.StatusNotFound, problem.BlankType},
+	{usecase.ErrNewsletterDisabled, http.StatusServiceUnavailable, problem.BlankType},
+
+	{usecase.ErrUnauthorized, http.StatusForbidden, problem.BlankType},
+	{usecase.ErrForbidden, http.StatusForbidden, problem.BlankType},
+	{usecase.ErrNotModerator, http.StatusForbidden, problem.BlankType},
+	{usecase.ErrAdminRequired, http.StatusForbidden, problem.BlankType},
+	{usecase.ErrTopicForbidden, http.StatusForbidden, problem.BlankType},
+	{usecase.ErrFieldNotWritable, http.StatusForbidden, problem.FieldNotWritableType},
+	{usecase.ErrEditWindowExpired, http.StatusForbidden, problem.EditWindowExpiredType},
+	{usecase.ErrCommentsClosed, http.StatusForbidden, problem.CommentsClosedType},
+
+	{usecase.ErrVersionMismatch, http.StatusPreconditionFailed, problem.VersionMismatchType},
+	{entity.ErrVersionConflict, http.StatusPreconditionFailed, problem.VersionMismatchType},
+	{usecase.ErrUserExists, http.StatusConflict, problem.UserExistsType},
+	{usecase.ErrJobNotRetryable, http.StatusConflict, problem.JobNotRetryableType},
+	{patch.ErrConflict, http.StatusConflict, problem.PatchConflictType},
+	{patch.ErrUnsupportedType, http.StatusUnsupportedMediaType, problem.BlankType},
+
+	{usecase.ErrSpamDetected, http.StatusUnprocessableEntity, problem.SpamDetectedType},
+	{usecase.ErrEmptyTitleOrContent, http.StatusUnprocessableEntity, problem.ValidationType},
+	{patch.ErrInvalidResult, http.StatusUnprocessableEntity, problem.ValidationType},
+
+	{usecase.ErrEmptyCredentials, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidPage, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidLimit, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidComment, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidStatus, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidModeration, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidReaction, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidBookmark, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidReadingList, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidReadingListOrder, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidProfile, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrCannotFollowSelf, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidNotificationPreference, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidTopic, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidPresenceState, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidWebhook, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidJobStatus, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidSubscription, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidNewsletterToken, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidFeedTag, http.StatusBadRequest, problem.BlankType},
+	{usecase.ErrInvalidPostFilter, http.StatusBadRequest, problem.BlankType},
+	{entity.ErrInvalidCursor, http.StatusBadRequest, problem.BlankType},
+	{entity.ErrInvalidFields, http.StatusBadRequest, problem.BlankType},
+	{entity.ErrInvalidInclude, http.StatusBadRequest, problem.BlankType},
+	{entity.ErrInvalidSort, http.StatusBadRequest, problem.BlankType},
+	{patch.ErrInvalidPatch, http.StatusBadRequest, problem.BlankType},
+}
+
+// problemError is an error the handlers answer with a problem of their
+// own, rather than one errorProblems maps a sentinel to.
+type problemError struct {
+	status int
+	typ    string
+	detail string
+	// invalid is the error of a body that failed validation, whose fields
+	// the problem lists.
+	invalid error
+}
+
+func (e *problemError) Error() string {
+	if e.invalid != nil {
+		return e.invalid.Error()
+	}
+	return e.detail
+}
+
+func (e *problemError) Unwrap() error {
+	return e.invalid
+}
+
+func (e *problemError) problem(r *http.Request) *problem.Details {
+	if e.invalid != nil {
+		return problem.Validation(r, e.status, e.invalid)
+	}
+	return problem.Typed(r, e.status, e.typ, e.detail)
+}
+
+// errorStatus returns an error answered with a problem of the blank type,
+// the detail explaining what went wrong.
+func errorStatus(status int, detail string) error {
+	return &problemError{status: status, typ: problem.BlankType, detail: detail}
+}
+
+// errorTyped returns an error answered with a problem of one of the types of
+// the problem package.
+func errorTyped(status int, typ string, detail string) error {
+	return &problemError{status: status, typ: typ, detail: detail}
+}
+
+// errorValidation returns the error of a body that failed validation, which
+// is answered listing the fields that are invalid.
+func errorValidation(status int, err error) error {
+	return &problemError{status: status, invalid: err}
+}
+
+// usecaseError is an error of the usecases along with the detail it is
+// answered with when no sentinel of errorProblems matches it.
+type usecaseError struct {
+	err      error
+	fallback string
+}
+
+func (e *usecaseError) Error() string {
+	return e.err.Error()
+}
+
+func (e *usecaseError) Unwrap() error {
+	return e.err
+}
+
+// errorUsecase returns err to be answered with the problem its sentinel maps
+// to. Anything else is a 500 with fallback as the detail, so that internals
+// don't leak to clients.
+func errorUsecase(err error, fallback string) error {
+	return &usecaseError{err: err, fallback: fallback}
+}
+
+// RespondError answers the error a handler returned. It is the response
+// error handler of the strict server.
+func RespondError(w http.ResponseWriter, r *http.Request, err error) {
+	var pe *problemError
+	if errors.As(err, &pe) {
+		problem.Write(w, pe.problem(r))
+		return
+	}
+
+	if errors.Is(err, patch.ErrUnsupportedType) {
+		w.Header().Set("Accept-Patch", patch.MergePatchType+", "+patch.JSONPatchType)
+	}
+
+	for _, ep := range errorProblems {
+		if errors.Is(err, ep.err) {
+			problem.Write(w, problem.Typed(r, ep.status, ep.typ, err.Error()))
+			return
+		}
+	}
+
+	fallback := "Internal server error"
+	var ue *usecaseError
+	if errors.As(err, &ue) {
+		fallback = ue.fallback
+	}
+	problem.Error(w, r, http.StatusInternalServerError, fallback)
+}
+
+// RespondRequestError answers a request the strict server could not decode.
+// Requests are validated against the spec before, so this is mostly a body
+// that isn't JSON.
+func RespondRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	problem.Error(w, r, http.StatusBadRequest, err.Error())
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+// staleVersion is what an If-Match that no version can match expects, such
+// as a weak tag or one that isn't ours, so the write fails with 412.
+const staleVersion = -1
+
+// entityTag is the tag of the representation of a post or comment: its
+// version followed by a hash of the body. The version alone would name
+// bodies that differ in reactions, comment counts, the viewer's bookmark or
+// the requested include and fields, none of which changes it. Writes only
+// compare the version, see ifMatchVersion.
+func entityTag(version int, body any) (string, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash representation: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return `"` + strconv.Itoa(version) + "-" + base64.RawURLEncoding.EncodeToString(sum[:12]) + `"`, nil
+}
+
+// ifMatchVersion returns the version an If-Match header expects, and false
+// when there is no header. "*" expects entity.AnyVersion.
+func ifMatchVersion(ifMatch *string) (int, bool) {
+	if ifMatch == nil {
+		return entity.AnyVersion, false
+	}
+
+	header := strings.TrimSpace(*ifMatch)
+	switch header {
+	case "":
+		return entity.AnyVersion, false
+	case "*":
+		return entity.AnyVersion, true
+	}
+
+	// If-Match compares tags strongly, so a weak tag never matches. A list
+	// of tags isn't supported either, as a write expects a single version.
+	// Only the version of the tag counts: a write doesn't depend on the
+	// reactions or fields the resource was read with.
+	quoted, ok := strings.CutPrefix(header, `"`)
+	if !ok {
+		return staleVersion, true
+	}
+	opaque, ok := strings.CutSuffix(quoted, `"`)
+	if !ok {
+		return staleVersion, true
+	}
+	number, _, _ := strings.Cut(opaque, "-")
+	version, err := strconv.Atoi(number)
+	if err != nil || version <= 0 {
+		return staleVersion, true
+	}
+
+	return version, true
+}
+
+// expectedVersion reads the version a write expects. When If-Match is
+// required and missing the error is answered with 428.
+func expectedVersion(ifMatch *string, required bool) (int, error) {
+	version, ok := ifMatchVersion(ifMatch)
+	if !ok && required {
+		return 0, errorTyped(http.StatusPreconditionRequired, problem.PreconditionRequiredType, "If-Match header is required")
+	}
+	return version, nil
+}
+
+// notModified reports whether If-None-Match names the tag of the
+// representation, so that 304 Not Modified is answered. Tags are compared
+// weakly.
+func notModified(ifNoneMatch *string, etag string) bool {
+	if ifNoneMatch == nil {
+		return false
+	}
+
+	for _, tag := range strings.Split(*ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
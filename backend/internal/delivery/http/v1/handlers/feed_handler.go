@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/feed"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+type FeedHandler struct {
+	feedUseCase usecase.UseCaseFeed
+	logger      *logrus.Logger
+	opts        feed.Options
+	apiURL      string
+	excerpt     bool
+}
+
+// NewFeedHandler creates the syndication feed handler. apiURL is where the
+// feeds are reachable from the outside; excerpt is the default content mode.
+func NewFeedHandler(feedUseCase usecase.UseCaseFeed, logger *logrus.Logger, opts feed.Options, apiURL string, excerpt bool) *FeedHandler {
+	return &FeedHandler{
+		feedUseCase: feedUseCase,
+		logger:      logger,
+		opts:        opts,
+		apiURL:      apiURL,
+		excerpt:     excerpt,
+	}
+}
+
+// GetFeed serves a feed. The content query parameter picks "full" posts or
+// an "excerpt" of them. Conditional requests are answered with 304 Not
+// Modified, by ETag or by the time the newest change was made.
+func (h *FeedHandler) GetFeed(w http.ResponseWriter, r *http.Request, format feed.Format, query entity.FeedQuery) {
+	excerpt := h.excerpt
+	switch r.URL.Query().Get("content") {
+	case "":
+	case "full":
+		excerpt = false
+	case "excerpt":
+		excerpt = true
+	default:
+		respondError(w, r, http.StatusBadRequest, "Invalid content mode")
+		return
+	}
+
+	entries, err := h.feedUseCase.GetFeed(r.Context(), &query)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get feed")
+		respondUsecaseError(w, r, err, "Failed to get feed")
+		return
+	}
+
+	document := feed.New(h.opts, &query, h.apiURL+r.URL.RequestURI(), entries, excerpt)
+
+	var body bytes.Buffer
+	if err := feed.Write(&body, format, document); err != nil {
+		h.logger.WithError(err).Error("Failed to render feed")
+		respondUsecaseError(w, r, err, "Failed to render feed")
+		return
+	}
+
+	sum := sha256.Sum256(body.Bytes())
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	// ServeContent answers If-None-Match and If-Modified-Since.
+	http.ServeContent(w, r, "", document.Updated, bytes.NewReader(body.Bytes()))
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+type FollowHandler struct {
+	followUseCase usecase.UseCaseFollow
+	logger        *logrus.Logger
+}
+
+func NewFollowHandler(followUseCase usecase.UseCaseFollow, logger *logrus.Logger) *FollowHandler {
+	return &FollowHandler{
+		followUseCase: followUseCase,
+		logger:        logger,
+	}
+}
+
+func (h *FollowHandler) PutApiV1AuthorsUsernameFollow(ctx context.Context, request api.PutApiV1AuthorsUsernameFollowRequestObject) (api.PutApiV1AuthorsUsernameFollowResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.followUseCase.Follow(ctx, userId, request.Username); err != nil {
+		h.logger.WithError(err).Error("Failed to follow author")
+		return nil, errorUsecase(err, "Failed to follow author")
+	}
+
+	return api.PutApiV1AuthorsUsernameFollow204Response{}, nil
+}
+
+func (h *FollowHandler) DeleteApiV1AuthorsUsernameFollow(ctx context.Context, request api.DeleteApiV1AuthorsUsernameFollowRequestObject) (api.DeleteApiV1AuthorsUsernameFollowResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.followUseCase.Unfollow(ctx, userId, request.Username); err != nil {
+		h.logger.WithError(err).Error("Failed to unfollow author")
+		return nil, errorUsecase(err, "Failed to unfollow author")
+	}
+
+	return api.DeleteApiV1AuthorsUsernameFollow204Response{}, nil
+}
+
+func (h *FollowHandler) GetApiV1Feed(ctx context.Context, request api.GetApiV1FeedRequestObject) (api.GetApiV1FeedResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	var cursor string
+	if request.Params.Cursor != nil {
+		cursor = *request.Params.Cursor
+	}
+
+	var limit int
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	page, err := h.followUseCase.GetFeed(ctx, userId, cursor, limit)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get feed")
+		return nil, errorUsecase(err, "Failed to get feed")
+	}
+
+	return api.GetApiV1Feed200JSONResponse(*page), nil
+}
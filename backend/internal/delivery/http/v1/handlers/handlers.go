@@ -1,123 +1,498 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
-	"github.com/google/uuid"
 	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/feed"
 )
 
 type PostHandlers interface {
-	GetApiV1Posts(w http.ResponseWriter, r *http.Request, params api.GetApiV1PostsParams)
-	PostApiV1Posts(w http.ResponseWriter, r *http.Request)
-	DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID)
-	GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID)
-	PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID)
+	GetApiV1Posts(ctx context.Context, request api.GetApiV1PostsRequestObject) (api.GetApiV1PostsResponseObject, error)
+	PostApiV1Posts(ctx context.Context, request api.PostApiV1PostsRequestObject) (api.PostApiV1PostsResponseObject, error)
+	DeleteApiV1PostsPostId(ctx context.Context, request api.DeleteApiV1PostsPostIdRequestObject) (api.DeleteApiV1PostsPostIdResponseObject, error)
+	GetApiV1PostsPostId(ctx context.Context, request api.GetApiV1PostsPostIdRequestObject) (api.GetApiV1PostsPostIdResponseObject, error)
+	PutApiV1PostsPostId(ctx context.Context, request api.PutApiV1PostsPostIdRequestObject) (api.PutApiV1PostsPostIdResponseObject, error)
+	PatchApiV1PostsPostId(ctx context.Context, request api.PatchApiV1PostsPostIdRequestObject) (api.PatchApiV1PostsPostIdResponseObject, error)
 }
 
 type CommentHandlers interface {
-	GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId uuid.UUID, params api.GetApiV1PostsPostIdCommentsParams)
-	PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId uuid.UUID)
+	GetApiV1PostsPostIdComments(ctx context.Context, request api.GetApiV1PostsPostIdCommentsRequestObject) (api.GetApiV1PostsPostIdCommentsResponseObject, error)
+	PostApiV1PostsPostIdComments(ctx context.Context, request api.PostApiV1PostsPostIdCommentsRequestObject) (api.PostApiV1PostsPostIdCommentsResponseObject, error)
+	GetApiV1CommentsCommentId(ctx context.Context, request api.GetApiV1CommentsCommentIdRequestObject) (api.GetApiV1CommentsCommentIdResponseObject, error)
+	PutApiV1CommentsCommentId(ctx context.Context, request api.PutApiV1CommentsCommentIdRequestObject) (api.PutApiV1CommentsCommentIdResponseObject, error)
+	PatchApiV1CommentsCommentId(ctx context.Context, request api.PatchApiV1CommentsCommentIdRequestObject) (api.PatchApiV1CommentsCommentIdResponseObject, error)
+	DeleteApiV1CommentsCommentId(ctx context.Context, request api.DeleteApiV1CommentsCommentIdRequestObject) (api.DeleteApiV1CommentsCommentIdResponseObject, error)
+}
+
+type ModerationHandlers interface {
+	GetApiV1ModerationComments(ctx context.Context, request api.GetApiV1ModerationCommentsRequestObject) (api.GetApiV1ModerationCommentsResponseObject, error)
+	PostApiV1ModerationComments(ctx context.Context, request api.PostApiV1ModerationCommentsRequestObject) (api.PostApiV1ModerationCommentsResponseObject, error)
+	PutApiV1PostsPostIdModeration(ctx context.Context, request api.PutApiV1PostsPostIdModerationRequestObject) (api.PutApiV1PostsPostIdModerationResponseObject, error)
+}
+
+type ReactionHandlers interface {
+	PutApiV1PostsPostIdReactionsKind(ctx context.Context, request api.PutApiV1PostsPostIdReactionsKindRequestObject) (api.PutApiV1PostsPostIdReactionsKindResponseObject, error)
+	DeleteApiV1PostsPostIdReactionsKind(ctx context.Context, request api.DeleteApiV1PostsPostIdReactionsKindRequestObject) (api.DeleteApiV1PostsPostIdReactionsKindResponseObject, error)
+	PutApiV1CommentsCommentIdReactionsKind(ctx context.Context, request api.PutApiV1CommentsCommentIdReactionsKindRequestObject) (api.PutApiV1CommentsCommentIdReactionsKindResponseObject, error)
+	DeleteApiV1CommentsCommentIdReactionsKind(ctx context.Context, request api.DeleteApiV1CommentsCommentIdReactionsKindRequestObject) (api.DeleteApiV1CommentsCommentIdReactionsKindResponseObject, error)
+}
+
+type BookmarkHandlers interface {
+	GetApiV1MeBookmarks(ctx context.Context, request api.GetApiV1MeBookmarksRequestObject) (api.GetApiV1MeBookmarksResponseObject, error)
+	PutApiV1PostsPostIdBookmark(ctx context.Context, request api.PutApiV1PostsPostIdBookmarkRequestObject) (api.PutApiV1PostsPostIdBookmarkResponseObject, error)
+	DeleteApiV1PostsPostIdBookmark(ctx context.Context, request api.DeleteApiV1PostsPostIdBookmarkRequestObject) (api.DeleteApiV1PostsPostIdBookmarkResponseObject, error)
+}
+
+type ReadingListHandlers interface {
+	GetApiV1MeReadingLists(ctx context.Context, request api.GetApiV1MeReadingListsRequestObject) (api.GetApiV1MeReadingListsResponseObject, error)
+	PostApiV1MeReadingLists(ctx context.Context, request api.PostApiV1MeReadingListsRequestObject) (api.PostApiV1MeReadingListsResponseObject, error)
+	GetApiV1MeReadingListsListId(ctx context.Context, request api.GetApiV1MeReadingListsListIdRequestObject) (api.GetApiV1MeReadingListsListIdResponseObject, error)
+	PutApiV1MeReadingListsListId(ctx context.Context, request api.PutApiV1MeReadingListsListIdRequestObject) (api.PutApiV1MeReadingListsListIdResponseObject, error)
+	DeleteApiV1MeReadingListsListId(ctx context.Context, request api.DeleteApiV1MeReadingListsListIdRequestObject) (api.DeleteApiV1MeReadingListsListIdResponseObject, error)
+	PutApiV1MeReadingListsListIdItemsPostId(ctx context.Context, request api.PutApiV1MeReadingListsListIdItemsPostIdRequestObject) (api.PutApiV1MeReadingListsListIdItemsPostIdResponseObject, error)
+	DeleteApiV1MeReadingListsListIdItemsPostId(ctx context.Context, request api.DeleteApiV1MeReadingListsListIdItemsPostIdRequestObject) (api.DeleteApiV1MeReadingListsListIdItemsPostIdResponseObject, error)
+	PutApiV1MeReadingListsListIdOrder(ctx context.Context, request api.PutApiV1MeReadingListsListIdOrderRequestObject) (api.PutApiV1MeReadingListsListIdOrderResponseObject, error)
+	GetApiV1ReadingListsSharedToken(ctx context.Context, request api.GetApiV1ReadingListsSharedTokenRequestObject) (api.GetApiV1ReadingListsSharedTokenResponseObject, error)
+}
+
+type AuthorHandlers interface {
+	GetApiV1AuthorsUsername(ctx context.Context, request api.GetApiV1AuthorsUsernameRequestObject) (api.GetApiV1AuthorsUsernameResponseObject, error)
+	GetApiV1AuthorsUsernamePosts(ctx context.Context, request api.GetApiV1AuthorsUsernamePostsRequestObject) (api.GetApiV1AuthorsUsernamePostsResponseObject, error)
+	GetApiV1MeProfile(ctx context.Context, request api.GetApiV1MeProfileRequestObject) (api.GetApiV1MeProfileResponseObject, error)
+	PutApiV1MeProfile(ctx context.Context, request api.PutApiV1MeProfileRequestObject) (api.PutApiV1MeProfileResponseObject, error)
+}
+
+type FollowHandlers interface {
+	PutApiV1AuthorsUsernameFollow(ctx context.Context, request api.PutApiV1AuthorsUsernameFollowRequestObject) (api.PutApiV1AuthorsUsernameFollowResponseObject, error)
+	DeleteApiV1AuthorsUsernameFollow(ctx context.Context, request api.DeleteApiV1AuthorsUsernameFollowRequestObject) (api.DeleteApiV1AuthorsUsernameFollowResponseObject, error)
+	GetApiV1Feed(ctx context.Context, request api.GetApiV1FeedRequestObject) (api.GetApiV1FeedResponseObject, error)
+}
+
+type NotificationHandlers interface {
+	GetApiV1Notifications(ctx context.Context, request api.GetApiV1NotificationsRequestObject) (api.GetApiV1NotificationsResponseObject, error)
+	PostApiV1NotificationsNotificationIdRead(ctx context.Context, request api.PostApiV1NotificationsNotificationIdReadRequestObject) (api.PostApiV1NotificationsNotificationIdReadResponseObject, error)
+	PostApiV1NotificationsReadAll(ctx context.Context, request api.PostApiV1NotificationsReadAllRequestObject) (api.PostApiV1NotificationsReadAllResponseObject, error)
+	GetApiV1MeNotificationPreferences(ctx context.Context, request api.GetApiV1MeNotificationPreferencesRequestObject) (api.GetApiV1MeNotificationPreferencesResponseObject, error)
+	PutApiV1MeNotificationPreferences(ctx context.Context, request api.PutApiV1MeNotificationPreferencesRequestObject) (api.PutApiV1MeNotificationPreferencesResponseObject, error)
+}
+
+type StreamHandlers interface {
+	GetApiV1Stream(ctx context.Context, request api.GetApiV1StreamRequestObject) (api.GetApiV1StreamResponseObject, error)
+}
+
+type PresenceHandlers interface {
+	GetApiV1PostsPostIdPresence(ctx context.Context, request api.GetApiV1PostsPostIdPresenceRequestObject) (api.GetApiV1PostsPostIdPresenceResponseObject, error)
+	// Drain closes the open WebSocket connections on shutdown.
+	Drain(ctx context.Context) error
+}
+
+type WebhookHandlers interface {
+	GetApiV1AdminWebhooks(ctx context.Context, request api.GetApiV1AdminWebhooksRequestObject) (api.GetApiV1AdminWebhooksResponseObject, error)
+	PostApiV1AdminWebhooks(ctx context.Context, request api.PostApiV1AdminWebhooksRequestObject) (api.PostApiV1AdminWebhooksResponseObject, error)
+	DeleteApiV1AdminWebhooksWebhookId(ctx context.Context, request api.DeleteApiV1AdminWebhooksWebhookIdRequestObject) (api.DeleteApiV1AdminWebhooksWebhookIdResponseObject, error)
+	GetApiV1AdminWebhooksWebhookId(ctx context.Context, request api.GetApiV1AdminWebhooksWebhookIdRequestObject) (api.GetApiV1AdminWebhooksWebhookIdResponseObject, error)
+	PutApiV1AdminWebhooksWebhookId(ctx context.Context, request api.PutApiV1AdminWebhooksWebhookIdRequestObject) (api.PutApiV1AdminWebhooksWebhookIdResponseObject, error)
+	GetApiV1AdminWebhooksWebhookIdDeliveries(ctx context.Context, request api.GetApiV1AdminWebhooksWebhookIdDeliveriesRequestObject) (api.GetApiV1AdminWebhooksWebhookIdDeliveriesResponseObject, error)
+	PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx context.Context, request api.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequestObject) (api.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponseObject, error)
+	PostApiV1AdminWebhooksWebhookIdTest(ctx context.Context, request api.PostApiV1AdminWebhooksWebhookIdTestRequestObject) (api.PostApiV1AdminWebhooksWebhookIdTestResponseObject, error)
+}
+
+type JobHandlers interface {
+	GetApiV1AdminJobs(ctx context.Context, request api.GetApiV1AdminJobsRequestObject) (api.GetApiV1AdminJobsResponseObject, error)
+	GetApiV1AdminJobsJobId(ctx context.Context, request api.GetApiV1AdminJobsJobIdRequestObject) (api.GetApiV1AdminJobsJobIdResponseObject, error)
+	PostApiV1AdminJobsJobIdRetry(ctx context.Context, request api.PostApiV1AdminJobsJobIdRetryRequestObject) (api.PostApiV1AdminJobsJobIdRetryResponseObject, error)
+}
+
+type NewsletterHandlers interface {
+	PostApiV1NewsletterSubscriptions(ctx context.Context, request api.PostApiV1NewsletterSubscriptionsRequestObject) (api.PostApiV1NewsletterSubscriptionsResponseObject, error)
+	GetApiV1NewsletterConfirm(ctx context.Context, request api.GetApiV1NewsletterConfirmRequestObject) (api.GetApiV1NewsletterConfirmResponseObject, error)
+	GetApiV1NewsletterUnsubscribe(ctx context.Context, request api.GetApiV1NewsletterUnsubscribeRequestObject) (api.GetApiV1NewsletterUnsubscribeResponseObject, error)
+	PostApiV1NewsletterUnsubscribe(ctx context.Context, request api.PostApiV1NewsletterUnsubscribeRequestObject) (api.PostApiV1NewsletterUnsubscribeResponseObject, error)
+}
+
+type FeedHandlers interface {
+	GetFeed(w http.ResponseWriter, r *http.Request, format feed.Format, query entity.FeedQuery)
+}
+
+type SitemapHandlers interface {
+	GetSitemapIndex(w http.ResponseWriter, r *http.Request)
+	GetSitemap(w http.ResponseWriter, r *http.Request, kind string, after string)
+	GetRobots(w http.ResponseWriter, r *http.Request)
 }
 
 type UserHandlers interface {
-	GetApiV1Users(w http.ResponseWriter, r *http.Request, params api.GetApiV1UsersParams)
-	PostApiV1Users(w http.ResponseWriter, r *http.Request)
-	DeleteApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID)
-	GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID)
-	PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID)
+	GetApiV1Users(ctx context.Context, request api.GetApiV1UsersRequestObject) (api.GetApiV1UsersResponseObject, error)
+	DeleteApiV1UsersUserId(ctx context.Context, request api.DeleteApiV1UsersUserIdRequestObject) (api.DeleteApiV1UsersUserIdResponseObject, error)
+	GetApiV1UsersUserId(ctx context.Context, request api.GetApiV1UsersUserIdRequestObject) (api.GetApiV1UsersUserIdResponseObject, error)
+	PutApiV1UsersUserId(ctx context.Context, request api.PutApiV1UsersUserIdRequestObject) (api.PutApiV1UsersUserIdResponseObject, error)
+	PatchApiV1UsersUserId(ctx context.Context, request api.PatchApiV1UsersUserIdRequestObject) (api.PatchApiV1UsersUserIdResponseObject, error)
 }
 
 type AuthHandlers interface {
-	PostAuthRegister(w http.ResponseWriter, r *http.Request)
-	PostAuthLogin(w http.ResponseWriter, r *http.Request)
-	GetAuthMe(w http.ResponseWriter, r *http.Request)
-	PostAuthLogout(w http.ResponseWriter, r *http.Request)
+	PostAuthRegister(ctx context.Context, request api.PostAuthRegisterRequestObject) (api.PostAuthRegisterResponseObject, error)
+	PostAuthLogin(ctx context.Context, request api.PostAuthLoginRequestObject) (api.PostAuthLoginResponseObject, error)
+	GetAuthMe(ctx context.Context, request api.GetAuthMeRequestObject) (api.GetAuthMeResponseObject, error)
+	PostAuthLogout(ctx context.Context, request api.PostAuthLogoutRequestObject) (api.PostAuthLogoutResponseObject, error)
 }
 
 type Handler struct {
-	api.Unimplemented
-
-	postHandlers    PostHandlers
-	commentHandlers CommentHandlers
-	userHandlers    UserHandlers
-	authHandlers    AuthHandlers
+	postHandlers         PostHandlers
+	commentHandlers      CommentHandlers
+	moderationHandlers   ModerationHandlers
+	reactionHandlers     ReactionHandlers
+	bookmarkHandlers     BookmarkHandlers
+	readingListHandlers  ReadingListHandlers
+	authorHandlers       AuthorHandlers
+	followHandlers       FollowHandlers
+	notificationHandlers NotificationHandlers
+	streamHandlers       StreamHandlers
+	presenceHandlers     PresenceHandlers
+	webhookHandlers      WebhookHandlers
+	jobHandlers          JobHandlers
+	newsletterHandlers   NewsletterHandlers
+	feedHandlers         FeedHandlers
+	sitemapHandlers      SitemapHandlers
+	userHandlers         UserHandlers
+	authHandlers         AuthHandlers
 }
 
 func NewHandler(
 	postHandler PostHandlers,
 	commentHandler CommentHandlers,
+	moderationHandler ModerationHandlers,
+	reactionHandler ReactionHandlers,
+	bookmarkHandler BookmarkHandlers,
+	readingListHandler ReadingListHandlers,
+	authorHandler AuthorHandlers,
+	followHandler FollowHandlers,
+	notificationHandler NotificationHandlers,
+	streamHandler StreamHandlers,
+	presenceHandler PresenceHandlers,
+	webhookHandler WebhookHandlers,
+	jobHandler JobHandlers,
+	newsletterHandler NewsletterHandlers,
+	feedHandler FeedHandlers,
+	sitemapHandler SitemapHandlers,
 	userHandler UserHandlers,
 	authHandler AuthHandlers,
 ) *Handler {
 	return &Handler{
-		postHandlers:    postHandler,
-		commentHandlers: commentHandler,
-		userHandlers:    userHandler,
-		authHandlers:    authHandler,
+		postHandlers:         postHandler,
+		commentHandlers:      commentHandler,
+		moderationHandlers:   moderationHandler,
+		reactionHandlers:     reactionHandler,
+		bookmarkHandlers:     bookmarkHandler,
+		readingListHandlers:  readingListHandler,
+		authorHandlers:       authorHandler,
+		followHandlers:       followHandler,
+		notificationHandlers: notificationHandler,
+		streamHandlers:       streamHandler,
+		presenceHandlers:     presenceHandler,
+		webhookHandlers:      webhookHandler,
+		jobHandlers:          jobHandler,
+		newsletterHandlers:   newsletterHandler,
+		feedHandlers:         feedHandler,
+		sitemapHandlers:      sitemapHandler,
+		userHandlers:         userHandler,
+		authHandlers:         authHandler,
 	}
 }
 
-func (h *Handler) GetApiV1Posts(w http.ResponseWriter, r *http.Request, params api.GetApiV1PostsParams) {
-	h.postHandlers.GetApiV1Posts(w, r, params)
+func (h *Handler) GetApiV1Posts(ctx context.Context, request api.GetApiV1PostsRequestObject) (api.GetApiV1PostsResponseObject, error) {
+	return h.postHandlers.GetApiV1Posts(ctx, request)
+}
+
+func (h *Handler) PostApiV1Posts(ctx context.Context, request api.PostApiV1PostsRequestObject) (api.PostApiV1PostsResponseObject, error) {
+	return h.postHandlers.PostApiV1Posts(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1PostsPostId(ctx context.Context, request api.DeleteApiV1PostsPostIdRequestObject) (api.DeleteApiV1PostsPostIdResponseObject, error) {
+	return h.postHandlers.DeleteApiV1PostsPostId(ctx, request)
+}
+
+func (h *Handler) GetApiV1PostsPostId(ctx context.Context, request api.GetApiV1PostsPostIdRequestObject) (api.GetApiV1PostsPostIdResponseObject, error) {
+	return h.postHandlers.GetApiV1PostsPostId(ctx, request)
+}
+
+func (h *Handler) PutApiV1PostsPostId(ctx context.Context, request api.PutApiV1PostsPostIdRequestObject) (api.PutApiV1PostsPostIdResponseObject, error) {
+	return h.postHandlers.PutApiV1PostsPostId(ctx, request)
+}
+
+func (h *Handler) PatchApiV1PostsPostId(ctx context.Context, request api.PatchApiV1PostsPostIdRequestObject) (api.PatchApiV1PostsPostIdResponseObject, error) {
+	return h.postHandlers.PatchApiV1PostsPostId(ctx, request)
+}
+
+func (h *Handler) GetApiV1PostsPostIdComments(ctx context.Context, request api.GetApiV1PostsPostIdCommentsRequestObject) (api.GetApiV1PostsPostIdCommentsResponseObject, error) {
+	return h.commentHandlers.GetApiV1PostsPostIdComments(ctx, request)
+}
+
+func (h *Handler) PostApiV1PostsPostIdComments(ctx context.Context, request api.PostApiV1PostsPostIdCommentsRequestObject) (api.PostApiV1PostsPostIdCommentsResponseObject, error) {
+	return h.commentHandlers.PostApiV1PostsPostIdComments(ctx, request)
+}
+
+func (h *Handler) GetApiV1CommentsCommentId(ctx context.Context, request api.GetApiV1CommentsCommentIdRequestObject) (api.GetApiV1CommentsCommentIdResponseObject, error) {
+	return h.commentHandlers.GetApiV1CommentsCommentId(ctx, request)
+}
+
+func (h *Handler) PutApiV1CommentsCommentId(ctx context.Context, request api.PutApiV1CommentsCommentIdRequestObject) (api.PutApiV1CommentsCommentIdResponseObject, error) {
+	return h.commentHandlers.PutApiV1CommentsCommentId(ctx, request)
+}
+
+func (h *Handler) PatchApiV1CommentsCommentId(ctx context.Context, request api.PatchApiV1CommentsCommentIdRequestObject) (api.PatchApiV1CommentsCommentIdResponseObject, error) {
+	return h.commentHandlers.PatchApiV1CommentsCommentId(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1CommentsCommentId(ctx context.Context, request api.DeleteApiV1CommentsCommentIdRequestObject) (api.DeleteApiV1CommentsCommentIdResponseObject, error) {
+	return h.commentHandlers.DeleteApiV1CommentsCommentId(ctx, request)
+}
+
+func (h *Handler) GetApiV1ModerationComments(ctx context.Context, request api.GetApiV1ModerationCommentsRequestObject) (api.GetApiV1ModerationCommentsResponseObject, error) {
+	return h.moderationHandlers.GetApiV1ModerationComments(ctx, request)
+}
+
+func (h *Handler) PostApiV1ModerationComments(ctx context.Context, request api.PostApiV1ModerationCommentsRequestObject) (api.PostApiV1ModerationCommentsResponseObject, error) {
+	return h.moderationHandlers.PostApiV1ModerationComments(ctx, request)
+}
+
+func (h *Handler) PutApiV1PostsPostIdModeration(ctx context.Context, request api.PutApiV1PostsPostIdModerationRequestObject) (api.PutApiV1PostsPostIdModerationResponseObject, error) {
+	return h.moderationHandlers.PutApiV1PostsPostIdModeration(ctx, request)
+}
+
+func (h *Handler) PutApiV1PostsPostIdReactionsKind(ctx context.Context, request api.PutApiV1PostsPostIdReactionsKindRequestObject) (api.PutApiV1PostsPostIdReactionsKindResponseObject, error) {
+	return h.reactionHandlers.PutApiV1PostsPostIdReactionsKind(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1PostsPostIdReactionsKind(ctx context.Context, request api.DeleteApiV1PostsPostIdReactionsKindRequestObject) (api.DeleteApiV1PostsPostIdReactionsKindResponseObject, error) {
+	return h.reactionHandlers.DeleteApiV1PostsPostIdReactionsKind(ctx, request)
+}
+
+func (h *Handler) PutApiV1CommentsCommentIdReactionsKind(ctx context.Context, request api.PutApiV1CommentsCommentIdReactionsKindRequestObject) (api.PutApiV1CommentsCommentIdReactionsKindResponseObject, error) {
+	return h.reactionHandlers.PutApiV1CommentsCommentIdReactionsKind(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1CommentsCommentIdReactionsKind(ctx context.Context, request api.DeleteApiV1CommentsCommentIdReactionsKindRequestObject) (api.DeleteApiV1CommentsCommentIdReactionsKindResponseObject, error) {
+	return h.reactionHandlers.DeleteApiV1CommentsCommentIdReactionsKind(ctx, request)
+}
+
+func (h *Handler) GetApiV1MeBookmarks(ctx context.Context, request api.GetApiV1MeBookmarksRequestObject) (api.GetApiV1MeBookmarksResponseObject, error) {
+	return h.bookmarkHandlers.GetApiV1MeBookmarks(ctx, request)
+}
+
+func (h *Handler) PutApiV1PostsPostIdBookmark(ctx context.Context, request api.PutApiV1PostsPostIdBookmarkRequestObject) (api.PutApiV1PostsPostIdBookmarkResponseObject, error) {
+	return h.bookmarkHandlers.PutApiV1PostsPostIdBookmark(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1PostsPostIdBookmark(ctx context.Context, request api.DeleteApiV1PostsPostIdBookmarkRequestObject) (api.DeleteApiV1PostsPostIdBookmarkResponseObject, error) {
+	return h.bookmarkHandlers.DeleteApiV1PostsPostIdBookmark(ctx, request)
+}
+
+func (h *Handler) GetApiV1MeReadingLists(ctx context.Context, request api.GetApiV1MeReadingListsRequestObject) (api.GetApiV1MeReadingListsResponseObject, error) {
+	return h.readingListHandlers.GetApiV1MeReadingLists(ctx, request)
+}
+
+func (h *Handler) PostApiV1MeReadingLists(ctx context.Context, request api.PostApiV1MeReadingListsRequestObject) (api.PostApiV1MeReadingListsResponseObject, error) {
+	return h.readingListHandlers.PostApiV1MeReadingLists(ctx, request)
+}
+
+func (h *Handler) GetApiV1MeReadingListsListId(ctx context.Context, request api.GetApiV1MeReadingListsListIdRequestObject) (api.GetApiV1MeReadingListsListIdResponseObject, error) {
+	return h.readingListHandlers.GetApiV1MeReadingListsListId(ctx, request)
+}
+
+func (h *Handler) PutApiV1MeReadingListsListId(ctx context.Context, request api.PutApiV1MeReadingListsListIdRequestObject) (api.PutApiV1MeReadingListsListIdResponseObject, error) {
+	return h.readingListHandlers.PutApiV1MeReadingListsListId(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1MeReadingListsListId(ctx context.Context, request api.DeleteApiV1MeReadingListsListIdRequestObject) (api.DeleteApiV1MeReadingListsListIdResponseObject, error) {
+	return h.readingListHandlers.DeleteApiV1MeReadingListsListId(ctx, request)
+}
+
+func (h *Handler) PutApiV1MeReadingListsListIdItemsPostId(ctx context.Context, request api.PutApiV1MeReadingListsListIdItemsPostIdRequestObject) (api.PutApiV1MeReadingListsListIdItemsPostIdResponseObject, error) {
+	return h.readingListHandlers.PutApiV1MeReadingListsListIdItemsPostId(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1MeReadingListsListIdItemsPostId(ctx context.Context, request api.DeleteApiV1MeReadingListsListIdItemsPostIdRequestObject) (api.DeleteApiV1MeReadingListsListIdItemsPostIdResponseObject, error) {
+	return h.readingListHandlers.DeleteApiV1MeReadingListsListIdItemsPostId(ctx, request)
+}
+
+func (h *Handler) PutApiV1MeReadingListsListIdOrder(ctx context.Context, request api.PutApiV1MeReadingListsListIdOrderRequestObject) (api.PutApiV1MeReadingListsListIdOrderResponseObject, error) {
+	return h.readingListHandlers.PutApiV1MeReadingListsListIdOrder(ctx, request)
+}
+
+func (h *Handler) GetApiV1ReadingListsSharedToken(ctx context.Context, request api.GetApiV1ReadingListsSharedTokenRequestObject) (api.GetApiV1ReadingListsSharedTokenResponseObject, error) {
+	return h.readingListHandlers.GetApiV1ReadingListsSharedToken(ctx, request)
+}
+
+func (h *Handler) GetApiV1AuthorsUsername(ctx context.Context, request api.GetApiV1AuthorsUsernameRequestObject) (api.GetApiV1AuthorsUsernameResponseObject, error) {
+	return h.authorHandlers.GetApiV1AuthorsUsername(ctx, request)
+}
+
+func (h *Handler) GetApiV1AuthorsUsernamePosts(ctx context.Context, request api.GetApiV1AuthorsUsernamePostsRequestObject) (api.GetApiV1AuthorsUsernamePostsResponseObject, error) {
+	return h.authorHandlers.GetApiV1AuthorsUsernamePosts(ctx, request)
+}
+
+func (h *Handler) GetApiV1MeProfile(ctx context.Context, request api.GetApiV1MeProfileRequestObject) (api.GetApiV1MeProfileResponseObject, error) {
+	return h.authorHandlers.GetApiV1MeProfile(ctx, request)
+}
+
+func (h *Handler) PutApiV1MeProfile(ctx context.Context, request api.PutApiV1MeProfileRequestObject) (api.PutApiV1MeProfileResponseObject, error) {
+	return h.authorHandlers.PutApiV1MeProfile(ctx, request)
+}
+
+func (h *Handler) PutApiV1AuthorsUsernameFollow(ctx context.Context, request api.PutApiV1AuthorsUsernameFollowRequestObject) (api.PutApiV1AuthorsUsernameFollowResponseObject, error) {
+	return h.followHandlers.PutApiV1AuthorsUsernameFollow(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1AuthorsUsernameFollow(ctx context.Context, request api.DeleteApiV1AuthorsUsernameFollowRequestObject) (api.DeleteApiV1AuthorsUsernameFollowResponseObject, error) {
+	return h.followHandlers.DeleteApiV1AuthorsUsernameFollow(ctx, request)
+}
+
+func (h *Handler) GetApiV1Feed(ctx context.Context, request api.GetApiV1FeedRequestObject) (api.GetApiV1FeedResponseObject, error) {
+	return h.followHandlers.GetApiV1Feed(ctx, request)
+}
+
+func (h *Handler) GetApiV1Notifications(ctx context.Context, request api.GetApiV1NotificationsRequestObject) (api.GetApiV1NotificationsResponseObject, error) {
+	return h.notificationHandlers.GetApiV1Notifications(ctx, request)
+}
+
+func (h *Handler) PostApiV1NotificationsNotificationIdRead(ctx context.Context, request api.PostApiV1NotificationsNotificationIdReadRequestObject) (api.PostApiV1NotificationsNotificationIdReadResponseObject, error) {
+	return h.notificationHandlers.PostApiV1NotificationsNotificationIdRead(ctx, request)
+}
+
+func (h *Handler) PostApiV1NotificationsReadAll(ctx context.Context, request api.PostApiV1NotificationsReadAllRequestObject) (api.PostApiV1NotificationsReadAllResponseObject, error) {
+	return h.notificationHandlers.PostApiV1NotificationsReadAll(ctx, request)
+}
+
+func (h *Handler) GetApiV1MeNotificationPreferences(ctx context.Context, request api.GetApiV1MeNotificationPreferencesRequestObject) (api.GetApiV1MeNotificationPreferencesResponseObject, error) {
+	return h.notificationHandlers.GetApiV1MeNotificationPreferences(ctx, request)
+}
+
+func (h *Handler) PutApiV1MeNotificationPreferences(ctx context.Context, request api.PutApiV1MeNotificationPreferencesRequestObject) (api.PutApiV1MeNotificationPreferencesResponseObject, error) {
+	return h.notificationHandlers.PutApiV1MeNotificationPreferences(ctx, request)
+}
+
+func (h *Handler) GetApiV1Stream(ctx context.Context, request api.GetApiV1StreamRequestObject) (api.GetApiV1StreamResponseObject, error) {
+	return h.streamHandlers.GetApiV1Stream(ctx, request)
+}
+
+func (h *Handler) GetApiV1PostsPostIdPresence(ctx context.Context, request api.GetApiV1PostsPostIdPresenceRequestObject) (api.GetApiV1PostsPostIdPresenceResponseObject, error) {
+	return h.presenceHandlers.GetApiV1PostsPostIdPresence(ctx, request)
+}
+
+func (h *Handler) Drain(ctx context.Context) error {
+	return h.presenceHandlers.Drain(ctx)
+}
+
+func (h *Handler) GetApiV1AdminWebhooks(ctx context.Context, request api.GetApiV1AdminWebhooksRequestObject) (api.GetApiV1AdminWebhooksResponseObject, error) {
+	return h.webhookHandlers.GetApiV1AdminWebhooks(ctx, request)
+}
+
+func (h *Handler) PostApiV1AdminWebhooks(ctx context.Context, request api.PostApiV1AdminWebhooksRequestObject) (api.PostApiV1AdminWebhooksResponseObject, error) {
+	return h.webhookHandlers.PostApiV1AdminWebhooks(ctx, request)
+}
+
+func (h *Handler) DeleteApiV1AdminWebhooksWebhookId(ctx context.Context, request api.DeleteApiV1AdminWebhooksWebhookIdRequestObject) (api.DeleteApiV1AdminWebhooksWebhookIdResponseObject, error) {
+	return h.webhookHandlers.DeleteApiV1AdminWebhooksWebhookId(ctx, request)
+}
+
+func (h *Handler) GetApiV1AdminWebhooksWebhookId(ctx context.Context, request api.GetApiV1AdminWebhooksWebhookIdRequestObject) (api.GetApiV1AdminWebhooksWebhookIdResponseObject, error) {
+	return h.webhookHandlers.GetApiV1AdminWebhooksWebhookId(ctx, request)
+}
+
+func (h *Handler) PutApiV1AdminWebhooksWebhookId(ctx context.Context, request api.PutApiV1AdminWebhooksWebhookIdRequestObject) (api.PutApiV1AdminWebhooksWebhookIdResponseObject, error) {
+	return h.webhookHandlers.PutApiV1AdminWebhooksWebhookId(ctx, request)
+}
+
+func (h *Handler) GetApiV1AdminWebhooksWebhookIdDeliveries(ctx context.Context, request api.GetApiV1AdminWebhooksWebhookIdDeliveriesRequestObject) (api.GetApiV1AdminWebhooksWebhookIdDeliveriesResponseObject, error) {
+	return h.webhookHandlers.GetApiV1AdminWebhooksWebhookIdDeliveries(ctx, request)
+}
+
+func (h *Handler) PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx context.Context, request api.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequestObject) (api.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponseObject, error) {
+	return h.webhookHandlers.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx, request)
+}
+
+func (h *Handler) PostApiV1AdminWebhooksWebhookIdTest(ctx context.Context, request api.PostApiV1AdminWebhooksWebhookIdTestRequestObject) (api.PostApiV1AdminWebhooksWebhookIdTestResponseObject, error) {
+	return h.webhookHandlers.PostApiV1AdminWebhooksWebhookIdTest(ctx, request)
+}
+
+func (h *Handler) GetApiV1AdminJobs(ctx context.Context, request api.GetApiV1AdminJobsRequestObject) (api.GetApiV1AdminJobsResponseObject, error) {
+	return h.jobHandlers.GetApiV1AdminJobs(ctx, request)
+}
+
+func (h *Handler) GetApiV1AdminJobsJobId(ctx context.Context, request api.GetApiV1AdminJobsJobIdRequestObject) (api.GetApiV1AdminJobsJobIdResponseObject, error) {
+	return h.jobHandlers.GetApiV1AdminJobsJobId(ctx, request)
+}
+
+func (h *Handler) PostApiV1AdminJobsJobIdRetry(ctx context.Context, request api.PostApiV1AdminJobsJobIdRetryRequestObject) (api.PostApiV1AdminJobsJobIdRetryResponseObject, error) {
+	return h.jobHandlers.PostApiV1AdminJobsJobIdRetry(ctx, request)
+}
+
+func (h *Handler) PostApiV1NewsletterSubscriptions(ctx context.Context, request api.PostApiV1NewsletterSubscriptionsRequestObject) (api.PostApiV1NewsletterSubscriptionsResponseObject, error) {
+	return h.newsletterHandlers.PostApiV1NewsletterSubscriptions(ctx, request)
+}
+
+func (h *Handler) GetApiV1NewsletterConfirm(ctx context.Context, request api.GetApiV1NewsletterConfirmRequestObject) (api.GetApiV1NewsletterConfirmResponseObject, error) {
+	return h.newsletterHandlers.GetApiV1NewsletterConfirm(ctx, request)
 }
 
-func (h *Handler) PostApiV1Posts(w http.ResponseWriter, r *http.Request) {
-	h.postHandlers.PostApiV1Posts(w, r)
+func (h *Handler) GetApiV1NewsletterUnsubscribe(ctx context.Context, request api.GetApiV1NewsletterUnsubscribeRequestObject) (api.GetApiV1NewsletterUnsubscribeResponseObject, error) {
+	return h.newsletterHandlers.GetApiV1NewsletterUnsubscribe(ctx, request)
 }
 
-func (h *Handler) DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID) {
-	h.postHandlers.DeleteApiV1PostsPostId(w, r, postId)
+func (h *Handler) PostApiV1NewsletterUnsubscribe(ctx context.Context, request api.PostApiV1NewsletterUnsubscribeRequestObject) (api.PostApiV1NewsletterUnsubscribeResponseObject, error) {
+	return h.newsletterHandlers.PostApiV1NewsletterUnsubscribe(ctx, request)
 }
 
-func (h *Handler) GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID) {
-	h.postHandlers.GetApiV1PostsPostId(w, r, postId)
+func (h *Handler) GetFeed(w http.ResponseWriter, r *http.Request, format feed.Format, query entity.FeedQuery) {
+	h.feedHandlers.GetFeed(w, r, format, query)
 }
 
-func (h *Handler) PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID) {
-	h.postHandlers.PutApiV1PostsPostId(w, r, postId)
+func (h *Handler) GetSitemapIndex(w http.ResponseWriter, r *http.Request) {
+	h.sitemapHandlers.GetSitemapIndex(w, r)
 }
 
-func (h *Handler) GetApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId uuid.UUID, params api.GetApiV1PostsPostIdCommentsParams) {
-	h.commentHandlers.GetApiV1PostsPostIdComments(w, r, postId, params)
+func (h *Handler) GetSitemap(w http.ResponseWriter, r *http.Request, kind string, after string) {
+	h.sitemapHandlers.GetSitemap(w, r, kind, after)
 }
 
-func (h *Handler) PostApiV1PostsPostIdComments(w http.ResponseWriter, r *http.Request, postId uuid.UUID) {
-	h.commentHandlers.PostApiV1PostsPostIdComments(w, r, postId)
+func (h *Handler) GetRobots(w http.ResponseWriter, r *http.Request) {
+	h.sitemapHandlers.GetRobots(w, r)
 }
 
-func (h *Handler) GetApiV1Users(w http.ResponseWriter, r *http.Request, params api.GetApiV1UsersParams) {
-	h.userHandlers.GetApiV1Users(w, r, params)
+func (h *Handler) GetApiV1Users(ctx context.Context, request api.GetApiV1UsersRequestObject) (api.GetApiV1UsersResponseObject, error) {
+	return h.userHandlers.GetApiV1Users(ctx, request)
 }
 
-func (h *Handler) PostApiV1Users(w http.ResponseWriter, r *http.Request) {
-	h.userHandlers.PostApiV1Users(w, r)
+func (h *Handler) DeleteApiV1UsersUserId(ctx context.Context, request api.DeleteApiV1UsersUserIdRequestObject) (api.DeleteApiV1UsersUserIdResponseObject, error) {
+	return h.userHandlers.DeleteApiV1UsersUserId(ctx, request)
 }
 
-func (h *Handler) DeleteApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID) {
-	h.userHandlers.DeleteApiV1UsersUserId(w, r, userId)
+func (h *Handler) GetApiV1UsersUserId(ctx context.Context, request api.GetApiV1UsersUserIdRequestObject) (api.GetApiV1UsersUserIdResponseObject, error) {
+	return h.userHandlers.GetApiV1UsersUserId(ctx, request)
 }
 
-func (h *Handler) GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID) {
-	h.userHandlers.GetApiV1UsersUserId(w, r, userId)
+func (h *Handler) PutApiV1UsersUserId(ctx context.Context, request api.PutApiV1UsersUserIdRequestObject) (api.PutApiV1UsersUserIdResponseObject, error) {
+	return h.userHandlers.PutApiV1UsersUserId(ctx, request)
 }
 
-func (h *Handler) PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID) {
-	h.userHandlers.PutApiV1UsersUserId(w, r, userId)
+func (h *Handler) PatchApiV1UsersUserId(ctx context.Context, request api.PatchApiV1UsersUserIdRequestObject) (api.PatchApiV1UsersUserIdResponseObject, error) {
+	return h.userHandlers.PatchApiV1UsersUserId(ctx, request)
 }
 
-func (h *Handler) PostAuthLogin(w http.ResponseWriter, r *http.Request) {
-	h.authHandlers.PostAuthLogin(w, r)
+func (h *Handler) PostAuthLogin(ctx context.Context, request api.PostAuthLoginRequestObject) (api.PostAuthLoginResponseObject, error) {
+	return h.authHandlers.PostAuthLogin(ctx, request)
 }
 
-func (h *Handler) PostAuthRegister(w http.ResponseWriter, r *http.Request) {
-	h.authHandlers.PostAuthRegister(w, r)
+func (h *Handler) PostAuthRegister(ctx context.Context, request api.PostAuthRegisterRequestObject) (api.PostAuthRegisterResponseObject, error) {
+	return h.authHandlers.PostAuthRegister(ctx, request)
 }
 
-func (h *Handler) GetAuthMe(w http.ResponseWriter, r *http.Request) {
-	h.authHandlers.GetAuthMe(w, r)
+func (h *Handler) GetAuthMe(ctx context.Context, request api.GetAuthMeRequestObject) (api.GetAuthMeResponseObject, error) {
+	return h.authHandlers.GetAuthMe(ctx, request)
 }
 
-func (h *Handler) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
-	h.authHandlers.PostAuthLogout(w, r)
+func (h *Handler) PostAuthLogout(ctx context.Context, request api.PostAuthLogoutRequestObject) (api.PostAuthLogoutResponseObject, error) {
+	return h.authHandlers.PostAuthLogout(ctx, request)
 }
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+type JobHandler struct {
+	jobUseCase usecase.UseCaseJob
+	logger     *logrus.Logger
+}
+
+func NewJobHandler(jobUseCase usecase.UseCaseJob, logger *logrus.Logger) *JobHandler {
+	return &JobHandler{
+		jobUseCase: jobUseCase,
+		logger:     logger,
+	}
+}
+
+func (h *JobHandler) GetApiV1AdminJobs(ctx context.Context, request api.GetApiV1AdminJobsRequestObject) (api.GetApiV1AdminJobsResponseObject, error) {
+	params := request.Params
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	paginationFromParams, err := entity.NewPaginationFromParams(entity.RemoteParams{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get pagination from params")
+		return nil, errorStatus(http.StatusBadRequest, "Invalid request params")
+	}
+
+	var status entity.JobStatus
+	if params.Status != nil {
+		status = entity.JobStatus(*params.Status)
+	}
+
+	var queue string
+	if params.Queue != nil {
+		queue = *params.Queue
+	}
+
+	result, err := h.jobUseCase.GetJobs(ctx, userId, status, queue, paginationFromParams)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get jobs")
+		return nil, errorUsecase(err, "Failed to get jobs")
+	}
+
+	return api.GetApiV1AdminJobs200JSONResponse(*result), nil
+}
+
+func (h *JobHandler) GetApiV1AdminJobsJobId(ctx context.Context, request api.GetApiV1AdminJobsJobIdRequestObject) (api.GetApiV1AdminJobsJobIdResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	job, err := h.jobUseCase.GetJob(ctx, userId, request.JobId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get job")
+		return nil, errorUsecase(err, "Failed to get job")
+	}
+
+	return api.GetApiV1AdminJobsJobId200JSONResponse(*job), nil
+}
+
+func (h *JobHandler) PostApiV1AdminJobsJobIdRetry(ctx context.Context, request api.PostApiV1AdminJobsJobIdRetryRequestObject) (api.PostApiV1AdminJobsJobIdRetryResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	job, err := h.jobUseCase.RetryJob(ctx, userId, request.JobId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to retry job")
+		return nil, errorUsecase(err, "Failed to retry job")
+	}
+
+	return api.PostApiV1AdminJobsJobIdRetry202JSONResponse(*job), nil
+}
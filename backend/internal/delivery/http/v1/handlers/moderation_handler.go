@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+type ModerationHandler struct {
+	moderationUseCase usecase.UseCaseModeration
+	logger            *logrus.Logger
+	validator         validator.Validator
+}
+
+func NewModerationHandler(moderationUseCase usecase.UseCaseModeration, logger *logrus.Logger, validator validator.Validator) *ModerationHandler {
+	return &ModerationHandler{
+		moderationUseCase: moderationUseCase,
+		logger:            logger,
+		validator:         validator,
+	}
+}
+
+func (h *ModerationHandler) GetApiV1ModerationComments(ctx context.Context, request api.GetApiV1ModerationCommentsRequestObject) (api.GetApiV1ModerationCommentsResponseObject, error) {
+	params := request.Params
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	paginationFromParams, err := entity.NewPaginationFromParams(entity.RemoteParams{
+		Page:         params.Page,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+		Sort:         (*string)(params.Sort),
+		Cursor:       params.Cursor,
+		IncludeTotal: params.IncludeTotal,
+	})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get pagination from params")
+		return nil, errorStatus(http.StatusBadRequest, "Invalid request params")
+	}
+
+	var status entity.CommentStatus
+	if params.Status != nil {
+		status = entity.CommentStatus(*params.Status)
+	}
+
+	result, err := h.moderationUseCase.GetQueue(ctx, userId, status, paginationFromParams)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get moderation queue")
+		return nil, moderationError(err, "Failed to get moderation queue")
+	}
+
+	return api.GetApiV1ModerationComments200JSONResponse(*result), nil
+}
+
+func (h *ModerationHandler) PostApiV1ModerationComments(ctx context.Context, request api.PostApiV1ModerationCommentsRequestObject) (api.PostApiV1ModerationCommentsResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	decision := request.Body
+	if err := h.validator.Struct(decision); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	updated, err := h.moderationUseCase.ModerateComments(ctx, userId, decision)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to moderate comments")
+		return nil, moderationError(err, "Failed to moderate comments")
+	}
+
+	return api.PostApiV1ModerationComments200JSONResponse{Updated: int(updated)}, nil
+}
+
+func (h *ModerationHandler) PutApiV1PostsPostIdModeration(ctx context.Context, request api.PutApiV1PostsPostIdModerationRequestObject) (api.PutApiV1PostsPostIdModerationResponseObject, error) {
+	postId := request.PostId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.moderationUseCase.SetPostModeration(ctx, userId, postId, request.Body.Mode); err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to set post moderation")
+		return nil, moderationError(err, "Failed to set post moderation")
+	}
+
+	return api.PutApiV1PostsPostIdModeration204Response{}, nil
+}
+
+// moderationError is errorUsecase, except that a moderator whose account is
+// gone is forbidden rather than not found.
+func moderationError(err error, fallback string) error {
+	if errors.Is(err, usecase.ErrUserNotFound) {
+		return errorStatus(http.StatusForbidden, err.Error())
+	}
+	return errorUsecase(err, fallback)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+type NewsletterHandler struct {
+	newsletterUseCase usecase.UseCaseNewsletter
+	logger            *logrus.Logger
+	validator         validator.Validator
+}
+
+func NewNewsletterHandler(newsletterUseCase usecase.UseCaseNewsletter, logger *logrus.Logger, validator validator.Validator) *NewsletterHandler {
+	return &NewsletterHandler{
+		newsletterUseCase: newsletterUseCase,
+		logger:            logger,
+		validator:         validator,
+	}
+}
+
+func (h *NewsletterHandler) PostApiV1NewsletterSubscriptions(ctx context.Context, request api.PostApiV1NewsletterSubscriptionsRequestObject) (api.PostApiV1NewsletterSubscriptionsResponseObject, error) {
+	subscription := request.Body
+	if err := h.validator.Struct(subscription); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	if err := h.newsletterUseCase.Subscribe(ctx, subscription); err != nil {
+		return nil, h.newsletterError(err, "Failed to subscribe")
+	}
+
+	return api.PostApiV1NewsletterSubscriptions202Response{}, nil
+}
+
+func (h *NewsletterHandler) GetApiV1NewsletterConfirm(ctx context.Context, request api.GetApiV1NewsletterConfirmRequestObject) (api.GetApiV1NewsletterConfirmResponseObject, error) {
+	subscriber, err := h.newsletterUseCase.Confirm(ctx, request.Params.Token)
+	if err != nil {
+		return nil, h.newsletterError(err, "Failed to confirm subscription")
+	}
+
+	return api.GetApiV1NewsletterConfirm200JSONResponse(*subscriber), nil
+}
+
+// GetApiV1NewsletterUnsubscribe only shows whom the link unsubscribes. Mail
+// scanners open links on their own, so unsubscribing takes the POST.
+func (h *NewsletterHandler) GetApiV1NewsletterUnsubscribe(ctx context.Context, request api.GetApiV1NewsletterUnsubscribeRequestObject) (api.GetApiV1NewsletterUnsubscribeResponseObject, error) {
+	subscriber, err := h.newsletterUseCase.CheckUnsubscribe(ctx, request.Params.Token)
+	if err != nil {
+		return nil, h.newsletterError(err, "Failed to check unsubscribe link")
+	}
+
+	return api.GetApiV1NewsletterUnsubscribe200JSONResponse(*subscriber), nil
+}
+
+func (h *NewsletterHandler) PostApiV1NewsletterUnsubscribe(ctx context.Context, request api.PostApiV1NewsletterUnsubscribeRequestObject) (api.PostApiV1NewsletterUnsubscribeResponseObject, error) {
+	if err := h.newsletterUseCase.Unsubscribe(ctx, request.Params.Token); err != nil {
+		return nil, h.newsletterError(err, "Failed to unsubscribe")
+	}
+
+	return api.PostApiV1NewsletterUnsubscribe204Response{}, nil
+}
+
+// newsletterError wraps err like errorUsecase, except that an unknown author
+// is a mistake in the subscription rather than a missing page.
+func (h *NewsletterHandler) newsletterError(err error, message string) error {
+	if errors.Is(err, usecase.ErrUserNotFound) {
+		return errorStatus(http.StatusBadRequest, "Unknown author")
+	}
+	h.logger.WithError(err).Error(message)
+	return errorUsecase(err, message)
+}
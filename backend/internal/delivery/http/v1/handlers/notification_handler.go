@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+type NotificationHandler struct {
+	notificationUseCase usecase.UseCaseNotification
+	logger              *logrus.Logger
+	validator           validator.Validator
+}
+
+func NewNotificationHandler(notificationUseCase usecase.UseCaseNotification, logger *logrus.Logger, validator validator.Validator) *NotificationHandler {
+	return &NotificationHandler{
+		notificationUseCase: notificationUseCase,
+		logger:              logger,
+		validator:           validator,
+	}
+}
+
+func (h *NotificationHandler) GetApiV1Notifications(ctx context.Context, request api.GetApiV1NotificationsRequestObject) (api.GetApiV1NotificationsResponseObject, error) {
+	params := request.Params
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	paginationFromParams, err := entity.NewPaginationFromParams(entity.RemoteParams{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get pagination from params")
+		return nil, errorStatus(http.StatusBadRequest, "Invalid request params")
+	}
+
+	unreadOnly := params.Unread != nil && *params.Unread
+
+	result, err := h.notificationUseCase.GetNotifications(ctx, userId, unreadOnly, paginationFromParams)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get notifications")
+		return nil, errorUsecase(err, "Failed to get notifications")
+	}
+
+	return api.GetApiV1Notifications200JSONResponse(*result), nil
+}
+
+func (h *NotificationHandler) PostApiV1NotificationsNotificationIdRead(ctx context.Context, request api.PostApiV1NotificationsNotificationIdReadRequestObject) (api.PostApiV1NotificationsNotificationIdReadResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.notificationUseCase.MarkRead(ctx, userId, request.NotificationId); err != nil {
+		h.logger.WithError(err).Error("Failed to mark notification as read")
+		return nil, errorUsecase(err, "Failed to mark notification as read")
+	}
+
+	return api.PostApiV1NotificationsNotificationIdRead204Response{}, nil
+}
+
+func (h *NotificationHandler) PostApiV1NotificationsReadAll(ctx context.Context, request api.PostApiV1NotificationsReadAllRequestObject) (api.PostApiV1NotificationsReadAllResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	updated, err := h.notificationUseCase.MarkAllRead(ctx, userId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to mark notifications as read")
+		return nil, errorUsecase(err, "Failed to mark notifications as read")
+	}
+
+	return api.PostApiV1NotificationsReadAll200JSONResponse{Updated: int(updated)}, nil
+}
+
+func (h *NotificationHandler) GetApiV1MeNotificationPreferences(ctx context.Context, request api.GetApiV1MeNotificationPreferencesRequestObject) (api.GetApiV1MeNotificationPreferencesResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	prefs, err := h.notificationUseCase.GetPreferences(ctx, userId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get notification preferences")
+		return nil, errorUsecase(err, "Failed to get notification preferences")
+	}
+
+	return api.GetApiV1MeNotificationPreferences200JSONResponse{Preferences: prefs}, nil
+}
+
+func (h *NotificationHandler) PutApiV1MeNotificationPreferences(ctx context.Context, request api.PutApiV1MeNotificationPreferencesRequestObject) (api.PutApiV1MeNotificationPreferencesResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	update := request.Body
+	if err := h.validator.Struct(update); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	prefs, err := h.notificationUseCase.UpdatePreferences(ctx, userId, update.Preferences)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to update notification preferences")
+		return nil, errorUsecase(err, "Failed to update notification preferences")
+	}
+
+	return api.PutApiV1MeNotificationPreferences200JSONResponse{Preferences: prefs}, nil
+}
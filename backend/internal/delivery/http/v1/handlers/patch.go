@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"encoding/json"
+
+	"github.com/popeskul/awesome-blog/backend/internal/patch"
+)
+
+// readPatch parses the merge patch or JSON Patch of a PATCH request,
+// whichever of the bodies the strict server decoded by Content-Type.
+func readPatch(mergePatch, jsonPatch *json.RawMessage) (patch.Patch, error) {
+	switch {
+	case mergePatch != nil:
+		return patch.Parse(patch.MergePatchType, *mergePatch)
+	case jsonPatch != nil:
+		return patch.Parse(patch.JSONPatchType, *jsonPatch)
+	default:
+		return nil, patch.ErrUnsupportedType
+	}
+}
@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	"encoding/json"
+	"context"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -9,6 +9,7 @@ import (
 
 	"github.com/popeskul/awesome-blog/backend/gen/api"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/patch"
 	"github.com/popeskul/awesome-blog/backend/internal/usecase"
 	"github.com/popeskul/awesome-blog/backend/internal/validator"
 )
@@ -17,127 +18,254 @@ type PostHandler struct {
 	postUseCase usecase.UseCasePost
 	validator   validator.Validator
 	logger      *logrus.Logger
+	// requireIfMatch rejects updates and deletes without an If-Match header.
+	requireIfMatch bool
 }
 
-func NewPostHandler(postUseCase usecase.UseCasePost, logger *logrus.Logger, validator validator.Validator) *PostHandler {
+func NewPostHandler(postUseCase usecase.UseCasePost, logger *logrus.Logger, validator validator.Validator, requireIfMatch bool) *PostHandler {
 	return &PostHandler{
-		postUseCase: postUseCase,
-		logger:      logger,
-		validator:   validator,
+		postUseCase:    postUseCase,
+		logger:         logger,
+		validator:      validator,
+		requireIfMatch: requireIfMatch,
 	}
 }
 
-func (h *PostHandler) GetApiV1Posts(w http.ResponseWriter, r *http.Request, params api.GetApiV1PostsParams) {
-	ctx := r.Context()
+func (h *PostHandler) GetApiV1Posts(ctx context.Context, request api.GetApiV1PostsRequestObject) (api.GetApiV1PostsResponseObject, error) {
+	params := request.Params
 
 	paginationFromParams, err := entity.NewPaginationFromParams(entity.RemoteParams{
-		Page:   params.Page,
-		Limit:  params.Limit,
-		Offset: params.Offset,
-		Sort:   (*string)(params.Sort),
+		Page:         params.Page,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+		Sort:         (*string)(params.Sort),
+		Cursor:       params.Cursor,
+		IncludeTotal: params.IncludeTotal,
 	})
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to get pagination from params")
-		respondError(w, http.StatusBadRequest, "Invalid request parameters")
-		return
+		return nil, errorStatus(http.StatusBadRequest, "Invalid request parameters")
 	}
 
-	result, err := h.postUseCase.GetAllPosts(ctx, paginationFromParams)
+	filter := &entity.PostFilter{
+		CreatedAfter:  params.CreatedAfter,
+		CreatedBefore: params.CreatedBefore,
+	}
+	if params.Author != nil {
+		filter.Author = *params.Author
+	}
+	if params.Status != nil {
+		filter.Status = entity.PostStatus(*params.Status)
+	}
+	if params.Tag != nil {
+		filter.Tag = *params.Tag
+	}
+	if params.Q != nil {
+		filter.TitlePrefix = *params.Q
+	}
+
+	include, err := entity.ParsePostInclude(params.Include)
 	if err != nil {
-		h.logger.WithError(err).Error("Failed to get posts")
-		respondError(w, http.StatusInternalServerError, "Failed to get posts")
-		return
+		h.logger.WithError(err).Error("Failed to parse include")
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
 	}
 
-	respondJSON(w, http.StatusOK, result)
-}
+	paginationFromParams.Fields, err = entity.ParseFields(params.Fields, entity.PostFields)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to parse fields")
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
+	}
+
+	viewerId, _ := ctx.Value("user_id").(uuid.UUID)
 
-func (h *PostHandler) PostApiV1Posts(w http.ResponseWriter, r *http.Request) {
-	var newPost entity.NewPost
-	if err := json.NewDecoder(r.Body).Decode(&newPost); err != nil {
-		h.logger.WithError(err).Error("Failed to decode request body")
-		respondError(w, http.StatusBadRequest, "Invalid request payload")
-		return
+	result, err := h.postUseCase.GetAllPosts(ctx, viewerId, filter, paginationFromParams, include)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get posts")
+		return nil, errorUsecase(err, "Failed to get posts")
 	}
 
-	if err := h.validator.Struct(&newPost); err != nil {
+	return page[entity.Post]{result}, nil
+}
+
+func (h *PostHandler) PostApiV1Posts(ctx context.Context, request api.PostApiV1PostsRequestObject) (api.PostApiV1PostsResponseObject, error) {
+	newPost := request.Body
+	if err := h.validator.Struct(newPost); err != nil {
 		h.logger.WithError(err).Error("Failed to validate request body")
-		respondError(w, http.StatusBadRequest, "Validation failed "+err.Error())
-		return
+		return nil, errorValidation(http.StatusBadRequest, err)
 	}
 
-	ctx := r.Context()
-	createdPost, err := h.postUseCase.CreatePost(ctx, &newPost)
+	createdPost, err := h.postUseCase.CreatePost(ctx, newPost)
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to create post")
-		respondError(w, http.StatusInternalServerError, "Failed to create post")
-		return
+		return nil, errorUsecase(err, "Failed to create post")
 	}
 
-	respondJSON(w, http.StatusCreated, createdPost)
+	return api.PostApiV1Posts201JSONResponse(*createdPost), nil
 }
 
-func (h *PostHandler) DeleteApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID) {
-	ctx := r.Context()
+func (h *PostHandler) DeleteApiV1PostsPostId(ctx context.Context, request api.DeleteApiV1PostsPostIdRequestObject) (api.DeleteApiV1PostsPostIdResponseObject, error) {
+	postId := request.PostId
 	userId, ok := ctx.Value("user_id").(uuid.UUID)
 	if !ok {
 		h.logger.Error("Failed to get user_id from context")
-		respondError(w, http.StatusUnauthorized, "Unauthorized")
-		return
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	version, err := expectedVersion(request.Params.IfMatch, h.requireIfMatch)
+	if err != nil {
+		return nil, err
 	}
 
-	err := h.postUseCase.DeletePost(ctx, postId, userId)
+	err = h.postUseCase.DeletePost(ctx, postId, userId, version)
 	if err != nil {
 		h.logger.WithError(err).WithField("postId", postId).Error("Failed to delete post")
-		respondError(w, http.StatusInternalServerError, "Failed to delete post")
-		return
+		return nil, errorUsecase(err, "Failed to delete post")
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	return api.DeleteApiV1PostsPostId204Response{}, nil
 }
 
-func (h *PostHandler) GetApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID) {
-	ctx := r.Context()
-	foundPost, err := h.postUseCase.GetPost(ctx, postId)
+func (h *PostHandler) GetApiV1PostsPostId(ctx context.Context, request api.GetApiV1PostsPostIdRequestObject) (api.GetApiV1PostsPostIdResponseObject, error) {
+	postId := request.PostId
+
+	include, err := entity.ParsePostInclude(request.Params.Include)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to parse include")
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
+	}
+
+	viewerId, _ := ctx.Value("user_id").(uuid.UUID)
+
+	foundPost, err := h.postUseCase.GetPost(ctx, postId, viewerId, include)
 	if err != nil {
 		h.logger.WithError(err).WithField("postId", postId).Error("Failed to get post")
-		respondError(w, http.StatusNotFound, "Post not found")
-		return
+		return nil, errorUsecase(err, "Failed to get post")
+	}
+
+	etag, err := entityTag(foundPost.Version, foundPost)
+	if err != nil {
+		return nil, err
+	}
+	if notModified(request.Params.IfNoneMatch, etag) {
+		return api.GetApiV1PostsPostId304Response{
+			Headers: api.GetApiV1PostsPostId304ResponseHeaders{ETag: etag},
+		}, nil
 	}
 
-	respondJSON(w, http.StatusOK, foundPost)
+	return api.GetApiV1PostsPostId200JSONResponse{
+		Body:    *foundPost,
+		Headers: api.GetApiV1PostsPostId200ResponseHeaders{ETag: etag},
+	}, nil
 }
 
-func (h *PostHandler) PutApiV1PostsPostId(w http.ResponseWriter, r *http.Request, postId uuid.UUID) {
-	ctx := r.Context()
+func (h *PostHandler) PutApiV1PostsPostId(ctx context.Context, request api.PutApiV1PostsPostIdRequestObject) (api.PutApiV1PostsPostIdResponseObject, error) {
+	postId := request.PostId
 	userId, ok := ctx.Value("user_id").(uuid.UUID)
 	if !ok {
 		h.logger.Error("Failed to get user_id from context")
-		respondError(w, http.StatusUnauthorized, "Unauthorized")
-		return
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
 	}
 
-	var postPut entity.Post
-	if err := json.NewDecoder(r.Body).Decode(&postPut); err != nil {
-		h.logger.WithError(err).Error("Failed to decode request body")
-		respondError(w, http.StatusBadRequest, "Invalid request payload")
-		return
+	postPut := request.Body
+	if err := h.validator.Struct(postPut); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
 	}
 
-	if err := h.validator.Struct(&postPut); err != nil {
-		h.logger.WithError(err).Error("Failed to validate request body")
-		respondError(w, http.StatusBadRequest, "Validation failed "+err.Error())
-		return
+	version, err := expectedVersion(request.Params.IfMatch, h.requireIfMatch)
+	if err != nil {
+		return nil, err
 	}
 
 	postPut.Id = postId
+	postPut.Version = version
 
-	err := h.postUseCase.UpdatePost(ctx, &postPut, userId)
+	err = h.postUseCase.UpdatePost(ctx, postPut, userId)
 	if err != nil {
 		h.logger.WithError(err).WithField("postId", postId).Error("Failed to update post")
-		respondError(w, http.StatusInternalServerError, "Failed to update post")
-		return
+		return nil, errorUsecase(err, "Failed to update post")
+	}
+
+	updatedPost, err := h.postUseCase.GetPost(ctx, postId, userId, entity.DefaultPostInclude)
+	if err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to get updated post")
+		return nil, errorUsecase(err, "Failed to update post")
+	}
+
+	etag, err := entityTag(updatedPost.Version, updatedPost)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PutApiV1PostsPostId200JSONResponse{
+		Body:    *updatedPost,
+		Headers: api.PutApiV1PostsPostId200ResponseHeaders{ETag: etag},
+	}, nil
+}
+
+func (h *PostHandler) PatchApiV1PostsPostId(ctx context.Context, request api.PatchApiV1PostsPostIdRequestObject) (api.PatchApiV1PostsPostIdResponseObject, error) {
+	postId := request.PostId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	version, err := expectedVersion(request.Params.IfMatch, h.requireIfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := readPatch(request.ApplicationMergePatchPlusJSONBody, request.ApplicationJSONPatchPlusJSONBody)
+	if err != nil {
+		return nil, errorUsecase(err, "Failed to read patch")
+	}
+
+	current, err := h.postUseCase.GetPost(ctx, postId, userId, entity.DefaultPostInclude)
+	if err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to get post")
+		return nil, errorUsecase(err, "Failed to get post")
+	}
+
+	// Without If-Match the patch still only applies to the version it was
+	// applied to here.
+	if version == entity.AnyVersion {
+		version = current.Version
+	}
+
+	var patched entity.Post
+	changed, err := patch.Apply(p, current, &patched)
+	if err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to apply patch")
+		return nil, errorUsecase(err, "Failed to apply patch")
+	}
+
+	if err := h.validator.Struct(&patched); err != nil {
+		h.logger.WithError(err).Error("Failed to validate patched post")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	patched.Id = postId
+	patched.Version = version
+
+	if err := h.postUseCase.PatchPost(ctx, &patched, changed, userId); err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to patch post")
+		return nil, errorUsecase(err, "Failed to patch post")
+	}
+
+	updatedPost, err := h.postUseCase.GetPost(ctx, postId, userId, entity.DefaultPostInclude)
+	if err != nil {
+		h.logger.WithError(err).WithField("postId", postId).Error("Failed to get patched post")
+		return nil, errorUsecase(err, "Failed to patch post")
+	}
+
+	etag, err := entityTag(updatedPost.Version, updatedPost)
+	if err != nil {
+		return nil, err
 	}
 
-	respondJSON(w, http.StatusOK, postPut)
+	return api.PatchApiV1PostsPostId200JSONResponse{
+		Body:    *updatedPost,
+		Headers: api.PatchApiV1PostsPostId200ResponseHeaders{ETag: etag},
+	}, nil
 }
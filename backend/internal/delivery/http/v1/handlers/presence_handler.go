@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+// presenceWriteTimeout bounds how long a slow client may hold up its own
+// connection loop.
+const presenceWriteTimeout = 10 * time.Second
+
+// presenceClosePolicyViolation is the WebSocket close status for clients
+// that break the rules of the connection, such as its rate limit.
+const presenceClosePolicyViolation = 1008
+
+var errPresenceRateLimited = errors.New("rate limit exceeded")
+
+// Messages sent by the server.
+const (
+	presenceMessageViewers = "presence"
+	presenceMessageError   = "error"
+	presenceMessagePong    = "pong"
+	presenceMessageClosing = "closing"
+)
+
+// Messages sent by the client.
+const (
+	presenceMessageState = "state"
+	presenceMessagePing  = "ping"
+)
+
+type presenceMessage struct {
+	Type    string             `json:"type"`
+	State   string             `json:"state,omitempty"`
+	Viewers []*entity.Presence `json:"viewers,omitempty"`
+	Data    json.RawMessage    `json:"data,omitempty"`
+	Error   string             `json:"error,omitempty"`
+}
+
+type PresenceHandler struct {
+	presenceUseCase usecase.UseCasePresence
+	logger          *logrus.Logger
+	cfg             config.PresenceConfig
+
+	mu       sync.Mutex
+	draining bool
+	drain    chan struct{}
+	conns    sync.WaitGroup
+}
+
+func NewPresenceHandler(presenceUseCase usecase.UseCasePresence, logger *logrus.Logger, cfg config.PresenceConfig) *PresenceHandler {
+	return &PresenceHandler{
+		presenceUseCase: presenceUseCase,
+		logger:          logger,
+		cfg:             cfg,
+		drain:           make(chan struct{}),
+	}
+}
+
+func (h *PresenceHandler) GetApiV1PostsPostIdPresence(ctx context.Context, request api.GetApiV1PostsPostIdPresenceRequestObject) (api.GetApiV1PostsPostIdPresenceResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if !h.track() {
+		return nil, errorStatus(http.StatusServiceUnavailable, "Server is shutting down")
+	}
+
+	session, err := h.presenceUseCase.Join(ctx, request.PostId, userId)
+	if err != nil {
+		h.conns.Done()
+		// The viewer's own account is gone, unlike a missing post.
+		if errors.Is(err, usecase.ErrUserNotFound) {
+			return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+		}
+		h.logger.WithError(err).Error("Failed to join post presence")
+		return nil, errorUsecase(err, "Failed to join post presence")
+	}
+
+	return &presenceResponse{handler: h, ctx: ctx, session: session}, nil
+}
+
+// presenceResponse upgrades the request to a WebSocket connection for a
+// joined session and serves it until either side closes it.
+type presenceResponse struct {
+	handler *PresenceHandler
+	ctx     context.Context
+	session *usecase.PresenceSession
+}
+
+func (p *presenceResponse) VisitGetApiV1PostsPostIdPresenceResponse(w http.ResponseWriter) error {
+	h := p.handler
+	defer h.conns.Done()
+	// The connection context ends with the request; leaving must still be
+	// announced after that.
+	defer p.session.Leave(context.WithoutCancel(p.ctx))
+
+	server := websocket.Server{
+		Handshake: acceptBearerProtocol,
+		Handler: func(conn *websocket.Conn) {
+			conn.MaxPayloadBytes = h.cfg.MaxMessageBytes
+			h.serve(p.ctx, conn, p.session)
+		},
+	}
+	server.ServeHTTP(w, httpRequest(p.ctx))
+
+	return nil
+}
+
+// Drain tells every open connection that the server is going away and waits
+// until they are closed. http.Server.Shutdown does not wait for hijacked
+// connections, so the server calls this after it.
+func (h *PresenceHandler) Drain(ctx context.Context) error {
+	h.mu.Lock()
+	if !h.draining {
+		h.draining = true
+		close(h.drain)
+	}
+	h.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		h.conns.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (h *PresenceHandler) track() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.draining {
+		return false
+	}
+	h.conns.Add(1)
+
+	return true
+}
+
+// serve owns the connection: it is the only goroutine writing to it and the
+// only one touching the session. A separate reader hands it client messages.
+func (h *PresenceHandler) serve(ctx context.Context, conn *websocket.Conn, session *usecase.PresenceSession) {
+	incoming := make(chan presenceMessage)
+	stop := make(chan struct{})
+	readDone := make(chan struct{})
+	var readErr error
+	go func() {
+		defer close(readDone)
+		readErr = h.read(conn, incoming, stop)
+	}()
+	// Closing the connection unblocks the reader. Once a close frame with a
+	// status of its own was sent, the websocket server closes the connection
+	// after serve returns.
+	closed := false
+	defer func() {
+		close(stop)
+		if !closed {
+			conn.Close()
+		}
+		<-readDone
+	}()
+
+	if err := h.send(conn, presenceMessage{Type: presenceMessageViewers, Viewers: session.Viewers()}); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(h.cfg.TTL / 3)
+	defer heartbeat.Stop()
+
+	for {
+		var reply *presenceMessage
+
+		select {
+		case <-h.drain:
+			h.send(conn, presenceMessage{Type: presenceMessageClosing, Error: "server is shutting down"})
+			return
+		case <-readDone:
+			if errors.Is(readErr, errPresenceRateLimited) {
+				h.send(conn, presenceMessage{Type: presenceMessageClosing, Error: readErr.Error()})
+				closed = conn.WriteClose(presenceClosePolicyViolation) == nil
+			}
+			return
+		case msg := <-incoming:
+			reply = h.handleMessage(ctx, session, msg)
+		case event, ok := <-session.Events():
+			if !ok {
+				h.send(conn, presenceMessage{Type: presenceMessageClosing, Error: "stream ended"})
+				return
+			}
+			reply = h.handleEvent(ctx, session, event)
+		case <-heartbeat.C:
+			if session.Heartbeat(ctx) {
+				reply = &presenceMessage{Type: presenceMessageViewers, Viewers: session.Viewers()}
+			}
+		}
+
+		if reply == nil {
+			continue
+		}
+		if err := h.send(conn, *reply); err != nil {
+			return
+		}
+		if reply.Type == presenceMessageClosing {
+			return
+		}
+	}
+}
+
+// read decodes client messages until the connection closes or stop is
+// closed. The first message over the rate limit ends reading with
+// errPresenceRateLimited; the connection is closed rather than answering a
+// flood message by message.
+func (h *PresenceHandler) read(conn *websocket.Conn, incoming chan<- presenceMessage, stop <-chan struct{}) error {
+	limiter := newTokenBucket(h.cfg.MessageRate, h.cfg.MessageBurst)
+
+	for {
+		var msg presenceMessage
+		if err := websocket.JSON.Receive(conn, &msg); err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &syntaxErr) && !errors.As(err, &typeErr) {
+				return err
+			}
+			msg = presenceMessage{Type: presenceMessageError, Error: "invalid message"}
+		}
+
+		if !limiter.allow(time.Now()) {
+			return errPresenceRateLimited
+		}
+
+		select {
+		case incoming <- msg:
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+func (h *PresenceHandler) handleMessage(ctx context.Context, session *usecase.PresenceSession, msg presenceMessage) *presenceMessage {
+	switch msg.Type {
+	case presenceMessageError:
+		return &msg
+	case presenceMessagePing:
+		return &presenceMessage{Type: presenceMessagePong}
+	case presenceMessageState:
+		err := session.SetState(ctx, entity.PresenceState(msg.State))
+		switch {
+		case err == nil:
+			return &presenceMessage{Type: presenceMessageViewers, Viewers: session.Viewers()}
+		case errors.Is(err, usecase.ErrInvalidPresenceState), errors.Is(err, usecase.ErrUnauthorized):
+			return &presenceMessage{Type: presenceMessageError, Error: err.Error()}
+		default:
+			h.logger.WithError(err).Error("Failed to update presence")
+			return &presenceMessage{Type: presenceMessageError, Error: "failed to update presence"}
+		}
+	}
+
+	return &presenceMessage{Type: presenceMessageError, Error: "unknown message type"}
+}
+
+// handleEvent turns a broker event into the message for this client. Post
+// updates are passed through so editors holding an older copy are warned
+// before they save over it; a deleted post ends the session.
+func (h *PresenceHandler) handleEvent(ctx context.Context, session *usecase.PresenceSession, event *entity.Event) *presenceMessage {
+	switch event.Type {
+	case entity.EventPostUpdated:
+		return &presenceMessage{Type: event.Type, Data: event.Data}
+	case entity.EventPostDeleted:
+		return &presenceMessage{Type: presenceMessageClosing, Error: "post was deleted"}
+	}
+
+	if session.Apply(ctx, event) {
+		return &presenceMessage{Type: presenceMessageViewers, Viewers: session.Viewers()}
+	}
+
+	return nil
+}
+
+func (h *PresenceHandler) send(conn *websocket.Conn, msg presenceMessage) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(presenceWriteTimeout)); err != nil {
+		return err
+	}
+
+	return websocket.JSON.Send(conn, msg)
+}
+
+// acceptBearerProtocol lets browsers, which cannot set an Authorization
+// header on WebSocket requests, pass the token as the subprotocol pair
+// "bearer, <token>". Only "bearer" is echoed back so the token never appears
+// in the response.
+func acceptBearerProtocol(config *websocket.Config, r *http.Request) error {
+	for _, protocol := range config.Protocol {
+		if protocol == "bearer" {
+			config.Protocol = []string{"bearer"}
+			return nil
+		}
+	}
+	config.Protocol = nil
+
+	return nil
+}
+
+// tokenBucket is a per-connection rate limiter. It is only used by the
+// connection's reader.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	if b.rate <= 0 {
+		return true
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+
+	return true
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+type ReactionHandler struct {
+	reactionUseCase usecase.UseCaseReaction
+	logger          *logrus.Logger
+}
+
+func NewReactionHandler(reactionUseCase usecase.UseCaseReaction, logger *logrus.Logger) *ReactionHandler {
+	return &ReactionHandler{
+		reactionUseCase: reactionUseCase,
+		logger:          logger,
+	}
+}
+
+func (h *ReactionHandler) PutApiV1PostsPostIdReactionsKind(ctx context.Context, request api.PutApiV1PostsPostIdReactionsKindRequestObject) (api.PutApiV1PostsPostIdReactionsKindResponseObject, error) {
+	summary, err := h.react(ctx, entity.ReactionTargetPost, request.PostId, request.Kind, true)
+	if err != nil {
+		return nil, err
+	}
+	return api.PutApiV1PostsPostIdReactionsKind200JSONResponse(*summary), nil
+}
+
+func (h *ReactionHandler) DeleteApiV1PostsPostIdReactionsKind(ctx context.Context, request api.DeleteApiV1PostsPostIdReactionsKindRequestObject) (api.DeleteApiV1PostsPostIdReactionsKindResponseObject, error) {
+	summary, err := h.react(ctx, entity.ReactionTargetPost, request.PostId, request.Kind, false)
+	if err != nil {
+		return nil, err
+	}
+	return api.DeleteApiV1PostsPostIdReactionsKind200JSONResponse(*summary), nil
+}
+
+func (h *ReactionHandler) PutApiV1CommentsCommentIdReactionsKind(ctx context.Context, request api.PutApiV1CommentsCommentIdReactionsKindRequestObject) (api.PutApiV1CommentsCommentIdReactionsKindResponseObject, error) {
+	summary, err := h.react(ctx, entity.ReactionTargetComment, request.CommentId, request.Kind, true)
+	if err != nil {
+		return nil, err
+	}
+	return api.PutApiV1CommentsCommentIdReactionsKind200JSONResponse(*summary), nil
+}
+
+func (h *ReactionHandler) DeleteApiV1CommentsCommentIdReactionsKind(ctx context.Context, request api.DeleteApiV1CommentsCommentIdReactionsKindRequestObject) (api.DeleteApiV1CommentsCommentIdReactionsKindResponseObject, error) {
+	summary, err := h.react(ctx, entity.ReactionTargetComment, request.CommentId, request.Kind, false)
+	if err != nil {
+		return nil, err
+	}
+	return api.DeleteApiV1CommentsCommentIdReactionsKind200JSONResponse(*summary), nil
+}
+
+func (h *ReactionHandler) react(ctx context.Context, target entity.ReactionTarget, targetId uuid.UUID, kind api.ReactionKind, add bool) (*entity.ReactionSummary, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	reaction := &entity.Reaction{
+		TargetType: target,
+		TargetId:   targetId,
+		UserId:     userId,
+		Kind:       kind,
+	}
+
+	var (
+		summary *entity.ReactionSummary
+		err     error
+	)
+	if add {
+		summary, err = h.reactionUseCase.AddReaction(ctx, reaction)
+	} else {
+		summary, err = h.reactionUseCase.RemoveReaction(ctx, reaction)
+	}
+	if err != nil {
+		h.logger.WithError(err).WithFields(logrus.Fields{
+			"target":   target,
+			"targetId": targetId,
+			"kind":     kind,
+		}).Error("Failed to update reaction")
+
+		return nil, errorUsecase(err, "Failed to update reaction")
+	}
+
+	return summary, nil
+}
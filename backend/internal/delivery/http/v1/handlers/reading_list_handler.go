@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+type ReadingListHandler struct {
+	readingListUseCase usecase.UseCaseReadingList
+	logger             *logrus.Logger
+	validator          validator.Validator
+}
+
+func NewReadingListHandler(readingListUseCase usecase.UseCaseReadingList, logger *logrus.Logger, validator validator.Validator) *ReadingListHandler {
+	return &ReadingListHandler{
+		readingListUseCase: readingListUseCase,
+		logger:             logger,
+		validator:          validator,
+	}
+}
+
+func (h *ReadingListHandler) GetApiV1MeReadingLists(ctx context.Context, request api.GetApiV1MeReadingListsRequestObject) (api.GetApiV1MeReadingListsResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	lists, err := h.readingListUseCase.GetLists(ctx, userId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get reading lists")
+		return nil, errorUsecase(err, "Failed to get reading lists")
+	}
+
+	response := make(api.GetApiV1MeReadingLists200JSONResponse, 0, len(lists))
+	for _, list := range lists {
+		response = append(response, *list)
+	}
+
+	return response, nil
+}
+
+func (h *ReadingListHandler) PostApiV1MeReadingLists(ctx context.Context, request api.PostApiV1MeReadingListsRequestObject) (api.PostApiV1MeReadingListsResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	newList := request.Body
+	if err := h.validator.Struct(newList); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	newList.OwnerId = userId
+
+	created, err := h.readingListUseCase.CreateList(ctx, newList)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to create reading list")
+		return nil, errorUsecase(err, "Failed to create reading list")
+	}
+
+	return api.PostApiV1MeReadingLists201JSONResponse(*created), nil
+}
+
+func (h *ReadingListHandler) GetApiV1MeReadingListsListId(ctx context.Context, request api.GetApiV1MeReadingListsListIdRequestObject) (api.GetApiV1MeReadingListsListIdResponseObject, error) {
+	listId := request.ListId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	list, err := h.readingListUseCase.GetList(ctx, listId, userId)
+	if err != nil {
+		h.logger.WithError(err).WithField("listId", listId).Error("Failed to get reading list")
+		return nil, errorUsecase(err, "Failed to get reading list")
+	}
+
+	return api.GetApiV1MeReadingListsListId200JSONResponse(*list), nil
+}
+
+func (h *ReadingListHandler) PutApiV1MeReadingListsListId(ctx context.Context, request api.PutApiV1MeReadingListsListIdRequestObject) (api.PutApiV1MeReadingListsListIdResponseObject, error) {
+	listId := request.ListId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	update := entity.UpdateReadingList{
+		Id:         listId,
+		OwnerId:    userId,
+		Name:       request.Body.Name,
+		Visibility: request.Body.Visibility,
+	}
+
+	if err := h.validator.Struct(&update); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	list, err := h.readingListUseCase.UpdateList(ctx, &update)
+	if err != nil {
+		h.logger.WithError(err).WithField("listId", listId).Error("Failed to update reading list")
+		return nil, errorUsecase(err, "Failed to update reading list")
+	}
+
+	return api.PutApiV1MeReadingListsListId200JSONResponse(*list), nil
+}
+
+func (h *ReadingListHandler) DeleteApiV1MeReadingListsListId(ctx context.Context, request api.DeleteApiV1MeReadingListsListIdRequestObject) (api.DeleteApiV1MeReadingListsListIdResponseObject, error) {
+	listId := request.ListId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.readingListUseCase.DeleteList(ctx, listId, userId); err != nil {
+		h.logger.WithError(err).WithField("listId", listId).Error("Failed to delete reading list")
+		return nil, errorUsecase(err, "Failed to delete reading list")
+	}
+
+	return api.DeleteApiV1MeReadingListsListId204Response{}, nil
+}
+
+func (h *ReadingListHandler) PutApiV1MeReadingListsListIdItemsPostId(ctx context.Context, request api.PutApiV1MeReadingListsListIdItemsPostIdRequestObject) (api.PutApiV1MeReadingListsListIdItemsPostIdResponseObject, error) {
+	listId, postId := request.ListId, request.PostId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	list, err := h.readingListUseCase.AddItem(ctx, listId, userId, postId)
+	if err != nil {
+		h.logger.WithError(err).WithFields(logrus.Fields{
+			"listId": listId,
+			"postId": postId,
+		}).Error("Failed to add reading list item")
+		h.logger.WithError(err).Error("Failed to add post to reading list")
+		return nil, errorUsecase(err, "Failed to add post to reading list")
+	}
+
+	return api.PutApiV1MeReadingListsListIdItemsPostId200JSONResponse(*list), nil
+}
+
+func (h *ReadingListHandler) DeleteApiV1MeReadingListsListIdItemsPostId(ctx context.Context, request api.DeleteApiV1MeReadingListsListIdItemsPostIdRequestObject) (api.DeleteApiV1MeReadingListsListIdItemsPostIdResponseObject, error) {
+	listId, postId := request.ListId, request.PostId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.readingListUseCase.RemoveItem(ctx, listId, userId, postId); err != nil {
+		h.logger.WithError(err).WithFields(logrus.Fields{
+			"listId": listId,
+			"postId": postId,
+		}).Error("Failed to remove reading list item")
+		h.logger.WithError(err).Error("Failed to remove post from reading list")
+		return nil, errorUsecase(err, "Failed to remove post from reading list")
+	}
+
+	return api.DeleteApiV1MeReadingListsListIdItemsPostId204Response{}, nil
+}
+
+func (h *ReadingListHandler) PutApiV1MeReadingListsListIdOrder(ctx context.Context, request api.PutApiV1MeReadingListsListIdOrderRequestObject) (api.PutApiV1MeReadingListsListIdOrderResponseObject, error) {
+	listId := request.ListId
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	list, err := h.readingListUseCase.ReorderItems(ctx, listId, userId, request.Body.PostIds)
+	if err != nil {
+		h.logger.WithError(err).WithField("listId", listId).Error("Failed to reorder reading list")
+		return nil, errorUsecase(err, "Failed to reorder reading list")
+	}
+
+	return api.PutApiV1MeReadingListsListIdOrder200JSONResponse(*list), nil
+}
+
+func (h *ReadingListHandler) GetApiV1ReadingListsSharedToken(ctx context.Context, request api.GetApiV1ReadingListsSharedTokenRequestObject) (api.GetApiV1ReadingListsSharedTokenResponseObject, error) {
+	list, err := h.readingListUseCase.GetSharedList(ctx, request.Token)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get reading list")
+		return nil, errorUsecase(err, "Failed to get reading list")
+	}
+
+	return api.GetApiV1ReadingListsSharedToken200JSONResponse(*list), nil
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/sitemap"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+type SitemapHandler struct {
+	sitemapUseCase usecase.UseCaseSitemap
+	logger         *logrus.Logger
+	siteURL        string
+	apiURL         string
+	robots         sitemap.RobotsOptions
+}
+
+// NewSitemapHandler creates the sitemap and robots.txt handler. Sitemaps
+// list pages of the frontend at siteURL and are served from apiURL.
+func NewSitemapHandler(sitemapUseCase usecase.UseCaseSitemap, logger *logrus.Logger, siteURL, apiURL string, robots sitemap.RobotsOptions) *SitemapHandler {
+	robots.SitemapURL = apiURL + "/sitemap.xml"
+
+	return &SitemapHandler{
+		sitemapUseCase: sitemapUseCase,
+		logger:         logger,
+		siteURL:        siteURL,
+		apiURL:         apiURL,
+		robots:         robots,
+	}
+}
+
+func (h *SitemapHandler) GetSitemapIndex(w http.ResponseWriter, r *http.Request) {
+	chunks, err := h.sitemapUseCase.GetSitemapIndex(r.Context())
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get sitemap index")
+		respondUsecaseError(w, r, err, "Failed to get sitemap index")
+		return
+	}
+
+	locs := make([]string, 0, len(chunks))
+	for _, chunk := range chunks {
+		name := string(chunk.Kind)
+		if chunk.After != nil {
+			name += "-" + chunk.After.Encode()
+		}
+		locs = append(locs, fmt.Sprintf("%s/sitemaps/%s.xml", h.apiURL, name))
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	if err := sitemap.WriteIndex(w, locs); err != nil {
+		h.logger.WithError(err).Error("Failed to write sitemap index")
+	}
+}
+
+// GetSitemap writes a sitemap chunk. after is the encoded position the
+// chunk starts after, empty for the first one. The chunk is read in full
+// before anything is written, so no database connection waits on the
+// client.
+func (h *SitemapHandler) GetSitemap(w http.ResponseWriter, r *http.Request, kind string, after string) {
+	chunk := entity.SitemapChunk{Kind: entity.SitemapKind(kind)}
+	if after != "" {
+		cursor, err := entity.DecodeCursor(after)
+		if err != nil {
+			respondUsecaseError(w, r, usecase.ErrSitemapNotFound, "Failed to get sitemap")
+			return
+		}
+		chunk.After = cursor
+	}
+
+	entries, err := h.sitemapUseCase.GetSitemap(r.Context(), chunk)
+	if err != nil {
+		h.logger.WithError(err).WithField("kind", kind).Error("Failed to get sitemap")
+		respondUsecaseError(w, r, err, "Failed to get sitemap")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	urls, err := sitemap.NewURLSet(w)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to write sitemap")
+		return
+	}
+	for _, entry := range entries {
+		if err := urls.Add(h.location(chunk.Kind, entry.Key), entry.LastMod); err != nil {
+			h.logger.WithError(err).WithField("kind", kind).Error("Failed to write sitemap")
+			return
+		}
+	}
+	if err := urls.Close(); err != nil {
+		h.logger.WithError(err).Error("Failed to write sitemap")
+	}
+}
+
+func (h *SitemapHandler) GetRobots(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if err := sitemap.WriteRobots(w, h.robots); err != nil {
+		h.logger.WithError(err).Error("Failed to write robots.txt")
+	}
+}
+
+func (h *SitemapHandler) location(kind entity.SitemapKind, key string) string {
+	switch kind {
+	case entity.SitemapAuthors:
+		return h.siteURL + "/authors/" + url.PathEscape(key)
+	case entity.SitemapTags:
+		return h.siteURL + "/tags/" + url.PathEscape(key)
+	default:
+		return h.siteURL + "/posts/" + key
+	}
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+// streamRetry tells EventSource clients how long to wait before reconnecting.
+const streamRetry = 3 * time.Second
+
+type StreamHandler struct {
+	streamUseCase usecase.UseCaseStream
+	logger        *logrus.Logger
+	heartbeat     time.Duration
+}
+
+func NewStreamHandler(streamUseCase usecase.UseCaseStream, logger *logrus.Logger, heartbeat time.Duration) *StreamHandler {
+	return &StreamHandler{
+		streamUseCase: streamUseCase,
+		logger:        logger,
+		heartbeat:     heartbeat,
+	}
+}
+
+func (h *StreamHandler) GetApiV1Stream(ctx context.Context, request api.GetApiV1StreamRequestObject) (api.GetApiV1StreamResponseObject, error) {
+	params := request.Params
+	viewerId, _ := ctx.Value("user_id").(uuid.UUID)
+
+	var lastEventID int64
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		id, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil {
+			return nil, errorStatus(http.StatusBadRequest, "Invalid Last-Event-ID")
+		}
+		lastEventID = id
+	}
+
+	var topics []string
+	for _, topic := range strings.Split(params.Topics, ",") {
+		if topic = strings.TrimSpace(topic); topic != "" {
+			topics = append(topics, topic)
+		}
+	}
+
+	sub, missed, err := h.streamUseCase.Subscribe(ctx, viewerId, topics, lastEventID)
+	if err != nil {
+		// Anonymous viewers may be allowed once they sign in.
+		if errors.Is(err, usecase.ErrTopicForbidden) && viewerId == uuid.Nil {
+			return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+		}
+		h.logger.WithError(err).Error("Failed to open stream")
+		return nil, errorUsecase(err, "Failed to open stream")
+	}
+
+	return &streamResponse{
+		handler:     h,
+		ctx:         ctx,
+		sub:         sub,
+		missed:      missed,
+		lastEventID: lastEventID,
+	}, nil
+}
+
+// streamResponse writes the event stream of an open subscription until the
+// client goes away.
+type streamResponse struct {
+	handler     *StreamHandler
+	ctx         context.Context
+	sub         *events.Subscription
+	missed      []*entity.Event
+	lastEventID int64
+}
+
+func (s *streamResponse) VisitGetApiV1StreamResponse(w http.ResponseWriter) error {
+	defer s.sub.Close()
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetry.Milliseconds())
+
+	lastSent := s.lastEventID
+	for _, event := range s.missed {
+		if err := writeEvent(w, event); err != nil {
+			return nil
+		}
+		lastSent = event.Id
+	}
+
+	if err := rc.Flush(); err != nil {
+		s.handler.logger.WithError(err).Error("Streaming is not supported by the response writer")
+		return nil
+	}
+
+	heartbeat := time.NewTicker(s.handler.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case event, ok := <-s.sub.Events():
+			if !ok {
+				return nil
+			}
+			// Events already sent from the replay may also arrive live.
+			if event.Id <= lastSent {
+				continue
+			}
+			if err := writeEvent(w, event); err != nil {
+				return nil
+			}
+			lastSent = event.Id
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return nil
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, event *entity.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+	return err
+}
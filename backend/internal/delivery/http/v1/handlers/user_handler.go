@@ -1,7 +1,7 @@
 package handlers
 
 import (
-	"encoding/json"
+	"context"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -9,6 +9,7 @@ import (
 
 	"github.com/popeskul/awesome-blog/backend/gen/api"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/patch"
 	"github.com/popeskul/awesome-blog/backend/internal/usecase"
 	"github.com/popeskul/awesome-blog/backend/internal/validator"
 )
@@ -27,107 +28,148 @@ func NewUserHandler(userUseCase usecase.UseCaseUser, logger *logrus.Logger, vali
 	}
 }
 
-func (h *UsrHandler) GetApiV1Users(w http.ResponseWriter, r *http.Request, params api.GetApiV1UsersParams) {
+func (h *UsrHandler) GetApiV1Users(ctx context.Context, request api.GetApiV1UsersRequestObject) (api.GetApiV1UsersResponseObject, error) {
 	h.logger.Printf("GetApiV1Users: Starting to handle request")
 
-	ctx := r.Context()
+	if err := h.authorize(ctx, uuid.Nil); err != nil {
+		return nil, err
+	}
 
+	params := request.Params
 	pagination, err := entity.NewPaginationFromParams(entity.RemoteParams{
-		Page:   params.Page,
-		Limit:  params.Limit,
-		Offset: params.Offset,
-		Sort:   (*string)(params.Sort),
+		Page:         params.Page,
+		Limit:        params.Limit,
+		Offset:       params.Offset,
+		Sort:         (*string)(params.Sort),
+		Cursor:       params.Cursor,
+		IncludeTotal: params.IncludeTotal,
 	})
 	if err != nil {
-		respondError(w, http.StatusBadRequest, err.Error())
-		return
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
+	}
+
+	pagination.Fields, err = entity.ParseFields(params.Fields, entity.UserFields)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to parse fields")
+		return nil, errorStatus(http.StatusBadRequest, err.Error())
 	}
 
 	users, err := h.userUseCase.GetAllUsers(ctx, pagination)
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to get users")
-		respondError(w, http.StatusInternalServerError, "Failed to get users")
-		return
+		return nil, errorUsecase(err, "Failed to get users")
 	}
 
 	pagination.Total = users.Pagination.Total
 
-	respondJSON(w, http.StatusOK, users)
 	h.logger.Printf("GetApiV1Users: Finished handling request")
+	return page[entity.User]{users}, nil
 }
 
-func (h *UsrHandler) PostApiV1Users(w http.ResponseWriter, r *http.Request) {
-	var newUser entity.NewUser
-	if err := json.NewDecoder(r.Body).Decode(&newUser); err != nil {
-		h.logger.WithError(err).Error("Failed to decode request body")
-		respondError(w, http.StatusBadRequest, "Invalid request payload")
-		return
+func (h *UsrHandler) DeleteApiV1UsersUserId(ctx context.Context, request api.DeleteApiV1UsersUserIdRequestObject) (api.DeleteApiV1UsersUserIdResponseObject, error) {
+	userId := request.UserId
+	if err := h.authorize(ctx, userId); err != nil {
+		return nil, err
 	}
 
-	if err := h.validator.Struct(&newUser); err != nil {
-		h.logger.WithError(err).Error("Failed to validate request body")
-		respondError(w, http.StatusBadRequest, "Validation failed "+err.Error())
-		return
+	err := h.userUseCase.DeleteUserByID(ctx, userId)
+	if err != nil {
+		h.logger.WithError(err).WithField("userId", userId).Error("Failed to delete user")
+		return nil, errorUsecase(err, "Failed to delete user")
 	}
 
-	ctx := r.Context()
-	createdUser, err := h.userUseCase.CreateUser(ctx, &newUser)
+	return api.DeleteApiV1UsersUserId204Response{}, nil
+}
+
+func (h *UsrHandler) GetApiV1UsersUserId(ctx context.Context, request api.GetApiV1UsersUserIdRequestObject) (api.GetApiV1UsersUserIdResponseObject, error) {
+	userId := request.UserId
+	if err := h.authorize(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	foundUser, err := h.userUseCase.GetUserByID(ctx, userId)
 	if err != nil {
-		h.logger.WithError(err).Error("Failed to create user")
-		respondError(w, http.StatusInternalServerError, "Failed to create user")
-		return
+		h.logger.WithError(err).WithField("userId", userId).Error("Failed to get user")
+		return nil, errorUsecase(err, "Failed to get user")
 	}
 
-	respondJSON(w, http.StatusCreated, createdUser)
+	return api.GetApiV1UsersUserId200JSONResponse(*foundUser), nil
 }
 
-func (h *UsrHandler) DeleteApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID) {
-	ctx := r.Context()
+func (h *UsrHandler) PutApiV1UsersUserId(ctx context.Context, request api.PutApiV1UsersUserIdRequestObject) (api.PutApiV1UsersUserIdResponseObject, error) {
+	userId := request.UserId
+	if err := h.authorize(ctx, userId); err != nil {
+		return nil, err
+	}
 
-	err := h.userUseCase.DeleteUserByID(ctx, userId)
+	updateUser := request.Body
+	if err := h.validator.Struct(updateUser); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	updatedUser, err := h.userUseCase.UpdateUserByID(ctx, userId, updateUser)
 	if err != nil {
-		h.logger.WithError(err).WithField("userId", userId).Error("Failed to delete user")
-		respondError(w, http.StatusInternalServerError, "Failed to delete user")
-		return
+		h.logger.WithError(err).WithField("userId", userId).Error("Failed to update user")
+		return nil, errorUsecase(err, "Failed to update user")
 	}
 
-	w.WriteHeader(http.StatusNoContent)
+	return api.PutApiV1UsersUserId200JSONResponse(*updatedUser), nil
 }
 
-func (h *UsrHandler) GetApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID) {
-	ctx := r.Context()
+func (h *UsrHandler) PatchApiV1UsersUserId(ctx context.Context, request api.PatchApiV1UsersUserIdRequestObject) (api.PatchApiV1UsersUserIdResponseObject, error) {
+	userId := request.UserId
+	if err := h.authorize(ctx, userId); err != nil {
+		return nil, err
+	}
 
-	foundUser, err := h.userUseCase.GetUserByID(ctx, userId)
+	p, err := readPatch(request.ApplicationMergePatchPlusJSONBody, request.ApplicationJSONPatchPlusJSONBody)
 	if err != nil {
-		h.logger.WithError(err).WithField("userId", userId).Error("Failed to get user")
-		respondError(w, http.StatusNotFound, "User not found")
-		return
+		return nil, errorUsecase(err, "Failed to read patch")
 	}
 
-	respondJSON(w, http.StatusOK, foundUser)
-}
+	actorId, _ := ctx.Value("user_id").(uuid.UUID)
 
-func (h *UsrHandler) PutApiV1UsersUserId(w http.ResponseWriter, r *http.Request, userId uuid.UUID) {
-	var updateUser entity.UpdateUser
-	if err := json.NewDecoder(r.Body).Decode(&updateUser); err != nil {
-		h.logger.WithError(err).Error("Failed to decode request body")
-		respondError(w, http.StatusBadRequest, "Invalid request payload")
-		return
+	current, err := h.userUseCase.GetUserByID(ctx, userId)
+	if err != nil {
+		h.logger.WithError(err).WithField("userId", userId).Error("Failed to get user")
+		return nil, errorUsecase(err, "Failed to get user")
 	}
 
-	if err := h.validator.Struct(&updateUser); err != nil {
-		h.logger.WithError(err).Error("Failed to validate request body")
-		respondError(w, http.StatusBadRequest, "Validation failed "+err.Error())
-		return
+	var patched entity.User
+	changed, err := patch.Apply(p, current, &patched)
+	if err != nil {
+		h.logger.WithError(err).WithField("userId", userId).Error("Failed to apply patch")
+		return nil, errorUsecase(err, "Failed to apply patch")
 	}
 
-	ctx := r.Context()
-	updatedUser, err := h.userUseCase.UpdateUserByID(ctx, userId, &updateUser)
+	if err := h.validator.Struct(&patched); err != nil {
+		h.logger.WithError(err).Error("Failed to validate patched user")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	updatedUser, err := h.userUseCase.PatchUserByID(ctx, actorId, userId, &patched, changed)
 	if err != nil {
-		h.logger.WithError(err).WithField("userId", userId).Error("Failed to update user")
-		respondError(w, http.StatusInternalServerError, "Failed to update user")
-		return
+		h.logger.WithError(err).WithField("userId", userId).Error("Failed to patch user")
+		return nil, errorUsecase(err, "Failed to patch user")
+	}
+
+	return api.PatchApiV1UsersUserId200JSONResponse(*updatedUser), nil
+}
+
+// authorize returns the error to answer with when the caller may not manage
+// userId. uuid.Nil stands for every account and requires an admin.
+func (h *UsrHandler) authorize(ctx context.Context, userId uuid.UUID) error {
+	actorId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.userUseCase.CanManageUser(ctx, actorId, userId); err != nil {
+		h.logger.WithError(err).WithField("userId", userId).Warn("User management denied")
+		return errorUsecase(err, "Failed to check permissions")
 	}
 
-	respondJSON(w, http.StatusOK, updatedUser)
+	return nil
 }
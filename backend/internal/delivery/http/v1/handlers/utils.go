@@ -1,22 +1,76 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"net"
 	"net/http"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 )
 
-func respondJSON(w http.ResponseWriter, status int, payload interface{}) {
-	response, err := json.Marshal(payload)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(err.Error()))
-		return
+// respondError answers with a problem of the blank type, the detail
+// explaining what went wrong.
+func respondError(w http.ResponseWriter, r *http.Request, code int, detail string) {
+	problem.Error(w, r, code, detail)
+}
+
+// respondUsecaseError answers err the way RespondError answers the errors
+// of the API handlers, for the handlers outside the spec.
+func respondUsecaseError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	RespondError(w, r, errorUsecase(err, fallback))
+}
+
+type requestKey struct{}
+
+// WithRequest is a middleware of the strict server that keeps the request in
+// the context, for the handlers that need more of it than the spec describes.
+func WithRequest(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return f(context.WithValue(ctx, requestKey{}, r), w, r, request)
 	}
+}
+
+func httpRequest(ctx context.Context) *http.Request {
+	r, _ := ctx.Value(requestKey{}).(*http.Request)
+	return r
+}
+
+// page answers a page of a list. It is written through entity.Response so
+// that only the requested fields of the items are sent.
+type page[T any] struct {
+	*entity.Response[T]
+}
+
+func (p page[T]) visit(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	w.Write(response)
+	w.WriteHeader(http.StatusOK)
+	return json.NewEncoder(w).Encode(p.Response)
+}
+
+func (p page[T]) VisitGetApiV1PostsResponse(w http.ResponseWriter) error {
+	return p.visit(w)
 }
 
-func respondError(w http.ResponseWriter, code int, message string) {
-	respondJSON(w, code, map[string]string{"error": message})
+func (p page[T]) VisitGetApiV1AuthorsUsernamePostsResponse(w http.ResponseWriter) error {
+	return p.visit(w)
+}
+
+func (p page[T]) VisitGetApiV1PostsPostIdCommentsResponse(w http.ResponseWriter) error {
+	return p.visit(w)
+}
+
+func (p page[T]) VisitGetApiV1UsersResponse(w http.ResponseWriter) error {
+	return p.visit(w)
+}
+
+// clientIP returns the address of the remote end of the connection without the port.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
 }
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+type WebhookHandler struct {
+	webhookUseCase usecase.UseCaseWebhook
+	logger         *logrus.Logger
+	validator      validator.Validator
+}
+
+func NewWebhookHandler(webhookUseCase usecase.UseCaseWebhook, logger *logrus.Logger, validator validator.Validator) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+		logger:         logger,
+		validator:      validator,
+	}
+}
+
+func (h *WebhookHandler) GetApiV1AdminWebhooks(ctx context.Context, request api.GetApiV1AdminWebhooksRequestObject) (api.GetApiV1AdminWebhooksResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	webhooks, err := h.webhookUseCase.GetWebhooks(ctx, userId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get webhooks")
+		return nil, errorUsecase(err, "Failed to get webhooks")
+	}
+
+	response := make(api.GetApiV1AdminWebhooks200JSONResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		response = append(response, *webhook)
+	}
+
+	return response, nil
+}
+
+func (h *WebhookHandler) PostApiV1AdminWebhooks(ctx context.Context, request api.PostApiV1AdminWebhooksRequestObject) (api.PostApiV1AdminWebhooksResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	newWebhook := request.Body
+	if err := h.validator.Struct(newWebhook); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	webhook, err := h.webhookUseCase.CreateWebhook(ctx, userId, newWebhook)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to create webhook")
+		return nil, errorUsecase(err, "Failed to create webhook")
+	}
+
+	return api.PostApiV1AdminWebhooks201JSONResponse(*webhook), nil
+}
+
+func (h *WebhookHandler) GetApiV1AdminWebhooksWebhookId(ctx context.Context, request api.GetApiV1AdminWebhooksWebhookIdRequestObject) (api.GetApiV1AdminWebhooksWebhookIdResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	webhook, err := h.webhookUseCase.GetWebhook(ctx, userId, request.WebhookId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get webhook")
+		return nil, errorUsecase(err, "Failed to get webhook")
+	}
+
+	return api.GetApiV1AdminWebhooksWebhookId200JSONResponse(*webhook), nil
+}
+
+func (h *WebhookHandler) PutApiV1AdminWebhooksWebhookId(ctx context.Context, request api.PutApiV1AdminWebhooksWebhookIdRequestObject) (api.PutApiV1AdminWebhooksWebhookIdResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	update := request.Body
+	if err := h.validator.Struct(update); err != nil {
+		h.logger.WithError(err).Error("Failed to validate request body")
+		return nil, errorValidation(http.StatusBadRequest, err)
+	}
+
+	webhook, err := h.webhookUseCase.UpdateWebhook(ctx, userId, request.WebhookId, update)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to update webhook")
+		return nil, errorUsecase(err, "Failed to update webhook")
+	}
+
+	return api.PutApiV1AdminWebhooksWebhookId200JSONResponse(*webhook), nil
+}
+
+func (h *WebhookHandler) DeleteApiV1AdminWebhooksWebhookId(ctx context.Context, request api.DeleteApiV1AdminWebhooksWebhookIdRequestObject) (api.DeleteApiV1AdminWebhooksWebhookIdResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	if err := h.webhookUseCase.DeleteWebhook(ctx, userId, request.WebhookId); err != nil {
+		h.logger.WithError(err).Error("Failed to delete webhook")
+		return nil, errorUsecase(err, "Failed to delete webhook")
+	}
+
+	return api.DeleteApiV1AdminWebhooksWebhookId204Response{}, nil
+}
+
+func (h *WebhookHandler) PostApiV1AdminWebhooksWebhookIdTest(ctx context.Context, request api.PostApiV1AdminWebhooksWebhookIdTestRequestObject) (api.PostApiV1AdminWebhooksWebhookIdTestResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	delivery, err := h.webhookUseCase.TestWebhook(ctx, userId, request.WebhookId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to test webhook")
+		return nil, errorUsecase(err, "Failed to test webhook")
+	}
+
+	return api.PostApiV1AdminWebhooksWebhookIdTest200JSONResponse(*delivery), nil
+}
+
+func (h *WebhookHandler) GetApiV1AdminWebhooksWebhookIdDeliveries(ctx context.Context, request api.GetApiV1AdminWebhooksWebhookIdDeliveriesRequestObject) (api.GetApiV1AdminWebhooksWebhookIdDeliveriesResponseObject, error) {
+	params := request.Params
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	paginationFromParams, err := entity.NewPaginationFromParams(entity.RemoteParams{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	})
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get pagination from params")
+		return nil, errorStatus(http.StatusBadRequest, "Invalid request params")
+	}
+
+	var status entity.WebhookDeliveryStatus
+	if params.Status != nil {
+		status = entity.WebhookDeliveryStatus(*params.Status)
+	}
+
+	result, err := h.webhookUseCase.GetDeliveries(ctx, userId, request.WebhookId, status, paginationFromParams)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get webhook deliveries")
+		return nil, errorUsecase(err, "Failed to get webhook deliveries")
+	}
+
+	return api.GetApiV1AdminWebhooksWebhookIdDeliveries200JSONResponse(*result), nil
+}
+
+func (h *WebhookHandler) PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx context.Context, request api.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequestObject) (api.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponseObject, error) {
+	userId, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		h.logger.Error("Failed to get user_id from context")
+		return nil, errorStatus(http.StatusUnauthorized, "Unauthorized")
+	}
+
+	delivery, err := h.webhookUseCase.Redeliver(ctx, userId, request.WebhookId, request.DeliveryId)
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to redeliver webhook delivery")
+		return nil, errorUsecase(err, "Failed to redeliver webhook delivery")
+	}
+
+	return api.PostApiV1AdminWebhooksWebhookIdDeliveriesDeliveryIdRedeliver202JSONResponse(*delivery), nil
+}
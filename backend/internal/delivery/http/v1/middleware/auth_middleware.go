@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,38 +13,74 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 )
 
+var (
+	errSessionEnded = errors.New("session has ended")
+	errSessionCheck = errors.New("failed to check session")
+)
+
+// SessionChecker reports whether the session a token was issued for is still
+// active. Sessions end before their tokens expire on logout and when they
+// are evicted or swept.
+type SessionChecker interface {
+	Active(ctx context.Context, sessionID uuid.UUID) (bool, error)
+}
+
 // AuthMiddleware creates a middleware handler for authentication
-func AuthMiddleware(cfg *config.Config, logger *logrus.Logger) func(http.Handler) http.Handler {
+func AuthMiddleware(cfg *config.Config, logger *logrus.Logger, sessions SessionChecker) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			publicPaths := []string{
-				"/api/v1/posts",
-				"/api/v1/posts/*/comments",
-				"/api/v1/users",
+			tokenStr := extractTokenFromHeader(r)
+			if tokenStr == "" {
+				logger.Error("AuthMiddleware: No token provided")
+				problem.Error(w, r, http.StatusUnauthorized, "A bearer token is required")
+				return
 			}
 
-			for _, path := range publicPaths {
-				if r.Method == http.MethodGet && (r.URL.Path == path || (strings.HasSuffix(path, "*") && strings.HasPrefix(r.URL.Path, strings.TrimSuffix(path, "*")))) {
-					logger.WithField("path", r.URL.Path).Info("AuthMiddleware: Public path, skipping authentication")
-					next.ServeHTTP(w, r)
-					return
-				}
+			claims, err := verifyToken(r.Context(), tokenStr, cfg, sessions)
+			if errors.Is(err, errSessionCheck) {
+				logger.WithError(err).Error("AuthMiddleware: Failed to check session")
+				problem.Error(w, r, http.StatusServiceUnavailable, "The session could not be checked")
+				return
+			}
+			if err != nil {
+				logger.WithError(err).Error("AuthMiddleware: Invalid token")
+				problem.Error(w, r, http.StatusUnauthorized, "The bearer token is invalid or expired")
+				return
 			}
 
+			ctx := context.WithValue(r.Context(), "user_id", claims.UserID)
+			ctx = context.WithValue(ctx, "session_id", claims.SessionID)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// OptionalAuthMiddleware attaches the caller's identity to the context when a
+// valid token is present, but lets anonymous requests through.
+func OptionalAuthMiddleware(cfg *config.Config, logger *logrus.Logger, sessions SessionChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			tokenStr := extractTokenFromHeader(r)
 			if tokenStr == "" {
-				logger.Error("AuthMiddleware: No token provided")
-				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				next.ServeHTTP(w, r)
 				return
 			}
 
-			claims, err := parseToken(tokenStr, cfg)
+			claims, err := verifyToken(r.Context(), tokenStr, cfg, sessions)
+			if errors.Is(err, errSessionCheck) {
+				// Going on anonymously would answer 401 to a valid token.
+				logger.WithError(err).Error("OptionalAuthMiddleware: Failed to check session")
+				problem.Error(w, r, http.StatusServiceUnavailable, "The session could not be checked")
+				return
+			}
 			if err != nil {
-				logger.WithError(err).Error("AuthMiddleware: Invalid token")
-				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				logger.WithError(err).Warn("OptionalAuthMiddleware: Invalid token, continuing anonymously")
+				next.ServeHTTP(w, r)
 				return
 			}
 
@@ -55,10 +92,32 @@ func AuthMiddleware(cfg *config.Config, logger *logrus.Logger) func(http.Handler
 	}
 }
 
+// verifyToken parses a token and checks that its session is still active.
+func verifyToken(ctx context.Context, tokenStr string, cfg *config.Config, sessions SessionChecker) (*entity.Session, error) {
+	claims, err := parseToken(tokenStr, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.SessionID == uuid.Nil {
+		return nil, fmt.Errorf("invalid token: missing session_id")
+	}
+
+	active, err := sessions.Active(ctx, claims.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errSessionCheck, err)
+	}
+	if !active {
+		return nil, errSessionEnded
+	}
+
+	return claims, nil
+}
+
 func extractTokenFromHeader(r *http.Request) string {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
-		return ""
+		return extractTokenFromWebSocketProtocol(r)
 	}
 	parts := strings.Split(authHeader, " ")
 	if len(parts) != 2 {
@@ -67,6 +126,23 @@ func extractTokenFromHeader(r *http.Request) string {
 	return parts[1]
 }
 
+// extractTokenFromWebSocketProtocol reads the token browsers send as the
+// WebSocket subprotocol pair "bearer, <token>", since they cannot set headers
+// on WebSocket requests.
+func extractTokenFromWebSocketProtocol(r *http.Request) string {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return ""
+	}
+
+	protocols := strings.Split(r.Header.Get("Sec-WebSocket-Protocol"), ",")
+	for i := 0; i+1 < len(protocols); i++ {
+		if strings.TrimSpace(protocols[i]) == "bearer" {
+			return strings.TrimSpace(protocols[i+1])
+		}
+	}
+	return ""
+}
+
 func parseToken(tokenStr string, cfg *config.Config) (*entity.Session, error) {
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
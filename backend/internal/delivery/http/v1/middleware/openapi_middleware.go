@@ -0,0 +1,298 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/gen/api"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
+)
+
+var (
+	errTokenMissing = errors.New("bearer token is missing")
+	errTokenInvalid = errors.New("bearer token is invalid")
+)
+
+func init() {
+	// Merge patches are plain JSON; kin-openapi only knows JSON Patch.
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
+}
+
+// OpenAPIOption configures NewOpenAPIValidator.
+type OpenAPIOption func(*openAPIValidator)
+
+// ValidateResponses checks every response against the spec too and hands
+// the ones that don't match it to report. It is meant for tests: responses
+// are buffered and still sent as they are.
+func ValidateResponses(report func(r *http.Request, err error)) OpenAPIOption {
+	return func(v *openAPIValidator) {
+		v.report = report
+	}
+}
+
+type openAPIValidator struct {
+	router routers.Router
+	logger *logrus.Logger
+	report func(r *http.Request, err error)
+}
+
+// NewOpenAPIValidator creates a middleware that checks requests against the
+// API spec before they reach the handlers: parameters, bodies and the
+// security of each operation. It is meant for the routes generated from the
+// spec; the feeds and other routes the spec doesn't describe must not use
+// it.
+//
+// The security of an operation is checked against the identity
+// OptionalAuthMiddleware attached to the context, so that must run first.
+func NewOpenAPIValidator(logger *logrus.Logger, opts ...OpenAPIOption) (func(http.Handler) http.Handler, error) {
+	doc, err := api.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the API spec: %w", err)
+	}
+	// Requests are matched on their path alone, whatever host they came to.
+	doc.Servers = nil
+
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the API router: %w", err)
+	}
+
+	v := &openAPIValidator{router: router, logger: logger}
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v.middleware, nil
+}
+
+func (v *openAPIValidator) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := v.router.FindRoute(r)
+		if err != nil {
+			// The router found an operation the spec doesn't, e.g. because
+			// chi matches an encoded slash in a path parameter and the spec
+			// router doesn't. Letting it through would skip validation and
+			// security, so it is refused.
+			v.logger.WithError(err).WithField("path", r.URL.EscapedPath()).Info("OpenAPIValidator: Request matches no operation of the spec")
+			problem.Error(w, r, http.StatusNotFound, "No such resource")
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				MultiError:          true,
+				AuthenticationFunc:  authenticate,
+				SkipSettingDefaults: true,
+			},
+		}
+
+		if !v.checkContentType(w, r, route) {
+			return
+		}
+
+		if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+			v.logger.WithError(err).WithField("path", r.URL.Path).Info("OpenAPIValidator: Request does not match the spec")
+			problem.Write(w, requestProblem(r, err))
+			return
+		}
+
+		if v.report == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		if rec.hijacked {
+			return
+		}
+
+		err = openapi3filter.ValidateResponse(r.Context(), &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 rec.status,
+			Header:                 w.Header(),
+			Body:                   io.NopCloser(bytes.NewReader(rec.body.Bytes())),
+			Options: &openapi3filter.Options{
+				MultiError:            true,
+				IncludeResponseStatus: true,
+			},
+		})
+		if err != nil {
+			v.report(r, err)
+		}
+	})
+}
+
+// checkContentType answers 415 when the body is of a media type the
+// operation doesn't take. kin-openapi only reports it as one more invalid
+// request.
+func (v *openAPIValidator) checkContentType(w http.ResponseWriter, r *http.Request, route *routers.Route) bool {
+	body := route.Operation.RequestBody
+	if body == nil || body.Value == nil || r.ContentLength == 0 {
+		return true
+	}
+
+	content := body.Value.Content
+	if content.Get(r.Header.Get("Content-Type")) != nil {
+		return true
+	}
+
+	types := make([]string, 0, len(content))
+	for typ := range content {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+
+	if r.Method == http.MethodPatch {
+		w.Header().Set("Accept-Patch", strings.Join(types, ", "))
+	}
+	problem.Error(w, r, http.StatusUnsupportedMediaType, "The body must be one of: "+strings.Join(types, ", "))
+
+	return false
+}
+
+// authenticate checks the bearer scheme of the spec against the identity
+// OptionalAuthMiddleware found in the request.
+func authenticate(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+	if _, ok := ctx.Value("user_id").(uuid.UUID); ok {
+		return nil
+	}
+
+	if extractTokenFromHeader(input.RequestValidationInput.Request) == "" {
+		return errTokenMissing
+	}
+	return errTokenInvalid
+}
+
+// requestProblem turns the errors of ValidateRequest into a problem. A
+// missing or bad token wins over everything else, since the caller isn't
+// allowed to learn more about the operation.
+func requestProblem(r *http.Request, err error) *problem.Details {
+	var security *openapi3filter.SecurityRequirementsError
+	if errors.As(err, &security) {
+		if errors.Is(err, errTokenMissing) {
+			return problem.New(r, http.StatusUnauthorized, "A bearer token is required")
+		}
+		return problem.New(r, http.StatusUnauthorized, "The bearer token is invalid or expired")
+	}
+
+	p := problem.Typed(r, http.StatusBadRequest, problem.ValidationType, "The request is invalid")
+
+	var errs openapi3.MultiError
+	if !errors.As(err, &errs) {
+		errs = openapi3.MultiError{err}
+	}
+	for _, e := range errs {
+		p.Errors = append(p.Errors, fieldErrors(e)...)
+	}
+
+	if len(p.Errors) == 0 {
+		p.Detail = err.Error()
+	}
+
+	return p
+}
+
+// fieldErrors lists the rules a parameter or the body broke. Fields of the
+// body are named like the validator of the handlers does, e.g. tags[1].
+func fieldErrors(err error) []problem.FieldError {
+	var re *openapi3filter.RequestError
+	if !errors.As(err, &re) {
+		return nil
+	}
+
+	var field string
+	switch {
+	case re.Parameter != nil:
+		field = re.Parameter.Name
+	case re.RequestBody == nil:
+		return nil
+	}
+
+	var schemaErrs openapi3.MultiError
+	if !errors.As(re.Err, &schemaErrs) {
+		schemaErrs = openapi3.MultiError{re.Err}
+	}
+
+	var out []problem.FieldError
+	for _, e := range schemaErrs {
+		var se *openapi3.SchemaError
+		switch {
+		case errors.As(e, &se):
+			out = append(out, problem.FieldError{
+				Field:   joinField(field, se.JSONPointer()),
+				Rule:    se.SchemaField,
+				Message: se.Reason,
+			})
+		case errors.Is(e, openapi3filter.ErrInvalidRequired) && field != "":
+			out = append(out, problem.FieldError{Field: field, Rule: "required", Message: "is required"})
+		case re.Parameter != nil:
+			out = append(out, problem.FieldError{Field: field, Rule: "type", Message: e.Error()})
+		}
+	}
+
+	return out
+}
+
+func joinField(field string, pointer []string) string {
+	for _, part := range pointer {
+		if _, err := strconv.Atoi(part); err == nil {
+			field += "[" + part + "]"
+			continue
+		}
+		if field != "" {
+			field += "."
+		}
+		field += part
+	}
+	return field
+}
+
+// responseRecorder keeps a copy of the body written through it.
+type responseRecorder struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	hijacked bool
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	rec.body.Write(b)
+	return rec.ResponseWriter.Write(b)
+}
+
+// Hijack hands the connection over to another protocol, such as WebSocket.
+// What is sent on it from then on is not a response the spec describes.
+func (rec *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	rec.hijacked = true
+	return http.NewResponseController(rec.ResponseWriter).Hijack()
+}
+
+// Unwrap lets http.ResponseController reach the writer underneath.
+func (rec *responseRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
@@ -0,0 +1,158 @@
+// Package problem writes error responses as RFC 7807 problem details.
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	playground "github.com/go-playground/validator/v10"
+)
+
+const ContentType = "application/problem+json"
+
+// Problem types. Each is a URI reference relative to the API; problems that
+// say no more than their status use BlankType.
+const (
+	BlankType                = "about:blank"
+	ValidationType           = "/problems/validation-failed"
+	VersionMismatchType      = "/problems/version-mismatch"
+	PreconditionRequiredType = "/problems/precondition-required"
+	FieldNotWritableType     = "/problems/field-not-writable"
+	EditWindowExpiredType    = "/problems/edit-window-expired"
+	CommentsClosedType       = "/problems/comments-closed"
+	SpamDetectedType         = "/problems/spam-detected"
+	UserExistsType           = "/problems/user-exists"
+	JobNotRetryableType      = "/problems/job-not-retryable"
+	PatchConflictType        = "/problems/patch-conflict"
+)
+
+// titles are the short, fixed summaries of the problem types.
+var titles = map[string]string{
+	ValidationType:           "Validation failed",
+	VersionMismatchType:      "Version mismatch",
+	PreconditionRequiredType: "Precondition required",
+	FieldNotWritableType:     "Field not writable",
+	EditWindowExpiredType:    "Edit window expired",
+	CommentsClosedType:       "Comments closed",
+	SpamDetectedType:         "Spam detected",
+	UserExistsType:           "User exists",
+	JobNotRetryableType:      "Job not retryable",
+	PatchConflictType:        "Patch conflict",
+}
+
+// Details is an RFC 7807 problem.
+type Details struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+	// Instance is the path of the request that failed.
+	Instance string `json:"instance,omitempty"`
+	// Errors lists the invalid fields of a request that failed validation.
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError is one failed rule of a request that failed validation.
+type FieldError struct {
+	// Field is the path of the field in the JSON body, e.g. tags[1].
+	Field string `json:"field"`
+	// Rule is the validation rule the field broke, e.g. required.
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// New returns a problem of the blank type, titled after its status.
+func New(r *http.Request, status int, detail string) *Details {
+	return &Details{
+		Type:     BlankType,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: r.URL.Path,
+	}
+}
+
+// Typed returns a problem of one of the types above.
+func Typed(r *http.Request, status int, typ string, detail string) *Details {
+	p := New(r, status, detail)
+	if title, ok := titles[typ]; ok {
+		p.Type = typ
+		p.Title = title
+	}
+	return p
+}
+
+// Write sends a problem.
+func Write(w http.ResponseWriter, p *Details) {
+	body, err := json.Marshal(p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	w.Write(body)
+}
+
+// Error sends a problem of the blank type.
+func Error(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	Write(w, New(r, status, detail))
+}
+
+// Validation returns the problem of a request that failed validation, with
+// a field error for each rule it broke when err comes from the validator.
+func Validation(r *http.Request, status int, err error) *Details {
+	p := Typed(r, status, ValidationType, "The request is invalid")
+
+	var invalid playground.ValidationErrors
+	if !errors.As(err, &invalid) {
+		p.Detail = err.Error()
+		return p
+	}
+
+	for _, fe := range invalid {
+		p.Errors = append(p.Errors, FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Message: message(fe),
+		})
+	}
+
+	return p
+}
+
+// fieldPath drops the struct name the validator starts a namespace with.
+func fieldPath(fe playground.FieldError) string {
+	_, path, found := strings.Cut(fe.Namespace(), ".")
+	if !found {
+		return fe.Field()
+	}
+	return path
+}
+
+func message(fe playground.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be an email address"
+	case "url":
+		return "must be a URL"
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "min", "max", "len":
+		limit := map[string]string{"min": "at least", "max": "at most", "len": "exactly"}[fe.Tag()]
+		switch fe.Kind().String() {
+		case "string":
+			return fmt.Sprintf("must be %s %s characters long", limit, fe.Param())
+		case "slice", "array", "map":
+			return fmt.Sprintf("must have %s %s items", limit, fe.Param())
+		}
+		return fmt.Sprintf("must be %s %s", limit, fe.Param())
+	default:
+		return fmt.Sprintf("must satisfy %s", strings.TrimSpace(fe.Tag()+" "+fe.Param()))
+	}
+}
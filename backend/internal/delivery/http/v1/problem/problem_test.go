@@ -0,0 +1,111 @@
+package problem_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+type request struct {
+	Title  string   `json:"title" validate:"required,max=5"`
+	Email  string   `json:"email" validate:"email"`
+	Status string   `json:"status" validate:"oneof=draft published"`
+	Tags   []string `json:"tags" validate:"max=1,dive,min=2"`
+	Secret string   `json:"-" validate:"required"`
+}
+
+func TestValidation(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/posts", nil)
+
+	tests := []struct {
+		name       string
+		input      request
+		wantDetail string
+		wantErrors []problem.FieldError
+	}{
+		{
+			name:       "lists each field by its JSON name",
+			input:      request{Email: "nope", Status: "gone", Tags: []string{"a", "b"}},
+			wantDetail: "The request is invalid",
+			wantErrors: []problem.FieldError{
+				{Field: "title", Rule: "required", Message: "is required"},
+				{Field: "email", Rule: "email", Message: "must be an email address"},
+				{Field: "status", Rule: "oneof", Message: "must be one of: draft, published"},
+				{Field: "tags", Rule: "max", Message: "must have at most 1 items"},
+				{Field: "Secret", Rule: "required", Message: "is required"},
+			},
+		},
+		{
+			name:       "paths into slices",
+			input:      request{Title: "Too long", Email: "a@b.io", Status: "draft", Tags: []string{"a"}, Secret: "s"},
+			wantDetail: "The request is invalid",
+			wantErrors: []problem.FieldError{
+				{Field: "title", Rule: "max", Message: "must be at most 5 characters long"},
+				{Field: "tags[0]", Rule: "min", Message: "must be at least 2 characters long"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.New().Struct(&tt.input)
+			require.Error(t, err)
+
+			p := problem.Validation(r, http.StatusBadRequest, err)
+
+			assert.Equal(t, problem.ValidationType, p.Type)
+			assert.Equal(t, "Validation failed", p.Title)
+			assert.Equal(t, http.StatusBadRequest, p.Status)
+			assert.Equal(t, "/api/v1/posts", p.Instance)
+			assert.Equal(t, tt.wantDetail, p.Detail)
+			assert.Equal(t, tt.wantErrors, p.Errors)
+		})
+	}
+}
+
+func TestValidation_OtherError(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/posts", nil)
+
+	p := problem.Validation(r, http.StatusUnprocessableEntity, errors.New("title and content are required"))
+
+	assert.Equal(t, problem.ValidationType, p.Type)
+	assert.Equal(t, "title and content are required", p.Detail)
+	assert.Empty(t, p.Errors)
+}
+
+func TestWrite(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/posts/42", nil)
+	w := httptest.NewRecorder()
+
+	problem.Write(w, problem.Typed(r, http.StatusPreconditionFailed, problem.VersionMismatchType, "stale"))
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, map[string]any{
+		"type":     problem.VersionMismatchType,
+		"title":    "Version mismatch",
+		"status":   float64(http.StatusPreconditionFailed),
+		"detail":   "stale",
+		"instance": "/api/v1/posts/42",
+	}, body)
+}
+
+func TestTyped_UnknownTypeIsBlank(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	p := problem.Typed(r, http.StatusNotFound, "/problems/unknown", "gone")
+
+	assert.Equal(t, problem.BlankType, p.Type)
+	assert.Equal(t, "Not Found", p.Title)
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Bookmark struct {
+	UserId    uuid.UUID `json:"userId"`
+	PostId    uuid.UUID `json:"postId"`
+	Note      string    `json:"note"`
+	Post      *Post     `json:"post,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type NewBookmark struct {
+	UserId uuid.UUID `json:"-"`
+	PostId uuid.UUID `json:"-"`
+	Note   string    `json:"note" validate:"max=1000"`
+}
+
+type ReadingListVisibility string
+
+const (
+	ReadingListPrivate ReadingListVisibility = "private"
+	ReadingListShared  ReadingListVisibility = "shared"
+)
+
+func (v ReadingListVisibility) Valid() bool {
+	return v == ReadingListPrivate || v == ReadingListShared
+}
+
+// ReadingList is a named, ordered collection of posts. Shared lists can be
+// read by anyone holding the share token.
+type ReadingList struct {
+	Id         uuid.UUID             `json:"id"`
+	OwnerId    uuid.UUID             `json:"ownerId"`
+	Name       string                `json:"name"`
+	Visibility ReadingListVisibility `json:"visibility"`
+	ShareToken *string               `json:"shareToken,omitempty"`
+	Items      []*ReadingListItem    `json:"items,omitempty"`
+	CreatedAt  time.Time             `json:"createdAt"`
+	UpdatedAt  time.Time             `json:"updatedAt"`
+}
+
+type ReadingListItem struct {
+	PostId   uuid.UUID `json:"postId"`
+	Position int       `json:"position"`
+	Post     *Post     `json:"post,omitempty"`
+	AddedAt  time.Time `json:"addedAt"`
+}
+
+type NewReadingList struct {
+	OwnerId    uuid.UUID             `json:"-"`
+	Name       string                `json:"name" validate:"required,max=255"`
+	Visibility ReadingListVisibility `json:"visibility"`
+}
+
+type UpdateReadingList struct {
+	Id         uuid.UUID             `json:"-"`
+	OwnerId    uuid.UUID             `json:"-"`
+	Name       string                `json:"name" validate:"required,max=255"`
+	Visibility ReadingListVisibility `json:"visibility"`
+}
+
+type ReadingListOrder struct {
+	PostIds []uuid.UUID `json:"postIds" validate:"required"`
+}
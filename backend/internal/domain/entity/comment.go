@@ -1,16 +1,25 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Comment struct {
-	Id        uuid.UUID `json:"id"`
-	Content   string    `json:"content"`
-	AuthorId  uuid.UUID `json:"authorId"`
-	PostId    uuid.UUID `json:"postId"`
+	Id        uuid.UUID        `json:"id"`
+	Content   string           `json:"content"`
+	AuthorId  uuid.UUID        `json:"authorId"`
+	PostId    uuid.UUID        `json:"postId"`
+	ParentId  *uuid.UUID       `json:"parentId,omitempty"`
+	Status    CommentStatus    `json:"status"`
+	Reactions *ReactionSummary `json:"reactions,omitempty"`
+	Author    *AuthorSummary   `json:"author,omitempty"`
+	Edited    bool             `json:"edited"`
+	EditedAt  *time.Time       `json:"editedAt,omitempty"`
+	// Version goes up on every change, moderation included.
+	Version   int       `json:"version,omitempty"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -19,10 +28,35 @@ type NewComment struct {
 	AuthorId uuid.UUID `json:"authorId" validate:"required"`
 	Content  string    `json:"content" validate:"required,max=1000"`
 	PostId   uuid.UUID `json:"postId" validate:"required"`
+	// ParentId is set when the comment replies to another comment on the same post.
+	ParentId *uuid.UUID `json:"parentId,omitempty"`
+	// Status is decided by the usecase from the post's moderation mode.
+	Status CommentStatus `json:"-"`
+	// IP and UserAgent describe the client and are only used for spam checks.
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 type UpdateComment struct {
 	Id       uuid.UUID `json:"id" validate:"required"`
 	AuthorId uuid.UUID `json:"authorId" validate:"required"`
 	Content  string    `json:"content" validate:"required,max=1000"`
+	// Version is the version the writer expects to replace, or AnyVersion.
+	// A successful update sets it to the comment's new version.
+	Version int `json:"-"`
+	// Status, when set, replaces the status of the comment, e.g. to send an
+	// edit back to the moderation queue.
+	Status CommentStatus `json:"-"`
+}
+
+// ParseCommentSort reads a comment sort, which is by creation time only, and
+// tells whether it is newest first. Post sorts like popular don't apply.
+func ParseCommentSort(sort string) (desc bool, err error) {
+	switch sort {
+	case "", "created_at_desc":
+		return true, nil
+	case "created_at_asc":
+		return false, nil
+	}
+	return false, fmt.Errorf("%w: comments are sorted by created_at_asc or created_at_desc, not %s", ErrInvalidSort, sort)
 }
@@ -1,5 +1,7 @@
 package entity
 
+import "encoding/json"
+
 type List[T any] struct {
 	Data       []*T        `json:"data"`
 	Pagination *Pagination `json:"pagination"`
@@ -8,4 +10,28 @@ type List[T any] struct {
 type Response[T any] struct {
 	Data       []*T        `json:"data"`
 	Pagination *Pagination `json:"pagination"`
+	// NextCursor and PrevCursor fetch the pages after and before this one.
+	// They are empty at either end of the list.
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+	// Fields, when set, are the only attributes of each item that are sent.
+	Fields Fields `json:"-"`
+}
+
+func (r Response[T]) MarshalJSON() ([]byte, error) {
+	var data any = r.Data
+	if r.Fields != nil && r.Data != nil {
+		projected, err := project(r.Data, r.Fields)
+		if err != nil {
+			return nil, err
+		}
+		data = projected
+	}
+
+	return json.Marshal(struct {
+		Data       any         `json:"data"`
+		Pagination *Pagination `json:"pagination"`
+		NextCursor string      `json:"nextCursor,omitempty"`
+		PrevCursor string      `json:"prevCursor,omitempty"`
+	}{data, r.Pagination, r.NextCursor, r.PrevCursor})
 }
@@ -0,0 +1,150 @@
+package entity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor marks a position in a list ordered by (created_at, id), or by
+// (Keys..., id) for lists sorted by something else. Clients get it back as an
+// opaque string and pass it unchanged to fetch the next page.
+type Cursor struct {
+	CreatedAt time.Time
+	Id        uuid.UUID
+	// Sort is the order the cursor was made for and Keys the values of its
+	// sort fields at the position. Both are empty in the follow feed's
+	// cursors.
+	Sort string
+	Keys []string
+	// Backward cursors point at the page before the position rather than
+	// the one after it.
+	Backward bool
+}
+
+// cursorJSON is the encoding of cursors that carry more than a creation
+// time and an id.
+type cursorJSON struct {
+	CreatedAt time.Time `json:"t"`
+	Id        uuid.UUID `json:"i"`
+	Sort      string    `json:"s,omitempty"`
+	Keys      []string  `json:"k,omitempty"`
+	Backward  bool      `json:"b,omitempty"`
+}
+
+func (c Cursor) Encode() string {
+	if c.Sort == "" && len(c.Keys) == 0 && !c.Backward {
+		raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.Id.String()
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	raw, _ := json.Marshal(cursorJSON(c))
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if len(raw) > 0 && raw[0] == '{' {
+		var decoded cursorJSON
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			return nil, ErrInvalidCursor
+		}
+		cursor := Cursor(decoded)
+		return &cursor, nil
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if cursor.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Id, err = uuid.Parse(id); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// CursorPage is a page of a keyset-paginated list. NextCursor is empty on
+// the last page.
+type CursorPage[T any] struct {
+	Data       []*T   `json:"data"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// TrimPage turns the rows fetched for params.Lookahead() into the page
+// params asked for, in list order, and reports whether the list goes on
+// past the page in the direction it was fetched.
+func TrimPage[T any](rows []*T, params *Pagination) ([]*T, bool) {
+	more := len(rows) > params.Limit
+	if more {
+		rows = rows[:params.Limit]
+	}
+
+	// Backward pages are fetched nearest row first.
+	if params.Cursor != nil && params.Cursor.Backward {
+		slices.Reverse(rows)
+	}
+
+	return rows, more
+}
+
+// NewResponse wraps a page from TrimPage with the cursors of the pages
+// before and after it. cursorOf returns the position of an item; the sort
+// and direction are filled in here.
+func NewResponse[T any](page []*T, more bool, params *Pagination, cursorOf func(*T) Cursor) *Response[T] {
+	response := &Response[T]{
+		Data: page,
+		Pagination: &Pagination{
+			Page:   params.Page,
+			Limit:  params.Limit,
+			Offset: params.Offset,
+			Sort:   params.Sort,
+		},
+		Fields: params.Fields,
+	}
+	if len(page) == 0 {
+		return response
+	}
+
+	cursor := func(item *T, backward bool) string {
+		c := cursorOf(item)
+		c.Sort = params.Sort
+		c.Backward = backward
+		return c.Encode()
+	}
+
+	backward := params.Cursor != nil && params.Cursor.Backward
+	first, last := page[0], page[len(page)-1]
+	switch {
+	case backward:
+		response.NextCursor = cursor(last, false)
+		if more {
+			response.PrevCursor = cursor(first, true)
+		}
+	default:
+		if more {
+			response.NextCursor = cursor(last, false)
+		}
+		if params.Cursor != nil || params.Offset > 0 {
+			response.PrevCursor = cursor(first, true)
+		}
+	}
+
+	return response
+}
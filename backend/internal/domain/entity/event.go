@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PostsTopic carries newly published posts.
+const PostsTopic = "posts"
+
+const (
+	EventPostCreated         = "post.created"
+	EventPostUpdated         = "post.updated"
+	EventPostDeleted         = "post.deleted"
+	EventCommentCreated      = "comment.created"
+	EventCommentUpdated      = "comment.updated"
+	EventCommentDeleted      = "comment.deleted"
+	EventNotificationCreated = "notification.created"
+	// EventStreamReset tells a resuming client that it missed more than can
+	// be replayed, so it should reload whatever it shows. The stream goes on
+	// from the reset's id.
+	EventStreamReset = "stream.reset"
+)
+
+// StreamTopic carries events about the stream itself, which every stream
+// gets whatever it subscribed to.
+const StreamTopic = "stream"
+
+// Event is a change pushed to stream subscribers. Ids only grow, so a client
+// can resume from the id of the last event it received.
+type Event struct {
+	Id        int64           `json:"id"`
+	Topic     string          `json:"topic"`
+	Type      string          `json:"type"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// PostTopic carries updates to a single post.
+func PostTopic(postID uuid.UUID) string {
+	return "post:" + postID.String()
+}
+
+// PostCommentsTopic carries the published comments of a post.
+func PostCommentsTopic(postID uuid.UUID) string {
+	return "post:" + postID.String() + ":comments"
+}
+
+// UserNotificationsTopic carries the notifications of one user.
+func UserNotificationsTopic(userID uuid.UUID) string {
+	return "user:" + userID.String() + ":notifications"
+}
@@ -0,0 +1,16 @@
+package entity
+
+// FeedQuery selects the posts of a syndication feed. Author is a username;
+// empty fields don't filter.
+type FeedQuery struct {
+	Author string
+	Tag    string
+}
+
+// FeedEntry is a post with what a feed shows about its author.
+type FeedEntry struct {
+	Post
+	AuthorUsername string
+	// AuthorName is the display name, or the username when there is none.
+	AuthorName string
+}
@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidFields is returned for a fields parameter that names an unknown
+// attribute.
+var ErrInvalidFields = errors.New("invalid fields")
+
+const (
+	// FieldExcerpt is the only post attribute that is never sent unless a
+	// fieldset asks for it.
+	FieldExcerpt = "excerpt"
+
+	// ExcerptLength is the length of the excerpt of a post in a listing.
+	ExcerptLength = 200
+	// ExcerptSourceLength is how much of a post's content is read to make
+	// its excerpt, leaving room for the whitespace Excerpt collapses.
+	ExcerptSourceLength = 4 * ExcerptLength
+)
+
+// The attributes a sparse fieldset may ask for, by their JSON names.
+var (
+	PostFields = []string{
+		"id", "title", "content", FieldExcerpt, "authorId", "status", "tags", "reactions",
+		"bookmarked", "author", "commentCount", "version", "createdAt", "updatedAt",
+	}
+	CommentFields = []string{
+		"id", "content", "authorId", "postId", "parentId", "status", "reactions", "author",
+		"edited", "editedAt", "version", "createdAt", "updatedAt",
+	}
+	UserFields = []string{"id", "username", "email", "role", "created", "updated"}
+)
+
+// Fields is a sparse fieldset: the attributes of a resource a client asked
+// for. A nil Fields stands for all of them.
+type Fields []string
+
+// ParseFields parses a comma-separated fields parameter against the
+// attributes of a resource. A nil parameter gives a nil Fields.
+func ParseFields(raw *string, allowed []string) (Fields, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	fields := Fields{}
+	for _, name := range strings.Split(*raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || slices.Contains(fields, name) {
+			continue
+		}
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidFields, name)
+		}
+		fields = append(fields, name)
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: no fields given", ErrInvalidFields)
+	}
+
+	return fields, nil
+}
+
+// Has reports whether the attribute is wanted, which all of them are when
+// there is no fieldset.
+func (f Fields) Has(name string) bool {
+	return f == nil || slices.Contains(f, name)
+}
+
+// Lists reports whether the fieldset names the attribute. Attributes that
+// are only sent on request, like excerpt, are checked with Lists.
+func (f Fields) Lists(name string) bool {
+	return slices.Contains(f, name)
+}
+
+// project marshals each item and keeps only the attributes in f.
+func project[T any](items []*T, f Fields) ([]map[string]json.RawMessage, error) {
+	projected := make([]map[string]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		encoded, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+
+		var attributes map[string]json.RawMessage
+		if err := json.Unmarshal(encoded, &attributes); err != nil {
+			return nil, err
+		}
+
+		kept := make(map[string]json.RawMessage, len(f))
+		for _, name := range f {
+			if value, ok := attributes[name]; ok {
+				kept[name] = value
+			}
+		}
+		projected = append(projected, kept)
+	}
+
+	return projected, nil
+}
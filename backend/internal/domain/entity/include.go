@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidInclude is returned for an include parameter that names a
+// relation the resource doesn't have.
+var ErrInvalidInclude = errors.New("invalid include")
+
+const (
+	IncludeAuthor       = "author"
+	IncludeCommentCount = "comment_count"
+	IncludeTags         = "tags"
+)
+
+// PostInclude lists the related resources embedded in each post.
+type PostInclude struct {
+	Author       bool
+	CommentCount bool
+	Tags         bool
+}
+
+// DefaultPostInclude applies when a request has no include parameter. Tags
+// were embedded before include existed, so they still are by default.
+var DefaultPostInclude = PostInclude{Tags: true}
+
+// CommentInclude lists the related resources embedded in each comment.
+type CommentInclude struct {
+	Author bool
+}
+
+// ParsePostInclude parses a comma-separated include parameter for posts. A
+// nil parameter gives DefaultPostInclude; an empty one embeds nothing.
+func ParsePostInclude(raw *string) (PostInclude, error) {
+	if raw == nil {
+		return DefaultPostInclude, nil
+	}
+
+	names, err := parseInclude(*raw, IncludeAuthor, IncludeCommentCount, IncludeTags)
+	if err != nil {
+		return PostInclude{}, err
+	}
+
+	return PostInclude{
+		Author:       names[IncludeAuthor],
+		CommentCount: names[IncludeCommentCount],
+		Tags:         names[IncludeTags],
+	}, nil
+}
+
+// ParseCommentInclude parses a comma-separated include parameter for
+// comments.
+func ParseCommentInclude(raw *string) (CommentInclude, error) {
+	if raw == nil {
+		return CommentInclude{}, nil
+	}
+
+	names, err := parseInclude(*raw, IncludeAuthor)
+	if err != nil {
+		return CommentInclude{}, err
+	}
+
+	return CommentInclude{Author: names[IncludeAuthor]}, nil
+}
+
+func parseInclude(raw string, allowed ...string) (map[string]bool, error) {
+	names := make(map[string]bool, len(allowed))
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf("%w: unknown relation %q", ErrInvalidInclude, name)
+		}
+		names[name] = true
+	}
+
+	return names, nil
+}
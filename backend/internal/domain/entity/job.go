@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobStatus string
+
+const (
+	// JobQueued covers both new jobs and jobs waiting for a retry.
+	JobQueued    JobStatus = "queued"
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	// JobFailed means every attempt failed. Only a manual retry runs it
+	// again.
+	JobFailed JobStatus = "failed"
+)
+
+func (s JobStatus) Valid() bool {
+	return s == JobQueued || s == JobRunning || s == JobSucceeded || s == JobFailed
+}
+
+// Job is a unit of background work. Kind selects the handler, which gets the
+// payload.
+type Job struct {
+	Id          uuid.UUID       `json:"id"`
+	Queue       string          `json:"queue"`
+	Kind        string          `json:"kind"`
+	Payload     json.RawMessage `json:"payload"`
+	Status      JobStatus       `json:"status"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"maxAttempts"`
+	RunAt       time.Time       `json:"runAt"`
+	LastError   string          `json:"lastError,omitempty"`
+	// UniqueKey keeps a job from being queued twice, e.g. by two replicas
+	// running the same schedule.
+	UniqueKey  *string    `json:"-"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// LockedUntil is when the lease of a running job runs out. A worker
+	// only records the result of the lease it was given.
+	LockedUntil *time.Time `json:"-"`
+}
+
+// ErrJobLeaseLost is returned when the result of an attempt comes after its
+// lease ran out and the job was claimed again, or was changed meanwhile.
+var ErrJobLeaseLost = errors.New("job lease lost")
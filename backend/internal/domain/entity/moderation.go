@@ -0,0 +1,69 @@
+package entity
+
+import "github.com/google/uuid"
+
+type CommentStatus string
+
+const (
+	CommentStatusPending  CommentStatus = "pending"
+	CommentStatusApproved CommentStatus = "approved"
+	CommentStatusRejected CommentStatus = "rejected"
+	CommentStatusSpam     CommentStatus = "spam"
+)
+
+func (s CommentStatus) IsValid() bool {
+	switch s {
+	case CommentStatusPending, CommentStatusApproved, CommentStatusRejected, CommentStatusSpam:
+		return true
+	}
+	return false
+}
+
+// ModerationMode controls how new comments on a post are handled.
+type ModerationMode string
+
+const (
+	// ModerationInherit means the post has no override and the global mode applies.
+	ModerationInherit   ModerationMode = ""
+	ModerationOpen      ModerationMode = "open"
+	ModerationModerated ModerationMode = "moderated"
+	ModerationClosed    ModerationMode = "closed"
+)
+
+func (m ModerationMode) IsValid() bool {
+	switch m {
+	case ModerationInherit, ModerationOpen, ModerationModerated, ModerationClosed:
+		return true
+	}
+	return false
+}
+
+type ModerationAction string
+
+const (
+	ModerationActionApprove ModerationAction = "approve"
+	ModerationActionReject  ModerationAction = "reject"
+	ModerationActionSpam    ModerationAction = "spam"
+)
+
+// Status returns the comment status a moderation action results in.
+func (a ModerationAction) Status() (CommentStatus, bool) {
+	switch a {
+	case ModerationActionApprove:
+		return CommentStatusApproved, true
+	case ModerationActionReject:
+		return CommentStatusRejected, true
+	case ModerationActionSpam:
+		return CommentStatusSpam, true
+	}
+	return "", false
+}
+
+type ModerationDecision struct {
+	CommentIds []uuid.UUID      `json:"commentIds" validate:"required,min=1,max=100"`
+	Action     ModerationAction `json:"action" validate:"required,oneof=approve reject spam"`
+}
+
+type PostModeration struct {
+	Mode ModerationMode `json:"mode" validate:"omitempty,oneof=open moderated closed"`
+}
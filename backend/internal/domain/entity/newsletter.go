@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SubscriberStatus string
+
+const (
+	// SubscriberPending subscribers have not clicked the confirmation link
+	// yet and get no digest.
+	SubscriberPending      SubscriberStatus = "pending"
+	SubscriberConfirmed    SubscriberStatus = "confirmed"
+	SubscriberUnsubscribed SubscriberStatus = "unsubscribed"
+)
+
+// Subscriber is an email address signed up for the newsletter. It doesn't
+// need an account.
+type Subscriber struct {
+	Id           uuid.UUID        `json:"id"`
+	Email        string           `json:"email"`
+	Status       SubscriberStatus `json:"status"`
+	ConfirmedAt  *time.Time       `json:"confirmedAt,omitempty"`
+	LastDigestAt *time.Time       `json:"-"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	UpdatedAt    time.Time        `json:"updatedAt"`
+}
+
+// NewSubscription signs an email address up for new posts of one author, of
+// one tag, or, with neither, of the whole blog.
+type NewSubscription struct {
+	Email    string     `json:"email" validate:"required,email,max=255"`
+	AuthorId *uuid.UUID `json:"authorId,omitempty"`
+	Tag      *string    `json:"tag,omitempty" validate:"omitempty,min=1,max=50"`
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	NotificationTypeComment    NotificationType = "comment"
+	NotificationTypeReply      NotificationType = "reply"
+	NotificationTypeFollow     NotificationType = "follow"
+	NotificationTypeMention    NotificationType = "mention"
+	NotificationTypeModeration NotificationType = "moderation"
+)
+
+var NotificationTypes = []NotificationType{
+	NotificationTypeComment,
+	NotificationTypeReply,
+	NotificationTypeFollow,
+	NotificationTypeMention,
+	NotificationTypeModeration,
+}
+
+func (t NotificationType) Valid() bool {
+	for _, known := range NotificationTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+type Notification struct {
+	Id          uuid.UUID        `json:"id"`
+	RecipientId uuid.UUID        `json:"-"`
+	ActorId     *uuid.UUID       `json:"actorId,omitempty"`
+	Type        NotificationType `json:"type"`
+	PostId      *uuid.UUID       `json:"postId,omitempty"`
+	CommentId   *uuid.UUID       `json:"commentId,omitempty"`
+	// Detail carries type specific data, e.g. the outcome of a moderation decision.
+	Detail    string     `json:"detail,omitempty"`
+	Read      bool       `json:"read"`
+	ReadAt    *time.Time `json:"readAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// NotificationPreference controls whether events of one type are kept in
+// the notification center and whether they are emailed.
+type NotificationPreference struct {
+	Type  NotificationType `json:"type" validate:"required"`
+	InApp bool             `json:"inApp"`
+	Email bool             `json:"email"`
+}
+
+// DefaultNotificationPreference is used for types the user never configured.
+func DefaultNotificationPreference(t NotificationType) *NotificationPreference {
+	return &NotificationPreference{Type: t, InApp: true}
+}
+
+type UpdateNotificationPreferences struct {
+	Preferences []*NotificationPreference `json:"preferences" validate:"required,dive"`
+}
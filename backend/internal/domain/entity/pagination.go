@@ -15,6 +15,10 @@ type RemoteParams struct {
 	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
 	Sort   *string `form:"sort,omitempty" json:"sort,omitempty"`
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	// IncludeTotal asks for the total number of items, which costs a full
+	// count.
+	IncludeTotal *bool `form:"include_total,omitempty" json:"include_total,omitempty"`
 }
 
 type Pagination struct {
@@ -22,7 +26,22 @@ type Pagination struct {
 	Offset int    `json:"offset"`
 	Page   int    `json:"page"`
 	Sort   string `json:"sort"`
-	Total  int    `json:"total"`
+	// Total is only counted when WithTotal is set.
+	Total int `json:"total,omitempty"`
+	// Cursor, when set, is where the page starts; Offset is ignored.
+	Cursor    *Cursor `json:"-"`
+	WithTotal bool    `json:"-"`
+	// Fields is the sparse fieldset of the page's items; nil reads them
+	// whole.
+	Fields Fields `json:"-"`
+}
+
+// Lookahead returns a copy of p for one more item than the page holds,
+// which tells whether there is a next page. See TrimPage.
+func (p *Pagination) Lookahead() *Pagination {
+	lookahead := *p
+	lookahead.Limit++
+	return &lookahead
 }
 
 func NewPaginationFromParams(params RemoteParams) (*Pagination, error) {
@@ -44,19 +63,39 @@ func NewPaginationFromParams(params RemoteParams) (*Pagination, error) {
 	}
 
 	if params.Sort != nil && *params.Sort != "" {
-		switch *params.Sort {
-		case "created_at_asc", "created_at_desc", "title_asc", "title_desc":
-			sort = *params.Sort
-		default:
-			return nil, fmt.Errorf("invalid sort parameter: %s", *params.Sort)
+		sort = *params.Sort
+	}
+
+	var cursor *Cursor
+	if params.Cursor != nil && *params.Cursor != "" {
+		decoded, err := DecodeCursor(*params.Cursor)
+		if err != nil {
+			return nil, err
 		}
+		// Cursors without a sort come from the newest-first follow feed.
+		if decoded.Sort == "" {
+			decoded.Sort = "created_at_desc"
+		}
+		// A cursor only makes sense in the order it was made for.
+		if params.Sort != nil && *params.Sort != "" && *params.Sort != decoded.Sort {
+			return nil, ErrInvalidCursor
+		}
+		sort = decoded.Sort
+		cursor = decoded
+		offset = 0
+	}
+
+	if _, err := ParsePostSort(sort); err != nil {
+		return nil, fmt.Errorf("invalid sort parameter: %s", sort)
 	}
 
 	pagination := &Pagination{
-		Page:   page,
-		Limit:  limit,
-		Offset: offset,
-		Sort:   sort,
+		Page:      page,
+		Limit:     limit,
+		Offset:    offset,
+		Sort:      sort,
+		Cursor:    cursor,
+		WithTotal: params.IncludeTotal != nil && *params.IncludeTotal,
 	}
 
 	fmt.Println("Pagination", pagination)
@@ -1,24 +1,55 @@
 package entity
 
 import (
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Post struct {
-	Id        uuid.UUID `json:"id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	AuthorId  uuid.UUID `json:"authorId"`
+	Id           uuid.UUID        `json:"id"`
+	Title        string           `json:"title"`
+	Content      string           `json:"content"`
+	Excerpt      string           `json:"excerpt,omitempty"`
+	AuthorId     uuid.UUID        `json:"authorId"`
+	Status       PostStatus       `json:"status,omitempty" validate:"omitempty,oneof=draft published archived"`
+	Tags         []string         `json:"tags,omitempty" validate:"omitempty,max=10,dive,required,max=50"`
+	Reactions    *ReactionSummary `json:"reactions,omitempty"`
+	Bookmarked   *bool            `json:"bookmarked,omitempty"`
+	Author       *AuthorSummary   `json:"author,omitempty"`
+	CommentCount *int             `json:"commentCount,omitempty"`
+	// Version goes up on every change. On an update it is the version the
+	// writer expects to replace and is taken from If-Match, not the body.
+	Version   int       `json:"version,omitempty"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// VisibleTo reports whether the viewer may see the post: drafts and
+// archived posts are only shown to their author.
+func (p *Post) VisibleTo(viewerID uuid.UUID) bool {
+	switch p.Status {
+	case PostStatusDraft, PostStatusArchived:
+		return p.AuthorId == viewerID
+	}
+	return true
+}
+
+// IsPublished reports whether the post is public. Only published posts are
+// announced to the stream, webhooks and feeds.
+func (p *Post) IsPublished() bool {
+	return p.Status == PostStatusPublished
+}
+
 type NewPost struct {
 	AuthorId uuid.UUID `json:"authorId" validate:"required"`
 	Content  string    `json:"content" validate:"required"`
 	Title    string    `json:"title" validate:"required"`
+	// Status defaults to published.
+	Status PostStatus `json:"status,omitempty" validate:"omitempty,oneof=draft published archived"`
+	Tags   []string   `json:"tags,omitempty" validate:"omitempty,max=10,dive,required,max=50"`
 }
 
 type UpdatePost struct {
@@ -27,3 +58,42 @@ type UpdatePost struct {
 	Id       uuid.UUID `json:"id" validate:"required"`
 	Title    string    `json:"title"`
 }
+
+// NormalizeTags lower-cases and trims tags, drops empty and duplicate ones
+// and sorts the rest, so that "Go" and " go" are the same tag.
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+
+	return normalized
+}
+
+// Excerpt returns the start of a post's content as plain running text of at
+// most limit characters, cut at a word boundary.
+func Excerpt(content string, limit int) string {
+	text := strings.Join(strings.Fields(content), " ")
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+
+	cut := string(runes[:limit])
+	if space := strings.LastIndex(cut, " "); space > 0 {
+		cut = cut[:space]
+	}
+
+	return strings.TrimRight(cut, ".,;:!?") + "…"
+}
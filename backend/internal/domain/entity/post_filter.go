@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PostStatus is where a post is in its life. Only published posts are
+// shown to anyone but their author.
+type PostStatus string
+
+const (
+	PostStatusDraft     PostStatus = "draft"
+	PostStatusPublished PostStatus = "published"
+	PostStatusArchived  PostStatus = "archived"
+)
+
+func (s PostStatus) IsValid() bool {
+	switch s {
+	case PostStatusDraft, PostStatusPublished, PostStatusArchived:
+		return true
+	}
+	return false
+}
+
+// PostFilter narrows a post listing. Zero fields don't filter.
+type PostFilter struct {
+	AuthorID *uuid.UUID
+	// Author is the username of the author.
+	Author        string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Status        PostStatus
+	Tag           string
+	// TitlePrefix matches the start of the title, ignoring case.
+	TitlePrefix string
+	// ViewerID also sees their own unpublished posts. uuid.Nil sees only
+	// published ones.
+	ViewerID uuid.UUID
+}
+
+// The fields post listings can be sorted on.
+const (
+	PostSortCreatedAt = "created_at"
+	PostSortUpdatedAt = "updated_at"
+	PostSortTitle     = "title"
+	PostSortReactions = "reactions"
+)
+
+// SortField is one level of a sort order.
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+var ErrInvalidSort = errors.New("invalid sort")
+
+// ParsePostSort reads a post sort: a comma-separated list of field_asc or
+// field_desc terms, most significant first, or "popular" for the most
+// reacted-to posts. Lists are always finally ordered by id, so equal keys
+// still page stably.
+func ParsePostSort(sort string) ([]SortField, error) {
+	switch sort {
+	case "":
+		return []SortField{{Field: PostSortCreatedAt, Desc: true}}, nil
+	case "popular":
+		// Newer posts win ties.
+		return []SortField{{Field: PostSortReactions, Desc: true}, {Field: PostSortCreatedAt, Desc: true}}, nil
+	}
+
+	terms := strings.Split(sort, ",")
+	fields := make([]SortField, 0, len(terms))
+	seen := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		i := strings.LastIndex(term, "_")
+		if i <= 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSort, term)
+		}
+
+		field, order := term[:i], term[i+1:]
+		switch field {
+		case PostSortCreatedAt, PostSortUpdatedAt, PostSortTitle, PostSortReactions:
+		default:
+			return nil, fmt.Errorf("%w: unknown field %s", ErrInvalidSort, field)
+		}
+		if order != "asc" && order != "desc" {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSort, term)
+		}
+		if seen[field] {
+			return nil, fmt.Errorf("%w: %s given twice", ErrInvalidSort, field)
+		}
+		seen[field] = true
+
+		fields = append(fields, SortField{Field: field, Desc: order == "desc"})
+	}
+
+	return fields, nil
+}
+
+// PostSortKeys returns the values of the sort fields for a post, as stored
+// in a cursor. The reaction count is read from post.Reactions.
+func PostSortKeys(post *Post, fields []SortField) []string {
+	keys := make([]string, len(fields))
+	for i, field := range fields {
+		switch field.Field {
+		case PostSortCreatedAt:
+			keys[i] = post.CreatedAt.UTC().Format(time.RFC3339Nano)
+		case PostSortUpdatedAt:
+			keys[i] = post.UpdatedAt.UTC().Format(time.RFC3339Nano)
+		case PostSortTitle:
+			keys[i] = post.Title
+		case PostSortReactions:
+			reactions := 0
+			if post.Reactions != nil {
+				for _, count := range post.Reactions.Counts {
+					reactions += count
+				}
+			}
+			keys[i] = strconv.Itoa(reactions)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PresenceState tells collaborators what someone is doing with a post.
+type PresenceState string
+
+const (
+	PresenceViewing PresenceState = "viewing"
+	PresenceEditing PresenceState = "editing"
+)
+
+func (s PresenceState) Valid() bool {
+	return s == PresenceViewing || s == PresenceEditing
+}
+
+const (
+	EventPresenceJoined  = "presence.joined"
+	EventPresenceUpdated = "presence.updated"
+	EventPresenceLeft    = "presence.left"
+)
+
+// Presence is one open connection to a post. A user with the post open in
+// two tabs has two.
+type Presence struct {
+	ConnectionId uuid.UUID     `json:"connectionId"`
+	PostId       uuid.UUID     `json:"postId"`
+	UserId       uuid.UUID     `json:"userId"`
+	Username     string        `json:"username"`
+	State        PresenceState `json:"state"`
+	// LastSeen is when this replica last heard from the connection. It is
+	// local bookkeeping for expiring connections whose replica went away.
+	LastSeen time.Time `json:"-"`
+}
+
+// PostPresenceTopic carries who joins, leaves or starts editing a post.
+func PostPresenceTopic(postID uuid.UUID) string {
+	return "post:" + postID.String() + ":presence"
+}
@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Profile holds the public, self-managed part of a user account.
+type Profile struct {
+	UserId      uuid.UUID         `json:"-"`
+	DisplayName string            `json:"displayName"`
+	Bio         string            `json:"bio"`
+	AvatarURL   string            `json:"avatarUrl"`
+	Website     string            `json:"website"`
+	SocialLinks map[string]string `json:"socialLinks"`
+}
+
+type UpdateProfile struct {
+	DisplayName string            `json:"displayName" validate:"max=100"`
+	Bio         string            `json:"bio" validate:"max=2000"`
+	AvatarURL   string            `json:"avatarUrl" validate:"omitempty,url,max=2048"`
+	Website     string            `json:"website" validate:"omitempty,url,max=2048"`
+	SocialLinks map[string]string `json:"socialLinks" validate:"max=10,dive,keys,min=1,max=32,endkeys,url,max=2048"`
+}
+
+// Author is the public view of a user. It never carries the email address
+// or the role.
+type Author struct {
+	Id       uuid.UUID `json:"id"`
+	Username string    `json:"username"`
+	Profile
+	PostCount      int       `json:"postCount"`
+	FollowerCount  int       `json:"followerCount"`
+	FollowingCount int       `json:"followingCount"`
+	JoinedAt       time.Time `json:"joinedAt"`
+}
+
+// AuthorSummary is the short public view of a user that is embedded in
+// posts and comments.
+type AuthorSummary struct {
+	Id          uuid.UUID `json:"id"`
+	Username    string    `json:"username"`
+	DisplayName string    `json:"displayName,omitempty"`
+	AvatarURL   string    `json:"avatarUrl,omitempty"`
+}
+
+func NewProfile(userID uuid.UUID) *Profile {
+	return &Profile{
+		UserId:      userID,
+		SocialLinks: map[string]string{},
+	}
+}
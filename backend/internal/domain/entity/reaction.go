@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReactionTarget string
+
+const (
+	ReactionTargetPost    ReactionTarget = "post"
+	ReactionTargetComment ReactionTarget = "comment"
+)
+
+type Reaction struct {
+	TargetType ReactionTarget `json:"targetType"`
+	TargetId   uuid.UUID      `json:"targetId"`
+	UserId     uuid.UUID      `json:"userId"`
+	Kind       string         `json:"kind"`
+	CreatedAt  time.Time      `json:"createdAt"`
+}
+
+// ReactionSummary is the reaction count per kind on a post or comment, plus
+// the kinds the current viewer has used.
+type ReactionSummary struct {
+	Counts map[string]int `json:"counts"`
+	Mine   []string       `json:"mine"`
+}
+
+func NewReactionSummary() *ReactionSummary {
+	return &ReactionSummary{
+		Counts: map[string]int{},
+		Mine:   []string{},
+	}
+}
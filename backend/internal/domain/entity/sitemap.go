@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// SitemapKind is one of the sitemaps listed in the sitemap index.
+type SitemapKind string
+
+const (
+	SitemapPosts   SitemapKind = "posts"
+	SitemapAuthors SitemapKind = "authors"
+	SitemapTags    SitemapKind = "tags"
+)
+
+var SitemapKinds = []SitemapKind{SitemapPosts, SitemapAuthors, SitemapTags}
+
+// SitemapEntry is a page in a sitemap. Key is the post id, the username or
+// the tag the page is about.
+type SitemapEntry struct {
+	Key     string
+	LastMod time.Time
+}
+
+// SitemapChunk is one file of a sitemap that is too large for a single one.
+// After is the position of the last entry of the chunk before it, nil for
+// the first chunk.
+type SitemapChunk struct {
+	Kind  SitemapKind
+	After *Cursor
+}
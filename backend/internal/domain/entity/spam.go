@@ -0,0 +1,17 @@
+package entity
+
+import "github.com/google/uuid"
+
+// SpamTokenCount is how many spam and ham training samples contained a token.
+type SpamTokenCount struct {
+	Token     string
+	SpamCount int
+	HamCount  int
+}
+
+// SpamSample is a moderator decision used to train the spam classifier.
+type SpamSample struct {
+	CommentId uuid.UUID
+	IsSpam    bool
+	Tokens    []string
+}
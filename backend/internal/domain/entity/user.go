@@ -6,25 +6,41 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
 type User struct {
 	Id           uuid.UUID `json:"id"`
-	Username     string    `json:"username"`
+	Username     string    `json:"username" validate:"required"`
 	PasswordHash string    `json:"-"`
-	Email        string    `json:"email"`
-	Role         string    `json:"role"`
+	Email        string    `json:"email" validate:"required,email"`
+	Role         string    `json:"role" validate:"oneof=user moderator admin"`
 	CreatedAt    time.Time `json:"created"`
 	UpdatedAt    time.Time `json:"updated"`
 }
 
+// CanModerate reports whether the user may act on the comment moderation queue.
+func (u *User) CanModerate() bool {
+	return u.Role == RoleModerator || u.Role == RoleAdmin
+}
+
 type UpdateUser struct {
 	Id       uuid.UUID `json:"id"`
 	Email    string    `json:"email,omitempty" validate:"omitempty,email"`
 	Password string    `json:"password,omitempty" validate:"omitempty,min=6"`
 	Username string    `json:"username,omitempty" validate:"omitempty"`
+	// Role is only changed by admins, through a PATCH of the user.
+	Role string `json:"-"`
 }
 
 type NewUser struct {
 	Email        string `json:"email" validate:"required,email"`
 	PasswordHash string `json:"password" validate:"required,min=6"`
 	Username     string `json:"username" validate:"required"`
+	// IP and UserAgent describe the client and are only used for spam checks.
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
 }
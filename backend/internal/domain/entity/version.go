@@ -0,0 +1,11 @@
+package entity
+
+import "errors"
+
+// ErrVersionConflict is returned when a post or comment is written with a
+// version it no longer has because someone else changed it first.
+var ErrVersionConflict = errors.New("version conflict")
+
+// AnyVersion, as the expected version of a write, skips the version check.
+// Versions start at 1 and go up by one on every change.
+const AnyVersion = 0
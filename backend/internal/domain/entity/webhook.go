@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEventType is what a webhook subscription listens for.
+type WebhookEventType string
+
+const (
+	WebhookPostPublished  WebhookEventType = "post.published"
+	WebhookPostUpdated    WebhookEventType = "post.updated"
+	WebhookPostDeleted    WebhookEventType = "post.deleted"
+	WebhookCommentCreated WebhookEventType = "comment.created"
+	WebhookCommentUpdated WebhookEventType = "comment.updated"
+	WebhookCommentDeleted WebhookEventType = "comment.deleted"
+	// WebhookTest is only sent by the test endpoint and cannot be subscribed to.
+	WebhookTest WebhookEventType = "webhook.test"
+)
+
+var WebhookEventTypes = []WebhookEventType{
+	WebhookPostPublished,
+	WebhookPostUpdated,
+	WebhookPostDeleted,
+	WebhookCommentCreated,
+	WebhookCommentUpdated,
+	WebhookCommentDeleted,
+}
+
+func (t WebhookEventType) Valid() bool {
+	for _, known := range WebhookEventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+type Webhook struct {
+	Id  uuid.UUID `json:"id"`
+	URL string    `json:"url"`
+	// Secret signs the payloads. It is never returned by the API.
+	Secret    string             `json:"-"`
+	Events    []WebhookEventType `json:"events"`
+	Active    bool               `json:"active"`
+	CreatedBy *uuid.UUID         `json:"createdBy,omitempty"`
+	CreatedAt time.Time          `json:"createdAt"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+type NewWebhook struct {
+	URL    string             `json:"url" validate:"required,url"`
+	Secret string             `json:"secret" validate:"required,min=16"`
+	Events []WebhookEventType `json:"events" validate:"required,min=1"`
+	Active *bool              `json:"active"`
+}
+
+// UpdateWebhook replaces the settings of a webhook. An empty secret keeps the
+// current one.
+type UpdateWebhook struct {
+	URL    string             `json:"url" validate:"required,url"`
+	Secret string             `json:"secret" validate:"omitempty,min=16"`
+	Events []WebhookEventType `json:"events" validate:"required,min=1"`
+	Active bool               `json:"active"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending covers both the first attempt and retries.
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryDead means every attempt failed. Only a manual
+	// redelivery sends it again.
+	WebhookDeliveryDead WebhookDeliveryStatus = "dead"
+)
+
+func (s WebhookDeliveryStatus) Valid() bool {
+	return s == WebhookDeliveryPending || s == WebhookDeliverySucceeded || s == WebhookDeliveryDead
+}
+
+// WebhookDelivery is one event queued for one webhook, together with the
+// outcome of its latest attempt.
+type WebhookDelivery struct {
+	Id             uuid.UUID             `json:"id"`
+	WebhookId      uuid.UUID             `json:"webhookId"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt"`
+	LastAttemptAt  *time.Time            `json:"lastAttemptAt,omitempty"`
+	ResponseStatus *int                  `json:"responseStatus,omitempty"`
+	LastError      string                `json:"lastError,omitempty"`
+	CreatedAt      time.Time             `json:"createdAt"`
+	// Webhook is set on deliveries loaded for sending.
+	Webhook *Webhook `json:"-"`
+}
+
+// ErrWebhookDeliveryGone is returned for a delivery that is no longer
+// pending, or was deleted along with its webhook.
+var ErrWebhookDeliveryGone = errors.New("webhook delivery gone")
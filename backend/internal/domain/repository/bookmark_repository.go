@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_bookmark_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository BookmarkRepository
+
+type BookmarkRepository interface {
+	AddBookmark(ctx context.Context, bookmark *entity.NewBookmark) (*entity.Bookmark, error)
+	DeleteBookmark(ctx context.Context, userID, postID uuid.UUID) error
+	GetBookmarks(ctx context.Context, userID uuid.UUID, params *entity.Pagination) ([]*entity.Bookmark, error)
+	GetTotalBookmarks(ctx context.Context, userID uuid.UUID) (int, error)
+	GetBookmarkedPostIds(ctx context.Context, userID uuid.UUID, postIDs []uuid.UUID) (map[uuid.UUID]bool, error)
+}
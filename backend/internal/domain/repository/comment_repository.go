@@ -12,8 +12,16 @@ import (
 type CommentRepository interface {
 	CreateComment(ctx context.Context, comment *entity.NewComment) (*entity.Comment, error)
 	GetCommentById(ctx context.Context, id uuid.UUID) (*entity.Comment, error)
-	GetComments(ctx context.Context, postID uuid.UUID, pagination *entity.Pagination) ([]*entity.Comment, error)
+	GetCommentsByIds(ctx context.Context, ids []uuid.UUID) ([]*entity.Comment, error)
+	GetComments(ctx context.Context, postID uuid.UUID, viewerID uuid.UUID, pagination *entity.Pagination) ([]*entity.Comment, error)
+	GetCommentsByStatus(ctx context.Context, status entity.CommentStatus, pagination *entity.Pagination) ([]*entity.Comment, error)
+	// UpdateComment and DeleteCommentById check the comment still has the
+	// version they are given, unless it is entity.AnyVersion, and fail with
+	// entity.ErrVersionConflict otherwise.
 	UpdateComment(ctx context.Context, comment *entity.UpdateComment) error
-	DeleteCommentById(ctx context.Context, id uuid.UUID) error
-	GetTotalCommentsByPostID(ctx context.Context, postID uuid.UUID) (int, error)
+	UpdateCommentsStatus(ctx context.Context, ids []uuid.UUID, status entity.CommentStatus, moderatorID uuid.UUID) (int64, error)
+	DeleteCommentById(ctx context.Context, id uuid.UUID, version int) error
+	GetTotalCommentsByPostID(ctx context.Context, postID uuid.UUID, viewerID uuid.UUID) (int, error)
+	GetTotalCommentsByStatus(ctx context.Context, status entity.CommentStatus) (int, error)
+	CountApprovedCommentsByAuthor(ctx context.Context, authorID uuid.UUID) (int, error)
 }
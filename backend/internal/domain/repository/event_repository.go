@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_event_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository EventRepository
+
+type EventRepository interface {
+	AppendEvent(ctx context.Context, event *entity.Event) error
+	SignalEvent(ctx context.Context, event *entity.Event) error
+	GetEventsAfter(ctx context.Context, afterID int64, topics []string, limit int) ([]*entity.Event, error)
+	GetLatestEventId(ctx context.Context) (int64, error)
+	GetOldestEventId(ctx context.Context) (int64, error)
+	DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error)
+}
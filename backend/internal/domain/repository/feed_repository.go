@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_feed_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository FeedRepository
+
+type FeedRepository interface {
+	// GetFeedEntries returns the newest posts with their authors and tags.
+	// A nil authorID or an empty tag doesn't filter.
+	GetFeedEntries(ctx context.Context, authorID *uuid.UUID, tag string, limit int) ([]*entity.FeedEntry, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_follow_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository FollowRepository
+
+type FollowRepository interface {
+	Follow(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error)
+	Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error
+	GetFollowCounts(ctx context.Context, userID uuid.UUID) (followers int, following int, err error)
+	GetFeed(ctx context.Context, followerID uuid.UUID, after *entity.Cursor, limit int) ([]*entity.Post, error)
+}
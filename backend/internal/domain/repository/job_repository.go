@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_job_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository JobRepository
+
+type JobRepository interface {
+	// EnqueueJob stores a new job. It returns false without an error when a
+	// job with the same unique key exists.
+	EnqueueJob(ctx context.Context, job *entity.Job) (bool, error)
+	// ClaimJobs marks up to limit due jobs of the given kinds on a queue as
+	// running and leases them, so that no other worker picks them up. Jobs
+	// whose lease ran out without a result are due again.
+	ClaimJobs(ctx context.Context, queue string, kinds []string, limit int, lease time.Duration) ([]*entity.Job, error)
+	// SaveJobResult stores the outcome of an attempt and ends the lease.
+	SaveJobResult(ctx context.Context, job *entity.Job) error
+	GetJob(ctx context.Context, id uuid.UUID) (*entity.Job, error)
+	GetJobs(ctx context.Context, status entity.JobStatus, queue string, pagination *entity.Pagination) ([]*entity.Job, error)
+	GetTotalJobs(ctx context.Context, status entity.JobStatus, queue string) (int, error)
+	// RetryJob queues a failed job again with a fresh set of attempts.
+	RetryJob(ctx context.Context, id uuid.UUID) (*entity.Job, error)
+	// DeleteFinishedJobs deletes succeeded jobs that finished before the
+	// given time.
+	DeleteFinishedJobs(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/domain/repository (interfaces: BookmarkRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_bookmark_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository BookmarkRepository
+//
+
+// Package mocksrepository is a generated GoMock package.
+package mocksrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBookmarkRepository is a mock of BookmarkRepository interface.
+type MockBookmarkRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookmarkRepositoryMockRecorder
+}
+
+// MockBookmarkRepositoryMockRecorder is the mock recorder for MockBookmarkRepository.
+type MockBookmarkRepositoryMockRecorder struct {
+	mock *MockBookmarkRepository
+}
+
+// NewMockBookmarkRepository creates a new mock instance.
+func NewMockBookmarkRepository(ctrl *gomock.Controller) *MockBookmarkRepository {
+	mock := &MockBookmarkRepository{ctrl: ctrl}
+	mock.recorder = &MockBookmarkRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookmarkRepository) EXPECT() *MockBookmarkRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddBookmark mocks base method.
+func (m *MockBookmarkRepository) AddBookmark(arg0 context.Context, arg1 *entity.NewBookmark) (*entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockBookmarkRepositoryMockRecorder) AddBookmark(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockBookmarkRepository)(nil).AddBookmark), arg0, arg1)
+}
+
+// DeleteBookmark mocks base method.
+func (m *MockBookmarkRepository) DeleteBookmark(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBookmark", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBookmark indicates an expected call of DeleteBookmark.
+func (mr *MockBookmarkRepositoryMockRecorder) DeleteBookmark(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBookmark", reflect.TypeOf((*MockBookmarkRepository)(nil).DeleteBookmark), arg0, arg1, arg2)
+}
+
+// GetBookmarkedPostIds mocks base method.
+func (m *MockBookmarkRepository) GetBookmarkedPostIds(arg0 context.Context, arg1 uuid.UUID, arg2 []uuid.UUID) (map[uuid.UUID]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmarkedPostIds", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[uuid.UUID]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmarkedPostIds indicates an expected call of GetBookmarkedPostIds.
+func (mr *MockBookmarkRepositoryMockRecorder) GetBookmarkedPostIds(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarkedPostIds", reflect.TypeOf((*MockBookmarkRepository)(nil).GetBookmarkedPostIds), arg0, arg1, arg2)
+}
+
+// GetBookmarks mocks base method.
+func (m *MockBookmarkRepository) GetBookmarks(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.Pagination) ([]*entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmarks", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmarks indicates an expected call of GetBookmarks.
+func (mr *MockBookmarkRepositoryMockRecorder) GetBookmarks(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarks", reflect.TypeOf((*MockBookmarkRepository)(nil).GetBookmarks), arg0, arg1, arg2)
+}
+
+// GetTotalBookmarks mocks base method.
+func (m *MockBookmarkRepository) GetTotalBookmarks(arg0 context.Context, arg1 uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalBookmarks", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalBookmarks indicates an expected call of GetTotalBookmarks.
+func (mr *MockBookmarkRepositoryMockRecorder) GetTotalBookmarks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalBookmarks", reflect.TypeOf((*MockBookmarkRepository)(nil).GetTotalBookmarks), arg0, arg1)
+}
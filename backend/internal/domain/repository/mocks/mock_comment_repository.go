@@ -41,6 +41,21 @@ func (m *MockCommentRepository) EXPECT() *MockCommentRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountApprovedCommentsByAuthor mocks base method.
+func (m *MockCommentRepository) CountApprovedCommentsByAuthor(arg0 context.Context, arg1 uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountApprovedCommentsByAuthor", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountApprovedCommentsByAuthor indicates an expected call of CountApprovedCommentsByAuthor.
+func (mr *MockCommentRepositoryMockRecorder) CountApprovedCommentsByAuthor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountApprovedCommentsByAuthor", reflect.TypeOf((*MockCommentRepository)(nil).CountApprovedCommentsByAuthor), arg0, arg1)
+}
+
 // CreateComment mocks base method.
 func (m *MockCommentRepository) CreateComment(arg0 context.Context, arg1 *entity.NewComment) (*entity.Comment, error) {
 	m.ctrl.T.Helper()
@@ -57,17 +72,17 @@ func (mr *MockCommentRepositoryMockRecorder) CreateComment(arg0, arg1 any) *gomo
 }
 
 // DeleteCommentById mocks base method.
-func (m *MockCommentRepository) DeleteCommentById(arg0 context.Context, arg1 uuid.UUID) error {
+func (m *MockCommentRepository) DeleteCommentById(arg0 context.Context, arg1 uuid.UUID, arg2 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCommentById", arg0, arg1)
+	ret := m.ctrl.Call(m, "DeleteCommentById", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteCommentById indicates an expected call of DeleteCommentById.
-func (mr *MockCommentRepositoryMockRecorder) DeleteCommentById(arg0, arg1 any) *gomock.Call {
+func (mr *MockCommentRepositoryMockRecorder) DeleteCommentById(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentById", reflect.TypeOf((*MockCommentRepository)(nil).DeleteCommentById), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentById", reflect.TypeOf((*MockCommentRepository)(nil).DeleteCommentById), arg0, arg1, arg2)
 }
 
 // GetCommentById mocks base method.
@@ -86,33 +101,78 @@ func (mr *MockCommentRepositoryMockRecorder) GetCommentById(arg0, arg1 any) *gom
 }
 
 // GetComments mocks base method.
-func (m *MockCommentRepository) GetComments(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.Pagination) (*entity.CommentList, error) {
+func (m *MockCommentRepository) GetComments(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 *entity.Pagination) ([]*entity.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetComments", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*entity.CommentList)
+	ret := m.ctrl.Call(m, "GetComments", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetComments indicates an expected call of GetComments.
-func (mr *MockCommentRepositoryMockRecorder) GetComments(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockCommentRepositoryMockRecorder) GetComments(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockCommentRepository)(nil).GetComments), arg0, arg1, arg2, arg3)
+}
+
+// GetCommentsByIds mocks base method.
+func (m *MockCommentRepository) GetCommentsByIds(arg0 context.Context, arg1 []uuid.UUID) ([]*entity.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsByIds", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentsByIds indicates an expected call of GetCommentsByIds.
+func (mr *MockCommentRepositoryMockRecorder) GetCommentsByIds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByIds", reflect.TypeOf((*MockCommentRepository)(nil).GetCommentsByIds), arg0, arg1)
+}
+
+// GetCommentsByStatus mocks base method.
+func (m *MockCommentRepository) GetCommentsByStatus(arg0 context.Context, arg1 entity.CommentStatus, arg2 *entity.Pagination) ([]*entity.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsByStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentsByStatus indicates an expected call of GetCommentsByStatus.
+func (mr *MockCommentRepositoryMockRecorder) GetCommentsByStatus(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockCommentRepository)(nil).GetComments), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByStatus", reflect.TypeOf((*MockCommentRepository)(nil).GetCommentsByStatus), arg0, arg1, arg2)
 }
 
 // GetTotalCommentsByPostID mocks base method.
-func (m *MockCommentRepository) GetTotalCommentsByPostID(arg0 context.Context, arg1 uuid.UUID) (int, error) {
+func (m *MockCommentRepository) GetTotalCommentsByPostID(arg0 context.Context, arg1, arg2 uuid.UUID) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalCommentsByPostID", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetTotalCommentsByPostID", arg0, arg1, arg2)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTotalCommentsByPostID indicates an expected call of GetTotalCommentsByPostID.
-func (mr *MockCommentRepositoryMockRecorder) GetTotalCommentsByPostID(arg0, arg1 any) *gomock.Call {
+func (mr *MockCommentRepositoryMockRecorder) GetTotalCommentsByPostID(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCommentsByPostID", reflect.TypeOf((*MockCommentRepository)(nil).GetTotalCommentsByPostID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCommentsByPostID", reflect.TypeOf((*MockCommentRepository)(nil).GetTotalCommentsByPostID), arg0, arg1, arg2)
+}
+
+// GetTotalCommentsByStatus mocks base method.
+func (m *MockCommentRepository) GetTotalCommentsByStatus(arg0 context.Context, arg1 entity.CommentStatus) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalCommentsByStatus", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalCommentsByStatus indicates an expected call of GetTotalCommentsByStatus.
+func (mr *MockCommentRepositoryMockRecorder) GetTotalCommentsByStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCommentsByStatus", reflect.TypeOf((*MockCommentRepository)(nil).GetTotalCommentsByStatus), arg0, arg1)
 }
 
 // UpdateComment mocks base method.
@@ -128,3 +188,18 @@ func (mr *MockCommentRepositoryMockRecorder) UpdateComment(arg0, arg1 any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommentRepository)(nil).UpdateComment), arg0, arg1)
 }
+
+// UpdateCommentsStatus mocks base method.
+func (m *MockCommentRepository) UpdateCommentsStatus(arg0 context.Context, arg1 []uuid.UUID, arg2 entity.CommentStatus, arg3 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCommentsStatus", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCommentsStatus indicates an expected call of UpdateCommentsStatus.
+func (mr *MockCommentRepositoryMockRecorder) UpdateCommentsStatus(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommentsStatus", reflect.TypeOf((*MockCommentRepository)(nil).UpdateCommentsStatus), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/domain/repository (interfaces: EventRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_event_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository EventRepository
+//
+
+// Package mocksrepository is a generated GoMock package.
+package mocksrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventRepository is a mock of EventRepository interface.
+type MockEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRepositoryMockRecorder
+}
+
+// MockEventRepositoryMockRecorder is the mock recorder for MockEventRepository.
+type MockEventRepositoryMockRecorder struct {
+	mock *MockEventRepository
+}
+
+// NewMockEventRepository creates a new mock instance.
+func NewMockEventRepository(ctrl *gomock.Controller) *MockEventRepository {
+	mock := &MockEventRepository{ctrl: ctrl}
+	mock.recorder = &MockEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendEvent mocks base method.
+func (m *MockEventRepository) AppendEvent(arg0 context.Context, arg1 *entity.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendEvent indicates an expected call of AppendEvent.
+func (mr *MockEventRepositoryMockRecorder) AppendEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendEvent", reflect.TypeOf((*MockEventRepository)(nil).AppendEvent), arg0, arg1)
+}
+
+// DeleteEventsBefore mocks base method.
+func (m *MockEventRepository) DeleteEventsBefore(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventsBefore", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventsBefore indicates an expected call of DeleteEventsBefore.
+func (mr *MockEventRepositoryMockRecorder) DeleteEventsBefore(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventsBefore", reflect.TypeOf((*MockEventRepository)(nil).DeleteEventsBefore), arg0, arg1)
+}
+
+// GetEventsAfter mocks base method.
+func (m *MockEventRepository) GetEventsAfter(arg0 context.Context, arg1 int64, arg2 []string, arg3 int) ([]*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventsAfter", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventsAfter indicates an expected call of GetEventsAfter.
+func (mr *MockEventRepositoryMockRecorder) GetEventsAfter(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventsAfter", reflect.TypeOf((*MockEventRepository)(nil).GetEventsAfter), arg0, arg1, arg2, arg3)
+}
+
+// GetLatestEventId mocks base method.
+func (m *MockEventRepository) GetLatestEventId(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestEventId", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestEventId indicates an expected call of GetLatestEventId.
+func (mr *MockEventRepositoryMockRecorder) GetLatestEventId(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEventId", reflect.TypeOf((*MockEventRepository)(nil).GetLatestEventId), arg0)
+}
+
+// GetOldestEventId mocks base method.
+func (m *MockEventRepository) GetOldestEventId(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldestEventId", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldestEventId indicates an expected call of GetOldestEventId.
+func (mr *MockEventRepositoryMockRecorder) GetOldestEventId(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldestEventId", reflect.TypeOf((*MockEventRepository)(nil).GetOldestEventId), arg0)
+}
+
+// SignalEvent mocks base method.
+func (m *MockEventRepository) SignalEvent(arg0 context.Context, arg1 *entity.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalEvent", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalEvent indicates an expected call of SignalEvent.
+func (mr *MockEventRepositoryMockRecorder) SignalEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalEvent", reflect.TypeOf((*MockEventRepository)(nil).SignalEvent), arg0, arg1)
+}
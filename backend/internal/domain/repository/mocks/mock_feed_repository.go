@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/domain/repository (interfaces: FeedRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_feed_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository FeedRepository
+//
+
+// Package mocksrepository is a generated GoMock package.
+package mocksrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeedRepository is a mock of FeedRepository interface.
+type MockFeedRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedRepositoryMockRecorder
+}
+
+// MockFeedRepositoryMockRecorder is the mock recorder for MockFeedRepository.
+type MockFeedRepositoryMockRecorder struct {
+	mock *MockFeedRepository
+}
+
+// NewMockFeedRepository creates a new mock instance.
+func NewMockFeedRepository(ctrl *gomock.Controller) *MockFeedRepository {
+	mock := &MockFeedRepository{ctrl: ctrl}
+	mock.recorder = &MockFeedRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedRepository) EXPECT() *MockFeedRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetFeedEntries mocks base method.
+func (m *MockFeedRepository) GetFeedEntries(arg0 context.Context, arg1 *uuid.UUID, arg2 string, arg3 int) ([]*entity.FeedEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedEntries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.FeedEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedEntries indicates an expected call of GetFeedEntries.
+func (mr *MockFeedRepositoryMockRecorder) GetFeedEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedEntries", reflect.TypeOf((*MockFeedRepository)(nil).GetFeedEntries), arg0, arg1, arg2, arg3)
+}
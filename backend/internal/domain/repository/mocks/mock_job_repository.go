@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/domain/repository (interfaces: JobRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_job_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository JobRepository
+//
+
+// Package mocksrepository is a generated GoMock package.
+package mocksrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJobRepository is a mock of JobRepository interface.
+type MockJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepositoryMockRecorder
+}
+
+// MockJobRepositoryMockRecorder is the mock recorder for MockJobRepository.
+type MockJobRepositoryMockRecorder struct {
+	mock *MockJobRepository
+}
+
+// NewMockJobRepository creates a new mock instance.
+func NewMockJobRepository(ctrl *gomock.Controller) *MockJobRepository {
+	mock := &MockJobRepository{ctrl: ctrl}
+	mock.recorder = &MockJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepository) EXPECT() *MockJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimJobs mocks base method.
+func (m *MockJobRepository) ClaimJobs(arg0 context.Context, arg1 string, arg2 []string, arg3 int, arg4 time.Duration) ([]*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimJobs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimJobs indicates an expected call of ClaimJobs.
+func (mr *MockJobRepositoryMockRecorder) ClaimJobs(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimJobs", reflect.TypeOf((*MockJobRepository)(nil).ClaimJobs), arg0, arg1, arg2, arg3, arg4)
+}
+
+// DeleteFinishedJobs mocks base method.
+func (m *MockJobRepository) DeleteFinishedJobs(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinishedJobs", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinishedJobs indicates an expected call of DeleteFinishedJobs.
+func (mr *MockJobRepositoryMockRecorder) DeleteFinishedJobs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedJobs", reflect.TypeOf((*MockJobRepository)(nil).DeleteFinishedJobs), arg0, arg1)
+}
+
+// EnqueueJob mocks base method.
+func (m *MockJobRepository) EnqueueJob(arg0 context.Context, arg1 *entity.Job) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueJob", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueJob indicates an expected call of EnqueueJob.
+func (mr *MockJobRepositoryMockRecorder) EnqueueJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueJob", reflect.TypeOf((*MockJobRepository)(nil).EnqueueJob), arg0, arg1)
+}
+
+// GetJob mocks base method.
+func (m *MockJobRepository) GetJob(arg0 context.Context, arg1 uuid.UUID) (*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockJobRepositoryMockRecorder) GetJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockJobRepository)(nil).GetJob), arg0, arg1)
+}
+
+// GetJobs mocks base method.
+func (m *MockJobRepository) GetJobs(arg0 context.Context, arg1 entity.JobStatus, arg2 string, arg3 *entity.Pagination) ([]*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobs indicates an expected call of GetJobs.
+func (mr *MockJobRepositoryMockRecorder) GetJobs(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockJobRepository)(nil).GetJobs), arg0, arg1, arg2, arg3)
+}
+
+// GetTotalJobs mocks base method.
+func (m *MockJobRepository) GetTotalJobs(arg0 context.Context, arg1 entity.JobStatus, arg2 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalJobs", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalJobs indicates an expected call of GetTotalJobs.
+func (mr *MockJobRepositoryMockRecorder) GetTotalJobs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalJobs", reflect.TypeOf((*MockJobRepository)(nil).GetTotalJobs), arg0, arg1, arg2)
+}
+
+// RetryJob mocks base method.
+func (m *MockJobRepository) RetryJob(arg0 context.Context, arg1 uuid.UUID) (*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryJob", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryJob indicates an expected call of RetryJob.
+func (mr *MockJobRepositoryMockRecorder) RetryJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryJob", reflect.TypeOf((*MockJobRepository)(nil).RetryJob), arg0, arg1)
+}
+
+// SaveJobResult mocks base method.
+func (m *MockJobRepository) SaveJobResult(arg0 context.Context, arg1 *entity.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveJobResult", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveJobResult indicates an expected call of SaveJobResult.
+func (mr *MockJobRepositoryMockRecorder) SaveJobResult(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJobResult", reflect.TypeOf((*MockJobRepository)(nil).SaveJobResult), arg0, arg1)
+}
@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/domain/repository (interfaces: NewsletterRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_newsletter_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository NewsletterRepository
+//
+
+// Package mocksrepository is a generated GoMock package.
+package mocksrepository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNewsletterRepository is a mock of NewsletterRepository interface.
+type MockNewsletterRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNewsletterRepositoryMockRecorder
+}
+
+// MockNewsletterRepositoryMockRecorder is the mock recorder for MockNewsletterRepository.
+type MockNewsletterRepositoryMockRecorder struct {
+	mock *MockNewsletterRepository
+}
+
+// NewMockNewsletterRepository creates a new mock instance.
+func NewMockNewsletterRepository(ctrl *gomock.Controller) *MockNewsletterRepository {
+	mock := &MockNewsletterRepository{ctrl: ctrl}
+	mock.recorder = &MockNewsletterRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNewsletterRepository) EXPECT() *MockNewsletterRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddSubscription mocks base method.
+func (m *MockNewsletterRepository) AddSubscription(arg0 context.Context, arg1 uuid.UUID, arg2 *uuid.UUID, arg3 *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSubscription", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSubscription indicates an expected call of AddSubscription.
+func (mr *MockNewsletterRepositoryMockRecorder) AddSubscription(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubscription", reflect.TypeOf((*MockNewsletterRepository)(nil).AddSubscription), arg0, arg1, arg2, arg3)
+}
+
+// ConfirmSubscriber mocks base method.
+func (m *MockNewsletterRepository) ConfirmSubscriber(arg0 context.Context, arg1 uuid.UUID) (*entity.Subscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmSubscriber", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Subscriber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmSubscriber indicates an expected call of ConfirmSubscriber.
+func (mr *MockNewsletterRepositoryMockRecorder) ConfirmSubscriber(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmSubscriber", reflect.TypeOf((*MockNewsletterRepository)(nil).ConfirmSubscriber), arg0, arg1)
+}
+
+// GetDigestPosts mocks base method.
+func (m *MockNewsletterRepository) GetDigestPosts(arg0 context.Context, arg1 uuid.UUID, arg2, arg3 time.Time, arg4 int) ([]*entity.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestPosts", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*entity.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestPosts indicates an expected call of GetDigestPosts.
+func (mr *MockNewsletterRepositoryMockRecorder) GetDigestPosts(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestPosts", reflect.TypeOf((*MockNewsletterRepository)(nil).GetDigestPosts), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetDigestSubscribers mocks base method.
+func (m *MockNewsletterRepository) GetDigestSubscribers(arg0 context.Context, arg1 uuid.UUID, arg2 int) ([]*entity.Subscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestSubscribers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.Subscriber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestSubscribers indicates an expected call of GetDigestSubscribers.
+func (mr *MockNewsletterRepositoryMockRecorder) GetDigestSubscribers(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestSubscribers", reflect.TypeOf((*MockNewsletterRepository)(nil).GetDigestSubscribers), arg0, arg1, arg2)
+}
+
+// GetSubscriber mocks base method.
+func (m *MockNewsletterRepository) GetSubscriber(arg0 context.Context, arg1 uuid.UUID) (*entity.Subscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriber", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Subscriber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriber indicates an expected call of GetSubscriber.
+func (mr *MockNewsletterRepositoryMockRecorder) GetSubscriber(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriber", reflect.TypeOf((*MockNewsletterRepository)(nil).GetSubscriber), arg0, arg1)
+}
+
+// MarkDigestSent mocks base method.
+func (m *MockNewsletterRepository) MarkDigestSent(arg0 context.Context, arg1 uuid.UUID, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDigestSent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDigestSent indicates an expected call of MarkDigestSent.
+func (mr *MockNewsletterRepositoryMockRecorder) MarkDigestSent(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDigestSent", reflect.TypeOf((*MockNewsletterRepository)(nil).MarkDigestSent), arg0, arg1, arg2)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockNewsletterRepository) Unsubscribe(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockNewsletterRepositoryMockRecorder) Unsubscribe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockNewsletterRepository)(nil).Unsubscribe), arg0, arg1)
+}
+
+// UpsertSubscriber mocks base method.
+func (m *MockNewsletterRepository) UpsertSubscriber(arg0 context.Context, arg1 string) (*entity.Subscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSubscriber", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Subscriber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSubscriber indicates an expected call of UpsertSubscriber.
+func (mr *MockNewsletterRepositoryMockRecorder) UpsertSubscriber(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSubscriber", reflect.TypeOf((*MockNewsletterRepository)(nil).UpsertSubscriber), arg0, arg1)
+}
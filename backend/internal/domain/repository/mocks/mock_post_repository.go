@@ -41,6 +41,21 @@ func (m *MockPostRepository) EXPECT() *MockPostRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountSitemapEntries mocks base method.
+func (m *MockPostRepository) CountSitemapEntries(arg0 context.Context, arg1 entity.SitemapKind) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSitemapEntries", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSitemapEntries indicates an expected call of CountSitemapEntries.
+func (mr *MockPostRepositoryMockRecorder) CountSitemapEntries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSitemapEntries", reflect.TypeOf((*MockPostRepository)(nil).CountSitemapEntries), arg0, arg1)
+}
+
 // CreatePost mocks base method.
 func (m *MockPostRepository) CreatePost(arg0 context.Context, arg1 *entity.NewPost) (*entity.Post, error) {
 	m.ctrl.T.Helper()
@@ -57,32 +72,62 @@ func (mr *MockPostRepositoryMockRecorder) CreatePost(arg0, arg1 any) *gomock.Cal
 }
 
 // Delete mocks base method.
-func (m *MockPostRepository) Delete(arg0 context.Context, arg1 uuid.UUID) error {
+func (m *MockPostRepository) Delete(arg0 context.Context, arg1 uuid.UUID, arg2 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockPostRepositoryMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+func (mr *MockPostRepositoryMockRecorder) Delete(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPostRepository)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPostRepository)(nil).Delete), arg0, arg1, arg2)
 }
 
 // GetAll mocks base method.
-func (m *MockPostRepository) GetAll(arg0 context.Context, arg1 *entity.Pagination) ([]*entity.Post, error) {
+func (m *MockPostRepository) GetAll(arg0 context.Context, arg1 *entity.PostFilter, arg2 *entity.Pagination) ([]*entity.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2)
 	ret0, _ := ret[0].([]*entity.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockPostRepositoryMockRecorder) GetAll(arg0, arg1 any) *gomock.Call {
+func (mr *MockPostRepositoryMockRecorder) GetAll(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPostRepository)(nil).GetAll), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPostRepository)(nil).GetAll), arg0, arg1, arg2)
+}
+
+// GetCommentCounts mocks base method.
+func (m *MockPostRepository) GetCommentCounts(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentCounts", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentCounts indicates an expected call of GetCommentCounts.
+func (mr *MockPostRepositoryMockRecorder) GetCommentCounts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentCounts", reflect.TypeOf((*MockPostRepository)(nil).GetCommentCounts), arg0, arg1)
+}
+
+// GetCommentModeration mocks base method.
+func (m *MockPostRepository) GetCommentModeration(arg0 context.Context, arg1 uuid.UUID) (entity.ModerationMode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentModeration", arg0, arg1)
+	ret0, _ := ret[0].(entity.ModerationMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentModeration indicates an expected call of GetCommentModeration.
+func (mr *MockPostRepositoryMockRecorder) GetCommentModeration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentModeration", reflect.TypeOf((*MockPostRepository)(nil).GetCommentModeration), arg0, arg1)
 }
 
 // GetPostById mocks base method.
@@ -100,19 +145,78 @@ func (mr *MockPostRepositoryMockRecorder) GetPostById(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostById", reflect.TypeOf((*MockPostRepository)(nil).GetPostById), arg0, arg1)
 }
 
+// GetSitemapBoundaries mocks base method.
+func (m *MockPostRepository) GetSitemapBoundaries(arg0 context.Context, arg1 entity.SitemapKind, arg2 int) ([]entity.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSitemapBoundaries", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]entity.Cursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSitemapBoundaries indicates an expected call of GetSitemapBoundaries.
+func (mr *MockPostRepositoryMockRecorder) GetSitemapBoundaries(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSitemapBoundaries", reflect.TypeOf((*MockPostRepository)(nil).GetSitemapBoundaries), arg0, arg1, arg2)
+}
+
+// GetSitemapEntries mocks base method.
+func (m *MockPostRepository) GetSitemapEntries(arg0 context.Context, arg1 entity.SitemapKind, arg2 *entity.Cursor, arg3 int) ([]*entity.SitemapEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSitemapEntries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*entity.SitemapEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSitemapEntries indicates an expected call of GetSitemapEntries.
+func (mr *MockPostRepositoryMockRecorder) GetSitemapEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSitemapEntries", reflect.TypeOf((*MockPostRepository)(nil).GetSitemapEntries), arg0, arg1, arg2, arg3)
+}
+
 // GetTotalPosts mocks base method.
-func (m *MockPostRepository) GetTotalPosts(arg0 context.Context) (int64, error) {
+func (m *MockPostRepository) GetTotalPosts(arg0 context.Context, arg1 *entity.PostFilter) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalPosts", arg0)
+	ret := m.ctrl.Call(m, "GetTotalPosts", arg0, arg1)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTotalPosts indicates an expected call of GetTotalPosts.
-func (mr *MockPostRepositoryMockRecorder) GetTotalPosts(arg0 any) *gomock.Call {
+func (mr *MockPostRepositoryMockRecorder) GetTotalPosts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPosts", reflect.TypeOf((*MockPostRepository)(nil).GetTotalPosts), arg0, arg1)
+}
+
+// GetTotalPostsByAuthor mocks base method.
+func (m *MockPostRepository) GetTotalPostsByAuthor(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPostsByAuthor", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPostsByAuthor indicates an expected call of GetTotalPostsByAuthor.
+func (mr *MockPostRepositoryMockRecorder) GetTotalPostsByAuthor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPostsByAuthor", reflect.TypeOf((*MockPostRepository)(nil).GetTotalPostsByAuthor), arg0, arg1)
+}
+
+// SetCommentModeration mocks base method.
+func (m *MockPostRepository) SetCommentModeration(arg0 context.Context, arg1 uuid.UUID, arg2 entity.ModerationMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCommentModeration", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCommentModeration indicates an expected call of SetCommentModeration.
+func (mr *MockPostRepositoryMockRecorder) SetCommentModeration(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPosts", reflect.TypeOf((*MockPostRepository)(nil).GetTotalPosts), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentModeration", reflect.TypeOf((*MockPostRepository)(nil).SetCommentModeration), arg0, arg1, arg2)
 }
 
 // Update mocks base method.
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/domain/repository (interfaces: ReactionRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_reaction_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository ReactionRepository
+//
+
+// Package mocksrepository is a generated GoMock package.
+package mocksrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReactionRepository is a mock of ReactionRepository interface.
+type MockReactionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionRepositoryMockRecorder
+}
+
+// MockReactionRepositoryMockRecorder is the mock recorder for MockReactionRepository.
+type MockReactionRepositoryMockRecorder struct {
+	mock *MockReactionRepository
+}
+
+// NewMockReactionRepository creates a new mock instance.
+func NewMockReactionRepository(ctrl *gomock.Controller) *MockReactionRepository {
+	mock := &MockReactionRepository{ctrl: ctrl}
+	mock.recorder = &MockReactionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionRepository) EXPECT() *MockReactionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReaction mocks base method.
+func (m *MockReactionRepository) AddReaction(arg0 context.Context, arg1 *entity.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockReactionRepositoryMockRecorder) AddReaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockReactionRepository)(nil).AddReaction), arg0, arg1)
+}
+
+// DeleteReaction mocks base method.
+func (m *MockReactionRepository) DeleteReaction(arg0 context.Context, arg1 *entity.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReaction indicates an expected call of DeleteReaction.
+func (mr *MockReactionRepositoryMockRecorder) DeleteReaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReaction", reflect.TypeOf((*MockReactionRepository)(nil).DeleteReaction), arg0, arg1)
+}
+
+// GetReactionSummaries mocks base method.
+func (m *MockReactionRepository) GetReactionSummaries(arg0 context.Context, arg1 entity.ReactionTarget, arg2 []uuid.UUID, arg3 uuid.UUID) (map[uuid.UUID]*entity.ReactionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactionSummaries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(map[uuid.UUID]*entity.ReactionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactionSummaries indicates an expected call of GetReactionSummaries.
+func (mr *MockReactionRepositoryMockRecorder) GetReactionSummaries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactionSummaries", reflect.TypeOf((*MockReactionRepository)(nil).GetReactionSummaries), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/domain/repository (interfaces: ReadingListRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_reading_list_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository ReadingListRepository
+//
+
+// Package mocksrepository is a generated GoMock package.
+package mocksrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReadingListRepository is a mock of ReadingListRepository interface.
+type MockReadingListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingListRepositoryMockRecorder
+}
+
+// MockReadingListRepositoryMockRecorder is the mock recorder for MockReadingListRepository.
+type MockReadingListRepositoryMockRecorder struct {
+	mock *MockReadingListRepository
+}
+
+// NewMockReadingListRepository creates a new mock instance.
+func NewMockReadingListRepository(ctrl *gomock.Controller) *MockReadingListRepository {
+	mock := &MockReadingListRepository{ctrl: ctrl}
+	mock.recorder = &MockReadingListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadingListRepository) EXPECT() *MockReadingListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockReadingListRepository) AddItem(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockReadingListRepositoryMockRecorder) AddItem(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockReadingListRepository)(nil).AddItem), arg0, arg1, arg2)
+}
+
+// CreateList mocks base method.
+func (m *MockReadingListRepository) CreateList(arg0 context.Context, arg1 *entity.ReadingList) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockReadingListRepositoryMockRecorder) CreateList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockReadingListRepository)(nil).CreateList), arg0, arg1)
+}
+
+// DeleteList mocks base method.
+func (m *MockReadingListRepository) DeleteList(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockReadingListRepositoryMockRecorder) DeleteList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockReadingListRepository)(nil).DeleteList), arg0, arg1)
+}
+
+// GetItems mocks base method.
+func (m *MockReadingListRepository) GetItems(arg0 context.Context, arg1, arg2 uuid.UUID) ([]*entity.ReadingListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.ReadingListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItems indicates an expected call of GetItems.
+func (mr *MockReadingListRepositoryMockRecorder) GetItems(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockReadingListRepository)(nil).GetItems), arg0, arg1, arg2)
+}
+
+// GetListById mocks base method.
+func (m *MockReadingListRepository) GetListById(arg0 context.Context, arg1 uuid.UUID) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListById", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListById indicates an expected call of GetListById.
+func (mr *MockReadingListRepositoryMockRecorder) GetListById(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListById", reflect.TypeOf((*MockReadingListRepository)(nil).GetListById), arg0, arg1)
+}
+
+// GetListByShareToken mocks base method.
+func (m *MockReadingListRepository) GetListByShareToken(arg0 context.Context, arg1 string) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListByShareToken", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListByShareToken indicates an expected call of GetListByShareToken.
+func (mr *MockReadingListRepositoryMockRecorder) GetListByShareToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListByShareToken", reflect.TypeOf((*MockReadingListRepository)(nil).GetListByShareToken), arg0, arg1)
+}
+
+// GetListsByOwner mocks base method.
+func (m *MockReadingListRepository) GetListsByOwner(arg0 context.Context, arg1 uuid.UUID) ([]*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListsByOwner", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListsByOwner indicates an expected call of GetListsByOwner.
+func (mr *MockReadingListRepositoryMockRecorder) GetListsByOwner(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListsByOwner", reflect.TypeOf((*MockReadingListRepository)(nil).GetListsByOwner), arg0, arg1)
+}
+
+// RemoveItem mocks base method.
+func (m *MockReadingListRepository) RemoveItem(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockReadingListRepositoryMockRecorder) RemoveItem(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockReadingListRepository)(nil).RemoveItem), arg0, arg1, arg2)
+}
+
+// ReorderItems mocks base method.
+func (m *MockReadingListRepository) ReorderItems(arg0 context.Context, arg1 uuid.UUID, arg2 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderItems", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderItems indicates an expected call of ReorderItems.
+func (mr *MockReadingListRepositoryMockRecorder) ReorderItems(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderItems", reflect.TypeOf((*MockReadingListRepository)(nil).ReorderItems), arg0, arg1, arg2)
+}
+
+// UpdateList mocks base method.
+func (m *MockReadingListRepository) UpdateList(arg0 context.Context, arg1 *entity.ReadingList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockReadingListRepositoryMockRecorder) UpdateList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockReadingListRepository)(nil).UpdateList), arg0, arg1)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/domain/repository (interfaces: SpamRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_spam_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository SpamRepository
+//
+
+// Package mocksrepository is a generated GoMock package.
+package mocksrepository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSpamRepository is a mock of SpamRepository interface.
+type MockSpamRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpamRepositoryMockRecorder
+}
+
+// MockSpamRepositoryMockRecorder is the mock recorder for MockSpamRepository.
+type MockSpamRepositoryMockRecorder struct {
+	mock *MockSpamRepository
+}
+
+// NewMockSpamRepository creates a new mock instance.
+func NewMockSpamRepository(ctrl *gomock.Controller) *MockSpamRepository {
+	mock := &MockSpamRepository{ctrl: ctrl}
+	mock.recorder = &MockSpamRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpamRepository) EXPECT() *MockSpamRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddSample mocks base method.
+func (m *MockSpamRepository) AddSample(arg0 context.Context, arg1 *entity.SpamSample) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSample", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSample indicates an expected call of AddSample.
+func (mr *MockSpamRepositoryMockRecorder) AddSample(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSample", reflect.TypeOf((*MockSpamRepository)(nil).AddSample), arg0, arg1)
+}
+
+// GetSampleTotals mocks base method.
+func (m *MockSpamRepository) GetSampleTotals(arg0 context.Context) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSampleTotals", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSampleTotals indicates an expected call of GetSampleTotals.
+func (mr *MockSpamRepositoryMockRecorder) GetSampleTotals(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSampleTotals", reflect.TypeOf((*MockSpamRepository)(nil).GetSampleTotals), arg0)
+}
+
+// GetTokenCounts mocks base method.
+func (m *MockSpamRepository) GetTokenCounts(arg0 context.Context, arg1 []string) (map[string]entity.SpamTokenCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenCounts", arg0, arg1)
+	ret0, _ := ret[0].(map[string]entity.SpamTokenCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenCounts indicates an expected call of GetTokenCounts.
+func (mr *MockSpamRepositoryMockRecorder) GetTokenCounts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenCounts", reflect.TypeOf((*MockSpamRepository)(nil).GetTokenCounts), arg0, arg1)
+}
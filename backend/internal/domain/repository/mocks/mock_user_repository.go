@@ -85,6 +85,21 @@ func (mr *MockUserRepositoryMockRecorder) GetAllUsers(arg0, arg1 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserRepository)(nil).GetAllUsers), arg0, arg1)
 }
 
+// GetAuthorsByIds mocks base method.
+func (m *MockUserRepository) GetAuthorsByIds(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID]*entity.AuthorSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorsByIds", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID]*entity.AuthorSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorsByIds indicates an expected call of GetAuthorsByIds.
+func (mr *MockUserRepositoryMockRecorder) GetAuthorsByIds(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorsByIds", reflect.TypeOf((*MockUserRepository)(nil).GetAuthorsByIds), arg0, arg1)
+}
+
 // GetTotalUsers mocks base method.
 func (m *MockUserRepository) GetTotalUsers(arg0 context.Context) (int, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_newsletter_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository NewsletterRepository
+
+type NewsletterRepository interface {
+	// UpsertSubscriber returns the subscriber of an email address, creating
+	// it if needed. Unsubscribed addresses go back to pending.
+	UpsertSubscriber(ctx context.Context, email string) (*entity.Subscriber, error)
+	// AddSubscription adds an unconfirmed subscription; adding one that
+	// exists is a no-op.
+	AddSubscription(ctx context.Context, subscriberID uuid.UUID, authorID *uuid.UUID, tag *string) error
+	GetSubscriber(ctx context.Context, id uuid.UUID) (*entity.Subscriber, error)
+	// ConfirmSubscriber confirms the subscriber and all its subscriptions.
+	ConfirmSubscriber(ctx context.Context, id uuid.UUID) (*entity.Subscriber, error)
+	// Unsubscribe marks the subscriber unsubscribed and drops its
+	// subscriptions.
+	Unsubscribe(ctx context.Context, id uuid.UUID) error
+	// GetDigestSubscribers returns up to limit confirmed subscribers with an
+	// id above after, ordered by id.
+	GetDigestSubscribers(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Subscriber, error)
+	// GetDigestPosts returns the posts created in (since, until] that match
+	// one of the subscriber's confirmed subscriptions, newest first.
+	GetDigestPosts(ctx context.Context, subscriberID uuid.UUID, since, until time.Time, limit int) ([]*entity.Post, error)
+	MarkDigestSent(ctx context.Context, subscriberID uuid.UUID, at time.Time) error
+}
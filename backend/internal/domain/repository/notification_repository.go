@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_notification_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository NotificationRepository
+
+type NotificationRepository interface {
+	CreateNotification(ctx context.Context, notification *entity.Notification) error
+	GetNotifications(ctx context.Context, recipientID uuid.UUID, unreadOnly bool, params *entity.Pagination) ([]*entity.Notification, error)
+	GetTotalNotifications(ctx context.Context, recipientID uuid.UUID, unreadOnly bool) (int, error)
+	MarkRead(ctx context.Context, recipientID, id uuid.UUID) error
+	MarkAllRead(ctx context.Context, recipientID uuid.UUID) (int64, error)
+	GetPreferences(ctx context.Context, userID uuid.UUID) ([]*entity.NotificationPreference, error)
+	UpsertPreferences(ctx context.Context, userID uuid.UUID, prefs []*entity.NotificationPreference) error
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_outbox_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository OutboxRepository
+
+type OutboxRepository interface {
+	AddEvent(ctx context.Context, event *entity.Event) error
+	// LockConsumer reserves a consumer for the rest of the transaction in ctx.
+	// It returns false when another relay holds it.
+	LockConsumer(ctx context.Context, consumer string) (bool, error)
+	// GetPendingEvents returns the events the consumer has not consumed yet,
+	// oldest first.
+	GetPendingEvents(ctx context.Context, consumer string, limit int) ([]*entity.Event, error)
+	MarkConsumed(ctx context.Context, consumer string, eventIDs []int64) error
+	// DeleteConsumedEvents deletes events created before the given time that
+	// every one of the consumers has consumed.
+	DeleteConsumedEvents(ctx context.Context, consumers []string, before time.Time) (int64, error)
+}
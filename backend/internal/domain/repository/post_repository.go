@@ -12,8 +12,28 @@ import (
 type PostRepository interface {
 	CreatePost(ctx context.Context, post *entity.NewPost) (*entity.Post, error)
 	GetPostById(ctx context.Context, id uuid.UUID) (*entity.Post, error)
-	GetAll(ctx context.Context, pagination *entity.Pagination) ([]*entity.Post, error)
+	// GetAll returns a page of the posts that match filter. Unpublished
+	// posts are only included for the filter's viewer, who wrote them.
+	GetAll(ctx context.Context, filter *entity.PostFilter, pagination *entity.Pagination) ([]*entity.Post, error)
+	// Update and Delete check the post still has the version they are given,
+	// unless it is entity.AnyVersion, and fail with entity.ErrVersionConflict
+	// otherwise. Update sets post.Version to the new version.
 	Update(ctx context.Context, post *entity.Post) error
-	Delete(ctx context.Context, id uuid.UUID) error
-	GetTotalPosts(ctx context.Context) (int64, error)
+	Delete(ctx context.Context, id uuid.UUID, version int) error
+	GetTotalPosts(ctx context.Context, filter *entity.PostFilter) (int64, error)
+	// GetTotalPostsByAuthor counts the author's published posts.
+	GetTotalPostsByAuthor(ctx context.Context, authorID uuid.UUID) (int64, error)
+	// GetCommentCounts returns the number of approved comments on each of
+	// the posts that has any.
+	GetCommentCounts(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	GetCommentModeration(ctx context.Context, postID uuid.UUID) (entity.ModerationMode, error)
+	SetCommentModeration(ctx context.Context, postID uuid.UUID, mode entity.ModerationMode) error
+	// CountSitemapEntries returns how many pages of the kind a sitemap lists.
+	CountSitemapEntries(ctx context.Context, kind entity.SitemapKind) (int, error)
+	// GetSitemapEntries returns up to limit pages of the kind in a stable
+	// order, starting after the given position, or at the first page for nil.
+	GetSitemapEntries(ctx context.Context, kind entity.SitemapKind, after *entity.Cursor, limit int) ([]*entity.SitemapEntry, error)
+	// GetSitemapBoundaries returns the position of every size-th page of the
+	// kind, where the chunks of a sitemap split.
+	GetSitemapBoundaries(ctx context.Context, kind entity.SitemapKind, size int) ([]entity.Cursor, error)
 }
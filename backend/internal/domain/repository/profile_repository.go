@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_profile_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository ProfileRepository
+
+type ProfileRepository interface {
+	GetProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error)
+	UpsertProfile(ctx context.Context, profile *entity.Profile) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_reaction_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository ReactionRepository
+
+type ReactionRepository interface {
+	AddReaction(ctx context.Context, reaction *entity.Reaction) error
+	DeleteReaction(ctx context.Context, reaction *entity.Reaction) error
+	GetReactionSummaries(ctx context.Context, target entity.ReactionTarget, targetIDs []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID]*entity.ReactionSummary, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_reading_list_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository ReadingListRepository
+
+type ReadingListRepository interface {
+	CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error)
+	GetListById(ctx context.Context, id uuid.UUID) (*entity.ReadingList, error)
+	GetListByShareToken(ctx context.Context, token string) (*entity.ReadingList, error)
+	GetListsByOwner(ctx context.Context, ownerID uuid.UUID) ([]*entity.ReadingList, error)
+	UpdateList(ctx context.Context, list *entity.ReadingList) error
+	DeleteList(ctx context.Context, id uuid.UUID) error
+	GetItems(ctx context.Context, listID, viewerID uuid.UUID) ([]*entity.ReadingListItem, error)
+	AddItem(ctx context.Context, listID, postID uuid.UUID) error
+	RemoveItem(ctx context.Context, listID, postID uuid.UUID) error
+	ReorderItems(ctx context.Context, listID uuid.UUID, postIDs []uuid.UUID) error
+}
@@ -7,9 +7,18 @@ import (
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 )
 
+//go:generate mockgen -destination=mocks/mock_session_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository SessionRepository
+
 type SessionRepository interface {
 	CreateSession(ctx context.Context, session *entity.Session) (*entity.Session, error)
 	GetSessionByID(ctx context.Context, sessionID uuid.UUID) (*entity.Session, error)
+	// IsSessionActive reports whether the session exists and hasn't expired.
+	IsSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error)
 	UpdateSession(ctx context.Context, session *entity.Session) error
 	DeleteSession(ctx context.Context, sessionID uuid.UUID) error
+	// EvictSessions deletes all but the newest keep sessions of a user.
+	EvictSessions(ctx context.Context, userID uuid.UUID, keep int) (int64, error)
+	// DeleteExpiredSessions deletes up to limit expired sessions.
+	DeleteExpiredSessions(ctx context.Context, limit int) (int64, error)
+	CountActiveSessions(ctx context.Context) (int, error)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_spam_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository SpamRepository
+
+type SpamRepository interface {
+	GetTokenCounts(ctx context.Context, tokens []string) (map[string]entity.SpamTokenCount, error)
+	GetSampleTotals(ctx context.Context) (spam int, ham int, err error)
+	AddSample(ctx context.Context, sample *entity.SpamSample) error
+}
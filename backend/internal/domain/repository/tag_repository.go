@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_tag_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository TagRepository
+
+type TagRepository interface {
+	// SetPostTags replaces the tags of a post.
+	SetPostTags(ctx context.Context, postID uuid.UUID, tags []string) error
+	// GetTagsByPostIds returns the sorted tags of each post that has any.
+	GetTagsByPostIds(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]string, error)
+}
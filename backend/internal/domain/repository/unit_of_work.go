@@ -0,0 +1,13 @@
+package repository
+
+import "context"
+
+//go:generate mockgen -destination=mocks/mock_unit_of_work.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository UnitOfWork
+
+// UnitOfWork groups repository calls into one transaction.
+type UnitOfWork interface {
+	// Do runs fn in a transaction. Repository calls made with the context fn
+	// receives are part of it; they are committed together when fn returns
+	// nil and rolled back otherwise.
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -13,6 +13,8 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *entity.NewUser) (*entity.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*entity.User, error)
 	GetUserById(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	// GetAuthorsByIds returns the public summaries of the users that exist.
+	GetAuthorsByIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*entity.AuthorSummary, error)
 	GetAllUsers(ctx context.Context, params *entity.Pagination) ([]*entity.User, error)
 	GetTotalUsers(ctx context.Context) (int, error)
 	DeleteUserById(ctx context.Context, id uuid.UUID) error
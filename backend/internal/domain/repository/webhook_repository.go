@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_webhook_repository.go -package=mocksrepository github.com/popeskul/awesome-blog/backend/internal/domain/repository WebhookRepository
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *entity.Webhook) error
+	GetWebhook(ctx context.Context, id uuid.UUID) (*entity.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]*entity.Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook *entity.Webhook) error
+	DeleteWebhook(ctx context.Context, id uuid.UUID) error
+
+	// CreateDeliveries records a pending delivery of the event for every
+	// active webhook subscribed to its type and returns them.
+	CreateDeliveries(ctx context.Context, eventType entity.WebhookEventType, payload json.RawMessage) ([]*entity.WebhookDelivery, error)
+	CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+	GetDelivery(ctx context.Context, webhookID, id uuid.UUID) (*entity.WebhookDelivery, error)
+	GetDeliveries(ctx context.Context, webhookID uuid.UUID, status entity.WebhookDeliveryStatus, params *entity.Pagination) ([]*entity.WebhookDelivery, error)
+	GetTotalDeliveries(ctx context.Context, webhookID uuid.UUID, status entity.WebhookDeliveryStatus) (int, error)
+	// GetPendingDelivery returns a pending delivery with its webhook set, or
+	// entity.ErrWebhookDeliveryGone.
+	GetPendingDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	// SaveAttempt stores the outcome of an attempt.
+	SaveAttempt(ctx context.Context, delivery *entity.WebhookDelivery) error
+	// Redeliver makes a delivery pending again from its first attempt.
+	Redeliver(ctx context.Context, webhookID, id uuid.UUID) (*entity.WebhookDelivery, error)
+}
@@ -0,0 +1,149 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_events.go -package=mocksevents -source=events.go
+
+// subscriberBuffer is how many events a subscriber may fall behind before it
+// is dropped. A dropped client reconnects and resumes with Last-Event-ID.
+const subscriberBuffer = 64
+
+// Publisher is what usecases use to announce changes.
+type Publisher interface {
+	Publish(ctx context.Context, topic, eventType string, payload any) error
+}
+
+// Broker delivers published events to the subscribers of their topics.
+type Broker interface {
+	Publisher
+	// Signal delivers an event to whoever is subscribed right now, on every
+	// replica, without keeping it: it has no id and is never replayed. It
+	// suits ephemeral state like presence, which is re-sent until it stops
+	// being true.
+	Signal(ctx context.Context, topic, eventType string, payload any) error
+	Subscribe(topics []string) *Subscription
+	// Replay returns the events on the given topics published after
+	// afterID, oldest first. When more was published than the broker can
+	// replay, it returns a single reset event instead.
+	Replay(ctx context.Context, afterID int64, topics []string) ([]*entity.Event, error)
+}
+
+// Subscription receives the events of a fixed set of topics. Its channel is
+// closed when the subscription ends, either through Close, because the
+// subscriber fell too far behind or because the broker shut down.
+type Subscription struct {
+	hub    *hub
+	topics map[string]bool
+	events chan *entity.Event
+}
+
+func (s *Subscription) Events() <-chan *entity.Event {
+	return s.events
+}
+
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+func newEvent(topic, eventType string, payload any) (*entity.Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event payload: %w", err)
+	}
+
+	return &entity.Event{Topic: topic, Type: eventType, Data: data}, nil
+}
+
+// resetEvent is what Replay returns when it can't return everything that was
+// missed. Its id is the latest event's, so that the client resumes from there
+// once it has reloaded.
+func resetEvent(latestID int64) *entity.Event {
+	return &entity.Event{
+		Id:        latestID,
+		Topic:     entity.StreamTopic,
+		Type:      entity.EventStreamReset,
+		Data:      json.RawMessage(`{}`),
+		CreatedAt: time.Now(),
+	}
+}
+
+// hub fans events out to the local subscribers. Brokers decide where events
+// come from; the hub only does the delivery.
+type hub struct {
+	mu     sync.Mutex
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+func newHub() *hub {
+	return &hub{subs: map[*Subscription]struct{}{}}
+}
+
+func (h *hub) subscribe(topics []string) *Subscription {
+	sub := &Subscription{
+		hub:    h,
+		topics: make(map[string]bool, len(topics)),
+		events: make(chan *entity.Event, subscriberBuffer),
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		close(sub.events)
+		return sub
+	}
+	h.subs[sub] = struct{}{}
+
+	return sub
+}
+
+func (h *hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.events)
+	}
+}
+
+func (h *hub) dispatch(event *entity.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		if !sub.topics[event.Topic] {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			delete(h.subs, sub)
+			close(sub.events)
+		}
+	}
+}
+
+func (h *hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subs {
+		delete(h.subs, sub)
+		close(sub.events)
+	}
+}
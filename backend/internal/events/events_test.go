@@ -0,0 +1,234 @@
+package events_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+)
+
+func receive(t *testing.T, sub *events.Subscription) *entity.Event {
+	t.Helper()
+
+	select {
+	case event, ok := <-sub.Events():
+		require.True(t, ok, "subscription closed")
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return nil
+	}
+}
+
+func TestMemoryBroker_Publish(t *testing.T) {
+	broker := events.NewMemoryBroker(10)
+	defer broker.Close()
+
+	posts := broker.Subscribe([]string{entity.PostsTopic})
+	defer posts.Close()
+	other := broker.Subscribe([]string{"post:other"})
+	defer other.Close()
+
+	err := broker.Publish(context.Background(), entity.PostsTopic, entity.EventPostCreated, map[string]string{"title": "Hello"})
+	require.NoError(t, err)
+
+	event := receive(t, posts)
+	assert.Equal(t, int64(1), event.Id)
+	assert.Equal(t, entity.EventPostCreated, event.Type)
+	assert.JSONEq(t, `{"title":"Hello"}`, string(event.Data))
+	assert.Empty(t, other.Events())
+}
+
+func TestMemoryBroker_Replay(t *testing.T) {
+	broker := events.NewMemoryBroker(2)
+	defer broker.Close()
+
+	ctx := context.Background()
+	for _, topic := range []string{entity.PostsTopic, entity.PostsTopic, "post:other", entity.PostsTopic} {
+		require.NoError(t, broker.Publish(ctx, topic, entity.EventPostCreated, nil))
+	}
+
+	replayed, err := broker.Replay(ctx, 2, []string{entity.PostsTopic})
+	require.NoError(t, err)
+	require.Len(t, replayed, 1)
+	assert.Equal(t, int64(4), replayed[0].Id)
+
+	// The first two events are gone, so a client that missed them is told
+	// to start over.
+	replayed, err = broker.Replay(ctx, 1, []string{entity.PostsTopic})
+	require.NoError(t, err)
+	require.Len(t, replayed, 1)
+	assert.Equal(t, entity.EventStreamReset, replayed[0].Type)
+	assert.Equal(t, int64(4), replayed[0].Id)
+}
+
+func TestMemoryBroker_Signal(t *testing.T) {
+	broker := events.NewMemoryBroker(10)
+	defer broker.Close()
+
+	sub := broker.Subscribe([]string{entity.PostsTopic})
+	defer sub.Close()
+
+	ctx := context.Background()
+	require.NoError(t, broker.Signal(ctx, entity.PostsTopic, entity.EventPostUpdated, map[string]string{"title": "Hello"}))
+
+	event := receive(t, sub)
+	assert.Zero(t, event.Id)
+	assert.JSONEq(t, `{"title":"Hello"}`, string(event.Data))
+
+	replayed, err := broker.Replay(ctx, 0, []string{entity.PostsTopic})
+	require.NoError(t, err)
+	assert.Empty(t, replayed)
+}
+
+func TestMemoryBroker_DropsSlowSubscriber(t *testing.T) {
+	broker := events.NewMemoryBroker(1)
+	defer broker.Close()
+
+	sub := broker.Subscribe([]string{entity.PostsTopic})
+	for i := 0; i < 100; i++ {
+		require.NoError(t, broker.Publish(context.Background(), entity.PostsTopic, entity.EventPostCreated, nil))
+	}
+
+	received := 0
+	for range sub.Events() {
+		received++
+	}
+	assert.Less(t, received, 100)
+}
+
+func TestMemoryBroker_CloseEndsSubscriptions(t *testing.T) {
+	broker := events.NewMemoryBroker(1)
+	sub := broker.Subscribe([]string{entity.PostsTopic})
+
+	broker.Close()
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+
+	late := broker.Subscribe([]string{entity.PostsTopic})
+	_, ok = <-late.Events()
+	assert.False(t, ok)
+}
+
+func TestPostgresBroker_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocksrepository.NewMockEventRepository(ctrl)
+	wake := make(chan *pq.Notification)
+	broker := events.NewPostgresBroker(repo, wake, "stream_signals", logrus.New(), time.Hour)
+
+	event := &entity.Event{Id: 8, Topic: entity.PostsTopic, Type: entity.EventPostCreated}
+
+	repo.EXPECT().GetLatestEventId(gomock.Any()).Return(int64(7), nil)
+	repo.EXPECT().GetEventsAfter(gomock.Any(), int64(7), nil, gomock.Any()).Return([]*entity.Event{event}, nil)
+
+	sub := broker.Subscribe([]string{entity.PostsTopic})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- broker.Run(ctx) }()
+
+	wake <- &pq.Notification{Channel: "stream_events", Extra: "8"}
+	assert.Equal(t, event, receive(t, sub))
+
+	// Signals are delivered from the notification, without reading events.
+	wake <- &pq.Notification{Channel: "stream_signals", Extra: `{"topic":"posts","type":"post.updated","data":{"id":1}}`}
+	signal := receive(t, sub)
+	assert.Equal(t, entity.EventPostUpdated, signal.Type)
+	assert.JSONEq(t, `{"id":1}`, string(signal.Data))
+
+	cancel()
+	assert.NoError(t, <-done)
+
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+}
+
+func TestPostgresBroker_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocksrepository.NewMockEventRepository(ctrl)
+	broker := events.NewPostgresBroker(repo, nil, "stream_signals", logrus.New(), time.Hour)
+
+	repo.EXPECT().
+		AppendEvent(gomock.Any(), gomock.Cond(func(x any) bool {
+			event := x.(*entity.Event)
+			return event.Topic == entity.PostsTopic && string(event.Data) == `{"id":1}`
+		})).
+		Return(nil)
+
+	err := broker.Publish(context.Background(), entity.PostsTopic, entity.EventPostCreated, map[string]int{"id": 1})
+	assert.NoError(t, err)
+}
+
+func TestPostgresBroker_Signal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocksrepository.NewMockEventRepository(ctrl)
+	broker := events.NewPostgresBroker(repo, nil, "stream_signals", logrus.New(), time.Hour)
+
+	repo.EXPECT().
+		SignalEvent(gomock.Any(), gomock.Cond(func(x any) bool {
+			event := x.(*entity.Event)
+			return event.Topic == entity.PostsTopic && string(event.Data) == `{"id":1}`
+		})).
+		Return(nil)
+
+	err := broker.Signal(context.Background(), entity.PostsTopic, entity.EventPostUpdated, map[string]int{"id": 1})
+	assert.NoError(t, err)
+}
+
+func TestPostgresBroker_Replay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocksrepository.NewMockEventRepository(ctrl)
+	broker := events.NewPostgresBroker(repo, nil, "stream_signals", logrus.New(), time.Hour)
+	topics := []string{entity.PostsTopic}
+
+	missed := []*entity.Event{{Id: 8, Topic: entity.PostsTopic, Type: entity.EventPostCreated}}
+	repo.EXPECT().GetOldestEventId(gomock.Any()).Return(int64(3), nil)
+	repo.EXPECT().GetEventsAfter(gomock.Any(), int64(7), topics, gomock.Any()).Return(missed, nil)
+
+	replayed, err := broker.Replay(context.Background(), 7, topics)
+	require.NoError(t, err)
+	assert.Equal(t, missed, replayed)
+
+	// More than the broker replays: the client gets a reset at the latest id.
+	repo.EXPECT().GetOldestEventId(gomock.Any()).Return(int64(1), nil)
+	repo.EXPECT().GetEventsAfter(gomock.Any(), int64(1), topics, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int64, _ []string, limit int) ([]*entity.Event, error) {
+			return make([]*entity.Event, limit), nil
+		})
+	repo.EXPECT().GetLatestEventId(gomock.Any()).Return(int64(5000), nil)
+
+	replayed, err = broker.Replay(context.Background(), 1, topics)
+	require.NoError(t, err)
+	require.Len(t, replayed, 1)
+	assert.Equal(t, entity.EventStreamReset, replayed[0].Type)
+	assert.Equal(t, int64(5000), replayed[0].Id)
+
+	// The events right after the client's id were pruned: it gets a reset
+	// even though what is left would fit.
+	repo.EXPECT().GetOldestEventId(gomock.Any()).Return(int64(20), nil)
+	repo.EXPECT().GetLatestEventId(gomock.Any()).Return(int64(25), nil)
+
+	replayed, err = broker.Replay(context.Background(), 7, topics)
+	require.NoError(t, err)
+	require.Len(t, replayed, 1)
+	assert.Equal(t, entity.EventStreamReset, replayed[0].Type)
+	assert.Equal(t, int64(25), replayed[0].Id)
+}
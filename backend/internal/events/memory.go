@@ -0,0 +1,95 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+// MemoryBroker keeps everything in process. It suits a single replica and
+// tests; events published on other replicas are never seen.
+type MemoryBroker struct {
+	hub *hub
+
+	mu      sync.Mutex
+	lastID  int64
+	history []*entity.Event
+	size    int
+}
+
+// NewMemoryBroker keeps the last historySize events for Replay.
+func NewMemoryBroker(historySize int) *MemoryBroker {
+	return &MemoryBroker{
+		hub:  newHub(),
+		size: historySize,
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, topic, eventType string, payload any) error {
+	event, err := newEvent(topic, eventType, payload)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.lastID++
+	event.Id = b.lastID
+	event.CreatedAt = time.Now()
+	b.history = append(b.history, event)
+	if len(b.history) > b.size {
+		b.history = b.history[len(b.history)-b.size:]
+	}
+	b.mu.Unlock()
+
+	b.hub.dispatch(event)
+
+	return nil
+}
+
+func (b *MemoryBroker) Signal(ctx context.Context, topic, eventType string, payload any) error {
+	event, err := newEvent(topic, eventType, payload)
+	if err != nil {
+		return err
+	}
+	event.CreatedAt = time.Now()
+
+	b.hub.dispatch(event)
+
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(topics []string) *Subscription {
+	return b.hub.subscribe(topics)
+}
+
+func (b *MemoryBroker) Replay(ctx context.Context, afterID int64, topics []string) ([]*entity.Event, error) {
+	wanted := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		wanted[topic] = true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Ids have no gaps here, so a history that starts later means events
+	// were dropped.
+	if len(b.history) > 0 && b.history[0].Id > afterID+1 {
+		return []*entity.Event{resetEvent(b.lastID)}, nil
+	}
+
+	var events []*entity.Event
+	for _, event := range b.history {
+		if event.Id > afterID && wanted[event.Topic] {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// Close ends every subscription.
+func (b *MemoryBroker) Close() {
+	b.hub.close()
+}
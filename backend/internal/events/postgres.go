@@ -0,0 +1,198 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+const (
+	// catchUpBatch is how many events are read per query when catching up.
+	catchUpBatch = 500
+	// replayLimit caps how much history a resuming client gets. One that
+	// missed more gets a reset event.
+	replayLimit = 1000
+	// pollInterval bounds the delay when a notification is lost.
+	pollInterval = 30 * time.Second
+)
+
+// PostgresBroker shares events between replicas. Publish stores the event and
+// sends a NOTIFY; every replica, including the publishing one, reads new
+// events when notified and hands them to its local subscribers. The stored
+// events also serve Last-Event-ID resumes. Signals travel in the NOTIFY
+// itself and are never stored.
+type PostgresBroker struct {
+	hub       *hub
+	repo      repository.EventRepository
+	wake      <-chan *pq.Notification
+	signals   string
+	logger    *logrus.Logger
+	retention time.Duration
+	lastSeen  int64
+}
+
+// NewPostgresBroker reads new events whenever wake fires, normally the Notify
+// channel of a pq.Listener on postgres.StreamEventsChannel and
+// postgres.StreamSignalsChannel. Notifications on the signals channel carry
+// a signal, which is delivered as it is. Events older than retention are
+// deleted.
+func NewPostgresBroker(repo repository.EventRepository, wake <-chan *pq.Notification, signals string, logger *logrus.Logger, retention time.Duration) *PostgresBroker {
+	return &PostgresBroker{
+		hub:       newHub(),
+		repo:      repo,
+		wake:      wake,
+		signals:   signals,
+		logger:    logger,
+		retention: retention,
+	}
+}
+
+func (b *PostgresBroker) Publish(ctx context.Context, topic, eventType string, payload any) error {
+	event, err := newEvent(topic, eventType, payload)
+	if err != nil {
+		return err
+	}
+
+	return b.repo.AppendEvent(ctx, event)
+}
+
+func (b *PostgresBroker) Signal(ctx context.Context, topic, eventType string, payload any) error {
+	event, err := newEvent(topic, eventType, payload)
+	if err != nil {
+		return err
+	}
+	event.CreatedAt = time.Now()
+
+	return b.repo.SignalEvent(ctx, event)
+}
+
+func (b *PostgresBroker) Subscribe(topics []string) *Subscription {
+	return b.hub.subscribe(topics)
+}
+
+func (b *PostgresBroker) Replay(ctx context.Context, afterID int64, topics []string) ([]*entity.Event, error) {
+	if len(topics) == 0 {
+		return nil, nil
+	}
+
+	// Events older than the retention window are pruned; a client that
+	// missed some of them cannot catch up by replaying what is left.
+	oldestID, err := b.repo.GetOldestEventId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if oldestID > afterID+1 {
+		return b.reset(ctx)
+	}
+
+	events, err := b.repo.GetEventsAfter(ctx, afterID, topics, replayLimit+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) <= replayLimit {
+		return events, nil
+	}
+
+	return b.reset(ctx)
+}
+
+// reset tells a client to start over from the latest event.
+func (b *PostgresBroker) reset(ctx context.Context) ([]*entity.Event, error) {
+	latestID, err := b.repo.GetLatestEventId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*entity.Event{resetEvent(latestID)}, nil
+}
+
+// Run delivers events until ctx is cancelled or the listener goes away, then
+// ends every subscription so open streams finish.
+func (b *PostgresBroker) Run(ctx context.Context) error {
+	defer b.hub.close()
+
+	lastSeen, err := b.repo.GetLatestEventId(ctx)
+	if err != nil {
+		return err
+	}
+	b.lastSeen = lastSeen
+
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+
+	prune := time.NewTicker(b.retention)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n, ok := <-b.wake:
+			// pq sends a nil notification after reconnecting; catching up
+			// covers whatever was published in between. Signals sent in
+			// between are lost, which their senders put up with.
+			if !ok {
+				return errors.New("event listener closed")
+			}
+			if n != nil && n.Channel == b.signals {
+				b.signal(n.Extra)
+				continue
+			}
+			b.catchUp(ctx)
+		case <-poll.C:
+			b.catchUp(ctx)
+		case <-prune.C:
+			b.prune(ctx)
+		}
+	}
+}
+
+// catchUp reads everything after the last event it saw. That relies on the
+// repository making ids visible in order, which AppendEvent guarantees.
+func (b *PostgresBroker) catchUp(ctx context.Context) {
+	for {
+		events, err := b.repo.GetEventsAfter(ctx, b.lastSeen, nil, catchUpBatch)
+		if err != nil {
+			b.logger.WithError(err).Warn("Failed to read new stream events")
+			return
+		}
+
+		for _, event := range events {
+			b.hub.dispatch(event)
+			b.lastSeen = event.Id
+		}
+
+		if len(events) < catchUpBatch {
+			return
+		}
+	}
+}
+
+func (b *PostgresBroker) signal(payload string) {
+	var event entity.Event
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		b.logger.WithError(err).Warn("Failed to decode stream signal")
+		return
+	}
+
+	b.hub.dispatch(&event)
+}
+
+func (b *PostgresBroker) prune(ctx context.Context) {
+	deleted, err := b.repo.DeleteEventsBefore(ctx, time.Now().Add(-b.retention))
+	if err != nil {
+		b.logger.WithError(err).Warn("Failed to delete old stream events")
+		return
+	}
+
+	if deleted > 0 {
+		b.logger.WithField("deleted", deleted).Info("Deleted old stream events")
+	}
+}
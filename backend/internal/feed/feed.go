@@ -0,0 +1,131 @@
+// Package feed renders posts as RSS 2.0, Atom 1.0 and JSON Feed 1.1
+// documents.
+package feed
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+type Format string
+
+const (
+	RSS  Format = "rss"
+	Atom Format = "atom"
+	JSON Format = "json"
+)
+
+func (f Format) ContentType() string {
+	switch f {
+	case RSS:
+		return "application/rss+xml; charset=utf-8"
+	case Atom:
+		return "application/atom+xml; charset=utf-8"
+	default:
+		return "application/feed+json; charset=utf-8"
+	}
+}
+
+// Feed is what the three formats have in common.
+type Feed struct {
+	Title       string
+	Description string
+	// Link is the page the feed is about; FeedURL is the feed itself.
+	Link    string
+	FeedURL string
+	Updated time.Time
+	Items   []*Item
+}
+
+type Item struct {
+	ID         string
+	Title      string
+	Link       string
+	AuthorName string
+	AuthorURL  string
+	// Content is empty in excerpt mode; Summary is always set.
+	Content   string
+	Summary   string
+	Tags      []string
+	Published time.Time
+	Updated   time.Time
+}
+
+// Write renders feed in the given format.
+func Write(w io.Writer, format Format, feed *Feed) error {
+	switch format {
+	case RSS:
+		return writeRSS(w, feed)
+	case Atom:
+		return writeAtom(w, feed)
+	case JSON:
+		return writeJSON(w, feed)
+	default:
+		return fmt.Errorf("unknown feed format %q", format)
+	}
+}
+
+type Options struct {
+	SiteName string
+	// SiteURL is the frontend that links point at.
+	SiteURL string
+	// ExcerptLength is how much of a post a summary shows.
+	ExcerptLength int
+}
+
+// New builds the feed of entries selected by query. feedURL is where the
+// feed is served from. In excerpt mode items only carry a summary of the
+// post.
+func New(opts Options, query *entity.FeedQuery, feedURL string, entries []*entity.FeedEntry, excerpt bool) *Feed {
+	feed := &Feed{
+		Title:       opts.SiteName,
+		Description: "Latest posts on " + opts.SiteName,
+		Link:        opts.SiteURL,
+		FeedURL:     feedURL,
+		// Formats that require an update time get a stable one for an empty
+		// feed, so that its ETag doesn't change.
+		Updated: time.Unix(0, 0).UTC(),
+		Items:   make([]*Item, 0, len(entries)),
+	}
+	switch {
+	case query.Author != "":
+		feed.Title = "Posts by " + query.Author + " - " + opts.SiteName
+		feed.Description = "Latest posts by " + query.Author + " on " + opts.SiteName
+		feed.Link = authorURL(opts.SiteURL, query.Author)
+	case query.Tag != "":
+		feed.Title = "Posts tagged " + query.Tag + " - " + opts.SiteName
+		feed.Description = "Latest posts tagged " + query.Tag + " on " + opts.SiteName
+		feed.Link = opts.SiteURL + "/tags/" + url.PathEscape(query.Tag)
+	}
+
+	for _, entry := range entries {
+		item := &Item{
+			ID:         "urn:uuid:" + entry.Id.String(),
+			Title:      entry.Title,
+			Link:       opts.SiteURL + "/posts/" + entry.Id.String(),
+			AuthorName: entry.AuthorName,
+			AuthorURL:  authorURL(opts.SiteURL, entry.AuthorUsername),
+			Summary:    entity.Excerpt(entry.Content, opts.ExcerptLength),
+			Tags:       entry.Tags,
+			Published:  entry.CreatedAt.UTC(),
+			Updated:    entry.UpdatedAt.UTC(),
+		}
+		if !excerpt {
+			item.Content = entry.Content
+		}
+		if item.Updated.After(feed.Updated) {
+			feed.Updated = item.Updated
+		}
+		feed.Items = append(feed.Items, item)
+	}
+
+	return feed
+}
+
+func authorURL(siteURL, username string) string {
+	return siteURL + "/authors/" + url.PathEscape(username)
+}
@@ -0,0 +1,307 @@
+package feed_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/feed"
+)
+
+var (
+	postId1   = uuid.New()
+	postId2   = uuid.New()
+	createdAt = time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	opts      = feed.Options{SiteName: "Blog", SiteURL: "https://blog.example.com", ExcerptLength: 20}
+)
+
+func testEntries() []*entity.FeedEntry {
+	return []*entity.FeedEntry{
+		{
+			Post: entity.Post{
+				Id: postId1, Title: "Newer <post>", Content: "A long text about generics & interfaces in Go.",
+				Tags: []string{"go"}, CreatedAt: createdAt, UpdatedAt: createdAt.Add(time.Hour),
+			},
+			AuthorUsername: "alice",
+			AuthorName:     "Alice",
+		},
+		{
+			Post:           entity.Post{Id: postId2, Title: "Older post", Content: "Short.", CreatedAt: createdAt.Add(-time.Hour), UpdatedAt: createdAt.Add(-time.Hour)},
+			AuthorUsername: "bob",
+			AuthorName:     "bob",
+		},
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        entity.FeedQuery
+		excerpt      bool
+		expectedLink string
+		expectedBody string
+	}{
+		{
+			name:         "Whole blog",
+			expectedLink: "https://blog.example.com",
+			expectedBody: "A long text about generics & interfaces in Go.",
+		},
+		{
+			name:         "Author in excerpt mode",
+			query:        entity.FeedQuery{Author: "alice"},
+			excerpt:      true,
+			expectedLink: "https://blog.example.com/authors/alice",
+		},
+		{
+			name:         "Tag",
+			query:        entity.FeedQuery{Tag: "go"},
+			expectedLink: "https://blog.example.com/tags/go",
+			expectedBody: "A long text about generics & interfaces in Go.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := feed.New(opts, &tt.query, "https://api.example.com/feed.xml", testEntries(), tt.excerpt)
+
+			assert.Equal(t, tt.expectedLink, result.Link)
+			assert.Equal(t, createdAt.Add(time.Hour), result.Updated)
+			require.Len(t, result.Items, 2)
+			assert.Equal(t, "urn:uuid:"+postId1.String(), result.Items[0].ID)
+			assert.Equal(t, "https://blog.example.com/posts/"+postId1.String(), result.Items[0].Link)
+			assert.Equal(t, "A long text about…", result.Items[0].Summary)
+			assert.Equal(t, tt.expectedBody, result.Items[0].Content)
+		})
+	}
+}
+
+func TestNew_Empty(t *testing.T) {
+	result := feed.New(opts, &entity.FeedQuery{}, "https://api.example.com/feed.xml", nil, false)
+
+	assert.Empty(t, result.Items)
+	assert.Equal(t, time.Unix(0, 0).UTC(), result.Updated)
+}
+
+// The structs below follow the required elements of each specification:
+// RSS 2.0 (rssboard.org/rss-specification), Atom (RFC 4287) and JSON Feed
+// 1.1 (jsonfeed.org/version/1.1).
+
+type rssDocument struct {
+	XMLName xml.Name `xml:"rss"`
+	Version string   `xml:"version,attr"`
+	Channel struct {
+		Title       string `xml:"title"`
+		Description string `xml:"description"`
+		// Both the RSS link and the atom:link self link are "link" elements.
+		Links []struct {
+			XMLName xml.Name
+			Href    string `xml:"href,attr"`
+			Rel     string `xml:"rel,attr"`
+			Value   string `xml:",chardata"`
+		} `xml:"link"`
+		LastBuildDate string `xml:"lastBuildDate"`
+		Items         []struct {
+			Title string `xml:"title"`
+			Link  string `xml:"link"`
+			GUID  struct {
+				IsPermaLink string `xml:"isPermaLink,attr"`
+				Value       string `xml:",chardata"`
+			} `xml:"guid"`
+			PubDate     string   `xml:"pubDate"`
+			Creator     string   `xml:"http://purl.org/dc/elements/1.1/ creator"`
+			Categories  []string `xml:"category"`
+			Description string   `xml:"description"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+func TestWrite_RSS(t *testing.T) {
+	var buf bytes.Buffer
+	err := feed.Write(&buf, feed.RSS, feed.New(opts, &entity.FeedQuery{}, "https://api.example.com/feed.xml", testEntries(), false))
+	require.NoError(t, err)
+
+	var doc rssDocument
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	assert.Equal(t, "2.0", doc.Version)
+	assert.Equal(t, "Blog", doc.Channel.Title)
+	assert.NotEmpty(t, doc.Channel.Description)
+	assertTime(t, time.RFC1123Z, doc.Channel.LastBuildDate)
+	require.Len(t, doc.Channel.Links, 2)
+	for _, link := range doc.Channel.Links {
+		if link.XMLName.Space == "http://www.w3.org/2005/Atom" {
+			assert.Equal(t, "https://api.example.com/feed.xml", link.Href)
+			assert.Equal(t, "self", link.Rel)
+		} else {
+			assertAbsoluteURL(t, link.Value)
+		}
+	}
+
+	require.Len(t, doc.Channel.Items, 2)
+	item := doc.Channel.Items[0]
+	assert.Equal(t, "Newer <post>", item.Title)
+	assertAbsoluteURL(t, item.Link)
+	assert.Equal(t, "false", item.GUID.IsPermaLink)
+	assert.Equal(t, "urn:uuid:"+postId1.String(), item.GUID.Value)
+	assertTime(t, time.RFC1123Z, item.PubDate)
+	assert.Equal(t, "Alice", item.Creator)
+	assert.Equal(t, []string{"go"}, item.Categories)
+	assert.Equal(t, "A long text about generics & interfaces in Go.", item.Description)
+}
+
+type atomFeed struct {
+	XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string   `xml:"id"`
+	Title   string   `xml:"title"`
+	Updated string   `xml:"updated"`
+	Links   []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+	Entries []struct {
+		ID      string `xml:"id"`
+		Title   string `xml:"title"`
+		Updated string `xml:"updated"`
+		Author  struct {
+			Name string `xml:"name"`
+		} `xml:"author"`
+		Link struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Summary *struct {
+			Type  string `xml:"type,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"summary"`
+		Content *struct {
+			Type  string `xml:"type,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"content"`
+	} `xml:"entry"`
+}
+
+func TestWrite_Atom(t *testing.T) {
+	tests := []struct {
+		name    string
+		excerpt bool
+	}{
+		{name: "Full content"},
+		{name: "Excerpt", excerpt: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := feed.Write(&buf, feed.Atom, feed.New(opts, &entity.FeedQuery{}, "https://api.example.com/atom.xml", testEntries(), tt.excerpt))
+			require.NoError(t, err)
+
+			var doc atomFeed
+			require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+			assertAbsoluteURL(t, doc.ID)
+			assert.Equal(t, "Blog", doc.Title)
+			assertTime(t, time.RFC3339, doc.Updated)
+			rels := map[string]string{}
+			for _, link := range doc.Links {
+				rels[link.Rel] = link.Href
+			}
+			assert.Equal(t, "https://api.example.com/atom.xml", rels["self"])
+			assert.Equal(t, "https://blog.example.com", rels["alternate"])
+
+			require.Len(t, doc.Entries, 2)
+			for _, entry := range doc.Entries {
+				assert.NotEmpty(t, entry.ID)
+				assert.NotEmpty(t, entry.Title)
+				assertTime(t, time.RFC3339, entry.Updated)
+				// Feeds without a feed-level author need one in every entry.
+				assert.NotEmpty(t, entry.Author.Name)
+				assertAbsoluteURL(t, entry.Link.Href)
+				require.NotNil(t, entry.Summary)
+				assert.Equal(t, "text", entry.Summary.Type)
+				// An entry without content needs an alternate link and a summary.
+				if tt.excerpt {
+					assert.Nil(t, entry.Content)
+				} else {
+					require.NotNil(t, entry.Content)
+					assert.Equal(t, "text", entry.Content.Type)
+				}
+			}
+		})
+	}
+}
+
+type jsonFeed struct {
+	Version     string `json:"version"`
+	Title       string `json:"title"`
+	HomePageURL string `json:"home_page_url"`
+	FeedURL     string `json:"feed_url"`
+	Items       []struct {
+		ID            string  `json:"id"`
+		URL           string  `json:"url"`
+		ContentText   *string `json:"content_text"`
+		ContentHTML   *string `json:"content_html"`
+		Summary       string  `json:"summary"`
+		DatePublished string  `json:"date_published"`
+		Authors       []struct {
+			Name string `json:"name"`
+		} `json:"authors"`
+		Tags []string `json:"tags"`
+	} `json:"items"`
+}
+
+func TestWrite_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := feed.Write(&buf, feed.JSON, feed.New(opts, &entity.FeedQuery{Tag: "go"}, "https://api.example.com/tags/go/feed.json", testEntries(), true))
+	require.NoError(t, err)
+
+	var doc jsonFeed
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", doc.Version)
+	assert.Equal(t, "Posts tagged go - Blog", doc.Title)
+	assertAbsoluteURL(t, doc.HomePageURL)
+	assert.Equal(t, "https://api.example.com/tags/go/feed.json", doc.FeedURL)
+
+	require.Len(t, doc.Items, 2)
+	for _, item := range doc.Items {
+		assert.NotEmpty(t, item.ID)
+		assertAbsoluteURL(t, item.URL)
+		// Every item needs content_text or content_html.
+		assert.True(t, item.ContentText != nil || item.ContentHTML != nil)
+		assertTime(t, time.RFC3339, item.DatePublished)
+		require.Len(t, item.Authors, 1)
+		assert.NotEmpty(t, item.Authors[0].Name)
+	}
+	assert.Equal(t, "A long text about…", *doc.Items[0].ContentText)
+	assert.Equal(t, []string{"go"}, doc.Items[0].Tags)
+	assert.Nil(t, doc.Items[1].Tags)
+}
+
+func TestWrite_UnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	err := feed.Write(&buf, "csv", feed.New(opts, &entity.FeedQuery{}, "https://api.example.com/feed.csv", nil, false))
+
+	assert.EqualError(t, err, `unknown feed format "csv"`)
+}
+
+func assertAbsoluteURL(t *testing.T, raw string) {
+	t.Helper()
+	u, err := url.Parse(raw)
+	if assert.NoError(t, err) {
+		assert.True(t, u.IsAbs(), "%q is not an absolute URL", raw)
+	}
+}
+
+func assertTime(t *testing.T, layout, value string) {
+	t.Helper()
+	_, err := time.Parse(layout, value)
+	assert.NoError(t, err, "%q is not in the %q format", value, layout)
+}
@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type BookmarkRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewBookmarkRepository(db *db.PostgresDB, logger *logrus.Logger) *BookmarkRepository {
+	return &BookmarkRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// AddBookmark saves a post for later. Bookmarking the same post again only
+// replaces the note and keeps the original position in the list.
+func (r *BookmarkRepository) AddBookmark(ctx context.Context, bookmark *entity.NewBookmark) (*entity.Bookmark, error) {
+	query := `
+        INSERT INTO bookmarks (user_id, post_id, note, created_at)
+        VALUES ($1, $2, $3, NOW())
+        ON CONFLICT (user_id, post_id) DO UPDATE SET note = EXCLUDED.note
+        RETURNING user_id, post_id, note, created_at
+    `
+
+	var created entity.Bookmark
+	err := r.db.QueryRowContext(ctx, query, bookmark.UserId, bookmark.PostId, bookmark.Note).Scan(
+		&created.UserId, &created.PostId, &created.Note, &created.CreatedAt,
+	)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to add bookmark")
+		return nil, fmt.Errorf("failed to add bookmark: %w", err)
+	}
+
+	return &created, nil
+}
+
+func (r *BookmarkRepository) DeleteBookmark(ctx context.Context, userID, postID uuid.UUID) error {
+	query := `DELETE FROM bookmarks WHERE user_id = $1 AND post_id = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, postID); err != nil {
+		r.logger.WithError(err).Error("Failed to delete bookmark")
+		return fmt.Errorf("failed to delete bookmark: %w", err)
+	}
+
+	return nil
+}
+
+// GetBookmarks returns a page of the user's bookmarks, newest first, with
+// the posts loaded in the same query. Bookmarks on posts that have since been
+// unpublished are skipped unless the user wrote them.
+func (r *BookmarkRepository) GetBookmarks(ctx context.Context, userID uuid.UUID, params *entity.Pagination) ([]*entity.Bookmark, error) {
+	query := `
+        SELECT b.user_id, b.post_id, b.note, b.created_at,
+               p.id, p.title, p.content, p.author_id, p.created_at, p.updated_at
+        FROM bookmarks b
+        JOIN posts p ON p.id = b.post_id
+        WHERE b.user_id = $1 AND (p.status = 'published' OR p.author_id = $1)`
+
+	// Every post is bookmarked at most once, so the post id breaks ties.
+	keys := sortedBy(true, "b.created_at", "b.post_id")
+	var after []any
+	if params.Cursor != nil {
+		after = []any{params.Cursor.CreatedAt, params.Cursor.Id}
+	}
+
+	query, args := keys.paginate(query, []any{userID}, params, after)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get bookmarks")
+		return nil, fmt.Errorf("failed to get bookmarks: %w", err)
+	}
+	defer rows.Close()
+
+	var bookmarks []*entity.Bookmark
+	for rows.Next() {
+		var (
+			bookmark entity.Bookmark
+			post     entity.Post
+		)
+		if err := rows.Scan(
+			&bookmark.UserId, &bookmark.PostId, &bookmark.Note, &bookmark.CreatedAt,
+			&post.Id, &post.Title, &post.Content, &post.AuthorId, &post.CreatedAt, &post.UpdatedAt,
+		); err != nil {
+			r.logger.WithError(err).Error("Failed to scan bookmark")
+			return nil, fmt.Errorf("failed to scan bookmark: %w", err)
+		}
+		bookmark.Post = &post
+		bookmarks = append(bookmarks, &bookmark)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return bookmarks, nil
+}
+
+func (r *BookmarkRepository) GetTotalBookmarks(ctx context.Context, userID uuid.UUID) (int, error) {
+	query := `
+        SELECT COUNT(*)
+        FROM bookmarks b
+        JOIN posts p ON p.id = b.post_id
+        WHERE b.user_id = $1 AND (p.status = 'published' OR p.author_id = $1)
+    `
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&total); err != nil {
+		r.logger.WithError(err).Error("Failed to get total bookmarks")
+		return 0, fmt.Errorf("failed to get total bookmarks: %w", err)
+	}
+
+	return total, nil
+}
+
+// GetBookmarkedPostIds reports which of the given posts the user has
+// bookmarked; posts that are not bookmarked are absent from the map.
+func (r *BookmarkRepository) GetBookmarkedPostIds(ctx context.Context, userID uuid.UUID, postIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	bookmarked := make(map[uuid.UUID]bool, len(postIDs))
+	if len(postIDs) == 0 {
+		return bookmarked, nil
+	}
+
+	query := `SELECT post_id FROM bookmarks WHERE user_id = $1 AND post_id = ANY($2)`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, pq.Array(postIDs))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get bookmarked posts")
+		return nil, fmt.Errorf("failed to get bookmarked posts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postID uuid.UUID
+		if err := rows.Scan(&postID); err != nil {
+			r.logger.WithError(err).Error("Failed to scan bookmarked post")
+			return nil, fmt.Errorf("failed to scan bookmarked post: %w", err)
+		}
+		bookmarked[postID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return bookmarked, nil
+}
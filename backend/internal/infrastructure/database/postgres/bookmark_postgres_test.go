@@ -0,0 +1,80 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestBookmarkRepository_GetBookmarks(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewBookmarkRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT b.user_id, b.post_id, b.note, b.created_at, .* FROM bookmarks b JOIN posts p ON p.id = b.post_id WHERE b.user_id = \\$1 AND \\(p.status = 'published' OR p.author_id = \\$1\\) ORDER BY b.created_at DESC, b.post_id DESC LIMIT \\$2 OFFSET \\$3").
+		WithArgs(userId1, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"user_id", "post_id", "note", "created_at", "id", "title", "content", "author_id", "created_at", "updated_at",
+		}).AddRow(userId1, postId1, "later", now, postId1, "Title", "Content", userId2, now, now))
+
+	bookmarks, err := repo.GetBookmarks(context.Background(), userId1, &entity.Pagination{Limit: 10})
+
+	assert.NoError(t, err)
+	assert.Len(t, bookmarks, 1)
+	assert.Equal(t, "later", bookmarks[0].Note)
+	assert.Equal(t, "Title", bookmarks[0].Post.Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBookmarkRepository_GetBookmarks_Cursor(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewBookmarkRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	cursor := &entity.Cursor{CreatedAt: time.Now(), Id: postId1}
+	mock.ExpectQuery("WHERE b.user_id = \\$1 AND .* AND \\(b.created_at, b.post_id\\) < \\(\\$2, \\$3\\) ORDER BY b.created_at DESC, b.post_id DESC LIMIT \\$4 OFFSET \\$5").
+		WithArgs(userId1, cursor.CreatedAt, postId1, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"user_id", "post_id", "note", "created_at", "id", "title", "content", "author_id", "created_at", "updated_at",
+		}))
+
+	bookmarks, err := repo.GetBookmarks(context.Background(), userId1, &entity.Pagination{Limit: 10, Offset: 20, Cursor: cursor})
+
+	assert.NoError(t, err)
+	assert.Empty(t, bookmarks)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBookmarkRepository_GetBookmarkedPostIds(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewBookmarkRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	ids := []uuid.UUID{postId1, postId2}
+	mock.ExpectQuery("SELECT post_id FROM bookmarks WHERE user_id = \\$1 AND post_id = ANY\\(\\$2\\)").
+		WithArgs(userId1, pq.Array(ids)).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id"}).AddRow(postId2))
+
+	bookmarked, err := repo.GetBookmarkedPostIds(context.Background(), userId1, ids)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]bool{postId2: true}, bookmarked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+// column is a column a resource is read from, keyed by the JSON name of the
+// attribute it fills.
+type column[T any] struct {
+	field string
+	expr  string
+	dest  func(*T) any
+	// onRequest columns are only read when a fieldset lists them.
+	onRequest bool
+}
+
+// selection is the columns a query reads, in the order they are scanned.
+type selection[T any] []column[T]
+
+// selectColumns picks the columns of the attributes in fields plus the
+// required ones, which cursors and embeds are made from. A nil fieldset
+// reads every column that isn't onRequest.
+func selectColumns[T any](columns []column[T], fields entity.Fields, required ...string) selection[T] {
+	selected := make(selection[T], 0, len(columns))
+	for _, c := range columns {
+		wanted := fields.Lists(c.field) || slices.Contains(required, c.field)
+		if fields == nil && !c.onRequest {
+			wanted = true
+		}
+		if wanted {
+			selected = append(selected, c)
+		}
+	}
+	return selected
+}
+
+func (s selection[T]) columns() string {
+	exprs := make([]string, 0, len(s))
+	for _, c := range s {
+		exprs = append(exprs, c.expr)
+	}
+	return strings.Join(exprs, ", ")
+}
+
+func (s selection[T]) dests(item *T) []any {
+	dests := make([]any, 0, len(s))
+	for _, c := range s {
+		dests = append(dests, c.dest(item))
+	}
+	return dests
+}
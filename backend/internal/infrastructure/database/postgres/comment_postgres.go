@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
@@ -27,18 +28,24 @@ func NewCommentRepository(db *db.PostgresDB, logger *logrus.Logger) *CommentRepo
 
 func (r *CommentRepository) CreateComment(ctx context.Context, comment *entity.NewComment) (*entity.Comment, error) {
 	query := `
-        INSERT INTO comments (id, post_id, author_id, content, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, NOW(), NOW())
-        RETURNING id, post_id, author_id, content, created_at, updated_at
+        INSERT INTO comments (id, post_id, author_id, content, status, parent_id, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+        RETURNING id, post_id, parent_id, author_id, content, status, edited_at, created_at, updated_at, version
     `
 
+	status := comment.Status
+	if status == "" {
+		status = entity.CommentStatusApproved
+	}
+
 	commentID := uuid.New()
 	var createdComment entity.Comment
 	err := r.db.QueryRowContext(ctx, query,
-		commentID, comment.PostId, comment.AuthorId, comment.Content,
+		commentID, comment.PostId, comment.AuthorId, comment.Content, status, comment.ParentId,
 	).Scan(
-		&createdComment.Id, &createdComment.PostId, &createdComment.AuthorId,
-		&createdComment.Content, &createdComment.CreatedAt, &createdComment.UpdatedAt,
+		&createdComment.Id, &createdComment.PostId, &createdComment.ParentId, &createdComment.AuthorId, &createdComment.Content,
+		&createdComment.Status, &createdComment.EditedAt, &createdComment.CreatedAt, &createdComment.UpdatedAt,
+		&createdComment.Version,
 	)
 
 	if err != nil {
@@ -46,20 +53,22 @@ func (r *CommentRepository) CreateComment(ctx context.Context, comment *entity.N
 		return nil, fmt.Errorf("failed to create comment: %w", err)
 	}
 
+	createdComment.Edited = createdComment.EditedAt != nil
+
 	return &createdComment, nil
 }
 
 func (r *CommentRepository) GetCommentById(ctx context.Context, id uuid.UUID) (*entity.Comment, error) {
 	query := `
-        SELECT id, post_id, author_id, content, created_at, updated_at
+        SELECT id, post_id, parent_id, author_id, content, status, edited_at, created_at, updated_at, version
         FROM comments
         WHERE id = $1
     `
 
 	var comment entity.Comment
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&comment.Id, &comment.PostId, &comment.AuthorId,
-		&comment.Content, &comment.CreatedAt, &comment.UpdatedAt,
+		&comment.Id, &comment.PostId, &comment.ParentId, &comment.AuthorId, &comment.Content,
+		&comment.Status, &comment.EditedAt, &comment.CreatedAt, &comment.UpdatedAt, &comment.Version,
 	)
 
 	if err != nil {
@@ -72,85 +81,95 @@ func (r *CommentRepository) GetCommentById(ctx context.Context, id uuid.UUID) (*
 		return nil, fmt.Errorf("failed to get comment: %w", err)
 	}
 
+	comment.Edited = comment.EditedAt != nil
+
 	return &comment, nil
 }
 
-func (r *CommentRepository) GetComments(ctx context.Context, postID uuid.UUID, params *entity.Pagination) ([]*entity.Comment, error) {
-	query := `SELECT id, post_id, author_id, content, created_at, updated_at FROM comments WHERE post_id = $1`
+func (r *CommentRepository) GetCommentsByIds(ctx context.Context, ids []uuid.UUID) ([]*entity.Comment, error) {
+	query := `SELECT ` + allCommentColumns.columns() + ` FROM comments
+        WHERE id = ANY($1)`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get comments by ids")
+		return nil, fmt.Errorf("failed to get comments by ids: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanComments(rows, allCommentColumns)
+}
+
+// GetComments returns the approved comments of a post together with the
+// viewer's own pending ones. Pass uuid.Nil for anonymous viewers.
+func (r *CommentRepository) GetComments(ctx context.Context, postID uuid.UUID, viewerID uuid.UUID, params *entity.Pagination) ([]*entity.Comment, error) {
+	columns := commentSelection(params)
+	query := `SELECT ` + columns.columns() + ` FROM comments
+        WHERE post_id = $1 AND (status = 'approved' OR (status = 'pending' AND author_id = $2))`
 
 	r.logger.WithFields(logrus.Fields{
 		"postID": postID,
 		"params": params,
 	}).Info("GetComments called")
 
-	if params.Sort != "" {
-		r.logger.WithField("sort", params.Sort).Info("Sorting parameter")
-
-		sortField, sortOrder := "", ""
-
-		switch params.Sort {
-		case "created_at_asc":
-			sortField = "created_at"
-			sortOrder = "asc"
-		case "created_at_desc":
-			sortField = "created_at"
-			sortOrder = "desc"
-		default:
-			return nil, fmt.Errorf("invalid sort format: %s", params.Sort)
-		}
-
-		query += fmt.Sprintf(" ORDER BY %s %s", sortField, sortOrder)
-	} else {
-		query += " ORDER BY created_at DESC"
+	keys, after, err := commentKeyset(params)
+	if err != nil {
+		return nil, err
 	}
 
-	r.logger.WithField("query", query).Info("Final query before adding LIMIT and OFFSET")
-
-	query += " LIMIT $2 OFFSET $3"
+	query, args := keys.paginate(query, []any{postID, viewerID}, params, after)
 
 	r.logger.WithField("query", query).Info("Final query")
 
-	rows, err := r.db.QueryContext(ctx, query, postID, params.Limit, params.Offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		r.logger.WithError(err).Error("Failed to get comments")
 		return nil, fmt.Errorf("failed to get comments: %w", err)
 	}
 	defer rows.Close()
 
-	var comments []*entity.Comment
-	for rows.Next() {
-		var comment entity.Comment
-		err := rows.Scan(
-			&comment.Id, &comment.PostId, &comment.AuthorId,
-			&comment.Content, &comment.CreatedAt, &comment.UpdatedAt,
-		)
-		if err != nil {
-			r.logger.WithError(err).Error("Failed to scan comment")
-			return nil, fmt.Errorf("failed to scan comment: %w", err)
-		}
-		comments = append(comments, &comment)
+	return r.scanComments(rows, columns)
+}
+
+func (r *CommentRepository) GetCommentsByStatus(ctx context.Context, status entity.CommentStatus, params *entity.Pagination) ([]*entity.Comment, error) {
+	query := `SELECT ` + allCommentColumns.columns() + ` FROM comments
+        WHERE status = $1`
+
+	keys, after, err := commentKeyset(params)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, comment := range comments {
-		r.logger.WithFields(logrus.Fields{
-			"commentID": comment.Id,
-			"createdAt": comment.CreatedAt,
-			"authorID":  comment.AuthorId,
-		}).Info("Comment details")
+	query, args := keys.paginate(query, []any{status}, params, after)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.WithError(err).WithField("status", status).Error("Failed to get comments by status")
+		return nil, fmt.Errorf("failed to get comments by status: %w", err)
 	}
+	defer rows.Close()
 
-	return comments, nil
+	return r.scanComments(rows, allCommentColumns)
 }
 
+// UpdateComment writes the comment and bumps its version. comment.Version is
+// the version being replaced, or entity.AnyVersion, and is set to the new one.
 func (r *CommentRepository) UpdateComment(ctx context.Context, comment *entity.UpdateComment) error {
 	query := `
         UPDATE comments
-        SET content = $1, updated_at = NOW()
-        WHERE id = $2
+        SET content = $1, status = COALESCE(NULLIF($4, ''), status), edited_at = NOW(), updated_at = NOW(), version = version + 1
+        WHERE id = $2 AND ($3 = 0 OR version = $3)
+        RETURNING version
     `
 
-	_, err := r.db.ExecContext(ctx, query, comment.Content, comment.Id)
+	err := r.db.QueryRowContext(ctx, query, comment.Content, comment.Id, comment.Version, comment.Status).Scan(&comment.Version)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if comment.Version != entity.AnyVersion {
+				return entity.ErrVersionConflict
+			}
+			return fmt.Errorf("comment not found")
+		}
 		r.logger.WithError(err).Error("Failed to update comment")
 		return fmt.Errorf("failed to update comment: %w", err)
 	}
@@ -158,23 +177,64 @@ func (r *CommentRepository) UpdateComment(ctx context.Context, comment *entity.U
 	return nil
 }
 
-func (r *CommentRepository) DeleteCommentById(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM comments WHERE id = $1`
+// UpdateCommentsStatus applies a moderation decision to a batch of comments and
+// returns how many rows were changed.
+func (r *CommentRepository) UpdateCommentsStatus(ctx context.Context, ids []uuid.UUID, status entity.CommentStatus, moderatorID uuid.UUID) (int64, error) {
+	query := `
+        UPDATE comments
+        SET status = $1, moderated_by = $2, moderated_at = NOW(), updated_at = NOW(), version = version + 1
+        WHERE id = ANY($3)
+    `
+
+	result, err := r.db.ExecContext(ctx, query, status, moderatorID, pq.Array(ids))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to update comments status")
+		return 0, fmt.Errorf("failed to update comments status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to check rows affected")
+		return 0, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// DeleteCommentById removes the comment if it still has the given version, or
+// whatever its version with entity.AnyVersion.
+func (r *CommentRepository) DeleteCommentById(ctx context.Context, id uuid.UUID, version int) error {
+	query := `DELETE FROM comments WHERE id = $1 AND ($2 = 0 OR version = $2)`
 
-	_, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.db.ExecContext(ctx, query, id, version)
 	if err != nil {
 		r.logger.WithError(err).Error("Failed to delete comment")
 		return fmt.Errorf("failed to delete comment: %w", err)
 	}
 
+	if version == entity.AnyVersion {
+		return nil
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to check rows affected")
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return entity.ErrVersionConflict
+	}
+
 	return nil
 }
 
-func (r *CommentRepository) GetTotalCommentsByPostID(ctx context.Context, postID uuid.UUID) (int, error) {
-	query := `SELECT COUNT(*) FROM comments WHERE post_id = $1`
+func (r *CommentRepository) GetTotalCommentsByPostID(ctx context.Context, postID uuid.UUID, viewerID uuid.UUID) (int, error) {
+	query := `SELECT COUNT(*) FROM comments
+        WHERE post_id = $1 AND (status = 'approved' OR (status = 'pending' AND author_id = $2))`
 
 	var total int
-	err := r.db.QueryRowContext(ctx, query, postID).Scan(&total)
+	err := r.db.QueryRowContext(ctx, query, postID, viewerID).Scan(&total)
 	if err != nil {
 		r.logger.WithError(err).Error("Failed to get total comments")
 		return 0, fmt.Errorf("failed to get total comments: %w", err)
@@ -182,3 +242,96 @@ func (r *CommentRepository) GetTotalCommentsByPostID(ctx context.Context, postID
 
 	return total, nil
 }
+
+func (r *CommentRepository) GetTotalCommentsByStatus(ctx context.Context, status entity.CommentStatus) (int, error) {
+	query := `SELECT COUNT(*) FROM comments WHERE status = $1`
+
+	var total int
+	err := r.db.QueryRowContext(ctx, query, status).Scan(&total)
+	if err != nil {
+		r.logger.WithError(err).WithField("status", status).Error("Failed to get total comments by status")
+		return 0, fmt.Errorf("failed to get total comments by status: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *CommentRepository) CountApprovedCommentsByAuthor(ctx context.Context, authorID uuid.UUID) (int, error) {
+	query := `SELECT COUNT(*) FROM comments WHERE author_id = $1 AND status = 'approved'`
+
+	var total int
+	err := r.db.QueryRowContext(ctx, query, authorID).Scan(&total)
+	if err != nil {
+		r.logger.WithError(err).WithField("authorID", authorID).Error("Failed to count approved comments")
+		return 0, fmt.Errorf("failed to count approved comments: %w", err)
+	}
+
+	return total, nil
+}
+
+// commentColumns are the columns of comments by attribute, in the order they
+// are read.
+var commentColumns = []column[entity.Comment]{
+	{field: "id", expr: "id", dest: func(c *entity.Comment) any { return &c.Id }},
+	{field: "postId", expr: "post_id", dest: func(c *entity.Comment) any { return &c.PostId }},
+	{field: "parentId", expr: "parent_id", dest: func(c *entity.Comment) any { return &c.ParentId }},
+	{field: "authorId", expr: "author_id", dest: func(c *entity.Comment) any { return &c.AuthorId }},
+	{field: "content", expr: "content", dest: func(c *entity.Comment) any { return &c.Content }},
+	{field: "status", expr: "status", dest: func(c *entity.Comment) any { return &c.Status }},
+	{field: "editedAt", expr: "edited_at", dest: func(c *entity.Comment) any { return &c.EditedAt }},
+	{field: "createdAt", expr: "created_at", dest: func(c *entity.Comment) any { return &c.CreatedAt }},
+	{field: "updatedAt", expr: "updated_at", dest: func(c *entity.Comment) any { return &c.UpdatedAt }},
+	{field: "version", expr: "version", dest: func(c *entity.Comment) any { return &c.Version }},
+}
+
+var allCommentColumns = selectColumns(commentColumns, nil)
+
+// commentSelection picks the columns of a page of comments: the requested
+// ones, the id and creation time cursors are made from, the author for an
+// embedded author and the edit time the edited flag is made from.
+func commentSelection(params *entity.Pagination) selection[entity.Comment] {
+	required := []string{"id", "createdAt"}
+	if params.Fields.Has("author") {
+		required = append(required, "authorId")
+	}
+	if params.Fields.Has("edited") {
+		required = append(required, "editedAt")
+	}
+
+	return selectColumns(commentColumns, params.Fields, required...)
+}
+
+func (r *CommentRepository) scanComments(rows *sql.Rows, columns selection[entity.Comment]) ([]*entity.Comment, error) {
+	var comments []*entity.Comment
+	for rows.Next() {
+		var comment entity.Comment
+		if err := rows.Scan(columns.dests(&comment)...); err != nil {
+			r.logger.WithError(err).Error("Failed to scan comment")
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comment.Edited = comment.EditedAt != nil
+		comments = append(comments, &comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return comments, nil
+}
+
+// commentKeyset maps a pagination sort value onto the columns comments are
+// ordered by, and the cursor, if any, onto their values.
+func commentKeyset(params *entity.Pagination) (keyset, []any, error) {
+	desc, err := entity.ParseCommentSort(params.Sort)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := sortedBy(desc, "created_at", "id")
+
+	if params.Cursor == nil {
+		return keys, nil, nil
+	}
+	return keys, []any{params.Cursor.CreatedAt, params.Cursor.Id}, nil
+}
@@ -48,11 +48,11 @@ func TestCommentRepository_CreateComment_Success(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			rows := sqlmock.NewRows([]string{"id", "post_id", "author_id", "content", "created_at", "updated_at"}).
-				AddRow(tt.expectedID, tt.newComment.PostId, tt.newComment.AuthorId, tt.newComment.Content, time.Now(), time.Now())
+			rows := sqlmock.NewRows([]string{"id", "post_id", "parent_id", "author_id", "content", "status", "edited_at", "created_at", "updated_at", "version"}).
+				AddRow(tt.expectedID, tt.newComment.PostId, nil, tt.newComment.AuthorId, tt.newComment.Content, entity.CommentStatusApproved, nil, time.Now(), time.Now(), 1)
 
 			mock.ExpectQuery("INSERT INTO comments").
-				WithArgs(sqlmock.AnyArg(), tt.newComment.PostId, tt.newComment.AuthorId, tt.newComment.Content).
+				WithArgs(sqlmock.AnyArg(), tt.newComment.PostId, tt.newComment.AuthorId, tt.newComment.Content, entity.CommentStatusApproved, nil).
 				WillReturnRows(rows)
 
 			comment, err := repo.CreateComment(context.Background(), tt.newComment)
@@ -136,7 +136,7 @@ func TestCommentRepository_CreateComment_Failed(t *testing.T) {
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
 			mock.ExpectQuery("INSERT INTO comments").
-				WithArgs(sqlmock.AnyArg(), tt.newComment.PostId, tt.newComment.AuthorId, tt.newComment.Content).
+				WithArgs(sqlmock.AnyArg(), tt.newComment.PostId, tt.newComment.AuthorId, tt.newComment.Content, entity.CommentStatusApproved, nil).
 				WillReturnError(tt.expectedErr)
 
 			comment, err := repo.CreateComment(context.Background(), tt.newComment)
@@ -200,8 +200,8 @@ func TestCommentRepository_GetCommentById_Success(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			rows := sqlmock.NewRows([]string{"id", "post_id", "author_id", "content", "created_at", "updated_at"}).
-				AddRow(tt.expectedComment.Id, tt.expectedComment.PostId, tt.expectedComment.AuthorId, tt.expectedComment.Content, time.Now(), time.Now())
+			rows := sqlmock.NewRows([]string{"id", "post_id", "parent_id", "author_id", "content", "status", "edited_at", "created_at", "updated_at", "version"}).
+				AddRow(tt.expectedComment.Id, tt.expectedComment.PostId, nil, tt.expectedComment.AuthorId, tt.expectedComment.Content, entity.CommentStatusApproved, nil, time.Now(), time.Now(), 1)
 
 			mock.ExpectQuery("SELECT (.+) FROM comments WHERE id = \\$1").
 				WithArgs(tt.commentID).
@@ -215,10 +215,35 @@ func TestCommentRepository_GetCommentById_Success(t *testing.T) {
 			assert.Equal(t, tt.expectedComment.PostId, comment.PostId)
 			assert.Equal(t, tt.expectedComment.AuthorId, comment.AuthorId)
 			assert.Equal(t, tt.expectedComment.Content, comment.Content)
+			assert.Equal(t, 1, comment.Version)
 		})
 	}
 }
 
+func TestCommentRepository_GetCommentById_Edited(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	logger := logrus.New()
+	repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
+
+	editedAt := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "post_id", "parent_id", "author_id", "content", "status", "edited_at", "created_at", "updated_at", "version"}).
+		AddRow(commentId1, postId1, nil, userId1, "Edited comment", entity.CommentStatusApproved, editedAt, editedAt.Add(-time.Minute), editedAt, 1)
+
+	mock.ExpectQuery("SELECT (.+) FROM comments WHERE id = \\$1").
+		WithArgs(commentId1).
+		WillReturnRows(rows)
+
+	comment, err := repo.GetCommentById(context.Background(), commentId1)
+
+	assert.NoError(t, err)
+	assert.True(t, comment.Edited)
+	assert.NotNil(t, comment.EditedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestCommentRepository_GetCommentsByPostIdWithPagination_Success(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -240,19 +265,15 @@ func TestCommentRepository_GetCommentsByPostIdWithPagination_Success(t *testing.
 			totalCount:  15,
 			expectedErr: nil,
 			setupMock: func(mock sqlmock.Sqlmock, postID uuid.UUID, pagination *entity.Pagination, expectedLen int, totalCount int) {
-				rows := sqlmock.NewRows([]string{"id", "post_id", "author_id", "content", "created_at", "updated_at"})
+				rows := sqlmock.NewRows([]string{"id", "post_id", "parent_id", "author_id", "content", "status", "edited_at", "created_at", "updated_at", "version"})
 				for i := 0; i < expectedLen; i++ {
-					rows.AddRow(uuid.New(), postID, uuid.New(), fmt.Sprintf("Test comment %d", i+1), time.Now(), time.Now())
+					rows.AddRow(uuid.New(), postID, nil, uuid.New(), fmt.Sprintf("Test comment %d", i+1), entity.CommentStatusApproved, nil, time.Now(), time.Now(), 1)
 				}
 
 				offset := (pagination.Page - 1) * pagination.Limit
-				mock.ExpectQuery("SELECT (.+) FROM comments WHERE post_id = \\$1 ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
-					WithArgs(postID, pagination.Limit, offset).
+				mock.ExpectQuery("SELECT (.+) FROM comments WHERE post_id = \\$1 AND (.+) ORDER BY created_at DESC, id DESC LIMIT \\$3 OFFSET \\$4").
+					WithArgs(postID, uuid.Nil, pagination.Limit, offset).
 					WillReturnRows(rows)
-
-				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM comments WHERE post_id = \\$1").
-					WithArgs(postID).
-					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(totalCount))
 			},
 		},
 	}
@@ -268,14 +289,13 @@ func TestCommentRepository_GetCommentsByPostIdWithPagination_Success(t *testing.
 
 			tt.setupMock(mock, tt.postID, tt.pagination, tt.expectedLen, tt.totalCount)
 
-			commentList, err := repo.GetComments(context.Background(), tt.postID, tt.pagination)
+			commentList, err := repo.GetComments(context.Background(), tt.postID, uuid.Nil, tt.pagination)
 
 			assert.Equal(t, tt.expectedErr, err)
 			if tt.expectedErr == nil {
 				assert.NotNil(t, commentList)
-				assert.Len(t, commentList.Comments, tt.expectedLen)
-				assert.Equal(t, int64(tt.totalCount), commentList.Pagination.Total)
-				for _, comment := range commentList.Comments {
+				assert.Len(t, commentList, tt.expectedLen)
+				for _, comment := range commentList {
 					assert.NotEqual(t, uuid.Nil, comment.Id)
 					assert.Equal(t, tt.postID, comment.PostId)
 					assert.NotEqual(t, uuid.Nil, comment.AuthorId)
@@ -292,6 +312,29 @@ func TestCommentRepository_GetCommentsByPostIdWithPagination_Success(t *testing.
 	}
 }
 
+func TestCommentRepository_GetComments_Fields(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	mock.ExpectQuery(`SELECT id, content, edited_at, created_at FROM comments\s+WHERE post_id = \$1`).
+		WithArgs(postId1, uuid.Nil, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "content", "edited_at", "created_at"}).
+			AddRow(commentId1, "Nice post", time.Now(), time.Now()))
+
+	comments, err := repo.GetComments(context.Background(), postId1, uuid.Nil, &entity.Pagination{
+		Limit:  10,
+		Fields: entity.Fields{"content", "edited"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	assert.True(t, comments[0].Edited)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestCommentRepository_GetCommentsByPostIdWithPagination_Failed(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -317,15 +360,6 @@ func TestCommentRepository_GetCommentsByPostIdWithPagination_Failed(t *testing.T
 			},
 			expectedErr: errors.New("invalid post ID"),
 		},
-		{
-			name:   "Failed get comments by post ID with pagination - count query error",
-			postID: postId2,
-			pagination: &entity.Pagination{
-				Page:  1,
-				Limit: 10,
-			},
-			expectedErr: errors.New("count query error"),
-		},
 	}
 
 	for _, tt := range tests {
@@ -337,21 +371,11 @@ func TestCommentRepository_GetCommentsByPostIdWithPagination_Failed(t *testing.T
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			if tt.name == "Failed get comments by post ID with pagination - count query error" {
-				mock.ExpectQuery("SELECT (.+) FROM comments WHERE post_id = \\$1 ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
-					WithArgs(tt.postID, tt.pagination.Limit, 0).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "post_id", "author_id", "content", "created_at", "updated_at"}))
-
-				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM comments WHERE post_id = \\$1").
-					WithArgs(tt.postID).
-					WillReturnError(tt.expectedErr)
-			} else {
-				mock.ExpectQuery("SELECT (.+) FROM comments WHERE post_id = \\$1 ORDER BY created_at DESC LIMIT \\$2 OFFSET \\$3").
-					WithArgs(tt.postID, tt.pagination.Limit, 0).
-					WillReturnError(tt.expectedErr)
-			}
+			mock.ExpectQuery("SELECT (.+) FROM comments WHERE post_id = \\$1 AND (.+) ORDER BY created_at DESC, id DESC LIMIT \\$3 OFFSET \\$4").
+				WithArgs(tt.postID, uuid.Nil, tt.pagination.Limit, 0).
+				WillReturnError(tt.expectedErr)
 
-			commentList, err := repo.GetComments(context.Background(), tt.postID, tt.pagination)
+			commentList, err := repo.GetComments(context.Background(), tt.postID, uuid.Nil, tt.pagination)
 
 			assert.Error(t, err)
 			assert.Nil(t, commentList)
@@ -418,13 +442,14 @@ func TestCommentRepository_UpdateComment_Success(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			mock.ExpectExec("UPDATE comments SET content = \\$1, updated_at = NOW\\(\\) WHERE id = \\$2").
-				WithArgs(tt.comment.Content, tt.comment.Id).
-				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery("UPDATE comments SET content = \\$1, status = COALESCE\\(NULLIF\\(\\$4, ''\\), status\\), edited_at = NOW\\(\\), updated_at = NOW\\(\\), version = version \\+ 1 WHERE id = \\$2 AND \\(\\$3 = 0 OR version = \\$3\\) RETURNING version").
+				WithArgs(tt.comment.Content, tt.comment.Id, entity.AnyVersion, tt.comment.Status).
+				WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 			err = repo.UpdateComment(context.Background(), tt.comment)
 
 			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, 2, tt.comment.Version)
 		})
 	}
 }
@@ -462,8 +487,8 @@ func TestCommentRepository_UpdateComment_Failed(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			mock.ExpectExec("UPDATE comments SET content = \\$1, updated_at = NOW\\(\\) WHERE id = \\$2").
-				WithArgs(tt.comment.Content, tt.comment.Id).
+			mock.ExpectQuery("UPDATE comments SET content = \\$1, status = COALESCE\\(NULLIF\\(\\$4, ''\\), status\\), edited_at = NOW\\(\\), updated_at = NOW\\(\\), version = version \\+ 1 WHERE id = \\$2 AND \\(\\$3 = 0 OR version = \\$3\\) RETURNING version").
+				WithArgs(tt.comment.Content, tt.comment.Id, entity.AnyVersion, tt.comment.Status).
 				WillReturnError(tt.expectedErr)
 
 			err = repo.UpdateComment(context.Background(), tt.comment)
@@ -501,11 +526,11 @@ func TestCommentRepository_DeleteCommentById_Success(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			mock.ExpectExec("DELETE FROM comments WHERE id = \\$1").
-				WithArgs(tt.commentID).
+			mock.ExpectExec("DELETE FROM comments WHERE id = \\$1 AND \\(\\$2 = 0 OR version = \\$2\\)").
+				WithArgs(tt.commentID, entity.AnyVersion).
 				WillReturnResult(sqlmock.NewResult(1, 1))
 
-			err = repo.DeleteCommentById(context.Background(), tt.commentID)
+			err = repo.DeleteCommentById(context.Background(), tt.commentID, entity.AnyVersion)
 
 			assert.Equal(t, tt.expectedErr, err)
 		})
@@ -539,11 +564,11 @@ func TestCommentRepository_DeleteCommentById_Failed(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			mock.ExpectExec("DELETE FROM comments WHERE id = \\$1").
-				WithArgs(tt.commentID).
+			mock.ExpectExec("DELETE FROM comments WHERE id = \\$1 AND \\(\\$2 = 0 OR version = \\$2\\)").
+				WithArgs(tt.commentID, entity.AnyVersion).
 				WillReturnError(tt.expectedErr)
 
-			err = repo.DeleteCommentById(context.Background(), tt.commentID)
+			err = repo.DeleteCommentById(context.Background(), tt.commentID, entity.AnyVersion)
 
 			assert.Error(t, err)
 			assert.Contains(t, err.Error(), tt.expectedErr.Error())
@@ -593,11 +618,11 @@ func TestCommentRepository_GetTotalCommentsByPostID_Success(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM comments WHERE post_id = \\$1").
-				WithArgs(tt.postID).
+			mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM comments WHERE post_id = \\$1 AND (.+)").
+				WithArgs(tt.postID, userId1).
 				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tt.totalCount))
 
-			total, err := repo.GetTotalCommentsByPostID(context.Background(), tt.postID)
+			total, err := repo.GetTotalCommentsByPostID(context.Background(), tt.postID, userId1)
 
 			assert.Equal(t, tt.expectedErr, err)
 			assert.Equal(t, tt.totalCount, total)
@@ -632,11 +657,11 @@ func TestCommentRepository_GetTotalCommentsByPostID_Failed(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM comments WHERE post_id = \\$1").
-				WithArgs(tt.postID).
+			mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM comments WHERE post_id = \\$1 AND (.+)").
+				WithArgs(tt.postID, userId1).
 				WillReturnError(tt.expectedErr)
 
-			total, err := repo.GetTotalCommentsByPostID(context.Background(), tt.postID)
+			total, err := repo.GetTotalCommentsByPostID(context.Background(), tt.postID, userId1)
 
 			assert.Error(t, err)
 			assert.Zero(t, total)
@@ -644,3 +669,90 @@ func TestCommentRepository_GetTotalCommentsByPostID_Failed(t *testing.T) {
 		})
 	}
 }
+
+func TestCommentRepository_UpdateCommentsStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		ids           []uuid.UUID
+		status        entity.CommentStatus
+		rowsAffected  int64
+		dbErr         error
+		expectedCount int64
+		expectedErr   string
+	}{
+		{
+			name:          "Approve several comments",
+			ids:           []uuid.UUID{commentId1, commentId2},
+			status:        entity.CommentStatusApproved,
+			rowsAffected:  2,
+			expectedCount: 2,
+		},
+		{
+			name:          "Mark unknown comment as spam",
+			ids:           []uuid.UUID{uuid.New()},
+			status:        entity.CommentStatusSpam,
+			rowsAffected:  0,
+			expectedCount: 0,
+		},
+		{
+			name:        "Database error",
+			ids:         []uuid.UUID{commentId1},
+			status:      entity.CommentStatusRejected,
+			dbErr:       errors.New("database error"),
+			expectedErr: "failed to update comments status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			logger := logrus.New()
+			repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
+
+			expectation := mock.ExpectExec("UPDATE comments SET status = \\$1, moderated_by = \\$2, moderated_at = NOW\\(\\), updated_at = NOW\\(\\), version = version \\+ 1 WHERE id = ANY\\(\\$3\\)").
+				WithArgs(tt.status, userId1, sqlmock.AnyArg())
+			if tt.dbErr != nil {
+				expectation.WillReturnError(tt.dbErr)
+			} else {
+				expectation.WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+			}
+
+			updated, err := repo.UpdateCommentsStatus(context.Background(), tt.ids, tt.status, userId1)
+
+			if tt.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCount, updated)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCommentRepository_GetCommentsByStatus(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	logger := logrus.New()
+	repo := postgres.NewCommentRepository(&db.PostgresDB{DB: mockDB}, logger)
+
+	rows := sqlmock.NewRows([]string{"id", "post_id", "parent_id", "author_id", "content", "status", "edited_at", "created_at", "updated_at", "version"}).
+		AddRow(commentId1, postId1, nil, userId1, "Waiting for review", entity.CommentStatusPending, nil, time.Now(), time.Now(), 1)
+
+	mock.ExpectQuery("SELECT (.+) FROM comments WHERE status = \\$1 ORDER BY created_at ASC, id ASC LIMIT \\$2 OFFSET \\$3").
+		WithArgs(entity.CommentStatusPending, 10, 0).
+		WillReturnRows(rows)
+
+	comments, err := repo.GetCommentsByStatus(context.Background(), entity.CommentStatusPending, &entity.Pagination{Page: 1, Limit: 10, Sort: "created_at_asc"})
+
+	assert.NoError(t, err)
+	assert.Len(t, comments, 1)
+	assert.Equal(t, entity.CommentStatusPending, comments[0].Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,15 +1,115 @@
 package postgres
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
 
 func parseSortParam(sortParam string) (string, string) {
-	sortParts := strings.Split(sortParam, "_")
-	if len(sortParts) == 2 {
-		field := sortParts[0]
-		order := sortParts[1]
+	// Split at the last underscore: field names such as created_at have
+	// their own.
+	if i := strings.LastIndex(sortParam, "_"); i > 0 {
+		field, order := sortParam[:i], sortParam[i+1:]
 		if order == "asc" || order == "desc" {
 			return field, order
 		}
 	}
 	return "created_at", "desc"
 }
+
+// sortColumn is one level of the order of a cursor-paginated list.
+type sortColumn struct {
+	expr string
+	desc bool
+}
+
+// keyset is the order of a cursor-paginated list. It ends with id so that
+// every row has a distinct position.
+type keyset []sortColumn
+
+// sortedBy returns a keyset of columns that all sort the same direction.
+func sortedBy(desc bool, columns ...string) keyset {
+	keys := make(keyset, len(columns))
+	for i, column := range columns {
+		keys[i] = sortColumn{expr: column, desc: desc}
+	}
+	return keys
+}
+
+// paginate appends the cursor condition, ORDER BY and LIMIT/OFFSET to
+// query, which must already have a WHERE clause, and the matching args.
+// after holds the cursor's value for each column, or nil on the first page.
+// Backward cursors flip the order, so the rows come back nearest first.
+func (k keyset) paginate(query string, args []any, params *entity.Pagination, after []any) (string, []any) {
+	backward := params.Cursor != nil && params.Cursor.Backward
+
+	columns := make(keyset, len(k))
+	for i, column := range k {
+		columns[i] = sortColumn{expr: column.expr, desc: column.desc != backward}
+	}
+
+	offset := params.Offset
+	if after != nil {
+		placeholders := make([]string, len(after))
+		for i, value := range after {
+			args = append(args, value)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		query += " AND " + columns.after(placeholders)
+		offset = 0
+	}
+
+	order := make([]string, len(columns))
+	for i, column := range columns {
+		order[i] = column.expr + " " + direction(column.desc)
+	}
+
+	query += fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", strings.Join(order, ", "), len(args)+1, len(args)+2)
+	args = append(args, params.Limit, offset)
+
+	return query, args
+}
+
+// after returns the condition for rows past the position given by the
+// placeholders. A single row comparison does when every column sorts the
+// same way, which lets Postgres use a matching index; mixed orders need the
+// comparison spelled out column by column.
+func (k keyset) after(placeholders []string) string {
+	uniform := true
+	for _, column := range k {
+		uniform = uniform && column.desc == k[0].desc
+	}
+
+	if uniform {
+		exprs := make([]string, len(k))
+		for i, column := range k {
+			exprs[i] = column.expr
+		}
+		return fmt.Sprintf("(%s) %s (%s)", strings.Join(exprs, ", "), comparison(k[0].desc), strings.Join(placeholders, ", "))
+	}
+
+	// (a > $1 OR (a = $1 AND (b < $2 OR (b = $2 AND id > $3))))
+	last := len(k) - 1
+	condition := fmt.Sprintf("%s %s %s", k[last].expr, comparison(k[last].desc), placeholders[last])
+	for i := last - 1; i >= 0; i-- {
+		condition = fmt.Sprintf("(%s %s %s OR (%s = %s AND %s))",
+			k[i].expr, comparison(k[i].desc), placeholders[i], k[i].expr, placeholders[i], condition)
+	}
+	return condition
+}
+
+func direction(desc bool) string {
+	if desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+func comparison(desc bool) string {
+	if desc {
+		return "<"
+	}
+	return ">"
+}
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+// StreamEventsChannel is the LISTEN/NOTIFY channel that announces new stream
+// events. The payload is the event id; listeners read the rows themselves.
+const StreamEventsChannel = "stream_events"
+
+// StreamSignalsChannel carries signals: events that are delivered to current
+// subscribers and never stored. The payload is the whole event.
+const StreamSignalsChannel = "stream_signals"
+
+type EventRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewEventRepository(db *db.PostgresDB, logger *logrus.Logger) *EventRepository {
+	return &EventRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// AppendEvent stores the event and notifies every listening replica. The
+// notification is only delivered once the row is committed, so listeners
+// never miss it when they read the table. Called within a unit of work, the
+// event is part of its transaction.
+//
+// Readers keep the highest id they have seen, which only works if ids become
+// visible in order. A sequence doesn't promise that across transactions, so
+// appends take a transaction level lock: the next id is only drawn once the
+// transaction holding the previous one has ended.
+func (r *EventRepository) AppendEvent(ctx context.Context, event *entity.Event) error {
+	return r.db.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := r.db.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('stream_events'))`); err != nil {
+			r.logger.WithError(err).Error("Failed to lock stream events")
+			return fmt.Errorf("failed to lock stream events: %w", err)
+		}
+
+		query := `
+            INSERT INTO stream_events (topic, type, data, created_at)
+            VALUES ($1, $2, $3, NOW())
+            RETURNING id, created_at
+        `
+
+		if err := r.db.QueryRowContext(ctx, query, event.Topic, event.Type, []byte(event.Data)).Scan(&event.Id, &event.CreatedAt); err != nil {
+			r.logger.WithError(err).Error("Failed to append event")
+			return fmt.Errorf("failed to append event: %w", err)
+		}
+
+		if _, err := r.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, StreamEventsChannel, strconv.FormatInt(event.Id, 10)); err != nil {
+			r.logger.WithError(err).Error("Failed to notify about event")
+			return fmt.Errorf("failed to notify about event: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// SignalEvent sends the event to every listening replica without storing
+// it. NOTIFY payloads are limited to 8000 bytes, so signals must be small.
+func (r *EventRepository) SignalEvent(ctx context.Context, event *entity.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode signal: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, `SELECT pg_notify($1, $2)`, StreamSignalsChannel, string(payload)); err != nil {
+		r.logger.WithError(err).Error("Failed to send signal")
+		return fmt.Errorf("failed to send signal: %w", err)
+	}
+
+	return nil
+}
+
+// GetEventsAfter returns events with an id above afterID, oldest first. A nil
+// topics slice matches every topic.
+func (r *EventRepository) GetEventsAfter(ctx context.Context, afterID int64, topics []string, limit int) ([]*entity.Event, error) {
+	query := `
+        SELECT id, topic, type, data, created_at
+        FROM stream_events
+        WHERE id > $1 AND ($2::text[] IS NULL OR topic = ANY($2))
+        ORDER BY id
+        LIMIT $3
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, afterID, pq.Array(topics), limit)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get events")
+		return nil, fmt.Errorf("failed to get events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*entity.Event
+	for rows.Next() {
+		var event entity.Event
+		var data []byte
+		if err := rows.Scan(&event.Id, &event.Topic, &event.Type, &data, &event.CreatedAt); err != nil {
+			r.logger.WithError(err).Error("Failed to scan event")
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		event.Data = data
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *EventRepository) GetLatestEventId(ctx context.Context) (int64, error) {
+	var id int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(id), 0) FROM stream_events`).Scan(&id); err != nil {
+		r.logger.WithError(err).Error("Failed to get latest event id")
+		return 0, fmt.Errorf("failed to get latest event id: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetOldestEventId returns the first event still retained, or 0 when there
+// are none.
+func (r *EventRepository) GetOldestEventId(ctx context.Context) (int64, error) {
+	var id int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COALESCE(MIN(id), 0) FROM stream_events`).Scan(&id); err != nil {
+		r.logger.WithError(err).Error("Failed to get oldest event id")
+		return 0, fmt.Errorf("failed to get oldest event id: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *EventRepository) DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM stream_events WHERE created_at < $1`, before)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to delete old events")
+		return 0, fmt.Errorf("failed to delete old events: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
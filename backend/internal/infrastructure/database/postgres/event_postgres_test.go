@@ -0,0 +1,89 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestEventRepository_AppendEvent(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewEventRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	now := time.Now()
+	event := &entity.Event{Topic: entity.PostsTopic, Type: entity.EventPostCreated, Data: json.RawMessage(`{}`)}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT pg_advisory_xact_lock\\(hashtext\\('stream_events'\\)\\)").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("INSERT INTO stream_events \\(topic, type, data, created_at\\)").
+		WithArgs(entity.PostsTopic, entity.EventPostCreated, []byte(`{}`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(42, now))
+	mock.ExpectExec("SELECT pg_notify\\(\\$1, \\$2\\)").
+		WithArgs(postgres.StreamEventsChannel, "42").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.AppendEvent(context.Background(), event)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), event.Id)
+	assert.Equal(t, now, event.CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_SignalEvent(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewEventRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	event := &entity.Event{Topic: entity.PostsTopic, Type: entity.EventPostUpdated, Data: json.RawMessage(`{}`)}
+
+	// Signals are never stored, only sent.
+	mock.ExpectExec("SELECT pg_notify\\(\\$1, \\$2\\)").
+		WithArgs(postgres.StreamSignalsChannel, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.SignalEvent(context.Background(), event)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventRepository_GetEventsAfter(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewEventRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	topics := []string{entity.PostsTopic}
+	now := time.Now()
+	mock.ExpectQuery("SELECT id, topic, type, data, created_at FROM stream_events WHERE id > \\$1 AND \\(\\$2::text\\[\\] IS NULL OR topic = ANY\\(\\$2\\)\\) ORDER BY id LIMIT \\$3").
+		WithArgs(int64(5), pq.Array(topics), 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "topic", "type", "data", "created_at"}).
+			AddRow(6, entity.PostsTopic, entity.EventPostCreated, []byte(`{"id":1}`), now))
+
+	events, err := repo.GetEventsAfter(context.Background(), 5, topics, 100)
+
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, int64(6), events[0].Id)
+	assert.JSONEq(t, `{"id":1}`, string(events[0].Data))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type FeedRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewFeedRepository(db *db.PostgresDB, logger *logrus.Logger) *FeedRepository {
+	return &FeedRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *FeedRepository) GetFeedEntries(ctx context.Context, authorID *uuid.UUID, tag string, limit int) ([]*entity.FeedEntry, error) {
+	query := `
+        SELECT p.id, p.title, p.content, p.author_id, p.created_at, p.updated_at,
+               u.username, COALESCE(NULLIF(up.display_name, ''), u.username),
+               ARRAY(SELECT t.tag FROM post_tags t WHERE t.post_id = p.id ORDER BY t.tag)
+        FROM posts p
+        JOIN users u ON u.id = p.author_id
+        LEFT JOIN user_profiles up ON up.user_id = p.author_id
+        WHERE p.status = 'published'`
+
+	var args []any
+	if authorID != nil {
+		args = append(args, *authorID)
+		query += ` AND p.author_id = $` + strconv.Itoa(len(args))
+	}
+	if tag != "" {
+		args = append(args, tag)
+		query += ` AND EXISTS (SELECT 1 FROM post_tags t WHERE t.post_id = p.id AND t.tag = $` + strconv.Itoa(len(args)) + `)`
+	}
+	args = append(args, limit)
+	query += ` ORDER BY p.created_at DESC LIMIT $` + strconv.Itoa(len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get feed entries")
+		return nil, fmt.Errorf("failed to get feed entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entity.FeedEntry
+	for rows.Next() {
+		var entry entity.FeedEntry
+		var tags pq.StringArray
+		if err := rows.Scan(
+			&entry.Id, &entry.Title, &entry.Content, &entry.AuthorId, &entry.CreatedAt, &entry.UpdatedAt,
+			&entry.AuthorUsername, &entry.AuthorName, &tags,
+		); err != nil {
+			r.logger.WithError(err).Error("Failed to scan feed entry")
+			return nil, fmt.Errorf("failed to scan feed entry: %w", err)
+		}
+		if len(tags) > 0 {
+			entry.Tags = tags
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,90 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestFeedRepository_GetFeedEntries(t *testing.T) {
+	postId := uuid.New()
+	authorId := uuid.New()
+	now := time.Now()
+	columns := []string{"id", "title", "content", "author_id", "created_at", "updated_at", "username", "name", "tags"}
+
+	tests := []struct {
+		name          string
+		authorID      *uuid.UUID
+		tag           string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedTags  []string
+		expectedError string
+	}{
+		{
+			name: "Whole blog",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM posts p\\s+JOIN users u ON u.id = p.author_id\\s+LEFT JOIN user_profiles up ON up.user_id = p.author_id\\s+WHERE p.status = 'published' ORDER BY p.created_at DESC LIMIT \\$1").
+					WithArgs(20).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(postId, "Title", "Content", authorId, now, now, "alice", "Alice", "{go,sql}"))
+			},
+			expectedTags: []string{"go", "sql"},
+		},
+		{
+			name:     "Author and tag",
+			authorID: &authorId,
+			tag:      "go",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("WHERE p.status = 'published' AND p.author_id = \\$1 AND EXISTS \\(SELECT 1 FROM post_tags t WHERE t.post_id = p.id AND t.tag = \\$2\\) ORDER BY p.created_at DESC LIMIT \\$3").
+					WithArgs(authorId, "go", 20).
+					WillReturnRows(sqlmock.NewRows(columns).
+						AddRow(postId, "Title", "Content", authorId, now, now, "alice", "alice", "{}"))
+			},
+		},
+		{
+			name: "Database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM posts p").
+					WillReturnError(errors.New("database error"))
+			},
+			expectedError: "failed to get feed entries: database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewFeedRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			entries, err := repo.GetFeedEntries(context.Background(), tt.authorID, tt.tag, 20)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, entries)
+			} else {
+				assert.NoError(t, err)
+				if assert.Len(t, entries, 1) {
+					assert.Equal(t, postId, entries[0].Id)
+					assert.Equal(t, "alice", entries[0].AuthorUsername)
+					assert.Equal(t, tt.expectedTags, entries[0].Tags)
+				}
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type FollowRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewFollowRepository(db *db.PostgresDB, logger *logrus.Logger) *FollowRepository {
+	return &FollowRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Follow is idempotent: following an author twice keeps a single row. It
+// reports whether a new follow was recorded.
+func (r *FollowRepository) Follow(ctx context.Context, followerID, followeeID uuid.UUID) (bool, error) {
+	query := `
+        INSERT INTO follows (follower_id, followee_id, created_at)
+        VALUES ($1, $2, NOW())
+        ON CONFLICT (follower_id, followee_id) DO NOTHING
+    `
+
+	result, err := r.db.ExecContext(ctx, query, followerID, followeeID)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to follow user")
+		return false, fmt.Errorf("failed to follow user: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *FollowRepository) Unfollow(ctx context.Context, followerID, followeeID uuid.UUID) error {
+	query := `DELETE FROM follows WHERE follower_id = $1 AND followee_id = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, followerID, followeeID); err != nil {
+		r.logger.WithError(err).Error("Failed to unfollow user")
+		return fmt.Errorf("failed to unfollow user: %w", err)
+	}
+
+	return nil
+}
+
+func (r *FollowRepository) GetFollowCounts(ctx context.Context, userID uuid.UUID) (int, int, error) {
+	query := `
+        SELECT
+            (SELECT COUNT(*) FROM follows WHERE followee_id = $1),
+            (SELECT COUNT(*) FROM follows WHERE follower_id = $1)
+    `
+
+	var followers, following int
+	if err := r.db.QueryRowContext(ctx, query, userID).Scan(&followers, &following); err != nil {
+		r.logger.WithError(err).Error("Failed to get follow counts")
+		return 0, 0, fmt.Errorf("failed to get follow counts: %w", err)
+	}
+
+	return followers, following, nil
+}
+
+// GetFeed returns posts by the authors the user follows, newest first. It
+// pages by (created_at, id) rather than OFFSET, so new posts arriving while
+// the reader scrolls neither shift nor repeat entries.
+func (r *FollowRepository) GetFeed(ctx context.Context, followerID uuid.UUID, after *entity.Cursor, limit int) ([]*entity.Post, error) {
+	query := `
+        SELECT p.id, p.title, p.content, p.author_id, p.created_at, p.updated_at
+        FROM posts p
+        JOIN follows f ON f.followee_id = p.author_id
+        WHERE f.follower_id = $1 AND p.status = 'published'`
+	args := []any{followerID}
+
+	if after != nil {
+		query += ` AND (p.created_at, p.id) < ($2, $3)`
+		args = append(args, after.CreatedAt, after.Id)
+	}
+
+	query += fmt.Sprintf(` ORDER BY p.created_at DESC, p.id DESC LIMIT $%d`, len(args)+1)
+	args = append(args, limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get feed")
+		return nil, fmt.Errorf("failed to get feed: %w", err)
+	}
+	defer rows.Close()
+
+	posts := []*entity.Post{}
+	for rows.Next() {
+		var post entity.Post
+		if err := rows.Scan(&post.Id, &post.Title, &post.Content, &post.AuthorId, &post.CreatedAt, &post.UpdatedAt); err != nil {
+			r.logger.WithError(err).Error("Failed to scan post")
+			return nil, fmt.Errorf("failed to scan post: %w", err)
+		}
+		posts = append(posts, &post)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return posts, nil
+}
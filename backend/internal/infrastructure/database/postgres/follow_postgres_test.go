@@ -0,0 +1,81 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestFollowRepository_GetFeed(t *testing.T) {
+	now := time.Now()
+	postColumns := []string{"id", "title", "content", "author_id", "created_at", "updated_at"}
+
+	tests := []struct {
+		name      string
+		after     *entity.Cursor
+		mockSetup func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "First page",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT p.id, .* FROM posts p JOIN follows f ON f.followee_id = p.author_id WHERE f.follower_id = \\$1 AND p.status = 'published' ORDER BY p.created_at DESC, p.id DESC LIMIT \\$2").
+					WithArgs(userId1, 11).
+					WillReturnRows(sqlmock.NewRows(postColumns).AddRow(postId1, "Title", "Content", userId2, now, now))
+			},
+		},
+		{
+			name:  "After a cursor",
+			after: &entity.Cursor{CreatedAt: now, Id: postId2},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("WHERE f.follower_id = \\$1 AND p.status = 'published' AND \\(p.created_at, p.id\\) < \\(\\$2, \\$3\\) ORDER BY p.created_at DESC, p.id DESC LIMIT \\$4").
+					WithArgs(userId1, now, postId2, 11).
+					WillReturnRows(sqlmock.NewRows(postColumns).AddRow(postId1, "Title", "Content", userId2, now, now))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewFollowRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+			tt.mockSetup(mock)
+
+			posts, err := repo.GetFeed(context.Background(), userId1, tt.after, 11)
+
+			assert.NoError(t, err)
+			assert.Len(t, posts, 1)
+			assert.Equal(t, postId1, posts[0].Id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFollowRepository_GetFollowCounts(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewFollowRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	mock.ExpectQuery("SELECT \\(SELECT COUNT\\(\\*\\) FROM follows WHERE followee_id = \\$1\\), \\(SELECT COUNT\\(\\*\\) FROM follows WHERE follower_id = \\$1\\)").
+		WithArgs(userId1).
+		WillReturnRows(sqlmock.NewRows([]string{"followers", "following"}).AddRow(4, 2))
+
+	followers, following, err := repo.GetFollowCounts(context.Background(), userId1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, followers)
+	assert.Equal(t, 2, following)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+const jobColumns = `id, queue, kind, payload, status, attempts, max_attempts, run_at, last_error, created_at, updated_at, finished_at, locked_until`
+
+type JobRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewJobRepository(db *db.PostgresDB, logger *logrus.Logger) *JobRepository {
+	return &JobRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *JobRepository) EnqueueJob(ctx context.Context, job *entity.Job) (bool, error) {
+	query := `
+        INSERT INTO jobs (id, queue, kind, payload, status, max_attempts, run_at, unique_key, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, 'queued', $5, $6, $7, NOW(), NOW())
+        ON CONFLICT (unique_key) WHERE unique_key IS NOT NULL DO NOTHING
+        RETURNING status, created_at, updated_at
+    `
+
+	job.Id = uuid.New()
+	err := r.db.QueryRowContext(ctx, query,
+		job.Id, job.Queue, job.Kind, []byte(job.Payload), job.MaxAttempts, job.RunAt, job.UniqueKey,
+	).Scan(&job.Status, &job.CreatedAt, &job.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		r.logger.WithError(err).WithField("kind", job.Kind).Error("Failed to enqueue job")
+		return false, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	return true, nil
+}
+
+// ClaimJobs uses SKIP LOCKED so that several workers can take jobs from the
+// same queue without waiting for each other.
+func (r *JobRepository) ClaimJobs(ctx context.Context, queue string, kinds []string, limit int, lease time.Duration) ([]*entity.Job, error) {
+	query := `
+        WITH due AS (
+            SELECT id AS due_id
+            FROM jobs
+            WHERE queue = $1 AND kind = ANY($2)
+                AND ((status = 'queued' AND run_at <= NOW()) OR (status = 'running' AND locked_until < NOW()))
+            ORDER BY run_at
+            LIMIT $3
+            FOR UPDATE SKIP LOCKED
+        )
+        UPDATE jobs j
+        SET status = 'running', attempts = j.attempts + 1,
+            locked_until = NOW() + make_interval(secs => $4), updated_at = NOW()
+        FROM due
+        WHERE j.id = due.due_id
+        RETURNING ` + jobColumns
+
+	rows, err := r.db.QueryContext(ctx, query, queue, pq.Array(kinds), limit, lease.Seconds())
+	if err != nil {
+		r.logger.WithError(err).WithField("queue", queue).Error("Failed to claim jobs")
+		return nil, fmt.Errorf("failed to claim jobs: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanJobs(rows)
+}
+
+// SaveJobResult only writes the result of the claim the job came from: the
+// attempt and the lease must still be the job's. Otherwise the lease ran out
+// and another worker owns the job now.
+func (r *JobRepository) SaveJobResult(ctx context.Context, job *entity.Job) error {
+	query := `
+        UPDATE jobs
+        SET status = $2, run_at = $3, last_error = $4, finished_at = $5, locked_until = NULL, updated_at = NOW()
+        WHERE id = $1 AND status = 'running' AND attempts = $6 AND locked_until = $7
+        RETURNING updated_at
+    `
+
+	err := r.db.QueryRowContext(ctx, query,
+		job.Id, job.Status, job.RunAt, job.LastError, job.FinishedAt, job.Attempts, job.LockedUntil,
+	).Scan(&job.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.ErrJobLeaseLost
+		}
+		r.logger.WithError(err).WithField("jobID", job.Id).Error("Failed to save job result")
+		return fmt.Errorf("failed to save job result: %w", err)
+	}
+	job.LockedUntil = nil
+
+	return nil
+}
+
+func (r *JobRepository) GetJob(ctx context.Context, id uuid.UUID) (*entity.Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM jobs WHERE id = $1`
+
+	job, err := scanJob(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("job not found")
+		}
+		r.logger.WithError(err).Error("Failed to get job")
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	return job, nil
+}
+
+// GetJobs returns jobs newest first. An empty status or queue matches every
+// job.
+func (r *JobRepository) GetJobs(ctx context.Context, status entity.JobStatus, queue string, params *entity.Pagination) ([]*entity.Job, error) {
+	query := `
+        SELECT ` + jobColumns + `
+        FROM jobs
+        WHERE ($1 = '' OR status = $1) AND ($2 = '' OR queue = $2)
+        ORDER BY created_at DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, status, queue, params.Limit, params.Offset)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get jobs")
+		return nil, fmt.Errorf("failed to get jobs: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanJobs(rows)
+}
+
+func (r *JobRepository) GetTotalJobs(ctx context.Context, status entity.JobStatus, queue string) (int, error) {
+	query := `SELECT COUNT(*) FROM jobs WHERE ($1 = '' OR status = $1) AND ($2 = '' OR queue = $2)`
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, query, status, queue).Scan(&total); err != nil {
+		r.logger.WithError(err).Error("Failed to get total jobs")
+		return 0, fmt.Errorf("failed to get total jobs: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *JobRepository) RetryJob(ctx context.Context, id uuid.UUID) (*entity.Job, error) {
+	query := `
+        UPDATE jobs
+        SET status = 'queued', attempts = 0, run_at = NOW(), finished_at = NULL, updated_at = NOW()
+        WHERE id = $1 AND status = 'failed'
+        RETURNING ` + jobColumns
+
+	job, err := scanJob(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed job not found")
+		}
+		r.logger.WithError(err).Error("Failed to retry job")
+		return nil, fmt.Errorf("failed to retry job: %w", err)
+	}
+
+	return job, nil
+}
+
+func (r *JobRepository) DeleteFinishedJobs(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM jobs WHERE status = 'succeeded' AND finished_at < $1`, before)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to delete finished jobs")
+		return 0, fmt.Errorf("failed to delete finished jobs: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *JobRepository) scanJobs(rows *sql.Rows) ([]*entity.Job, error) {
+	jobs := []*entity.Job{}
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to scan job")
+			return nil, fmt.Errorf("failed to scan job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return jobs, nil
+}
+
+func scanJob(row rowScanner) (*entity.Job, error) {
+	var job entity.Job
+	var payload []byte
+	err := row.Scan(
+		&job.Id, &job.Queue, &job.Kind, &payload, &job.Status, &job.Attempts, &job.MaxAttempts,
+		&job.RunAt, &job.LastError, &job.CreatedAt, &job.UpdatedAt, &job.FinishedAt, &job.LockedUntil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	job.Payload = payload
+
+	return &job, nil
+}
@@ -0,0 +1,168 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestJobRepository_EnqueueJob(t *testing.T) {
+	key := "schedule:jobs.cleanup:1700000000"
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expected      bool
+		expectedError string
+	}{
+		{
+			name: "Job is queued",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO jobs .* ON CONFLICT \\(unique_key\\) WHERE unique_key IS NOT NULL DO NOTHING").
+					WithArgs(sqlmock.AnyArg(), "default", "jobs.cleanup", []byte(`{}`), 3, sqlmock.AnyArg(), &key).
+					WillReturnRows(sqlmock.NewRows([]string{"status", "created_at", "updated_at"}).
+						AddRow(entity.JobQueued, time.Now(), time.Now()))
+			},
+			expected: true,
+		},
+		{
+			name: "Duplicate key is skipped",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO jobs").
+					WillReturnRows(sqlmock.NewRows([]string{"status", "created_at", "updated_at"}))
+			},
+			expected: false,
+		},
+		{
+			name: "Database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO jobs").
+					WillReturnError(errors.New("database error"))
+			},
+			expectedError: "failed to enqueue job: database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewJobRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			job := &entity.Job{
+				Queue:       "default",
+				Kind:        "jobs.cleanup",
+				Payload:     json.RawMessage(`{}`),
+				MaxAttempts: 3,
+				RunAt:       time.Now(),
+				UniqueKey:   &key,
+			}
+			queued, err := repo.EnqueueJob(context.Background(), job)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, queued)
+				assert.NotEqual(t, uuid.Nil, job.Id)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestJobRepository_ClaimJobs(t *testing.T) {
+	jobId := uuid.New()
+	columns := []string{"id", "queue", "kind", "payload", "status", "attempts", "max_attempts", "run_at",
+		"last_error", "created_at", "updated_at", "finished_at", "locked_until"}
+
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewJobRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	now := time.Now()
+	mock.ExpectQuery("WITH due AS .* FOR UPDATE SKIP LOCKED\\s+\\)\\s+UPDATE jobs j\\s+SET status = 'running', attempts = j.attempts \\+ 1").
+		WithArgs("default", sqlmock.AnyArg(), 5, 90.0).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(
+			jobId, "default", "jobs.cleanup", []byte(`{}`), entity.JobRunning, 1, 3, now, "", now, now, nil, now.Add(90*time.Second),
+		))
+
+	jobs, err := repo.ClaimJobs(context.Background(), "default", []string{"jobs.cleanup"}, 5, 90*time.Second)
+
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 1) {
+		assert.Equal(t, jobId, jobs[0].Id)
+		assert.Equal(t, entity.JobRunning, jobs[0].Status)
+		assert.Equal(t, 1, jobs[0].Attempts)
+		assert.Nil(t, jobs[0].FinishedAt)
+		assert.NotNil(t, jobs[0].LockedUntil)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestJobRepository_SaveJobResult(t *testing.T) {
+	now := time.Now()
+	lease := now.Add(time.Minute)
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Result of the current lease",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE jobs\\s+SET status = \\$2.*WHERE id = \\$1 AND status = 'running' AND attempts = \\$6 AND locked_until = \\$7").
+					WithArgs(sqlmock.AnyArg(), entity.JobSucceeded, sqlmock.AnyArg(), "", sqlmock.AnyArg(), 2, lease).
+					WillReturnRows(sqlmock.NewRows([]string{"updated_at"}).AddRow(now))
+			},
+		},
+		{
+			name: "Lease ran out and the job was claimed again",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE jobs").
+					WillReturnRows(sqlmock.NewRows([]string{"updated_at"}))
+			},
+			expectedError: entity.ErrJobLeaseLost,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewJobRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+			tt.mockSetup(mock)
+
+			job := &entity.Job{Id: uuid.New(), Status: entity.JobSucceeded, Attempts: 2, FinishedAt: &now, LockedUntil: &lease}
+			err = repo.SaveJobResult(context.Background(), job)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Nil(t, job.LockedUntil)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+const subscriberColumns = `id, email, status, confirmed_at, last_digest_at, created_at, updated_at`
+
+type NewsletterRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewNewsletterRepository(db *db.PostgresDB, logger *logrus.Logger) *NewsletterRepository {
+	return &NewsletterRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *NewsletterRepository) UpsertSubscriber(ctx context.Context, email string) (*entity.Subscriber, error) {
+	query := `
+        INSERT INTO newsletter_subscribers (id, email, status, created_at, updated_at)
+        VALUES ($1, $2, 'pending', NOW(), NOW())
+        ON CONFLICT (email) DO UPDATE
+        SET status = CASE WHEN newsletter_subscribers.status = 'unsubscribed' THEN 'pending' ELSE newsletter_subscribers.status END,
+            updated_at = NOW()
+        RETURNING ` + subscriberColumns
+
+	subscriber, err := scanSubscriber(r.db.QueryRowContext(ctx, query, uuid.New(), email))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to upsert subscriber")
+		return nil, fmt.Errorf("failed to upsert subscriber: %w", err)
+	}
+
+	return subscriber, nil
+}
+
+func (r *NewsletterRepository) AddSubscription(ctx context.Context, subscriberID uuid.UUID, authorID *uuid.UUID, tag *string) error {
+	query := `
+        INSERT INTO newsletter_subscriptions (id, subscriber_id, author_id, tag, created_at)
+        VALUES ($1, $2, $3, $4, NOW())
+        ON CONFLICT DO NOTHING
+    `
+
+	if _, err := r.db.ExecContext(ctx, query, uuid.New(), subscriberID, authorID, tag); err != nil {
+		r.logger.WithError(err).WithField("subscriberID", subscriberID).Error("Failed to add subscription")
+		return fmt.Errorf("failed to add subscription: %w", err)
+	}
+
+	return nil
+}
+
+func (r *NewsletterRepository) GetSubscriber(ctx context.Context, id uuid.UUID) (*entity.Subscriber, error) {
+	query := `SELECT ` + subscriberColumns + ` FROM newsletter_subscribers WHERE id = $1`
+
+	subscriber, err := scanSubscriber(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("subscriber not found")
+		}
+		r.logger.WithError(err).Error("Failed to get subscriber")
+		return nil, fmt.Errorf("failed to get subscriber: %w", err)
+	}
+
+	return subscriber, nil
+}
+
+func (r *NewsletterRepository) ConfirmSubscriber(ctx context.Context, id uuid.UUID) (*entity.Subscriber, error) {
+	var subscriber *entity.Subscriber
+	err := r.db.WithinTx(ctx, func(ctx context.Context) error {
+		query := `
+            UPDATE newsletter_subscribers
+            SET status = 'confirmed', confirmed_at = COALESCE(confirmed_at, NOW()), updated_at = NOW()
+            WHERE id = $1
+            RETURNING ` + subscriberColumns
+
+		var err error
+		subscriber, err = scanSubscriber(r.db.QueryRowContext(ctx, query, id))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("subscriber not found")
+			}
+			r.logger.WithError(err).Error("Failed to confirm subscriber")
+			return fmt.Errorf("failed to confirm subscriber: %w", err)
+		}
+
+		if _, err := r.db.ExecContext(ctx, `UPDATE newsletter_subscriptions SET confirmed = TRUE WHERE subscriber_id = $1`, id); err != nil {
+			r.logger.WithError(err).Error("Failed to confirm subscriptions")
+			return fmt.Errorf("failed to confirm subscriptions: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriber, nil
+}
+
+func (r *NewsletterRepository) Unsubscribe(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithinTx(ctx, func(ctx context.Context) error {
+		result, err := r.db.ExecContext(ctx, `UPDATE newsletter_subscribers SET status = 'unsubscribed', updated_at = NOW() WHERE id = $1`, id)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to unsubscribe")
+			return fmt.Errorf("failed to unsubscribe: %w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to check rows affected: %w", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("subscriber not found")
+		}
+
+		if _, err := r.db.ExecContext(ctx, `DELETE FROM newsletter_subscriptions WHERE subscriber_id = $1`, id); err != nil {
+			r.logger.WithError(err).Error("Failed to delete subscriptions")
+			return fmt.Errorf("failed to delete subscriptions: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *NewsletterRepository) GetDigestSubscribers(ctx context.Context, after uuid.UUID, limit int) ([]*entity.Subscriber, error) {
+	query := `
+        SELECT ` + subscriberColumns + `
+        FROM newsletter_subscribers
+        WHERE status = 'confirmed' AND id > $1
+        ORDER BY id
+        LIMIT $2
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, after, limit)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get digest subscribers")
+		return nil, fmt.Errorf("failed to get digest subscribers: %w", err)
+	}
+	defer rows.Close()
+
+	subscribers := []*entity.Subscriber{}
+	for rows.Next() {
+		subscriber, err := scanSubscriber(rows)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to scan subscriber")
+			return nil, fmt.Errorf("failed to scan subscriber: %w", err)
+		}
+		subscribers = append(subscribers, subscriber)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return subscribers, nil
+}
+
+func (r *NewsletterRepository) GetDigestPosts(ctx context.Context, subscriberID uuid.UUID, since, until time.Time, limit int) ([]*entity.Post, error) {
+	query := `
+        SELECT p.id, p.title, p.content, p.author_id, p.created_at, p.updated_at
+        FROM posts p
+        WHERE p.status = 'published' AND p.created_at > $2 AND p.created_at <= $3
+            AND EXISTS (
+                SELECT 1 FROM newsletter_subscriptions s
+                WHERE s.subscriber_id = $1 AND s.confirmed
+                    AND ((s.author_id IS NULL AND s.tag IS NULL)
+                        OR s.author_id = p.author_id
+                        OR s.tag IN (SELECT t.tag FROM post_tags t WHERE t.post_id = p.id))
+            )
+        ORDER BY p.created_at DESC
+        LIMIT $4
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, subscriberID, since, until, limit)
+	if err != nil {
+		r.logger.WithError(err).WithField("subscriberID", subscriberID).Error("Failed to get digest posts")
+		return nil, fmt.Errorf("failed to get digest posts: %w", err)
+	}
+	defer rows.Close()
+
+	posts := []*entity.Post{}
+	for rows.Next() {
+		var post entity.Post
+		if err := rows.Scan(&post.Id, &post.Title, &post.Content, &post.AuthorId, &post.CreatedAt, &post.UpdatedAt); err != nil {
+			r.logger.WithError(err).Error("Failed to scan post")
+			return nil, fmt.Errorf("failed to scan post: %w", err)
+		}
+		posts = append(posts, &post)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return posts, nil
+}
+
+func (r *NewsletterRepository) MarkDigestSent(ctx context.Context, subscriberID uuid.UUID, at time.Time) error {
+	query := `UPDATE newsletter_subscribers SET last_digest_at = $2, updated_at = NOW() WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, subscriberID, at); err != nil {
+		r.logger.WithError(err).WithField("subscriberID", subscriberID).Error("Failed to mark digest sent")
+		return fmt.Errorf("failed to mark digest sent: %w", err)
+	}
+
+	return nil
+}
+
+func scanSubscriber(row rowScanner) (*entity.Subscriber, error) {
+	var subscriber entity.Subscriber
+	err := row.Scan(
+		&subscriber.Id, &subscriber.Email, &subscriber.Status, &subscriber.ConfirmedAt,
+		&subscriber.LastDigestAt, &subscriber.CreatedAt, &subscriber.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriber, nil
+}
@@ -0,0 +1,188 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+var subscriberRowColumns = []string{"id", "email", "status", "confirmed_at", "last_digest_at", "created_at", "updated_at"}
+
+func TestNewsletterRepository_UpsertSubscriber(t *testing.T) {
+	subscriberId := uuid.New()
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedError string
+	}{
+		{
+			name: "Subscriber is upserted",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO newsletter_subscribers .+ ON CONFLICT \\(email\\) DO UPDATE").
+					WithArgs(sqlmock.AnyArg(), "reader@example.com").
+					WillReturnRows(sqlmock.NewRows(subscriberRowColumns).
+						AddRow(subscriberId, "reader@example.com", "pending", nil, nil, now, now))
+			},
+		},
+		{
+			name: "Database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO newsletter_subscribers").
+					WillReturnError(errors.New("database error"))
+			},
+			expectedError: "failed to upsert subscriber: database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewNewsletterRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			subscriber, err := repo.UpsertSubscriber(context.Background(), "reader@example.com")
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, subscriber)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, subscriberId, subscriber.Id)
+				assert.Equal(t, entity.SubscriberPending, subscriber.Status)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNewsletterRepository_Unsubscribe(t *testing.T) {
+	subscriberId := uuid.New()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedError string
+	}{
+		{
+			name: "Subscriptions are dropped",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE newsletter_subscribers SET status = 'unsubscribed'").
+					WithArgs(subscriberId).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("DELETE FROM newsletter_subscriptions WHERE subscriber_id = \\$1").
+					WithArgs(subscriberId).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Subscriber not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE newsletter_subscribers").
+					WithArgs(subscriberId).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			expectedError: "subscriber not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewNewsletterRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			err = repo.Unsubscribe(context.Background(), subscriberId)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestNewsletterRepository_GetDigestPosts(t *testing.T) {
+	subscriberId := uuid.New()
+	postId := uuid.New()
+	authorId := uuid.New()
+	until := time.Now()
+	since := until.Add(-7 * 24 * time.Hour)
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedError string
+		expectedPosts int
+	}{
+		{
+			name: "Only published posts are sent",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM posts p\\s+WHERE p.status = 'published' AND p.created_at > \\$2 AND p.created_at <= \\$3").
+					WithArgs(subscriberId, since, until, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
+						AddRow(postId, "Title", "Content", authorId, until, until))
+			},
+			expectedPosts: 1,
+		},
+		{
+			name: "Database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM posts p").
+					WillReturnError(errors.New("database error"))
+			},
+			expectedError: "failed to get digest posts: database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewNewsletterRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			posts, err := repo.GetDigestPosts(context.Background(), subscriberId, since, until, 10)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, posts)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, posts, tt.expectedPosts)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
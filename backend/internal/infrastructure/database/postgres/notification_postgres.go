@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type NotificationRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewNotificationRepository(db *db.PostgresDB, logger *logrus.Logger) *NotificationRepository {
+	return &NotificationRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *NotificationRepository) CreateNotification(ctx context.Context, notification *entity.Notification) error {
+	query := `
+        INSERT INTO notifications (id, recipient_id, actor_id, type, post_id, comment_id, detail, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+        RETURNING created_at
+    `
+
+	notification.Id = uuid.New()
+	err := r.db.QueryRowContext(ctx, query,
+		notification.Id, notification.RecipientId, notification.ActorId, notification.Type,
+		notification.PostId, notification.CommentId, notification.Detail,
+	).Scan(&notification.CreatedAt)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to create notification")
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	return nil
+}
+
+// GetNotifications returns the recipient's notifications, newest first.
+func (r *NotificationRepository) GetNotifications(ctx context.Context, recipientID uuid.UUID, unreadOnly bool, params *entity.Pagination) ([]*entity.Notification, error) {
+	query := `
+        SELECT id, recipient_id, actor_id, type, post_id, comment_id, detail, read_at, created_at
+        FROM notifications
+        WHERE recipient_id = $1 AND ($2 = FALSE OR read_at IS NULL)
+        ORDER BY created_at DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, recipientID, unreadOnly, params.Limit, params.Offset)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get notifications")
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := []*entity.Notification{}
+	for rows.Next() {
+		var n entity.Notification
+		err := rows.Scan(&n.Id, &n.RecipientId, &n.ActorId, &n.Type, &n.PostId, &n.CommentId, &n.Detail, &n.ReadAt, &n.CreatedAt)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to scan notification")
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		n.Read = n.ReadAt != nil
+		notifications = append(notifications, &n)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (r *NotificationRepository) GetTotalNotifications(ctx context.Context, recipientID uuid.UUID, unreadOnly bool) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE recipient_id = $1 AND ($2 = FALSE OR read_at IS NULL)`
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, query, recipientID, unreadOnly).Scan(&total); err != nil {
+		r.logger.WithError(err).Error("Failed to get total notifications")
+		return 0, fmt.Errorf("failed to get total notifications: %w", err)
+	}
+
+	return total, nil
+}
+
+// MarkRead keeps the first read time when a notification is marked twice.
+func (r *NotificationRepository) MarkRead(ctx context.Context, recipientID, id uuid.UUID) error {
+	query := `UPDATE notifications SET read_at = COALESCE(read_at, NOW()) WHERE id = $1 AND recipient_id = $2`
+
+	result, err := r.db.ExecContext(ctx, query, id, recipientID)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to mark notification as read")
+		return fmt.Errorf("failed to mark notification as read: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("notification not found")
+	}
+
+	return nil
+}
+
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, recipientID uuid.UUID) (int64, error) {
+	query := `UPDATE notifications SET read_at = NOW() WHERE recipient_id = $1 AND read_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, recipientID)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to mark notifications as read")
+		return 0, fmt.Errorf("failed to mark notifications as read: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// GetPreferences returns only the types the user has configured.
+func (r *NotificationRepository) GetPreferences(ctx context.Context, userID uuid.UUID) ([]*entity.NotificationPreference, error) {
+	query := `SELECT type, in_app, email FROM notification_preferences WHERE user_id = $1`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get notification preferences")
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	defer rows.Close()
+
+	var prefs []*entity.NotificationPreference
+	for rows.Next() {
+		var pref entity.NotificationPreference
+		if err := rows.Scan(&pref.Type, &pref.InApp, &pref.Email); err != nil {
+			r.logger.WithError(err).Error("Failed to scan notification preference")
+			return nil, fmt.Errorf("failed to scan notification preference: %w", err)
+		}
+		prefs = append(prefs, &pref)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return prefs, nil
+}
+
+func (r *NotificationRepository) UpsertPreferences(ctx context.Context, userID uuid.UUID, prefs []*entity.NotificationPreference) error {
+	query := `
+        INSERT INTO notification_preferences (user_id, type, in_app, email)
+        SELECT $1, p.type, p.in_app, p.email
+        FROM unnest($2::text[], $3::boolean[], $4::boolean[]) AS p(type, in_app, email)
+        ON CONFLICT (user_id, type) DO UPDATE SET in_app = EXCLUDED.in_app, email = EXCLUDED.email
+    `
+
+	types := make([]string, 0, len(prefs))
+	inApp := make([]bool, 0, len(prefs))
+	email := make([]bool, 0, len(prefs))
+	for _, pref := range prefs {
+		types = append(types, string(pref.Type))
+		inApp = append(inApp, pref.InApp)
+		email = append(email, pref.Email)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, userID, pq.Array(types), pq.Array(inApp), pq.Array(email)); err != nil {
+		r.logger.WithError(err).Error("Failed to save notification preferences")
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return nil
+}
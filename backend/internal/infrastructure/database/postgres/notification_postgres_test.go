@@ -0,0 +1,73 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestNotificationRepository_GetNotifications(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewNotificationRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT id, recipient_id, actor_id, type, post_id, comment_id, detail, read_at, created_at FROM notifications WHERE recipient_id = \\$1 AND \\(\\$2 = FALSE OR read_at IS NULL\\) ORDER BY created_at DESC LIMIT \\$3 OFFSET \\$4").
+		WithArgs(userId1, true, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "recipient_id", "actor_id", "type", "post_id", "comment_id", "detail", "read_at", "created_at",
+		}).AddRow(commentId1, userId1, userId2, "comment", postId1, commentId2, "", nil, now))
+
+	notifications, err := repo.GetNotifications(context.Background(), userId1, true, &entity.Pagination{Limit: 10})
+
+	assert.NoError(t, err)
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, entity.NotificationTypeComment, notifications[0].Type)
+	assert.Equal(t, userId2, *notifications[0].ActorId)
+	assert.False(t, notifications[0].Read)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationRepository_MarkRead(t *testing.T) {
+	tests := []struct {
+		name      string
+		affected  int64
+		expectErr bool
+	}{
+		{name: "Own notification", affected: 1},
+		{name: "Missing or someone else's", affected: 0, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewNotificationRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			mock.ExpectExec("UPDATE notifications SET read_at = COALESCE\\(read_at, NOW\\(\\)\\) WHERE id = \\$1 AND recipient_id = \\$2").
+				WithArgs(commentId1, userId1).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = repo.MarkRead(context.Background(), userId1, commentId1)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type OutboxRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewOutboxRepository(db *db.PostgresDB, logger *logrus.Logger) *OutboxRepository {
+	return &OutboxRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *OutboxRepository) AddEvent(ctx context.Context, event *entity.Event) error {
+	query := `
+        INSERT INTO outbox_events (topic, type, data, created_at)
+        VALUES ($1, $2, $3, NOW())
+        RETURNING id, created_at
+    `
+
+	if err := r.db.QueryRowContext(ctx, query, event.Topic, event.Type, []byte(event.Data)).Scan(&event.Id, &event.CreatedAt); err != nil {
+		r.logger.WithError(err).Error("Failed to add outbox event")
+		return fmt.Errorf("failed to add outbox event: %w", err)
+	}
+
+	return nil
+}
+
+// LockConsumer takes a transaction level advisory lock, so it has to be
+// called within a unit of work.
+func (r *OutboxRepository) LockConsumer(ctx context.Context, consumer string) (bool, error) {
+	var locked bool
+	if err := r.db.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock(hashtext('outbox:' || $1))`, consumer).Scan(&locked); err != nil {
+		r.logger.WithError(err).WithField("consumer", consumer).Error("Failed to lock outbox consumer")
+		return false, fmt.Errorf("failed to lock outbox consumer: %w", err)
+	}
+
+	return locked, nil
+}
+
+// GetPendingEvents looks for events without a consumed row rather than past
+// the last consumed id: ids are assigned before commit, so an event may
+// become visible after events with higher ids.
+func (r *OutboxRepository) GetPendingEvents(ctx context.Context, consumer string, limit int) ([]*entity.Event, error) {
+	query := `
+        SELECT e.id, e.topic, e.type, e.data, e.created_at
+        FROM outbox_events e
+        WHERE NOT EXISTS (
+            SELECT 1 FROM outbox_consumed c WHERE c.consumer = $1 AND c.event_id = e.id
+        )
+        ORDER BY e.id
+        LIMIT $2
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, consumer, limit)
+	if err != nil {
+		r.logger.WithError(err).WithField("consumer", consumer).Error("Failed to get pending outbox events")
+		return nil, fmt.Errorf("failed to get pending outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*entity.Event
+	for rows.Next() {
+		var event entity.Event
+		var data []byte
+		if err := rows.Scan(&event.Id, &event.Topic, &event.Type, &data, &event.CreatedAt); err != nil {
+			r.logger.WithError(err).Error("Failed to scan outbox event")
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		event.Data = data
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkConsumed(ctx context.Context, consumer string, eventIDs []int64) error {
+	query := `
+        INSERT INTO outbox_consumed (consumer, event_id, consumed_at)
+        SELECT $1, event_id, NOW() FROM unnest($2::bigint[]) AS event_id
+        ON CONFLICT (consumer, event_id) DO NOTHING
+    `
+
+	if _, err := r.db.ExecContext(ctx, query, consumer, pq.Array(eventIDs)); err != nil {
+		r.logger.WithError(err).WithField("consumer", consumer).Error("Failed to mark outbox events consumed")
+		return fmt.Errorf("failed to mark outbox events consumed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *OutboxRepository) DeleteConsumedEvents(ctx context.Context, consumers []string, before time.Time) (int64, error) {
+	query := `
+        DELETE FROM outbox_events e
+        WHERE e.created_at < $2
+          AND (SELECT COUNT(*) FROM outbox_consumed c WHERE c.event_id = e.id AND c.consumer = ANY($1)) = cardinality($1::text[])
+    `
+
+	result, err := r.db.ExecContext(ctx, query, pq.Array(consumers), before)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to delete consumed outbox events")
+		return 0, fmt.Errorf("failed to delete consumed outbox events: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
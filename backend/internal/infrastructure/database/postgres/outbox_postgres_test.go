@@ -0,0 +1,82 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestOutboxRepository_AddEventInUnitOfWork(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	database := &db.PostgresDB{DB: mockDB, Logger: logrus.New()}
+	repo := postgres.NewOutboxRepository(database, logrus.New())
+	uow := postgres.NewUnitOfWork(database)
+
+	now := time.Now()
+	event := &entity.Event{Topic: entity.PostsTopic, Type: entity.EventPostCreated, Data: []byte(`{}`)}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO outbox_events \\(topic, type, data, created_at\\)").
+		WithArgs(entity.PostsTopic, entity.EventPostCreated, []byte(`{}`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(9, now))
+	mock.ExpectCommit()
+
+	err = uow.Do(context.Background(), func(ctx context.Context) error {
+		return repo.AddEvent(ctx, event)
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9), event.Id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_GetPendingEvents(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewOutboxRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT e.id, e.topic, e.type, e.data, e.created_at\\s+FROM outbox_events e\\s+WHERE NOT EXISTS").
+		WithArgs("stream", 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "topic", "type", "data", "created_at"}).
+			AddRow(3, entity.PostsTopic, entity.EventPostCreated, []byte(`{"id":1}`), now))
+
+	events, err := repo.GetPendingEvents(context.Background(), "stream", 100)
+
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, int64(3), events[0].Id)
+	assert.JSONEq(t, `{"id":1}`, string(events[0].Data))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOutboxRepository_MarkConsumed(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewOutboxRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	mock.ExpectExec("INSERT INTO outbox_consumed \\(consumer, event_id, consumed_at\\)").
+		WithArgs("webhooks", pq.Array([]int64{1, 2})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.MarkConsumed(context.Background(), "webhooks", []int64{1, 2})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
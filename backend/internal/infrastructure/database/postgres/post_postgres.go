@@ -3,14 +3,22 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	"github.com/popeskul/awesome-blog/backend/pkg/db"
 )
 
+const postReactionCount = "(SELECT COUNT(*) FROM reactions WHERE target_type = 'post' AND target_id = posts.id)"
+
 type PostRepository struct {
 	db     *db.PostgresDB
 	logger *logrus.Logger
@@ -24,15 +32,20 @@ func NewPostRepository(db *db.PostgresDB, logger *logrus.Logger) *PostRepository
 }
 
 func (r *PostRepository) CreatePost(ctx context.Context, post *entity.NewPost) (*entity.Post, error) {
-	query := `INSERT INTO posts (id, title, content, author_id, created_at, updated_at) 
-              VALUES ($1, $2, $3, $4, NOW(), NOW()) 
-              RETURNING id, title, content, author_id, created_at, updated_at`
+	query := `INSERT INTO posts (id, title, content, author_id, status, created_at, updated_at) 
+              VALUES ($1, $2, $3, $4, $5, NOW(), NOW()) 
+              RETURNING id, title, content, author_id, status, created_at, updated_at, version`
+
+	status := post.Status
+	if status == "" {
+		status = entity.PostStatusPublished
+	}
 
 	postID := uuid.New()
 	var createdPost entity.Post
-	err := r.db.QueryRowContext(ctx, query, postID, post.Title, post.Content, post.AuthorId).Scan(
+	err := r.db.QueryRowContext(ctx, query, postID, post.Title, post.Content, post.AuthorId, status).Scan(
 		&createdPost.Id, &createdPost.Title, &createdPost.Content,
-		&createdPost.AuthorId, &createdPost.CreatedAt, &createdPost.UpdatedAt,
+		&createdPost.AuthorId, &createdPost.Status, &createdPost.CreatedAt, &createdPost.UpdatedAt, &createdPost.Version,
 	)
 
 	if err != nil {
@@ -44,10 +57,10 @@ func (r *PostRepository) CreatePost(ctx context.Context, post *entity.NewPost) (
 }
 
 func (r *PostRepository) GetPostById(ctx context.Context, id uuid.UUID) (*entity.Post, error) {
-	query := `SELECT id, title, content, author_id, created_at, updated_at FROM posts WHERE id = $1`
+	query := `SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE id = $1`
 	var post entity.Post
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&post.Id, &post.Title, &post.Content, &post.AuthorId, &post.CreatedAt, &post.UpdatedAt,
+		&post.Id, &post.Title, &post.Content, &post.AuthorId, &post.Status, &post.CreatedAt, &post.UpdatedAt, &post.Version,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -59,43 +72,102 @@ func (r *PostRepository) GetPostById(ctx context.Context, id uuid.UUID) (*entity
 	return &post, nil
 }
 
-func (r *PostRepository) GetAll(ctx context.Context, params *entity.Pagination) ([]*entity.Post, error) {
-	query := `SELECT id, title, content, author_id, created_at, updated_at FROM posts`
-
+// GetAll returns a page of the posts that match filter. Unpublished posts
+// are only included for their author, the filter's viewer.
+func (r *PostRepository) GetAll(ctx context.Context, filter *entity.PostFilter, params *entity.Pagination) ([]*entity.Post, error) {
 	r.logger.WithField("params", params).Info("GetAll posts")
 
-	if params.Sort != "" {
-		r.logger.Info("params.Sort", params.Sort)
-
-		sortField, sortOrder := "", ""
-
-		switch params.Sort {
-		case "created_at_asc":
-			sortField = "created_at"
-			sortOrder = "asc"
-		case "created_at_desc":
-			sortField = "created_at"
-			sortOrder = "desc"
-		case "title_asc":
-			sortField = "title"
-			sortOrder = "asc"
-		case "title_desc":
-			sortField = "title"
-			sortOrder = "desc"
-		default:
-			return nil, fmt.Errorf("invalid sort format: %s", params.Sort)
+	keys, after, err := postKeyset(params)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := postSelection(params)
+	query, args := filterPosts(`SELECT `+columns.columns()+` FROM posts WHERE TRUE`, filter).build()
+	query, args = keys.paginate(query, args, params, after)
+
+	r.logger.WithField("query", query).Info("Final query")
+
+	return r.queryPosts(ctx, columns, query, args...)
+}
+
+// postColumns are the columns of posts by attribute, in the order they are
+// read. An excerpt is made from the start of the content only.
+var postColumns = []column[entity.Post]{
+	{field: "id", expr: "id", dest: func(p *entity.Post) any { return &p.Id }},
+	{field: "title", expr: "title", dest: func(p *entity.Post) any { return &p.Title }},
+	{field: "content", expr: "content", dest: func(p *entity.Post) any { return &p.Content }},
+	{
+		field:     entity.FieldExcerpt,
+		expr:      fmt.Sprintf("LEFT(content, %d)", entity.ExcerptSourceLength),
+		dest:      func(p *entity.Post) any { return &p.Excerpt },
+		onRequest: true,
+	},
+	{field: "authorId", expr: "author_id", dest: func(p *entity.Post) any { return &p.AuthorId }},
+	{field: "status", expr: "status", dest: func(p *entity.Post) any { return &p.Status }},
+	{field: "createdAt", expr: "created_at", dest: func(p *entity.Post) any { return &p.CreatedAt }},
+	{field: "updatedAt", expr: "updated_at", dest: func(p *entity.Post) any { return &p.UpdatedAt }},
+	{field: "version", expr: "version", dest: func(p *entity.Post) any { return &p.Version }},
+}
+
+// postSelection picks the columns of a page of posts: the requested ones,
+// the id and creation time and sort keys cursors are made from, and the
+// author for an embedded author.
+func postSelection(params *entity.Pagination) selection[entity.Post] {
+	required := []string{"id", "createdAt"}
+	if params.Fields.Has("author") {
+		required = append(required, "authorId")
+	}
+
+	sortFields, _ := entity.ParsePostSort(params.Sort)
+	for _, field := range sortFields {
+		switch field.Field {
+		case entity.PostSortTitle:
+			required = append(required, "title")
+		case entity.PostSortUpdatedAt:
+			required = append(required, "updatedAt")
 		}
+	}
+
+	return selectColumns(postColumns, params.Fields, required...)
+}
 
-		query += fmt.Sprintf(" ORDER BY %s %s", sortField, sortOrder)
-	} else {
-		query += " ORDER BY created_at DESC"
+// filterPosts adds the conditions of filter to a query on posts.
+func filterPosts(query string, filter *entity.PostFilter) *queryBuilder {
+	q := newQueryBuilder(query)
+	if filter == nil {
+		filter = &entity.PostFilter{}
 	}
 
-	r.logger.WithField("query", query).Info("Final query")
+	q.where("(status = 'published' OR author_id = ?)", filter.ViewerID)
+
+	if filter.AuthorID != nil {
+		q.where("author_id = ?", *filter.AuthorID)
+	}
+	if filter.Author != "" {
+		q.where("author_id = (SELECT id FROM users WHERE username = ?)", filter.Author)
+	}
+	if filter.CreatedAfter != nil {
+		q.where("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		q.where("created_at < ?", *filter.CreatedBefore)
+	}
+	if filter.Status != "" {
+		q.where("status = ?", filter.Status)
+	}
+	if filter.Tag != "" {
+		q.where("EXISTS (SELECT 1 FROM post_tags t WHERE t.post_id = posts.id AND t.tag = ?)", filter.Tag)
+	}
+	if filter.TitlePrefix != "" {
+		q.where(`lower(title) LIKE ? ESCAPE '\'`, strings.ToLower(escapeLike(filter.TitlePrefix))+"%")
+	}
 
-	query += " LIMIT $1 OFFSET $2"
+	return q
+}
 
-	rows, err := r.db.QueryContext(ctx, query, params.Limit, params.Offset)
+func (r *PostRepository) queryPosts(ctx context.Context, columns selection[entity.Post], query string, args ...any) ([]*entity.Post, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		r.logger.WithError(err).Error("Failed to get posts")
 		return nil, fmt.Errorf("failed to get posts: %w", err)
@@ -105,32 +177,106 @@ func (r *PostRepository) GetAll(ctx context.Context, params *entity.Pagination)
 	var posts []*entity.Post
 	for rows.Next() {
 		var post entity.Post
-		if err := rows.Scan(&post.Id, &post.Title, &post.Content, &post.AuthorId, &post.CreatedAt, &post.UpdatedAt); err != nil {
+		if err := rows.Scan(columns.dests(&post)...); err != nil {
 			r.logger.WithError(err).Error("Failed to scan post")
 			return nil, fmt.Errorf("failed to scan post: %w", err)
 		}
 		posts = append(posts, &post)
 	}
 
-	for _, post := range posts {
-		r.logger.Infof("Post: %v, CreatedAt: %v, Title: %v", post.Id, post.CreatedAt, post.Title)
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
 	}
 
 	return posts, nil
 }
 
+// postSortColumns maps the sort fields onto the columns posts are ordered
+// by. Only these fixed expressions ever reach the ORDER BY.
+var postSortColumns = map[string]string{
+	entity.PostSortCreatedAt: "created_at",
+	entity.PostSortUpdatedAt: "updated_at",
+	entity.PostSortTitle:     "title",
+	entity.PostSortReactions: postReactionCount,
+}
+
+// postKeyset maps a pagination sort value onto the columns posts are
+// ordered by, and the cursor, if any, onto their values.
+func postKeyset(params *entity.Pagination) (keyset, []any, error) {
+	fields, err := entity.ParsePostSort(params.Sort)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := make(keyset, 0, len(fields)+1)
+	for _, field := range fields {
+		keys = append(keys, sortColumn{expr: postSortColumns[field.Field], desc: field.Desc})
+	}
+	// The id breaks ties in the direction of the least significant field.
+	keys = append(keys, sortColumn{expr: "id", desc: fields[len(fields)-1].Desc})
+
+	cursor := params.Cursor
+	if cursor == nil {
+		return keys, nil, nil
+	}
+
+	// Cursors of the follow feed only carry the creation time.
+	if len(cursor.Keys) == 0 && len(fields) == 1 && fields[0].Field == entity.PostSortCreatedAt {
+		return keys, []any{cursor.CreatedAt, cursor.Id}, nil
+	}
+	if len(cursor.Keys) != len(fields) {
+		return nil, nil, entity.ErrInvalidCursor
+	}
+
+	after := make([]any, 0, len(keys))
+	for i, field := range fields {
+		value, err := postSortValue(field.Field, cursor.Keys[i])
+		if err != nil {
+			return nil, nil, entity.ErrInvalidCursor
+		}
+		after = append(after, value)
+	}
+
+	return keys, append(after, cursor.Id), nil
+}
+
+// postSortValue parses a cursor key of a sort field.
+func postSortValue(field, key string) (any, error) {
+	switch field {
+	case entity.PostSortCreatedAt, entity.PostSortUpdatedAt:
+		return time.Parse(time.RFC3339Nano, key)
+	case entity.PostSortReactions:
+		return strconv.Atoi(key)
+	default:
+		return key, nil
+	}
+}
+
+// Update writes the post and bumps its version. post.Version is the version
+// being replaced, or entity.AnyVersion, and is set to the new one.
 func (r *PostRepository) Update(ctx context.Context, post *entity.Post) error {
-	query := `UPDATE posts SET title = $1, content = $2, updated_at = NOW() WHERE id = $3`
-	_, err := r.db.ExecContext(ctx, query, post.Title, post.Content, post.Id)
+	query := `UPDATE posts SET title = $1, content = $2, status = COALESCE(NULLIF($3, ''), status),
+              version = version + 1, updated_at = NOW()
+              WHERE id = $4 AND ($5 = 0 OR version = $5) RETURNING version`
+	err := r.db.QueryRowContext(ctx, query, post.Title, post.Content, post.Status, post.Id, post.Version).Scan(&post.Version)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if post.Version != entity.AnyVersion {
+				return entity.ErrVersionConflict
+			}
+			return fmt.Errorf("post not found")
+		}
 		r.logger.WithError(err).Error("Failed to update post")
 		return fmt.Errorf("failed to update post: %w", err)
 	}
 	return nil
 }
 
-func (r *PostRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result, err := r.db.ExecContext(ctx, `DELETE FROM posts WHERE id = $1`, id)
+// Delete removes the post if it still has the given version, or whatever
+// its version with entity.AnyVersion.
+func (r *PostRepository) Delete(ctx context.Context, id uuid.UUID, version int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM posts WHERE id = $1 AND ($2 = 0 OR version = $2)`, id, version)
 	if err != nil {
 		return fmt.Errorf("failed to delete post: %w", err)
 	}
@@ -141,19 +287,253 @@ func (r *PostRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if rowsAffected == 0 {
+		if version != entity.AnyVersion {
+			return entity.ErrVersionConflict
+		}
 		return fmt.Errorf("no rows affected")
 	}
 
 	return nil
 }
 
-func (r *PostRepository) GetTotalPosts(ctx context.Context) (int64, error) {
-	query := `SELECT COUNT(*) FROM posts`
+func (r *PostRepository) GetTotalPostsByAuthor(ctx context.Context, authorID uuid.UUID) (int64, error) {
+	query := `SELECT COUNT(*) FROM posts WHERE author_id = $1 AND status = 'published'`
 	var total int64
-	err := r.db.QueryRowContext(ctx, query).Scan(&total)
+	err := r.db.QueryRowContext(ctx, query, authorID).Scan(&total)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get total posts by author")
+		return 0, fmt.Errorf("failed to get total posts by author: %w", err)
+	}
+	return total, nil
+}
+
+// GetTotalPosts counts the posts GetAll would list for filter.
+func (r *PostRepository) GetTotalPosts(ctx context.Context, filter *entity.PostFilter) (int64, error) {
+	query, args := filterPosts(`SELECT COUNT(*) FROM posts WHERE TRUE`, filter).build()
+	var total int64
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&total)
 	if err != nil {
 		r.logger.WithError(err).Error("Failed to get total posts")
 		return 0, fmt.Errorf("failed to get total posts: %w", err)
 	}
 	return total, nil
 }
+
+func (r *PostRepository) GetCommentCounts(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(postIDs))
+	if len(postIDs) == 0 {
+		return counts, nil
+	}
+
+	query := `
+        SELECT post_id, COUNT(*)
+        FROM comments
+        WHERE post_id = ANY($1) AND status = 'approved'
+        GROUP BY post_id
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(postIDs))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get comment counts")
+		return nil, fmt.Errorf("failed to get comment counts: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postID uuid.UUID
+		var count int
+		if err := rows.Scan(&postID, &count); err != nil {
+			r.logger.WithError(err).Error("Failed to scan comment count")
+			return nil, fmt.Errorf("failed to scan comment count: %w", err)
+		}
+		counts[postID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetCommentModeration returns the post's moderation override, or
+// entity.ModerationInherit when the post follows the global setting.
+func (r *PostRepository) GetCommentModeration(ctx context.Context, postID uuid.UUID) (entity.ModerationMode, error) {
+	query := `SELECT comment_moderation FROM posts WHERE id = $1`
+
+	var mode sql.NullString
+	err := r.db.QueryRowContext(ctx, query, postID).Scan(&mode)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.ModerationInherit, fmt.Errorf("post not found")
+		}
+		r.logger.WithError(err).Error("Failed to get comment moderation")
+		return entity.ModerationInherit, fmt.Errorf("failed to get comment moderation: %w", err)
+	}
+
+	return entity.ModerationMode(mode.String), nil
+}
+
+func (r *PostRepository) SetCommentModeration(ctx context.Context, postID uuid.UUID, mode entity.ModerationMode) error {
+	query := `UPDATE posts SET comment_moderation = $1, updated_at = NOW() WHERE id = $2`
+
+	var value sql.NullString
+	if mode != entity.ModerationInherit {
+		value = sql.NullString{String: string(mode), Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx, query, value, postID)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to set comment moderation")
+		return fmt.Errorf("failed to set comment moderation: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("post not found")
+	}
+
+	return nil
+}
+
+// Authors and tags only get a sitemap entry once they have a post; their
+// lastmod is that of their most recently updated post. Entries are paged by
+// keyset: posts on (created_at, id), authors and tags on their key. The zero
+// position comes before every entry.
+var sitemapQueries = map[entity.SitemapKind]struct{ count, entries, boundaries string }{
+	entity.SitemapPosts: {
+		count: `SELECT COUNT(*) FROM posts WHERE status = 'published'`,
+		entries: `SELECT id::text, updated_at FROM posts
+                  WHERE status = 'published' AND (created_at, id) > ($1, $2) ORDER BY created_at, id LIMIT $3`,
+		boundaries: `SELECT created_at, id FROM (
+                         SELECT created_at, id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS n
+                         FROM posts WHERE status = 'published'
+                     ) entries WHERE n % $1 = 0 ORDER BY n`,
+	},
+	entity.SitemapAuthors: {
+		count: `SELECT COUNT(DISTINCT author_id) FROM posts WHERE status = 'published'`,
+		entries: `SELECT u.username, MAX(p.updated_at) FROM posts p JOIN users u ON u.id = p.author_id
+                  WHERE p.status = 'published' AND u.username > $1 GROUP BY u.username ORDER BY u.username LIMIT $2`,
+		boundaries: `SELECT username FROM (
+                         SELECT u.username, ROW_NUMBER() OVER (ORDER BY u.username) AS n FROM users u
+                         WHERE EXISTS (SELECT 1 FROM posts p WHERE p.author_id = u.id AND p.status = 'published')
+                     ) entries WHERE n % $1 = 0 ORDER BY n`,
+	},
+	entity.SitemapTags: {
+		count: `SELECT COUNT(DISTINCT t.tag) FROM post_tags t JOIN posts p ON p.id = t.post_id WHERE p.status = 'published'`,
+		entries: `SELECT t.tag, MAX(p.updated_at) FROM post_tags t JOIN posts p ON p.id = t.post_id
+                  WHERE p.status = 'published' AND t.tag > $1 GROUP BY t.tag ORDER BY t.tag LIMIT $2`,
+		boundaries: `SELECT tag FROM (
+                         SELECT tag, ROW_NUMBER() OVER (ORDER BY tag) AS n FROM (
+                             SELECT DISTINCT t.tag FROM post_tags t JOIN posts p ON p.id = t.post_id WHERE p.status = 'published'
+                         ) tags
+                     ) entries WHERE n % $1 = 0 ORDER BY n`,
+	},
+}
+
+func (r *PostRepository) CountSitemapEntries(ctx context.Context, kind entity.SitemapKind) (int, error) {
+	queries, ok := sitemapQueries[kind]
+	if !ok {
+		return 0, fmt.Errorf("unknown sitemap kind %q", kind)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, queries.count).Scan(&total); err != nil {
+		r.logger.WithError(err).WithField("kind", kind).Error("Failed to count sitemap entries")
+		return 0, fmt.Errorf("failed to count sitemap entries: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *PostRepository) GetSitemapEntries(ctx context.Context, kind entity.SitemapKind, after *entity.Cursor, limit int) ([]*entity.SitemapEntry, error) {
+	queries, ok := sitemapQueries[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown sitemap kind %q", kind)
+	}
+
+	var position entity.Cursor
+	if after != nil {
+		position = *after
+	}
+
+	var args []any
+	switch kind {
+	case entity.SitemapPosts:
+		args = []any{position.CreatedAt, position.Id, limit}
+	default:
+		var key string
+		if len(position.Keys) > 0 {
+			key = position.Keys[0]
+		}
+		args = []any{key, limit}
+	}
+
+	rows, err := r.db.QueryContext(ctx, queries.entries, args...)
+	if err != nil {
+		r.logger.WithError(err).WithField("kind", kind).Error("Failed to get sitemap entries")
+		return nil, fmt.Errorf("failed to get sitemap entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]*entity.SitemapEntry, 0, limit)
+	for rows.Next() {
+		var entry entity.SitemapEntry
+		if err := rows.Scan(&entry.Key, &entry.LastMod); err != nil {
+			r.logger.WithError(err).Error("Failed to scan sitemap entry")
+			return nil, fmt.Errorf("failed to scan sitemap entry: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (r *PostRepository) GetSitemapBoundaries(ctx context.Context, kind entity.SitemapKind, size int) ([]entity.Cursor, error) {
+	queries, ok := sitemapQueries[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown sitemap kind %q", kind)
+	}
+
+	rows, err := r.db.QueryContext(ctx, queries.boundaries, size)
+	if err != nil {
+		r.logger.WithError(err).WithField("kind", kind).Error("Failed to get sitemap boundaries")
+		return nil, fmt.Errorf("failed to get sitemap boundaries: %w", err)
+	}
+	defer rows.Close()
+
+	var boundaries []entity.Cursor
+	for rows.Next() {
+		var position entity.Cursor
+		switch kind {
+		case entity.SitemapPosts:
+			err = rows.Scan(&position.CreatedAt, &position.Id)
+		default:
+			var key string
+			err = rows.Scan(&key)
+			position.Keys = []string{key}
+		}
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to scan sitemap boundary")
+			return nil, fmt.Errorf("failed to scan sitemap boundary: %w", err)
+		}
+		boundaries = append(boundaries, position)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return boundaries, nil
+}
@@ -3,6 +3,7 @@ package postgres_test
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"testing"
 	"time"
@@ -46,11 +47,11 @@ func TestPostRepository_CreatePost_Success(t *testing.T) {
 				UpdatedAt: time.Now(),
 			},
 			setupMocks: func(mock sqlmock.Sqlmock, post *entity.NewPost) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(postId1, post.Title, post.Content, post.AuthorId, time.Now(), time.Now())
+				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "created_at", "updated_at", "version"}).
+					AddRow(postId1, post.Title, post.Content, post.AuthorId, "published", time.Now(), time.Now(), 1)
 
-				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, created_at, updated_at`).
-					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId).
+				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, status, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, status, created_at, updated_at, version`).
+					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId, entity.PostStatusPublished).
 					WillReturnRows(rows)
 			},
 		},
@@ -70,11 +71,11 @@ func TestPostRepository_CreatePost_Success(t *testing.T) {
 				UpdatedAt: time.Now(),
 			},
 			setupMocks: func(mock sqlmock.Sqlmock, post *entity.NewPost) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(postId2, post.Title, post.Content, post.AuthorId, time.Now(), time.Now())
+				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "created_at", "updated_at", "version"}).
+					AddRow(postId2, post.Title, post.Content, post.AuthorId, "published", time.Now(), time.Now(), 1)
 
-				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, created_at, updated_at`).
-					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId).
+				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, status, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, status, created_at, updated_at, version`).
+					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId, entity.PostStatusPublished).
 					WillReturnRows(rows)
 			},
 		},
@@ -117,8 +118,8 @@ func TestPostRepository_CreatePost_Failed(t *testing.T) {
 			},
 			expectedErr: errors.New("failed to create post"),
 			mockBehavior: func(mock sqlmock.Sqlmock, post *entity.NewPost) {
-				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, created_at, updated_at`).
-					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId).
+				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, status, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, status, created_at, updated_at, version`).
+					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId, entity.PostStatusPublished).
 					WillReturnError(errors.New("failed to create post"))
 			},
 		},
@@ -131,8 +132,8 @@ func TestPostRepository_CreatePost_Failed(t *testing.T) {
 			},
 			expectedErr: errors.New("failed to create post"),
 			mockBehavior: func(mock sqlmock.Sqlmock, post *entity.NewPost) {
-				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, created_at, updated_at`).
-					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId).
+				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, status, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, status, created_at, updated_at, version`).
+					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId, entity.PostStatusPublished).
 					WillReturnError(errors.New("unique constraint violation"))
 			},
 		},
@@ -145,8 +146,8 @@ func TestPostRepository_CreatePost_Failed(t *testing.T) {
 			},
 			expectedErr: errors.New("failed to create post"),
 			mockBehavior: func(mock sqlmock.Sqlmock, post *entity.NewPost) {
-				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, created_at, updated_at`).
-					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId).
+				mock.ExpectQuery(`INSERT INTO posts \(id, title, content, author_id, status, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, NOW\(\), NOW\(\)\) RETURNING id, title, content, author_id, status, created_at, updated_at, version`).
+					WithArgs(sqlmock.AnyArg(), post.Title, post.Content, post.AuthorId, entity.PostStatusPublished).
 					WillReturnError(errors.New("type mismatch"))
 			},
 		},
@@ -190,10 +191,10 @@ func TestPostRepository_GetPostById_Success(t *testing.T) {
 				UpdatedAt: time.Now(),
 			},
 			setupMocks: func(mock sqlmock.Sqlmock, id uuid.UUID, post *entity.Post) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(post.Id, post.Title, post.Content, post.AuthorId, post.CreatedAt, post.UpdatedAt)
+				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "created_at", "updated_at", "version"}).
+					AddRow(post.Id, post.Title, post.Content, post.AuthorId, "published", post.CreatedAt, post.UpdatedAt, 1)
 
-				mock.ExpectQuery(`SELECT id, title, content, author_id, created_at, updated_at FROM posts WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE id = \$1`).
 					WithArgs(id).
 					WillReturnRows(rows)
 			},
@@ -210,10 +211,10 @@ func TestPostRepository_GetPostById_Success(t *testing.T) {
 				UpdatedAt: time.Now(),
 			},
 			setupMocks: func(mock sqlmock.Sqlmock, id uuid.UUID, post *entity.Post) {
-				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"}).
-					AddRow(post.Id, post.Title, post.Content, post.AuthorId, post.CreatedAt, post.UpdatedAt)
+				rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "created_at", "updated_at", "version"}).
+					AddRow(post.Id, post.Title, post.Content, post.AuthorId, "published", post.CreatedAt, post.UpdatedAt, 1)
 
-				mock.ExpectQuery(`SELECT id, title, content, author_id, created_at, updated_at FROM posts WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE id = \$1`).
 					WithArgs(id).
 					WillReturnRows(rows)
 			},
@@ -251,7 +252,7 @@ func TestPostRepository_GetPostById_Failed(t *testing.T) {
 			name: "Failed to get post by ID - not found",
 			id:   postId1,
 			setupMocks: func(mock sqlmock.Sqlmock, id uuid.UUID, err error) {
-				mock.ExpectQuery(`SELECT id, title, content, author_id, created_at, updated_at FROM posts WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE id = \$1`).
 					WithArgs(id).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -261,7 +262,7 @@ func TestPostRepository_GetPostById_Failed(t *testing.T) {
 			name: "Failed to get post by ID - SQL error",
 			id:   postId2,
 			setupMocks: func(mock sqlmock.Sqlmock, id uuid.UUID, err error) {
-				mock.ExpectQuery(`SELECT id, title, content, author_id, created_at, updated_at FROM posts WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE id = \$1`).
 					WithArgs(id).
 					WillReturnError(err)
 			},
@@ -347,16 +348,16 @@ func TestPostRepository_GetAllByParams_Success(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "created_at", "updated_at"})
+			rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "created_at", "updated_at", "version"})
 			for _, post := range tt.expectedPosts {
-				rows.AddRow(post.Id, post.Title, post.Content, post.AuthorId, post.CreatedAt, post.UpdatedAt)
+				rows.AddRow(post.Id, post.Title, post.Content, post.AuthorId, "published", post.CreatedAt, post.UpdatedAt, 1)
 			}
 
-			mock.ExpectQuery(`SELECT id, title, content, author_id, created_at, updated_at FROM posts ORDER BY created_at DESC LIMIT \$1 OFFSET \$2`).
-				WithArgs(tt.params.Limit, tt.params.Offset).
+			mock.ExpectQuery(`SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) ORDER BY created_at DESC, id DESC LIMIT \$2 OFFSET \$3`).
+				WithArgs(uuid.Nil, tt.params.Limit, tt.params.Offset).
 				WillReturnRows(rows)
 
-			posts, err := repo.GetAll(context.Background(), tt.params)
+			posts, err := repo.GetAll(context.Background(), nil, tt.params)
 			assert.NoError(t, err)
 			assert.Len(t, posts, len(tt.expectedPosts))
 			for i, post := range tt.expectedPosts {
@@ -367,6 +368,157 @@ func TestPostRepository_GetAllByParams_Success(t *testing.T) {
 	}
 }
 
+func TestPostRepository_GetAll_FilterByAuthor(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "created_at", "updated_at", "version"}).
+		AddRow(postId1, "Post 1", "Content 1", authorId1, "draft", time.Now(), time.Now(), 1)
+
+	mock.ExpectQuery(`SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) AND author_id = \$2 ORDER BY title ASC, id ASC LIMIT \$3 OFFSET \$4`).
+		WithArgs(authorId1, authorId1, 10, 0).
+		WillReturnRows(rows)
+
+	posts, err := repo.GetAll(context.Background(), &entity.PostFilter{AuthorID: &authorId1, ViewerID: authorId1}, &entity.Pagination{Limit: 10, Sort: "title_asc"})
+
+	assert.NoError(t, err)
+	assert.Len(t, posts, 1)
+	assert.Equal(t, authorId1, posts[0].AuthorId)
+	assert.Equal(t, entity.PostStatusDraft, posts[0].Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepository_GetAll_Fields(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	// The title is read for the cursor of a title sort even though it
+	// wasn't asked for; the content only for the excerpt.
+	rows := sqlmock.NewRows([]string{"id", "title", "excerpt", "created_at"}).
+		AddRow(postId1, "Post 1", "Content 1", time.Now())
+
+	mock.ExpectQuery(`SELECT id, title, LEFT\(content, 800\), created_at FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) ORDER BY title ASC, id ASC LIMIT \$2 OFFSET \$3`).
+		WithArgs(uuid.Nil, 10, 0).
+		WillReturnRows(rows)
+
+	posts, err := repo.GetAll(context.Background(), nil, &entity.Pagination{
+		Limit:  10,
+		Sort:   "title_asc",
+		Fields: entity.Fields{"id", "excerpt"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, posts, 1)
+	assert.Equal(t, "Content 1", posts[0].Excerpt)
+	assert.Empty(t, posts[0].Content)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepository_GetAll_Filters(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\)`+
+		` AND author_id = \(SELECT id FROM users WHERE username = \$2\)`+
+		` AND created_at >= \$3 AND created_at < \$4 AND status = \$5`+
+		` AND EXISTS \(SELECT 1 FROM post_tags t WHERE t.post_id = posts.id AND t.tag = \$6\)`+
+		` AND lower\(title\) LIKE \$7 ESCAPE '\\'`+
+		` ORDER BY title ASC, created_at DESC, id DESC LIMIT \$8 OFFSET \$9`).
+		WithArgs(uuid.Nil, "tom", after, before, entity.PostStatusPublished, "go", `50\% off\_%`, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "created_at", "updated_at", "version"}))
+
+	_, err = repo.GetAll(context.Background(), &entity.PostFilter{
+		Author:        "tom",
+		CreatedAfter:  &after,
+		CreatedBefore: &before,
+		Status:        entity.PostStatusPublished,
+		Tag:           "go",
+		TitlePrefix:   "50% OFF_",
+	}, &entity.Pagination{Limit: 10, Sort: "title_asc,created_at_desc"})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepository_GetAll_Cursor(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		params *entity.Pagination
+		query  string
+		args   []driver.Value
+	}{
+		{
+			name:   "newest first after the cursor",
+			params: &entity.Pagination{Limit: 10, Offset: 20, Sort: "created_at_desc", Cursor: &entity.Cursor{CreatedAt: createdAt, Id: postId1}},
+			query:  `FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) AND \(created_at, id\) < \(\$2, \$3\) ORDER BY created_at DESC, id DESC LIMIT \$4 OFFSET \$5`,
+			args:   []driver.Value{uuid.Nil, createdAt, postId1, 10, 0},
+		},
+		{
+			name:   "title order before the cursor",
+			params: &entity.Pagination{Limit: 10, Sort: "title_asc", Cursor: &entity.Cursor{Keys: []string{"Go"}, Id: postId1, Backward: true}},
+			query:  `FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) AND \(title, id\) < \(\$2, \$3\) ORDER BY title DESC, id DESC LIMIT \$4 OFFSET \$5`,
+			args:   []driver.Value{uuid.Nil, "Go", postId1, 10, 0},
+		},
+		{
+			name:   "popular after the cursor",
+			params: &entity.Pagination{Limit: 10, Sort: "popular", Cursor: &entity.Cursor{Keys: []string{"7", createdAt.Format(time.RFC3339Nano)}, Id: postId1}},
+			query:  `FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) AND \(\(SELECT COUNT\(\*\) FROM reactions .+\), created_at, id\) < \(\$2, \$3, \$4\) ORDER BY .+ DESC, created_at DESC, id DESC LIMIT \$5 OFFSET \$6`,
+			args:   []driver.Value{uuid.Nil, 7, createdAt, postId1, 10, 0},
+		},
+		{
+			name:   "mixed directions after the cursor",
+			params: &entity.Pagination{Limit: 10, Sort: "title_asc,created_at_desc", Cursor: &entity.Cursor{Keys: []string{"Go", createdAt.Format(time.RFC3339Nano)}, Id: postId1}},
+			query:  `FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) AND \(title > \$2 OR \(title = \$2 AND \(created_at < \$3 OR \(created_at = \$3 AND id < \$4\)\)\)\) ORDER BY title ASC, created_at DESC, id DESC LIMIT \$5 OFFSET \$6`,
+			args:   []driver.Value{uuid.Nil, "Go", createdAt, postId1, 10, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+			mock.ExpectQuery(tt.query).
+				WithArgs(tt.args...).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "created_at", "updated_at", "version"}))
+
+			_, err = repo.GetAll(context.Background(), nil, tt.params)
+
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostRepository_GetAll_InvalidPopularCursor(t *testing.T) {
+	mockDB, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	_, err = repo.GetAll(context.Background(), nil, &entity.Pagination{Limit: 10, Sort: "popular", Cursor: &entity.Cursor{Keys: []string{"many", time.Now().Format(time.RFC3339Nano)}, Id: postId1}})
+
+	assert.ErrorIs(t, err, entity.ErrInvalidCursor)
+}
+
 func TestPostRepository_GetAllByParams_Failed(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -401,16 +553,16 @@ func TestPostRepository_GetAllByParams_Failed(t *testing.T) {
 			repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logger)
 
 			if tt.expectedErr == "no rows in result set" {
-				mock.ExpectQuery(`SELECT id, title, content, author_id, created_at, updated_at FROM posts ORDER BY created_at DESC LIMIT \$1 OFFSET \$2`).
-					WithArgs(tt.params.Limit, tt.params.Offset).
+				mock.ExpectQuery(`SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) ORDER BY created_at DESC, id DESC LIMIT \$2 OFFSET \$3`).
+					WithArgs(uuid.Nil, tt.params.Limit, tt.params.Offset).
 					WillReturnError(sql.ErrNoRows)
 			} else {
-				mock.ExpectQuery(`SELECT id, title, content, author_id, created_at, updated_at FROM posts ORDER BY created_at DESC LIMIT \$1 OFFSET \$2`).
-					WithArgs(tt.params.Limit, tt.params.Offset).
+				mock.ExpectQuery(`SELECT id, title, content, author_id, status, created_at, updated_at, version FROM posts WHERE TRUE AND \(status = 'published' OR author_id = \$1\) ORDER BY created_at DESC, id DESC LIMIT \$2 OFFSET \$3`).
+					WithArgs(uuid.Nil, tt.params.Limit, tt.params.Offset).
 					WillReturnError(errors.New(tt.expectedErr))
 			}
 
-			posts, err := repo.GetAll(context.Background(), tt.params)
+			posts, err := repo.GetAll(context.Background(), nil, tt.params)
 			assert.Error(t, err)
 			assert.Nil(t, posts)
 			assert.Contains(t, err.Error(), tt.expectedErr)
@@ -452,12 +604,13 @@ func TestPostRepository_Update_Success(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			mock.ExpectExec(`UPDATE posts SET title = \$1, content = \$2, updated_at = NOW\(\) WHERE id = \$3`).
-				WithArgs(tt.post.Title, tt.post.Content, tt.post.Id).
-				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(`UPDATE posts SET title = \$1, content = \$2, status = COALESCE\(NULLIF\(\$3, ''\), status\), version = version \+ 1, updated_at = NOW\(\) WHERE id = \$4 AND \(\$5 = 0 OR version = \$5\) RETURNING version`).
+				WithArgs(tt.post.Title, tt.post.Content, tt.post.Status, tt.post.Id, entity.AnyVersion).
+				WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
 
 			err = repo.Update(context.Background(), tt.post)
 			assert.NoError(t, err)
+			assert.Equal(t, 2, tt.post.Version)
 		})
 	}
 }
@@ -466,6 +619,7 @@ func TestPostRepository_Update_Failed(t *testing.T) {
 	tests := []struct {
 		name        string
 		post        *entity.Post
+		dbErr       error
 		expectedErr string
 	}{
 		{
@@ -477,6 +631,17 @@ func TestPostRepository_Update_Failed(t *testing.T) {
 			},
 			expectedErr: "failed to update post",
 		},
+		{
+			name: "Failed to update post - version conflict",
+			post: &entity.Post{
+				Id:      postId1,
+				Title:   "Test Title",
+				Content: "Test Content",
+				Version: 3,
+			},
+			dbErr:       sql.ErrNoRows,
+			expectedErr: entity.ErrVersionConflict.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -488,9 +653,13 @@ func TestPostRepository_Update_Failed(t *testing.T) {
 			logger := logrus.New()
 			repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logger)
 
-			mock.ExpectExec(`UPDATE posts SET title = \$1, content = \$2, updated_at = NOW\(\) WHERE id = \$3`).
-				WithArgs(tt.post.Title, tt.post.Content, tt.post.Id).
-				WillReturnError(errors.New(tt.expectedErr))
+			dbErr := tt.dbErr
+			if dbErr == nil {
+				dbErr = errors.New(tt.expectedErr)
+			}
+			mock.ExpectQuery(`UPDATE posts SET title = \$1, content = \$2, status = COALESCE\(NULLIF\(\$3, ''\), status\), version = version \+ 1, updated_at = NOW\(\) WHERE id = \$4 AND \(\$5 = 0 OR version = \$5\) RETURNING version`).
+				WithArgs(tt.post.Title, tt.post.Content, tt.post.Status, tt.post.Id, tt.post.Version).
+				WillReturnError(dbErr)
 
 			err = repo.Update(context.Background(), tt.post)
 			assert.Error(t, err)
@@ -509,8 +678,8 @@ func TestPostRepository_Delete_Success(t *testing.T) {
 			name: "Successful delete post with ID 1",
 			id:   postId1,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1`).
-					WithArgs(postId1).
+				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1 AND \(\$2 = 0 OR version = \$2\)`).
+					WithArgs(postId1, entity.AnyVersion).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
@@ -518,8 +687,8 @@ func TestPostRepository_Delete_Success(t *testing.T) {
 			name: "Successful delete post with ID 2",
 			id:   postId2,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1`).
-					WithArgs(postId2).
+				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1 AND \(\$2 = 0 OR version = \$2\)`).
+					WithArgs(postId2, entity.AnyVersion).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
@@ -536,7 +705,7 @@ func TestPostRepository_Delete_Success(t *testing.T) {
 
 			tt.mockSetup(mock)
 
-			err = repo.Delete(context.Background(), tt.id)
+			err = repo.Delete(context.Background(), tt.id, entity.AnyVersion)
 
 			assert.NoError(t, err)
 
@@ -550,6 +719,7 @@ func TestPostRepository_Delete_Failed(t *testing.T) {
 	tests := []struct {
 		name        string
 		id          uuid.UUID
+		version     int
 		mockSetup   func(mock sqlmock.Sqlmock)
 		expectedErr string
 	}{
@@ -557,8 +727,8 @@ func TestPostRepository_Delete_Failed(t *testing.T) {
 			name: "Failed to delete post - SQL error",
 			id:   postId1,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1`).
-					WithArgs(postId1).
+				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1 AND \(\$2 = 0 OR version = \$2\)`).
+					WithArgs(postId1, entity.AnyVersion).
 					WillReturnError(errors.New("failed to delete post"))
 			},
 			expectedErr: "failed to delete post",
@@ -567,12 +737,23 @@ func TestPostRepository_Delete_Failed(t *testing.T) {
 			name: "Failed to delete post - No rows affected",
 			id:   postId2,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1`).
-					WithArgs(postId2).
+				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1 AND \(\$2 = 0 OR version = \$2\)`).
+					WithArgs(postId2, entity.AnyVersion).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			expectedErr: "no rows affected",
 		},
+		{
+			name:    "Failed to delete post - version conflict",
+			id:      postId1,
+			version: 3,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM posts WHERE id = \$1 AND \(\$2 = 0 OR version = \$2\)`).
+					WithArgs(postId1, 3).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectedErr: entity.ErrVersionConflict.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -586,7 +767,7 @@ func TestPostRepository_Delete_Failed(t *testing.T) {
 
 			tt.mockSetup(mock)
 
-			err = repo.Delete(context.Background(), tt.id)
+			err = repo.Delete(context.Background(), tt.id, tt.version)
 
 			assert.Error(t, err)
 			assert.Contains(t, err.Error(), tt.expectedErr)
@@ -632,7 +813,7 @@ func TestPostRepository_GetTotalPosts_Success(t *testing.T) {
 
 			tt.mockSetup(mock)
 
-			total, err := repo.GetTotalPosts(context.Background())
+			total, err := repo.GetTotalPosts(context.Background(), nil)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedTotal, total)
@@ -686,7 +867,7 @@ func TestPostRepository_GetTotalPosts_Failed(t *testing.T) {
 
 			tt.mockSetup(mock)
 
-			total, err := repo.GetTotalPosts(context.Background())
+			total, err := repo.GetTotalPosts(context.Background(), nil)
 
 			if tt.mockError != nil {
 				assert.Error(t, err)
@@ -700,3 +881,208 @@ func TestPostRepository_GetTotalPosts_Failed(t *testing.T) {
 		})
 	}
 }
+
+func TestPostRepository_GetCommentCounts(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	mock.ExpectQuery(`SELECT post_id, COUNT\(\*\) FROM comments WHERE post_id = ANY\(\$1\) AND status = 'approved' GROUP BY post_id`).
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "count"}).AddRow(postId1, 3))
+
+	counts, err := repo.GetCommentCounts(context.Background(), []uuid.UUID{postId1, postId2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int{postId1: 3}, counts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostRepository_CountSitemapEntries(t *testing.T) {
+	tests := []struct {
+		name        string
+		kind        entity.SitemapKind
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expected    int
+		expectedErr string
+	}{
+		{
+			name: "Authors with posts",
+			kind: entity.SitemapAuthors,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(DISTINCT author_id\) FROM posts WHERE status = 'published'`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			},
+			expected: 3,
+		},
+		{
+			name:        "Unknown kind",
+			kind:        "comments",
+			mockSetup:   func(mock sqlmock.Sqlmock) {},
+			expectedErr: `unknown sitemap kind "comments"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			total, err := repo.CountSitemapEntries(context.Background(), tt.kind)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, total)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostRepository_GetSitemapEntries(t *testing.T) {
+	updatedAt := time.Now()
+
+	tests := []struct {
+		name         string
+		kind         entity.SitemapKind
+		after        *entity.Cursor
+		mockSetup    func(mock sqlmock.Sqlmock)
+		expectedKeys []string
+		expectedErr  string
+	}{
+		{
+			name: "First posts chunk starts before every post",
+			kind: entity.SitemapPosts,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id::text, updated_at FROM posts\s+WHERE status = 'published' AND \(created_at, id\) > \(\$1, \$2\) ORDER BY created_at, id LIMIT \$3`).
+					WithArgs(time.Time{}, uuid.Nil, 50).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).
+						AddRow(postId1.String(), updatedAt))
+			},
+			expectedKeys: []string{postId1.String()},
+		},
+		{
+			name:  "Later posts chunks start after their boundary",
+			kind:  entity.SitemapPosts,
+			after: &entity.Cursor{CreatedAt: updatedAt, Id: postId1},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id::text, updated_at FROM posts`).
+					WithArgs(updatedAt, postId1, 50).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).
+						AddRow(postId2.String(), updatedAt))
+			},
+			expectedKeys: []string{postId2.String()},
+		},
+		{
+			name:  "Tags start after their key",
+			kind:  entity.SitemapTags,
+			after: &entity.Cursor{Keys: []string{"api"}},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT t.tag, MAX\(p.updated_at\) FROM post_tags t JOIN posts p ON p.id = t.post_id\s+WHERE p.status = 'published' AND t.tag > \$1 GROUP BY t.tag ORDER BY t.tag LIMIT \$2`).
+					WithArgs("api", 50).
+					WillReturnRows(sqlmock.NewRows([]string{"tag", "max"}).
+						AddRow("go", updatedAt).
+						AddRow("sql", updatedAt))
+			},
+			expectedKeys: []string{"go", "sql"},
+		},
+		{
+			name: "Database error",
+			kind: entity.SitemapAuthors,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT u.username, MAX\(p.updated_at\)`).
+					WithArgs("", 50).
+					WillReturnError(errors.New("connection reset"))
+			},
+			expectedErr: "failed to get sitemap entries: connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			entries, err := repo.GetSitemapEntries(context.Background(), tt.kind, tt.after, 50)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				var keys []string
+				for _, entry := range entries {
+					keys = append(keys, entry.Key)
+				}
+				assert.Equal(t, tt.expectedKeys, keys)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostRepository_GetSitemapBoundaries(t *testing.T) {
+	createdAt := time.Now()
+
+	tests := []struct {
+		name      string
+		kind      entity.SitemapKind
+		mockSetup func(mock sqlmock.Sqlmock)
+		expected  []entity.Cursor
+	}{
+		{
+			name: "Posts split on creation time and id",
+			kind: entity.SitemapPosts,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT created_at, id FROM \(\s+SELECT created_at, id, ROW_NUMBER\(\) OVER \(ORDER BY created_at, id\) AS n\s+FROM posts WHERE status = 'published'\s+\) entries WHERE n % \$1 = 0 ORDER BY n`).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at", "id"}).
+						AddRow(createdAt, postId1))
+			},
+			expected: []entity.Cursor{{CreatedAt: createdAt, Id: postId1}},
+		},
+		{
+			name: "Authors split on their username",
+			kind: entity.SitemapAuthors,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT username FROM`).
+					WithArgs(2).
+					WillReturnRows(sqlmock.NewRows([]string{"username"}).
+						AddRow("bob").
+						AddRow("dave"))
+			},
+			expected: []entity.Cursor{{Keys: []string{"bob"}}, {Keys: []string{"dave"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewPostRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			boundaries, err := repo.GetSitemapBoundaries(context.Background(), tt.kind, 2)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, boundaries)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
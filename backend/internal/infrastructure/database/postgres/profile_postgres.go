@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type ProfileRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewProfileRepository(db *db.PostgresDB, logger *logrus.Logger) *ProfileRepository {
+	return &ProfileRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetProfile returns an empty profile for users who have never edited theirs.
+func (r *ProfileRepository) GetProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	query := `
+        SELECT display_name, bio, avatar_url, website, social_links
+        FROM user_profiles
+        WHERE user_id = $1
+    `
+
+	profile := entity.NewProfile(userID)
+	var socialLinks []byte
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&profile.DisplayName, &profile.Bio, &profile.AvatarURL, &profile.Website, &socialLinks,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return profile, nil
+		}
+		r.logger.WithError(err).Error("Failed to get profile")
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	if err := json.Unmarshal(socialLinks, &profile.SocialLinks); err != nil {
+		r.logger.WithError(err).Error("Failed to decode social links")
+		return nil, fmt.Errorf("failed to decode social links: %w", err)
+	}
+
+	return profile, nil
+}
+
+func (r *ProfileRepository) UpsertProfile(ctx context.Context, profile *entity.Profile) error {
+	query := `
+        INSERT INTO user_profiles (user_id, display_name, bio, avatar_url, website, social_links, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, NOW())
+        ON CONFLICT (user_id) DO UPDATE SET
+            display_name = EXCLUDED.display_name,
+            bio = EXCLUDED.bio,
+            avatar_url = EXCLUDED.avatar_url,
+            website = EXCLUDED.website,
+            social_links = EXCLUDED.social_links,
+            updated_at = NOW()
+    `
+
+	socialLinks, err := json.Marshal(profile.SocialLinks)
+	if err != nil {
+		return fmt.Errorf("failed to encode social links: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx, query,
+		profile.UserId, profile.DisplayName, profile.Bio, profile.AvatarURL, profile.Website, socialLinks,
+	)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to save profile")
+		return fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestProfileRepository_GetProfile(t *testing.T) {
+	tests := []struct {
+		name            string
+		setupMock       func(mock sqlmock.Sqlmock)
+		expectedProfile *entity.Profile
+	}{
+		{
+			name: "Saved profile",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT display_name, bio, avatar_url, website, social_links FROM user_profiles WHERE user_id = \\$1").
+					WithArgs(userId1).
+					WillReturnRows(sqlmock.NewRows([]string{"display_name", "bio", "avatar_url", "website", "social_links"}).
+						AddRow("Tom", "Gopher", "", "https://tom.example.com", []byte(`{"github":"https://github.com/tom"}`)))
+			},
+			expectedProfile: &entity.Profile{
+				UserId:      userId1,
+				DisplayName: "Tom",
+				Bio:         "Gopher",
+				Website:     "https://tom.example.com",
+				SocialLinks: map[string]string{"github": "https://github.com/tom"},
+			},
+		},
+		{
+			name: "Never edited",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT display_name, bio, avatar_url, website, social_links FROM user_profiles WHERE user_id = \\$1").
+					WithArgs(userId1).
+					WillReturnError(sql.ErrNoRows)
+			},
+			expectedProfile: entity.NewProfile(userId1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewProfileRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.setupMock(mock)
+
+			profile, err := repo.GetProfile(context.Background(), userId1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedProfile, profile)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+)
+
+// queryBuilder adds conditions to a query that already has a WHERE clause.
+// Conditions are fixed SQL written by the caller with a ? for each value;
+// the values only ever reach the database as $n parameters, so nothing a
+// client sends is spliced into the SQL.
+type queryBuilder struct {
+	sql  strings.Builder
+	args []any
+}
+
+func newQueryBuilder(query string, args ...any) *queryBuilder {
+	q := &queryBuilder{args: args}
+	q.sql.WriteString(query)
+	return q
+}
+
+// where ANDs cond onto the query, numbering its placeholders after the
+// arguments already given.
+func (q *queryBuilder) where(cond string, args ...any) *queryBuilder {
+	if strings.Count(cond, "?") != len(args) {
+		panic(fmt.Sprintf("postgres: condition %q takes %d arguments, got %d", cond, strings.Count(cond, "?"), len(args)))
+	}
+
+	q.sql.WriteString(" AND ")
+	for _, arg := range args {
+		before, rest, _ := strings.Cut(cond, "?")
+		q.args = append(q.args, arg)
+		fmt.Fprintf(&q.sql, "%s$%d", before, len(q.args))
+		cond = rest
+	}
+	q.sql.WriteString(cond)
+
+	return q
+}
+
+func (q *queryBuilder) build() (string, []any) {
+	return q.sql.String(), q.args
+}
+
+// escapeLike escapes the LIKE wildcards in s, so that it only matches
+// itself.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
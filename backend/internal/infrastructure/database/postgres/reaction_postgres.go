@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type ReactionRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewReactionRepository(db *db.PostgresDB, logger *logrus.Logger) *ReactionRepository {
+	return &ReactionRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// AddReaction is idempotent: reacting twice with the same kind keeps a single row.
+func (r *ReactionRepository) AddReaction(ctx context.Context, reaction *entity.Reaction) error {
+	query := `
+        INSERT INTO reactions (target_type, target_id, user_id, kind, created_at)
+        VALUES ($1, $2, $3, $4, NOW())
+        ON CONFLICT (target_type, target_id, user_id, kind) DO NOTHING
+    `
+
+	_, err := r.db.ExecContext(ctx, query, reaction.TargetType, reaction.TargetId, reaction.UserId, reaction.Kind)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to add reaction")
+		return fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReactionRepository) DeleteReaction(ctx context.Context, reaction *entity.Reaction) error {
+	query := `DELETE FROM reactions WHERE target_type = $1 AND target_id = $2 AND user_id = $3 AND kind = $4`
+
+	_, err := r.db.ExecContext(ctx, query, reaction.TargetType, reaction.TargetId, reaction.UserId, reaction.Kind)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to delete reaction")
+		return fmt.Errorf("failed to delete reaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetReactionSummaries aggregates reactions for a batch of targets in one
+// query. Every requested id gets a summary, empty when nobody has reacted.
+func (r *ReactionRepository) GetReactionSummaries(ctx context.Context, target entity.ReactionTarget, targetIDs []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID]*entity.ReactionSummary, error) {
+	summaries := make(map[uuid.UUID]*entity.ReactionSummary, len(targetIDs))
+	for _, id := range targetIDs {
+		summaries[id] = entity.NewReactionSummary()
+	}
+
+	if len(targetIDs) == 0 {
+		return summaries, nil
+	}
+
+	query := `
+        SELECT target_id, kind, COUNT(*), BOOL_OR(user_id = $3)
+        FROM reactions
+        WHERE target_type = $1 AND target_id = ANY($2)
+        GROUP BY target_id, kind
+        ORDER BY target_id, kind
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, target, pq.Array(targetIDs), viewerID)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get reaction summaries")
+		return nil, fmt.Errorf("failed to get reaction summaries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			targetID uuid.UUID
+			kind     string
+			count    int
+			mine     bool
+		)
+		if err := rows.Scan(&targetID, &kind, &count, &mine); err != nil {
+			r.logger.WithError(err).Error("Failed to scan reaction summary")
+			return nil, fmt.Errorf("failed to scan reaction summary: %w", err)
+		}
+
+		summary, ok := summaries[targetID]
+		if !ok {
+			continue
+		}
+		summary.Counts[kind] = count
+		if mine {
+			summary.Mine = append(summary.Mine, kind)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return summaries, nil
+}
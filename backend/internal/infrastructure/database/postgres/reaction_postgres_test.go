@@ -0,0 +1,61 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestReactionRepository_AddReaction(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewReactionRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	mock.ExpectExec("INSERT INTO reactions .* ON CONFLICT \\(target_type, target_id, user_id, kind\\) DO NOTHING").
+		WithArgs(entity.ReactionTargetPost, postId1, userId1, "like").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.AddReaction(context.Background(), &entity.Reaction{
+		TargetType: entity.ReactionTargetPost,
+		TargetId:   postId1,
+		UserId:     userId1,
+		Kind:       "like",
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReactionRepository_GetReactionSummaries(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewReactionRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	ids := []uuid.UUID{postId1, postId2}
+	mock.ExpectQuery("SELECT target_id, kind, COUNT\\(\\*\\), BOOL_OR\\(user_id = \\$3\\) FROM reactions").
+		WithArgs(entity.ReactionTargetPost, pq.Array(ids), userId1).
+		WillReturnRows(sqlmock.NewRows([]string{"target_id", "kind", "count", "mine"}).
+			AddRow(postId1, "like", 3, true).
+			AddRow(postId1, "love", 1, false))
+
+	summaries, err := repo.GetReactionSummaries(context.Background(), entity.ReactionTargetPost, ids, userId1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"like": 3, "love": 1}, summaries[postId1].Counts)
+	assert.Equal(t, []string{"like"}, summaries[postId1].Mine)
+	assert.Empty(t, summaries[postId2].Counts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,236 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+const readingListColumns = `id, owner_id, name, visibility, share_token, created_at, updated_at`
+
+type ReadingListRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewReadingListRepository(db *db.PostgresDB, logger *logrus.Logger) *ReadingListRepository {
+	return &ReadingListRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *ReadingListRepository) CreateList(ctx context.Context, list *entity.ReadingList) (*entity.ReadingList, error) {
+	query := `
+        INSERT INTO reading_lists (id, owner_id, name, visibility, share_token, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+        RETURNING ` + readingListColumns
+
+	created, err := scanReadingList(r.db.QueryRowContext(ctx, query,
+		uuid.New(), list.OwnerId, list.Name, list.Visibility, list.ShareToken,
+	))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to create reading list")
+		return nil, fmt.Errorf("failed to create reading list: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *ReadingListRepository) GetListById(ctx context.Context, id uuid.UUID) (*entity.ReadingList, error) {
+	query := `SELECT ` + readingListColumns + ` FROM reading_lists WHERE id = $1`
+
+	list, err := scanReadingList(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("reading list not found")
+		}
+		r.logger.WithError(err).Error("Failed to get reading list")
+		return nil, fmt.Errorf("failed to get reading list: %w", err)
+	}
+
+	return list, nil
+}
+
+func (r *ReadingListRepository) GetListByShareToken(ctx context.Context, token string) (*entity.ReadingList, error) {
+	query := `SELECT ` + readingListColumns + ` FROM reading_lists WHERE share_token = $1 AND visibility = 'shared'`
+
+	list, err := scanReadingList(r.db.QueryRowContext(ctx, query, token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("reading list not found")
+		}
+		r.logger.WithError(err).Error("Failed to get reading list")
+		return nil, fmt.Errorf("failed to get reading list: %w", err)
+	}
+
+	return list, nil
+}
+
+func (r *ReadingListRepository) GetListsByOwner(ctx context.Context, ownerID uuid.UUID) ([]*entity.ReadingList, error) {
+	query := `SELECT ` + readingListColumns + ` FROM reading_lists WHERE owner_id = $1 ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, ownerID)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get reading lists")
+		return nil, fmt.Errorf("failed to get reading lists: %w", err)
+	}
+	defer rows.Close()
+
+	lists := []*entity.ReadingList{}
+	for rows.Next() {
+		list, err := scanReadingList(rows)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to scan reading list")
+			return nil, fmt.Errorf("failed to scan reading list: %w", err)
+		}
+		lists = append(lists, list)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return lists, nil
+}
+
+func (r *ReadingListRepository) UpdateList(ctx context.Context, list *entity.ReadingList) error {
+	query := `UPDATE reading_lists SET name = $1, visibility = $2, share_token = $3, updated_at = NOW() WHERE id = $4`
+
+	if _, err := r.db.ExecContext(ctx, query, list.Name, list.Visibility, list.ShareToken, list.Id); err != nil {
+		r.logger.WithError(err).Error("Failed to update reading list")
+		return fmt.Errorf("failed to update reading list: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReadingListRepository) DeleteList(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM reading_lists WHERE id = $1`
+
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		r.logger.WithError(err).Error("Failed to delete reading list")
+		return fmt.Errorf("failed to delete reading list: %w", err)
+	}
+
+	return nil
+}
+
+// GetItems returns the posts of a list in reading order. Unpublished posts
+// are skipped unless the viewer wrote them.
+func (r *ReadingListRepository) GetItems(ctx context.Context, listID, viewerID uuid.UUID) ([]*entity.ReadingListItem, error) {
+	query := `
+        SELECT i.post_id, i.position, i.added_at,
+               p.id, p.title, p.content, p.author_id, p.created_at, p.updated_at
+        FROM reading_list_items i
+        JOIN posts p ON p.id = i.post_id
+        WHERE i.list_id = $1 AND (p.status = 'published' OR p.author_id = $2)
+        ORDER BY i.position, i.added_at
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, listID, viewerID)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get reading list items")
+		return nil, fmt.Errorf("failed to get reading list items: %w", err)
+	}
+	defer rows.Close()
+
+	items := []*entity.ReadingListItem{}
+	for rows.Next() {
+		var (
+			item entity.ReadingListItem
+			post entity.Post
+		)
+		if err := rows.Scan(
+			&item.PostId, &item.Position, &item.AddedAt,
+			&post.Id, &post.Title, &post.Content, &post.AuthorId, &post.CreatedAt, &post.UpdatedAt,
+		); err != nil {
+			r.logger.WithError(err).Error("Failed to scan reading list item")
+			return nil, fmt.Errorf("failed to scan reading list item: %w", err)
+		}
+		item.Post = &post
+		items = append(items, &item)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return items, nil
+}
+
+// AddItem appends a post to the end of the list; adding it twice is a no-op.
+func (r *ReadingListRepository) AddItem(ctx context.Context, listID, postID uuid.UUID) error {
+	query := `
+        INSERT INTO reading_list_items (list_id, post_id, position, added_at)
+        SELECT $1, $2, COALESCE(MAX(position), 0) + 1, NOW()
+        FROM reading_list_items WHERE list_id = $1
+        ON CONFLICT (list_id, post_id) DO NOTHING
+    `
+
+	if _, err := r.db.ExecContext(ctx, query, listID, postID); err != nil {
+		r.logger.WithError(err).Error("Failed to add reading list item")
+		return fmt.Errorf("failed to add reading list item: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReadingListRepository) RemoveItem(ctx context.Context, listID, postID uuid.UUID) error {
+	query := `DELETE FROM reading_list_items WHERE list_id = $1 AND post_id = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, listID, postID); err != nil {
+		r.logger.WithError(err).Error("Failed to remove reading list item")
+		return fmt.Errorf("failed to remove reading list item: %w", err)
+	}
+
+	return nil
+}
+
+// ReorderItems sets each item's position to its index in postIDs in a single
+// statement, so readers never see a half-applied order.
+func (r *ReadingListRepository) ReorderItems(ctx context.Context, listID uuid.UUID, postIDs []uuid.UUID) error {
+	query := `
+        UPDATE reading_list_items i
+        SET position = o.position
+        FROM unnest($2::uuid[]) WITH ORDINALITY AS o(post_id, position)
+        WHERE i.list_id = $1 AND i.post_id = o.post_id
+    `
+
+	if _, err := r.db.ExecContext(ctx, query, listID, pq.Array(postIDs)); err != nil {
+		r.logger.WithError(err).Error("Failed to reorder reading list items")
+		return fmt.Errorf("failed to reorder reading list items: %w", err)
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanReadingList(row rowScanner) (*entity.ReadingList, error) {
+	var list entity.ReadingList
+	var shareToken sql.NullString
+	if err := row.Scan(
+		&list.Id, &list.OwnerId, &list.Name, &list.Visibility, &shareToken, &list.CreatedAt, &list.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if shareToken.Valid {
+		list.ShareToken = &shareToken.String
+	}
+
+	return &list, nil
+}
@@ -0,0 +1,76 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestReadingListRepository_AddItem(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewReadingListRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	listId := uuid.New()
+	mock.ExpectExec("INSERT INTO reading_list_items .* SELECT \\$1, \\$2, COALESCE\\(MAX\\(position\\), 0\\) \\+ 1, NOW\\(\\) .* ON CONFLICT \\(list_id, post_id\\) DO NOTHING").
+		WithArgs(listId, postId1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.AddItem(context.Background(), listId, postId1)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReadingListRepository_ReorderItems(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewReadingListRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	listId := uuid.New()
+	order := []uuid.UUID{postId2, postId1}
+	mock.ExpectExec("UPDATE reading_list_items i SET position = o.position FROM unnest\\(\\$2::uuid\\[\\]\\) WITH ORDINALITY").
+		WithArgs(listId, pq.Array(order)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.ReorderItems(context.Background(), listId, order)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReadingListRepository_GetItems(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewReadingListRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	listId := uuid.New()
+	now := time.Now()
+	mock.ExpectQuery("SELECT i.post_id, i.position, i.added_at, .* FROM reading_list_items i JOIN posts p ON p.id = i.post_id WHERE i.list_id = \\$1 AND \\(p.status = 'published' OR p.author_id = \\$2\\) ORDER BY i.position, i.added_at").
+		WithArgs(listId, userId1).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"post_id", "position", "added_at", "id", "title", "content", "author_id", "created_at", "updated_at",
+		}).AddRow(postId1, 1, now, postId1, "Title", "Content", userId2, now, now))
+
+	items, err := repo.GetItems(context.Background(), listId, userId1)
+
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "Title", items[0].Post.Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
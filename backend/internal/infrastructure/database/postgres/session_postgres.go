@@ -80,6 +80,18 @@ func (r *SessionRepository) GetSessionByID(ctx context.Context, sessionID uuid.U
 	return &session, nil
 }
 
+func (r *SessionRepository) IsSessionActive(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM sessions WHERE session_id = $1 AND expires_at >= NOW())`
+
+	var active bool
+	if err := r.db.QueryRowContext(ctx, query, sessionID).Scan(&active); err != nil {
+		r.logger.WithError(err).Error("Failed to check session")
+		return false, fmt.Errorf("failed to check session: %w", err)
+	}
+
+	return active, nil
+}
+
 func (r *SessionRepository) UpdateSession(ctx context.Context, session *entity.Session) error {
 	query := `UPDATE sessions SET token = $1, expires_at = $2 WHERE id = $3`
 
@@ -114,3 +126,62 @@ func (r *SessionRepository) DeleteSession(ctx context.Context, sessionID uuid.UU
 
 	return nil
 }
+
+func (r *SessionRepository) EvictSessions(ctx context.Context, userID uuid.UUID, keep int) (int64, error) {
+	query := `
+        DELETE FROM sessions
+        WHERE id IN (
+            SELECT id FROM sessions
+            WHERE user_id = $1
+            ORDER BY created_at DESC
+            OFFSET $2
+        )`
+
+	result, err := r.db.ExecContext(ctx, query, userID, keep)
+	if err != nil {
+		r.logger.WithError(err).WithField("userID", userID).Error("Failed to evict sessions")
+		return 0, fmt.Errorf("failed to evict sessions: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *SessionRepository) DeleteExpiredSessions(ctx context.Context, limit int) (int64, error) {
+	query := `
+        DELETE FROM sessions
+        WHERE id IN (
+            SELECT id FROM sessions
+            WHERE expires_at < NOW()
+            ORDER BY expires_at
+            LIMIT $1
+        )`
+
+	result, err := r.db.ExecContext(ctx, query, limit)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to delete expired sessions")
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *SessionRepository) CountActiveSessions(ctx context.Context) (int, error) {
+	var total int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM sessions WHERE expires_at >= NOW()`).Scan(&total)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to count active sessions")
+		return 0, fmt.Errorf("failed to count active sessions: %w", err)
+	}
+
+	return total, nil
+}
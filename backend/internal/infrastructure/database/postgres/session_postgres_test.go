@@ -0,0 +1,103 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestSessionRepository_EvictSessions(t *testing.T) {
+	userId := uuid.New()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expected      int64
+		expectedError string
+	}{
+		{
+			name: "Oldest sessions are evicted",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM sessions\\s+WHERE id IN \\(\\s+SELECT id FROM sessions\\s+WHERE user_id = \\$1\\s+ORDER BY created_at DESC\\s+OFFSET \\$2").
+					WithArgs(userId, 5).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			expected: 2,
+		},
+		{
+			name: "Database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM sessions").
+					WillReturnError(errors.New("database error"))
+			},
+			expectedError: "failed to evict sessions: database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewSessionRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			evicted, err := repo.EvictSessions(context.Background(), userId, 5)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, evicted)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSessionRepository_DeleteExpiredSessions(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewSessionRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	mock.ExpectExec("DELETE FROM sessions\\s+WHERE id IN \\(\\s+SELECT id FROM sessions\\s+WHERE expires_at < NOW\\(\\)\\s+ORDER BY expires_at\\s+LIMIT \\$1").
+		WithArgs(500).
+		WillReturnResult(sqlmock.NewResult(0, 500))
+
+	deleted, err := repo.DeleteExpiredSessions(context.Background(), 500)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), deleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionRepository_IsSessionActive(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewSessionRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	sessionId := uuid.New()
+	mock.ExpectQuery("SELECT EXISTS \\(SELECT 1 FROM sessions WHERE session_id = \\$1 AND expires_at >= NOW\\(\\)\\)").
+		WithArgs(sessionId).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	active, err := repo.IsSessionActive(context.Background(), sessionId)
+
+	assert.NoError(t, err)
+	assert.False(t, active)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type SpamRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewSpamRepository(db *db.PostgresDB, logger *logrus.Logger) *SpamRepository {
+	return &SpamRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *SpamRepository) GetTokenCounts(ctx context.Context, tokens []string) (map[string]entity.SpamTokenCount, error) {
+	query := `SELECT token, spam_count, ham_count FROM spam_tokens WHERE token = ANY($1)`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(tokens))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get spam token counts")
+		return nil, fmt.Errorf("failed to get spam token counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]entity.SpamTokenCount, len(tokens))
+	for rows.Next() {
+		var count entity.SpamTokenCount
+		if err := rows.Scan(&count.Token, &count.SpamCount, &count.HamCount); err != nil {
+			r.logger.WithError(err).Error("Failed to scan spam token count")
+			return nil, fmt.Errorf("failed to scan spam token count: %w", err)
+		}
+		counts[count.Token] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return counts, nil
+}
+
+func (r *SpamRepository) GetSampleTotals(ctx context.Context) (int, int, error) {
+	query := `SELECT COUNT(*) FILTER (WHERE is_spam), COUNT(*) FILTER (WHERE NOT is_spam) FROM spam_training`
+
+	var spam, ham int
+	if err := r.db.QueryRowContext(ctx, query).Scan(&spam, &ham); err != nil {
+		r.logger.WithError(err).Error("Failed to get spam sample totals")
+		return 0, 0, fmt.Errorf("failed to get spam sample totals: %w", err)
+	}
+
+	return spam, ham, nil
+}
+
+// AddSample records a moderator decision. Each comment counts once: when a
+// comment is relabelled its previous contribution is removed first, and
+// repeating the same decision is a no-op. Samples stay when their comment is
+// deleted, so the token counts always add up to them.
+func (r *SpamRepository) AddSample(ctx context.Context, sample *entity.SpamSample) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var wasSpam bool
+	var previousTokens pq.StringArray
+	err = tx.QueryRowContext(ctx,
+		`SELECT is_spam, tokens FROM spam_training WHERE comment_id = $1 FOR UPDATE`,
+		sample.CommentId,
+	).Scan(&wasSpam, &previousTokens)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		r.logger.WithError(err).Error("Failed to get previous spam sample")
+		return fmt.Errorf("failed to get previous spam sample: %w", err)
+	case wasSpam == sample.IsSpam:
+		return nil
+	default:
+		_, err = tx.ExecContext(ctx, `
+            UPDATE spam_tokens
+            SET spam_count = spam_count - $2, ham_count = ham_count - $3
+            WHERE token = ANY($1)
+        `, previousTokens, boolToInt(wasSpam), boolToInt(!wasSpam))
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to remove previous spam sample")
+			return fmt.Errorf("failed to remove previous spam sample: %w", err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO spam_tokens (token, spam_count, ham_count)
+        SELECT token, $2, $3 FROM unnest($1::text[]) AS token
+        ON CONFLICT (token) DO UPDATE
+        SET spam_count = spam_tokens.spam_count + EXCLUDED.spam_count,
+            ham_count = spam_tokens.ham_count + EXCLUDED.ham_count
+    `, pq.Array(sample.Tokens), boolToInt(sample.IsSpam), boolToInt(!sample.IsSpam))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to update spam tokens")
+		return fmt.Errorf("failed to update spam tokens: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+        INSERT INTO spam_training (comment_id, is_spam, tokens, trained_at)
+        VALUES ($1, $2, $3, NOW())
+        ON CONFLICT (comment_id) DO UPDATE
+        SET is_spam = EXCLUDED.is_spam, tokens = EXCLUDED.tokens, trained_at = NOW()
+    `, sample.CommentId, sample.IsSpam, pq.Array(sample.Tokens))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to save spam sample")
+		return fmt.Errorf("failed to save spam sample: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.WithError(err).Error("Failed to commit spam sample")
+		return fmt.Errorf("failed to commit spam sample: %w", err)
+	}
+
+	return nil
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,141 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestSpamRepository_GetTokenCounts(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewSpamRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	mock.ExpectQuery("SELECT token, spam_count, ham_count FROM spam_tokens WHERE token = ANY\\(\\$1\\)").
+		WithArgs(pq.Array([]string{"cheap", "pills"})).
+		WillReturnRows(sqlmock.NewRows([]string{"token", "spam_count", "ham_count"}).AddRow("cheap", 4, 1))
+
+	counts, err := repo.GetTokenCounts(context.Background(), []string{"cheap", "pills"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]entity.SpamTokenCount{"cheap": {Token: "cheap", SpamCount: 4, HamCount: 1}}, counts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSpamRepository_GetSampleTotals(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewSpamRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FILTER \\(WHERE is_spam\\), COUNT\\(\\*\\) FILTER \\(WHERE NOT is_spam\\) FROM spam_training").
+		WillReturnRows(sqlmock.NewRows([]string{"spam", "ham"}).AddRow(7, 12))
+
+	spamTotal, hamTotal, err := repo.GetSampleTotals(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, spamTotal)
+	assert.Equal(t, 12, hamTotal)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSpamRepository_AddSample(t *testing.T) {
+	sample := &entity.SpamSample{CommentId: commentId1, IsSpam: true, Tokens: []string{"cheap", "pills"}}
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock)
+		expectErr bool
+	}{
+		{
+			name: "New sample",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT is_spam, tokens FROM spam_training WHERE comment_id = \\$1 FOR UPDATE").
+					WithArgs(commentId1).
+					WillReturnRows(sqlmock.NewRows([]string{"is_spam", "tokens"}))
+				mock.ExpectExec("INSERT INTO spam_tokens").
+					WithArgs(pq.Array(sample.Tokens), 1, 0).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec("INSERT INTO spam_training").
+					WithArgs(commentId1, true, pq.Array(sample.Tokens)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Same decision again is ignored",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT is_spam, tokens FROM spam_training WHERE comment_id = \\$1 FOR UPDATE").
+					WithArgs(commentId1).
+					WillReturnRows(sqlmock.NewRows([]string{"is_spam", "tokens"}).AddRow(true, "{cheap,pills}"))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			name: "Relabelled comment replaces its previous contribution",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT is_spam, tokens FROM spam_training WHERE comment_id = \\$1 FOR UPDATE").
+					WithArgs(commentId1).
+					WillReturnRows(sqlmock.NewRows([]string{"is_spam", "tokens"}).AddRow(false, "{cheap,pills}"))
+				mock.ExpectExec("UPDATE spam_tokens SET spam_count = spam_count - \\$2, ham_count = ham_count - \\$3 WHERE token = ANY\\(\\$1\\)").
+					WithArgs(sqlmock.AnyArg(), 0, 1).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec("INSERT INTO spam_tokens").
+					WithArgs(pq.Array(sample.Tokens), 1, 0).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec("INSERT INTO spam_training").
+					WithArgs(commentId1, true, pq.Array(sample.Tokens)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Database error rolls back",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT is_spam, tokens FROM spam_training WHERE comment_id = \\$1 FOR UPDATE").
+					WithArgs(commentId1).
+					WillReturnRows(sqlmock.NewRows([]string{"is_spam", "tokens"}))
+				mock.ExpectExec("INSERT INTO spam_tokens").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewSpamRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+			tt.setupMock(mock)
+
+			err = repo.AddSample(context.Background(), sample)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
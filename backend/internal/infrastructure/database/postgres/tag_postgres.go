@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+type TagRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewTagRepository(db *db.PostgresDB, logger *logrus.Logger) *TagRepository {
+	return &TagRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *TagRepository) SetPostTags(ctx context.Context, postID uuid.UUID, tags []string) error {
+	return r.db.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := r.db.ExecContext(ctx, `DELETE FROM post_tags WHERE post_id = $1`, postID); err != nil {
+			r.logger.WithError(err).WithField("postID", postID).Error("Failed to clear post tags")
+			return fmt.Errorf("failed to clear post tags: %w", err)
+		}
+
+		if len(tags) == 0 {
+			return nil
+		}
+
+		query := `INSERT INTO post_tags (post_id, tag) SELECT $1, unnest($2::text[]) ON CONFLICT DO NOTHING`
+		if _, err := r.db.ExecContext(ctx, query, postID, pq.Array(tags)); err != nil {
+			r.logger.WithError(err).WithField("postID", postID).Error("Failed to set post tags")
+			return fmt.Errorf("failed to set post tags: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r *TagRepository) GetTagsByPostIds(ctx context.Context, postIDs []uuid.UUID) (map[uuid.UUID][]string, error) {
+	tags := make(map[uuid.UUID][]string, len(postIDs))
+	if len(postIDs) == 0 {
+		return tags, nil
+	}
+
+	query := `SELECT post_id, tag FROM post_tags WHERE post_id = ANY($1) ORDER BY post_id, tag`
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(postIDs))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get post tags")
+		return nil, fmt.Errorf("failed to get post tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var postID uuid.UUID
+		var tag string
+		if err := rows.Scan(&postID, &tag); err != nil {
+			r.logger.WithError(err).Error("Failed to scan post tag")
+			return nil, fmt.Errorf("failed to scan post tag: %w", err)
+		}
+		tags[postID] = append(tags[postID], tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return tags, nil
+}
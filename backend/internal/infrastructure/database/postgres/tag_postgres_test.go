@@ -0,0 +1,111 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+func TestTagRepository_SetPostTags(t *testing.T) {
+	postId := uuid.New()
+
+	tests := []struct {
+		name          string
+		tags          []string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedError string
+	}{
+		{
+			name: "Tags are replaced",
+			tags: []string{"go", "sql"},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM post_tags WHERE post_id = \\$1").
+					WithArgs(postId).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO post_tags \\(post_id, tag\\) SELECT \\$1, unnest\\(\\$2::text\\[\\]\\)").
+					WithArgs(postId, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "No tags clears them",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM post_tags").
+					WithArgs(postId).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Database error",
+			tags: []string{"go"},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM post_tags").
+					WithArgs(postId).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("INSERT INTO post_tags").
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			expectedError: "failed to set post tags: database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewTagRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			err = repo.SetPostTags(context.Background(), postId, tt.tags)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTagRepository_GetTagsByPostIds(t *testing.T) {
+	postId1 := uuid.New()
+	postId2 := uuid.New()
+
+	mockDB, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewTagRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+	mock.ExpectQuery("SELECT post_id, tag FROM post_tags WHERE post_id = ANY\\(\\$1\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"post_id", "tag"}).
+			AddRow(postId1, "go").
+			AddRow(postId1, "sql").
+			AddRow(postId2, "go"))
+
+	tags, err := repo.GetTagsByPostIds(context.Background(), []uuid.UUID{postId1, postId2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"go", "sql"}, tags[postId1])
+	assert.Equal(t, []string{"go"}, tags[postId2])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
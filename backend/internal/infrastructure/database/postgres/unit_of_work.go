@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+// UnitOfWork runs repository calls in one database transaction. Every
+// repository built on the same database joins it through the context.
+type UnitOfWork struct {
+	db *db.PostgresDB
+}
+
+func NewUnitOfWork(db *db.PostgresDB) *UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return u.db.WithinTx(ctx, fn)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
@@ -29,13 +30,13 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *entity.NewUser) (
 	query := `
         INSERT INTO users (id, username, email, password, created_at, updated_at)
         VALUES ($1, $2, $3, $4, NOW(), NOW())
-        RETURNING id, username, email, created_at, updated_at
+        RETURNING id, username, email, role, created_at, updated_at
     `
 
 	id := uuid.New()
 	var createdUser entity.User
 	err := r.db.QueryRowContext(ctx, query, id, user.Username, user.Email, user.PasswordHash).Scan(
-		&createdUser.Id, &createdUser.Username, &createdUser.Email, &createdUser.CreatedAt, &createdUser.UpdatedAt,
+		&createdUser.Id, &createdUser.Username, &createdUser.Email, &createdUser.Role, &createdUser.CreatedAt, &createdUser.UpdatedAt,
 	)
 	if err != nil {
 		r.logger.WithError(err).Error("Failed to create user")
@@ -47,14 +48,14 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *entity.NewUser) (
 
 func (r *UserRepository) GetUserById(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	query := `
-        SELECT id, username, email, password, created_at, updated_at
+        SELECT id, username, email, password, role, created_at, updated_at
         FROM users
         WHERE id = $1
     `
 
 	var user entity.User
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&user.Id, &user.Username, &user.Email, &user.PasswordHash, &user.CreatedAt, &user.UpdatedAt,
+		&user.Id, &user.Username, &user.Email, &user.PasswordHash, &user.Role, &user.CreatedAt, &user.UpdatedAt,
 	)
 
 	if err != nil {
@@ -68,16 +69,55 @@ func (r *UserRepository) GetUserById(ctx context.Context, id uuid.UUID) (*entity
 	return &user, nil
 }
 
+// GetAuthorsByIds loads the summaries of a page's authors in one query.
+// Users without a profile get empty profile fields.
+func (r *UserRepository) GetAuthorsByIds(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*entity.AuthorSummary, error) {
+	authors := make(map[uuid.UUID]*entity.AuthorSummary, len(ids))
+	if len(ids) == 0 {
+		return authors, nil
+	}
+
+	query := `
+        SELECT u.id, u.username, COALESCE(p.display_name, ''), COALESCE(p.avatar_url, '')
+        FROM users u
+        LEFT JOIN user_profiles p ON p.user_id = u.id
+        WHERE u.id = ANY($1)
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get authors")
+		return nil, fmt.Errorf("failed to get authors: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var author entity.AuthorSummary
+		if err := rows.Scan(&author.Id, &author.Username, &author.DisplayName, &author.AvatarURL); err != nil {
+			r.logger.WithError(err).Error("Failed to scan author")
+			return nil, fmt.Errorf("failed to scan author: %w", err)
+		}
+		authors[author.Id] = &author
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return authors, nil
+}
+
 func (r *UserRepository) GetUserByUsername(ctx context.Context, username string) (*entity.User, error) {
 	query := `
-        SELECT id, username, email, password, created_at, updated_at
+        SELECT id, username, email, password, role, created_at, updated_at
         FROM users
         WHERE username = $1
     `
 
 	var user entity.User
 	err := r.db.QueryRowContext(ctx, query, username).Scan(
-		&user.Id, &user.Username, &user.Email, &user.PasswordHash, &user.CreatedAt, &user.UpdatedAt,
+		&user.Id, &user.Username, &user.Email, &user.PasswordHash, &user.Role, &user.CreatedAt, &user.UpdatedAt,
 	)
 
 	if err != nil {
@@ -92,27 +132,15 @@ func (r *UserRepository) GetUserByUsername(ctx context.Context, username string)
 }
 
 func (r *UserRepository) GetAllUsers(ctx context.Context, params *entity.Pagination) ([]*entity.User, error) {
-	query := `SELECT id, username, email, created_at, updated_at FROM users`
-
-	if params.Sort != "" {
-		sortField, sortOrder := parseSortParam(params.Sort)
-		allowedSortFields := map[string]bool{
-			"created_at": true,
-			"username":   true,
-			"email":      true,
-		}
-		if !allowedSortFields[sortField] {
-			return nil, fmt.Errorf("invalid sort field: %s", sortField)
-		}
-
-		query += fmt.Sprintf(" ORDER BY %s %s", sortField, sortOrder)
-	} else {
-		query += " ORDER BY created_at DESC"
+	keys, after, err := userKeyset(params)
+	if err != nil {
+		return nil, err
 	}
 
-	query += " LIMIT $1 OFFSET $2"
+	columns := userSelection(params)
+	query, args := keys.paginate(`SELECT `+columns.columns()+` FROM users WHERE TRUE`, nil, params, after)
 
-	rows, err := r.db.QueryContext(ctx, query, params.Limit, params.Offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		r.logger.WithError(err).Error("Failed to get all users")
 		return nil, fmt.Errorf("failed to get all users: %w", err)
@@ -123,7 +151,7 @@ func (r *UserRepository) GetAllUsers(ctx context.Context, params *entity.Paginat
 
 	for rows.Next() {
 		var user entity.User
-		if err := rows.Scan(&user.Id, &user.Username, &user.Email, &user.CreatedAt, &user.UpdatedAt); err != nil {
+		if err := rows.Scan(columns.dests(&user)...); err != nil {
 			r.logger.WithError(err).Error("Failed to scan user")
 			return nil, fmt.Errorf("failed to scan user: %w", err)
 		}
@@ -138,8 +166,60 @@ func (r *UserRepository) GetAllUsers(ctx context.Context, params *entity.Paginat
 	return users, nil
 }
 
+// userColumns are the columns of users by attribute, in the order they are
+// read. The password hash is never listed.
+var userColumns = []column[entity.User]{
+	{field: "id", expr: "id", dest: func(u *entity.User) any { return &u.Id }},
+	{field: "username", expr: "username", dest: func(u *entity.User) any { return &u.Username }},
+	{field: "email", expr: "email", dest: func(u *entity.User) any { return &u.Email }},
+	{field: "role", expr: "role", dest: func(u *entity.User) any { return &u.Role }},
+	{field: "created", expr: "created_at", dest: func(u *entity.User) any { return &u.CreatedAt }},
+	{field: "updated", expr: "updated_at", dest: func(u *entity.User) any { return &u.UpdatedAt }},
+}
+
+// userSelection picks the columns of a page of users: the requested ones
+// and the id, creation time and sort key cursors are made from.
+func userSelection(params *entity.Pagination) selection[entity.User] {
+	required := []string{"id", "created"}
+	if sortField, _ := parseSortParam(params.Sort); sortField == "username" || sortField == "email" {
+		required = append(required, sortField)
+	}
+
+	return selectColumns(userColumns, params.Fields, required...)
+}
+
+// userKeyset maps a pagination sort value onto the columns users are
+// ordered by, and the cursor, if any, onto their values.
+func userKeyset(params *entity.Pagination) (keyset, []any, error) {
+	keys := sortedBy(true, "created_at", "id")
+	if params.Sort != "" {
+		sortField, sortOrder := parseSortParam(params.Sort)
+		allowedSortFields := map[string]bool{
+			"created_at": true,
+			"username":   true,
+			"email":      true,
+		}
+		if !allowedSortFields[sortField] {
+			return nil, nil, fmt.Errorf("invalid sort field: %s", sortField)
+		}
+
+		keys = sortedBy(sortOrder == "desc", sortField, "id")
+	}
+
+	if params.Cursor == nil {
+		return keys, nil, nil
+	}
+	if keys[0].expr == "created_at" {
+		return keys, []any{params.Cursor.CreatedAt, params.Cursor.Id}, nil
+	}
+	if len(params.Cursor.Keys) != 1 {
+		return nil, nil, entity.ErrInvalidCursor
+	}
+	return keys, []any{params.Cursor.Keys[0], params.Cursor.Id}, nil
+}
+
 func (r *UserRepository) UpdateUser(ctx context.Context, user *entity.UpdateUser) error {
-	if user.Username == "" && user.Email == "" && user.Password == "" {
+	if user.Username == "" && user.Email == "" && user.Password == "" && user.Role == "" {
 		return errors.New("no fields to update")
 	}
 
@@ -162,6 +242,11 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user *entity.UpdateUser
 		args = append(args, user.Password)
 		argIndex++
 	}
+	if user.Role != "" {
+		query += fmt.Sprintf(", role = $%d", argIndex)
+		args = append(args, user.Role)
+		argIndex++
+	}
 
 	query += fmt.Sprintf(` WHERE id = $%d`, argIndex)
 	args = append(args, user.Id)
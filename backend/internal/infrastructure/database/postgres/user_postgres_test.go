@@ -35,8 +35,8 @@ func TestUserRepository_CreateUser_Success(t *testing.T) {
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery(`INSERT INTO users`).
 					WithArgs(sqlmock.AnyArg(), "testuser", "testuser@example.com", "securepassword").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "created_at", "updated_at"}).
-						AddRow(uuid.New(), "testuser", "testuser@example.com", time.Now(), time.Now()))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "role", "created_at", "updated_at"}).
+						AddRow(uuid.New(), "testuser", "testuser@example.com", "user", time.Now(), time.Now()))
 			},
 			expectedUser: &entity.User{
 				Username: "testuser",
@@ -53,8 +53,8 @@ func TestUserRepository_CreateUser_Success(t *testing.T) {
 			mockSetup: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery(`INSERT INTO users`).
 					WithArgs(sqlmock.AnyArg(), "anotheruser", "anotheruser@example.com", "anotherpassword").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "created_at", "updated_at"}).
-						AddRow(uuid.New(), "anotheruser", "anotheruser@example.com", time.Now(), time.Now()))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "role", "created_at", "updated_at"}).
+						AddRow(uuid.New(), "anotheruser", "anotheruser@example.com", "user", time.Now(), time.Now()))
 			},
 			expectedUser: &entity.User{
 				Username: "anotheruser",
@@ -151,10 +151,10 @@ func TestUserRepository_GetUserById_Success(t *testing.T) {
 			name: "Get user by ID successfully",
 			id:   userId1,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, username, email, password, created_at, updated_at FROM users WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE id = \$1`).
 					WithArgs(userId1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password", "created_at", "updated_at"}).
-						AddRow(userId1, "testuser", "testuser@example.com", "securepassword", time.Now(), time.Now()))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password", "role", "created_at", "updated_at"}).
+						AddRow(userId1, "testuser", "testuser@example.com", "securepassword", "user", time.Now(), time.Now()))
 			},
 			expectedUser: &entity.User{
 				Id:           userId1,
@@ -167,10 +167,10 @@ func TestUserRepository_GetUserById_Success(t *testing.T) {
 			name: "Get user by different ID",
 			id:   userId2,
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, username, email, password, created_at, updated_at FROM users WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE id = \$1`).
 					WithArgs(userId2).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password", "created_at", "updated_at"}).
-						AddRow(userId2, "anotheruser", "anotheruser@example.com", "anotherpassword", time.Now(), time.Now()))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password", "role", "created_at", "updated_at"}).
+						AddRow(userId2, "anotheruser", "anotheruser@example.com", "anotherpassword", "user", time.Now(), time.Now()))
 			},
 			expectedUser: &entity.User{
 				Id:           userId2,
@@ -219,7 +219,7 @@ func TestUserRepository_GetUserById_Fail(t *testing.T) {
 			mockError:   errors.New("failed to get user"),
 			expectedErr: "failed to get user",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, username, email, password, created_at, updated_at FROM users WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE id = \$1`).
 					WithArgs(userId1).
 					WillReturnError(errors.New("failed to get user"))
 			},
@@ -230,7 +230,7 @@ func TestUserRepository_GetUserById_Fail(t *testing.T) {
 			mockError:   sql.ErrNoRows,
 			expectedErr: "user not found",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, username, email, password, created_at, updated_at FROM users WHERE id = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE id = \$1`).
 					WithArgs(userId2).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -275,10 +275,10 @@ func TestUserRepository_GetUserByUsername_Success(t *testing.T) {
 			name:     "Get user by username successfully",
 			username: "testuser",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, username, email, password, created_at, updated_at FROM users WHERE username = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE username = \$1`).
 					WithArgs("testuser").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password", "created_at", "updated_at"}).
-						AddRow(userId1, "testuser", "testuser@example.com", "securepassword", time.Now(), time.Now()))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password", "role", "created_at", "updated_at"}).
+						AddRow(userId1, "testuser", "testuser@example.com", "securepassword", "user", time.Now(), time.Now()))
 			},
 			expectedUser: &entity.User{
 				Id:           userId1,
@@ -291,10 +291,10 @@ func TestUserRepository_GetUserByUsername_Success(t *testing.T) {
 			name:     "Get user by another username",
 			username: "anotheruser",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, username, email, password, created_at, updated_at FROM users WHERE username = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE username = \$1`).
 					WithArgs("anotheruser").
-					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password", "created_at", "updated_at"}).
-						AddRow(userId2, "anotheruser", "anotheruser@example.com", "anotherpassword", time.Now(), time.Now()))
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "password", "role", "created_at", "updated_at"}).
+						AddRow(userId2, "anotheruser", "anotheruser@example.com", "anotherpassword", "user", time.Now(), time.Now()))
 			},
 			expectedUser: &entity.User{
 				Id:           userId2,
@@ -343,7 +343,7 @@ func TestUserRepository_GetUserByUsername_Fail(t *testing.T) {
 			mockError:   errors.New("failed to get user"),
 			expectedErr: "failed to get user",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, username, email, password, created_at, updated_at FROM users WHERE username = \$1`).
+				mock.ExpectQuery(`SELECT id, username, email, password, role, created_at, updated_at FROM users WHERE username = \$1`).
 					WithArgs("testuser").
 					WillReturnError(errors.New("failed to get user"))
 			},
@@ -372,6 +372,73 @@ func TestUserRepository_GetUserByUsername_Fail(t *testing.T) {
 	}
 }
 
+func TestUserRepository_GetAllUsers_Cursor(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewUserRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	mock.ExpectQuery(`SELECT id, username, email, role, created_at, updated_at FROM users WHERE TRUE AND \(username, id\) > \(\$1, \$2\) ORDER BY username ASC, id ASC LIMIT \$3 OFFSET \$4`).
+		WithArgs("tom", userId1, 10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "role", "created_at", "updated_at"}).
+			AddRow(userId2, "zoe", "zoe@example.com", "user", time.Now(), time.Now()))
+
+	users, err := repo.GetAllUsers(context.Background(), &entity.Pagination{
+		Limit:  10,
+		Sort:   "username_asc",
+		Cursor: &entity.Cursor{Keys: []string{"tom"}, Id: userId1},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetAllUsers_Fields(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewUserRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	mock.ExpectQuery(`SELECT id, email, created_at FROM users WHERE TRUE ORDER BY email DESC, id DESC LIMIT \$1 OFFSET \$2`).
+		WithArgs(10, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "created_at"}).
+			AddRow(userId1, "tom@example.com", time.Now()))
+
+	users, err := repo.GetAllUsers(context.Background(), &entity.Pagination{
+		Limit:  10,
+		Sort:   "email_desc",
+		Fields: entity.Fields{"id"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "tom@example.com", users[0].Email)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserRepository_GetAuthorsByIds(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := postgres.NewUserRepository(&db.PostgresDB{DB: mockDB}, logrus.New())
+
+	mock.ExpectQuery(`SELECT u.id, u.username, COALESCE\(p.display_name, ''\), COALESCE\(p.avatar_url, ''\) FROM users u LEFT JOIN user_profiles p ON p.user_id = u.id WHERE u.id = ANY\(\$1\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "display_name", "avatar_url"}).
+			AddRow(userId1, "tom", "Tom", "").
+			AddRow(userId2, "zoe", "", ""))
+
+	authors, err := repo.GetAuthorsByIds(context.Background(), []uuid.UUID{userId1, userId2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &entity.AuthorSummary{Id: userId1, Username: "tom", DisplayName: "Tom"}, authors[userId1])
+	assert.Equal(t, "zoe", authors[userId2].Username)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestUserRepository_GetAllUsers_Fail(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -382,7 +449,7 @@ func TestUserRepository_GetAllUsers_Fail(t *testing.T) {
 			name:        "Failed to get all users - SQL error",
 			expectedErr: "failed to get all users",
 			mockSetup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery(`SELECT id, username, email, created_at, updated_at FROM users`).
+				mock.ExpectQuery(`SELECT id, username, email, role, created_at, updated_at FROM users`).
 					WithArgs(10, 0).
 					WillReturnError(errors.New("failed to get users"))
 			},
@@ -449,6 +516,18 @@ func TestUserRepository_UpdateUser_Success(t *testing.T) {
 				Password: "newPassword",
 			},
 		},
+		{
+			name: "Successfully update user role",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE users SET updated_at = NOW\(\), role = \$1 WHERE id = \$2`).
+					WithArgs(entity.RoleModerator, userId2).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			user: &entity.UpdateUser{
+				Id:   userId2,
+				Role: entity.RoleModerator,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,368 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+const deliveryColumns = `d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at,
+            d.last_attempt_at, d.response_status, d.last_error, d.created_at`
+
+type WebhookRepository struct {
+	db     *db.PostgresDB
+	logger *logrus.Logger
+}
+
+func NewWebhookRepository(db *db.PostgresDB, logger *logrus.Logger) *WebhookRepository {
+	return &WebhookRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *WebhookRepository) CreateWebhook(ctx context.Context, webhook *entity.Webhook) error {
+	query := `
+        INSERT INTO webhooks (id, url, secret, events, active, created_by, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+        RETURNING created_at, updated_at
+    `
+
+	webhook.Id = uuid.New()
+	err := r.db.QueryRowContext(ctx, query,
+		webhook.Id, webhook.URL, webhook.Secret, pq.Array(eventTypeStrings(webhook.Events)), webhook.Active, webhook.CreatedBy,
+	).Scan(&webhook.CreatedAt, &webhook.UpdatedAt)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to create webhook")
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) GetWebhook(ctx context.Context, id uuid.UUID) (*entity.Webhook, error) {
+	query := `
+        SELECT id, url, secret, events, active, created_by, created_at, updated_at
+        FROM webhooks
+        WHERE id = $1
+    `
+
+	webhook, err := scanWebhook(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("webhook not found")
+		}
+		r.logger.WithError(err).Error("Failed to get webhook")
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (r *WebhookRepository) GetWebhooks(ctx context.Context) ([]*entity.Webhook, error) {
+	query := `
+        SELECT id, url, secret, events, active, created_by, created_at, updated_at
+        FROM webhooks
+        ORDER BY created_at
+    `
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get webhooks")
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := []*entity.Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to scan webhook")
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) UpdateWebhook(ctx context.Context, webhook *entity.Webhook) error {
+	query := `
+        UPDATE webhooks
+        SET url = $2, secret = $3, events = $4, active = $5, updated_at = NOW()
+        WHERE id = $1
+        RETURNING updated_at
+    `
+
+	err := r.db.QueryRowContext(ctx, query,
+		webhook.Id, webhook.URL, webhook.Secret, pq.Array(eventTypeStrings(webhook.Events)), webhook.Active,
+	).Scan(&webhook.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("webhook not found")
+		}
+		r.logger.WithError(err).Error("Failed to update webhook")
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM webhooks WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to delete webhook")
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("webhook not found")
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) CreateDeliveries(ctx context.Context, eventType entity.WebhookEventType, payload json.RawMessage) ([]*entity.WebhookDelivery, error) {
+	query := `
+        INSERT INTO webhook_deliveries AS d (id, webhook_id, event_type, payload, next_attempt_at, created_at)
+        SELECT gen_random_uuid(), id, $1, $2, NOW(), NOW()
+        FROM webhooks
+        WHERE active AND $1 = ANY(events)
+        RETURNING ` + deliveryColumns
+
+	rows, err := r.db.QueryContext(ctx, query, eventType, []byte(payload))
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to create webhook deliveries")
+		return nil, fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*entity.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to scan webhook delivery")
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query := `
+        INSERT INTO webhook_deliveries (id, webhook_id, event_type, payload, status, next_attempt_at, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, NOW())
+        RETURNING created_at
+    `
+
+	delivery.Id = uuid.New()
+	err := r.db.QueryRowContext(ctx, query,
+		delivery.Id, delivery.WebhookId, delivery.EventType, []byte(delivery.Payload), delivery.Status, delivery.NextAttemptAt,
+	).Scan(&delivery.CreatedAt)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to create webhook delivery")
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) GetDelivery(ctx context.Context, webhookID, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries d WHERE d.id = $1 AND d.webhook_id = $2`
+
+	delivery, err := scanDelivery(r.db.QueryRowContext(ctx, query, id, webhookID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("webhook delivery not found")
+		}
+		r.logger.WithError(err).Error("Failed to get webhook delivery")
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// GetDeliveries returns the delivery log of a webhook, newest first. An empty
+// status returns every delivery.
+func (r *WebhookRepository) GetDeliveries(ctx context.Context, webhookID uuid.UUID, status entity.WebhookDeliveryStatus, params *entity.Pagination) ([]*entity.WebhookDelivery, error) {
+	query := `
+        SELECT ` + deliveryColumns + `
+        FROM webhook_deliveries d
+        WHERE d.webhook_id = $1 AND ($2 = '' OR d.status = $2)
+        ORDER BY d.created_at DESC
+        LIMIT $3 OFFSET $4
+    `
+
+	rows, err := r.db.QueryContext(ctx, query, webhookID, status, params.Limit, params.Offset)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to get webhook deliveries")
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*entity.WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			r.logger.WithError(err).Error("Failed to scan webhook delivery")
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.WithError(err).Error("Error occurred during row iteration")
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) GetTotalDeliveries(ctx context.Context, webhookID uuid.UUID, status entity.WebhookDeliveryStatus) (int, error) {
+	query := `SELECT COUNT(*) FROM webhook_deliveries WHERE webhook_id = $1 AND ($2 = '' OR status = $2)`
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, query, webhookID, status).Scan(&total); err != nil {
+		r.logger.WithError(err).Error("Failed to get total webhook deliveries")
+		return 0, fmt.Errorf("failed to get total webhook deliveries: %w", err)
+	}
+
+	return total, nil
+}
+
+func (r *WebhookRepository) GetPendingDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	query := `
+        SELECT ` + deliveryColumns + `, w.url, w.secret, w.active
+        FROM webhook_deliveries d
+        JOIN webhooks w ON w.id = d.webhook_id
+        WHERE d.id = $1 AND d.status = 'pending'
+    `
+
+	var d entity.WebhookDelivery
+	var payload []byte
+	webhook := &entity.Webhook{}
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&d.Id, &d.WebhookId, &d.EventType, &payload, &d.Status, &d.Attempts, &d.NextAttemptAt,
+		&d.LastAttemptAt, &d.ResponseStatus, &d.LastError, &d.CreatedAt, &webhook.URL, &webhook.Secret, &webhook.Active,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrWebhookDeliveryGone
+		}
+		r.logger.WithError(err).Error("Failed to get pending webhook delivery")
+		return nil, fmt.Errorf("failed to get pending webhook delivery: %w", err)
+	}
+	d.Payload = payload
+	webhook.Id = d.WebhookId
+	d.Webhook = webhook
+
+	return &d, nil
+}
+
+func (r *WebhookRepository) SaveAttempt(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query := `
+        UPDATE webhook_deliveries
+        SET status = $2, attempts = $3, next_attempt_at = $4, last_attempt_at = $5,
+            response_status = $6, last_error = $7
+        WHERE id = $1
+    `
+
+	_, err := r.db.ExecContext(ctx, query,
+		delivery.Id, delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastAttemptAt,
+		delivery.ResponseStatus, delivery.LastError,
+	)
+	if err != nil {
+		r.logger.WithError(err).Error("Failed to save webhook delivery attempt")
+		return fmt.Errorf("failed to save webhook delivery attempt: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) Redeliver(ctx context.Context, webhookID, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	query := `
+        UPDATE webhook_deliveries d
+        SET status = 'pending', attempts = 0, next_attempt_at = NOW()
+        WHERE d.id = $1 AND d.webhook_id = $2
+        RETURNING ` + deliveryColumns
+
+	delivery, err := scanDelivery(r.db.QueryRowContext(ctx, query, id, webhookID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("webhook delivery not found")
+		}
+		r.logger.WithError(err).Error("Failed to redeliver webhook delivery")
+		return nil, fmt.Errorf("failed to redeliver webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func scanWebhook(row rowScanner) (*entity.Webhook, error) {
+	var webhook entity.Webhook
+	var events []string
+	err := row.Scan(
+		&webhook.Id, &webhook.URL, &webhook.Secret, pq.Array(&events), &webhook.Active,
+		&webhook.CreatedBy, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		webhook.Events = append(webhook.Events, entity.WebhookEventType(event))
+	}
+
+	return &webhook, nil
+}
+
+func scanDelivery(row rowScanner) (*entity.WebhookDelivery, error) {
+	var d entity.WebhookDelivery
+	var payload []byte
+	err := row.Scan(
+		&d.Id, &d.WebhookId, &d.EventType, &payload, &d.Status, &d.Attempts, &d.NextAttemptAt,
+		&d.LastAttemptAt, &d.ResponseStatus, &d.LastError, &d.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	d.Payload = payload
+
+	return &d, nil
+}
+
+func eventTypeStrings(types []entity.WebhookEventType) []string {
+	strs := make([]string, 0, len(types))
+	for _, t := range types {
+		strs = append(strs, string(t))
+	}
+	return strs
+}
@@ -0,0 +1,193 @@
+package postgres_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/infrastructure/database/postgres"
+	"github.com/popeskul/awesome-blog/backend/pkg/db"
+)
+
+var deliveryColumns = []string{"id", "webhook_id", "event_type", "payload", "status", "attempts", "next_attempt_at",
+	"last_attempt_at", "response_status", "last_error", "created_at"}
+
+func TestWebhookRepository_CreateDeliveries(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expected      int
+		expectedError string
+	}{
+		{
+			name: "Created for matching webhooks",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				now := time.Now()
+				rows := sqlmock.NewRows(deliveryColumns)
+				for i := 0; i < 2; i++ {
+					rows.AddRow(uuid.New(), uuid.New(), entity.WebhookPostPublished, []byte(`{"id":1}`), entity.WebhookDeliveryPending, 0, now,
+						nil, nil, "", now)
+				}
+				mock.ExpectQuery("INSERT INTO webhook_deliveries .* FROM webhooks\\s+WHERE active AND \\$1 = ANY\\(events\\)\\s+RETURNING").
+					WithArgs(entity.WebhookPostPublished, []byte(`{"id":1}`)).
+					WillReturnRows(rows)
+			},
+			expected: 2,
+		},
+		{
+			name: "Database error",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("INSERT INTO webhook_deliveries").
+					WillReturnError(errors.New("database error"))
+			},
+			expectedError: "failed to create webhook deliveries: database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewWebhookRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			deliveries, err := repo.CreateDeliveries(context.Background(), entity.WebhookPostPublished, json.RawMessage(`{"id":1}`))
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, deliveries, tt.expected)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhookRepository_GetPendingDelivery(t *testing.T) {
+	webhookId := uuid.New()
+	deliveryId := uuid.New()
+	columns := append(append([]string{}, deliveryColumns...), "url", "secret", "active")
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Pending delivery with its webhook",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				now := time.Now()
+				mock.ExpectQuery("FROM webhook_deliveries d\\s+JOIN webhooks w ON w.id = d.webhook_id\\s+WHERE d.id = \\$1 AND d.status = 'pending'").
+					WithArgs(deliveryId).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(
+						deliveryId, webhookId, entity.WebhookPostPublished, []byte(`{}`), entity.WebhookDeliveryPending, 1, now,
+						now, 500, "unexpected status 500", now, "https://example.com/hook", "secret", true,
+					))
+			},
+		},
+		{
+			name: "Delivery no longer pending",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM webhook_deliveries d").
+					WithArgs(deliveryId).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			expectedError: entity.ErrWebhookDeliveryGone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewWebhookRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			delivery, err := repo.GetPendingDelivery(context.Background(), deliveryId)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, delivery)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, deliveryId, delivery.Id)
+				assert.Equal(t, 1, delivery.Attempts)
+				assert.Equal(t, &entity.Webhook{Id: webhookId, URL: "https://example.com/hook", Secret: "secret", Active: true}, delivery.Webhook)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhookRepository_Redeliver(t *testing.T) {
+	webhookId := uuid.New()
+	deliveryId := uuid.New()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectedError string
+	}{
+		{
+			name: "Delivery is queued again",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				now := time.Now()
+				mock.ExpectQuery("UPDATE webhook_deliveries d\\s+SET status = 'pending', attempts = 0").
+					WithArgs(deliveryId, webhookId).
+					WillReturnRows(sqlmock.NewRows(deliveryColumns).AddRow(
+						deliveryId, webhookId, entity.WebhookPostPublished, []byte(`{}`), entity.WebhookDeliveryPending, 0, now,
+						now, 500, "unexpected status 500", now,
+					))
+			},
+		},
+		{
+			name: "Delivery not found",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("UPDATE webhook_deliveries d").
+					WithArgs(deliveryId, webhookId).
+					WillReturnRows(sqlmock.NewRows(deliveryColumns))
+			},
+			expectedError: "webhook delivery not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			repo := postgres.NewWebhookRepository(&db.PostgresDB{DB: mockDB, Logger: logrus.New()}, logrus.New())
+
+			tt.mockSetup(mock)
+
+			delivery, err := repo.Redeliver(context.Background(), webhookId, deliveryId)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, delivery)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, deliveryId, delivery.Id)
+				assert.Equal(t, entity.WebhookDeliveryPending, delivery.Status)
+				assert.Equal(t, 0, delivery.Attempts)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
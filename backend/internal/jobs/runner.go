@@ -0,0 +1,403 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+const (
+	// DefaultQueue always exists, even if the options leave it out.
+	DefaultQueue = "default"
+	// KindCleanup deletes old succeeded jobs. The runner schedules it itself.
+	KindCleanup = "jobs.cleanup"
+)
+
+// Handler runs one job. An error schedules a retry until the job is out of
+// attempts. The context is cancelled when the job times out or the runner
+// shuts down.
+type Handler func(ctx context.Context, job *entity.Job) error
+
+// Typed adapts a handler that takes the decoded payload of its job.
+func Typed[T any](fn func(ctx context.Context, payload T) error) Handler {
+	return func(ctx context.Context, job *entity.Job) error {
+		var payload T
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode %s payload: %w", job.Kind, err)
+		}
+
+		return fn(ctx, payload)
+	}
+}
+
+type Options struct {
+	// Queues maps each queue to how many of its jobs run at once on this
+	// replica.
+	Queues map[string]int
+	// PollInterval is how often idle queues are checked for due jobs.
+	PollInterval time.Duration
+	// Timeout bounds a single attempt.
+	Timeout time.Duration
+	// MaxAttempts is how many attempts a job gets before it fails for good.
+	MaxAttempts int
+	// BackoffBase is the wait after the first failure; it doubles with every
+	// further failure up to BackoffMax.
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// Retention is how long succeeded jobs are kept.
+	Retention time.Duration
+}
+
+type registration struct {
+	queue   string
+	handler Handler
+}
+
+type schedule struct {
+	name     string
+	kind     string
+	payload  json.RawMessage
+	schedule cron.Schedule
+	next     time.Time
+}
+
+// Runner works the job queues. Several replicas may run one; every job is
+// handed to a single worker at a time.
+type Runner struct {
+	repo      repository.JobRepository
+	logger    *logrus.Logger
+	opts      Options
+	handlers  map[string]registration
+	schedules []*schedule
+}
+
+func NewRunner(repo repository.JobRepository, logger *logrus.Logger, opts Options) *Runner {
+	queues := map[string]int{DefaultQueue: 1}
+	for queue, concurrency := range opts.Queues {
+		queues[queue] = max(concurrency, 1)
+	}
+	opts.Queues = queues
+
+	r := &Runner{
+		repo:     repo,
+		logger:   logger,
+		opts:     opts,
+		handlers: map[string]registration{},
+	}
+
+	r.Register(KindCleanup, DefaultQueue, func(ctx context.Context, job *entity.Job) error {
+		deleted, err := repo.DeleteFinishedJobs(ctx, time.Now().Add(-opts.Retention))
+		if err == nil && deleted > 0 {
+			logger.WithField("jobs", deleted).Info("Deleted finished jobs")
+		}
+		return err
+	})
+	if err := r.Schedule(KindCleanup, "@hourly", KindCleanup, nil); err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Register sets the handler of a kind of job and the queue its jobs run on.
+// It panics on an unknown queue or a kind registered twice, like
+// http.HandleFunc, since both are programming errors.
+func (r *Runner) Register(kind, queue string, handler Handler) {
+	if _, ok := r.opts.Queues[queue]; !ok {
+		panic(fmt.Sprintf("jobs: unknown queue %q for %s", queue, kind))
+	}
+	if _, ok := r.handlers[kind]; ok {
+		panic(fmt.Sprintf("jobs: %s registered twice", kind))
+	}
+
+	r.handlers[kind] = registration{queue: queue, handler: handler}
+}
+
+// Schedule queues a job of a registered kind on a cron schedule, e.g.
+// "*/15 * * * *", "@daily" or "@every 1h". Every replica runs the schedule;
+// the name makes sure each run is only queued once.
+func (r *Runner) Schedule(name, spec, kind string, payload any) error {
+	if _, ok := r.handlers[kind]; !ok {
+		return fmt.Errorf("no handler for %s", kind)
+	}
+
+	parsed, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("invalid schedule %q for %s: %w", spec, name, err)
+	}
+
+	data, err := encodePayload(payload)
+	if err != nil {
+		return err
+	}
+
+	r.schedules = append(r.schedules, &schedule{name: name, kind: kind, payload: data, schedule: parsed})
+
+	return nil
+}
+
+// Enqueue queues a job of a registered kind to run right away. Called within
+// a unit of work, the job is only queued if the transaction commits.
+func (r *Runner) Enqueue(ctx context.Context, kind string, payload any) (*entity.Job, error) {
+	return r.EnqueueAt(ctx, kind, payload, time.Now())
+}
+
+// EnqueueAt queues a job of a registered kind to run at the given time.
+func (r *Runner) EnqueueAt(ctx context.Context, kind string, payload any, runAt time.Time) (*entity.Job, error) {
+	data, err := encodePayload(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.newJob(kind, data, runAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.repo.EnqueueJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// Run works the queues and the schedules until ctx is cancelled, then waits
+// for the running jobs, whose contexts are cancelled as well, to record
+// their outcome.
+func (r *Runner) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for queue, concurrency := range r.opts.Queues {
+		kinds := r.kinds(queue)
+		if len(kinds) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(queue string, concurrency int) {
+			defer wg.Done()
+			r.work(ctx, queue, kinds, concurrency)
+		}(queue, concurrency)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.runSchedules(ctx)
+	}()
+
+	wg.Wait()
+}
+
+// Backoff is the wait before the next attempt after the given number of
+// failed ones.
+func (r *Runner) Backoff(attempts int) time.Duration {
+	wait := r.opts.BackoffBase
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= r.opts.BackoffMax {
+			return r.opts.BackoffMax
+		}
+	}
+
+	return wait
+}
+
+func (r *Runner) work(ctx context.Context, queue string, kinds []string, concurrency int) {
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	// slots holds a token per running job; finished wakes the loop when one
+	// frees up so that a busy queue doesn't wait for the next tick.
+	slots := make(chan struct{}, concurrency)
+	finished := make(chan struct{}, 1)
+
+	for {
+		if free := concurrency - len(slots); free > 0 {
+			jobs, err := r.repo.ClaimJobs(ctx, queue, kinds, free, r.opts.Timeout+time.Minute)
+			if err != nil && ctx.Err() == nil {
+				r.logger.WithError(err).WithField("queue", queue).Warn("Failed to claim jobs")
+			}
+
+			for _, job := range jobs {
+				slots <- struct{}{}
+				wg.Add(1)
+				go func(job *entity.Job) {
+					defer wg.Done()
+					r.Execute(ctx, job)
+					<-slots
+					select {
+					case finished <- struct{}{}:
+					default:
+					}
+				}(job)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-finished:
+		}
+	}
+}
+
+// Execute makes one attempt at a claimed job and stores the outcome.
+func (r *Runner) Execute(ctx context.Context, job *entity.Job) {
+	logger := r.logger.WithFields(logrus.Fields{
+		"jobID":   job.Id,
+		"kind":    job.Kind,
+		"attempt": job.Attempts,
+	})
+
+	err := r.run(ctx, job)
+
+	now := time.Now()
+	switch {
+	case err == nil:
+		job.Status = entity.JobSucceeded
+		job.LastError = ""
+		job.FinishedAt = &now
+	case job.Attempts >= job.MaxAttempts:
+		job.Status = entity.JobFailed
+		job.LastError = err.Error()
+		job.FinishedAt = &now
+		logger.WithError(err).Error("Job failed for good")
+	default:
+		job.Status = entity.JobQueued
+		job.LastError = err.Error()
+		job.RunAt = now.Add(r.Backoff(job.Attempts))
+		logger.WithError(err).Warn("Job failed, will retry")
+	}
+
+	// The outcome is stored even when the runner is shutting down.
+	err = r.repo.SaveJobResult(context.WithoutCancel(ctx), job)
+	switch {
+	case errors.Is(err, entity.ErrJobLeaseLost):
+		logger.Warn("Job outlived its lease; another worker has it now, so the result is dropped")
+	case err != nil:
+		logger.WithError(err).Error("Failed to record job result")
+	}
+}
+
+func (r *Runner) run(ctx context.Context, job *entity.Job) (err error) {
+	registration, ok := r.handlers[job.Kind]
+	if !ok {
+		return fmt.Errorf("no handler for %s", job.Kind)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.opts.Timeout)
+	defer cancel()
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panicked: %v", p)
+		}
+	}()
+
+	return registration.handler(ctx, job)
+}
+
+func (r *Runner) runSchedules(ctx context.Context) {
+	if len(r.schedules) == 0 {
+		return
+	}
+
+	now := time.Now()
+	for _, s := range r.schedules {
+		s.next = s.schedule.Next(now)
+	}
+
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now = <-ticker.C:
+		}
+
+		for _, s := range r.schedules {
+			if now.Before(s.next) {
+				continue
+			}
+
+			if err := r.enqueueScheduled(ctx, s); err != nil {
+				r.logger.WithError(err).WithField("schedule", s.name).Warn("Failed to queue scheduled job")
+				continue
+			}
+			s.next = s.schedule.Next(now)
+		}
+	}
+}
+
+func (r *Runner) enqueueScheduled(ctx context.Context, s *schedule) error {
+	job, err := r.newJob(s.kind, s.payload, s.next)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("schedule:%s:%d", s.name, s.next.Unix())
+	job.UniqueKey = &key
+
+	_, err = r.repo.EnqueueJob(ctx, job)
+	return err
+}
+
+func (r *Runner) newJob(kind string, payload json.RawMessage, runAt time.Time) (*entity.Job, error) {
+	registration, ok := r.handlers[kind]
+	if !ok {
+		return nil, fmt.Errorf("no handler for %s", kind)
+	}
+
+	return &entity.Job{
+		Queue:       registration.queue,
+		Kind:        kind,
+		Payload:     payload,
+		MaxAttempts: r.opts.MaxAttempts,
+		RunAt:       runAt,
+	}, nil
+}
+
+// kinds lists the kinds of jobs that run on a queue. Workers only claim
+// those, so that a replica running an older release leaves jobs it cannot
+// handle to the others.
+func (r *Runner) kinds(queue string) []string {
+	var kinds []string
+	for kind, registration := range r.handlers {
+		if registration.queue == queue {
+			kinds = append(kinds, kind)
+		}
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+func encodePayload(payload any) (json.RawMessage, error) {
+	if payload == nil {
+		return json.RawMessage(`{}`), nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode job payload: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,258 @@
+package jobs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/jobs"
+)
+
+const kindGreet = "test.greet"
+
+var options = jobs.Options{
+	Queues:       map[string]int{"mail": 2},
+	PollInterval: 10 * time.Millisecond,
+	Timeout:      time.Second,
+	MaxAttempts:  3,
+	BackoffBase:  time.Minute,
+	BackoffMax:   10 * time.Minute,
+	Retention:    time.Hour,
+}
+
+type greeting struct {
+	Name string `json:"name"`
+}
+
+func newJob(kind string, attempts int) *entity.Job {
+	return &entity.Job{
+		Id:          uuid.New(),
+		Queue:       "mail",
+		Kind:        kind,
+		Payload:     json.RawMessage(`{"name":"Ada"}`),
+		Status:      entity.JobRunning,
+		Attempts:    attempts,
+		MaxAttempts: options.MaxAttempts,
+		RunAt:       time.Now(),
+	}
+}
+
+func TestRunner_Execute(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		attempts       int
+		expectedStatus entity.JobStatus
+		expectRetry    bool
+	}{
+		{
+			name:           "Success",
+			attempts:       1,
+			expectedStatus: entity.JobSucceeded,
+		},
+		{
+			name:           "Failure is retried with backoff",
+			err:            errors.New("smtp unavailable"),
+			attempts:       2,
+			expectedStatus: entity.JobQueued,
+			expectRetry:    true,
+		},
+		{
+			name:           "Last failure fails the job",
+			err:            errors.New("smtp unavailable"),
+			attempts:       3,
+			expectedStatus: entity.JobFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mocksrepository.NewMockJobRepository(ctrl)
+			runner := jobs.NewRunner(repo, logrus.New(), options)
+
+			var received greeting
+			runner.Register(kindGreet, "mail", jobs.Typed(func(ctx context.Context, payload greeting) error {
+				received = payload
+				return tt.err
+			}))
+
+			job := newJob(kindGreet, tt.attempts)
+			repo.EXPECT().SaveJobResult(gomock.Any(), job).Return(nil)
+
+			before := time.Now()
+			runner.Execute(context.Background(), job)
+
+			assert.Equal(t, "Ada", received.Name)
+			assert.Equal(t, tt.expectedStatus, job.Status)
+			if tt.err != nil {
+				assert.Equal(t, tt.err.Error(), job.LastError)
+			} else {
+				assert.Empty(t, job.LastError)
+			}
+			if tt.expectRetry {
+				assert.Nil(t, job.FinishedAt)
+				assert.WithinDuration(t, before.Add(runner.Backoff(tt.attempts)), job.RunAt, time.Second)
+			} else {
+				assert.NotNil(t, job.FinishedAt)
+			}
+		})
+	}
+}
+
+func TestRunner_ExecuteFailures(t *testing.T) {
+	tests := []struct {
+		name          string
+		kind          string
+		payload       json.RawMessage
+		expectedError string
+	}{
+		{
+			name:          "Unknown kind",
+			kind:          "test.unknown",
+			payload:       json.RawMessage(`{}`),
+			expectedError: "no handler for test.unknown",
+		},
+		{
+			name:          "Undecodable payload",
+			kind:          kindGreet,
+			payload:       json.RawMessage(`[]`),
+			expectedError: "failed to decode test.greet payload",
+		},
+		{
+			name:          "Panic",
+			kind:          "test.panic",
+			payload:       json.RawMessage(`{}`),
+			expectedError: "job panicked: boom",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mocksrepository.NewMockJobRepository(ctrl)
+			runner := jobs.NewRunner(repo, logrus.New(), options)
+			runner.Register(kindGreet, "mail", jobs.Typed(func(ctx context.Context, payload greeting) error {
+				return nil
+			}))
+			runner.Register("test.panic", "mail", func(ctx context.Context, job *entity.Job) error {
+				panic("boom")
+			})
+
+			job := newJob(tt.kind, 1)
+			job.Payload = tt.payload
+			repo.EXPECT().SaveJobResult(gomock.Any(), job).Return(nil)
+
+			runner.Execute(context.Background(), job)
+
+			assert.Equal(t, entity.JobQueued, job.Status)
+			assert.Contains(t, job.LastError, tt.expectedError)
+		})
+	}
+}
+
+func TestRunner_Register(t *testing.T) {
+	runner := jobs.NewRunner(nil, logrus.New(), options)
+	handler := func(ctx context.Context, job *entity.Job) error { return nil }
+
+	assert.Panics(t, func() { runner.Register(kindGreet, "reports", handler) })
+	assert.Panics(t, func() { runner.Register(jobs.KindCleanup, jobs.DefaultQueue, handler) })
+	assert.NotPanics(t, func() { runner.Register(kindGreet, "mail", handler) })
+}
+
+func TestRunner_Schedule(t *testing.T) {
+	runner := jobs.NewRunner(nil, logrus.New(), options)
+	runner.Register(kindGreet, "mail", func(ctx context.Context, job *entity.Job) error { return nil })
+
+	assert.NoError(t, runner.Schedule("greet", "*/15 * * * *", kindGreet, greeting{Name: "Ada"}))
+	assert.NoError(t, runner.Schedule("greet-daily", "@daily", kindGreet, nil))
+	assert.Error(t, runner.Schedule("greet", "every minute", kindGreet, nil))
+	assert.Error(t, runner.Schedule("unknown", "@daily", "test.unknown", nil))
+}
+
+func TestRunner_Enqueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mocksrepository.NewMockJobRepository(ctrl)
+	runner := jobs.NewRunner(repo, logrus.New(), options)
+	runner.Register(kindGreet, "mail", func(ctx context.Context, job *entity.Job) error { return nil })
+
+	repo.EXPECT().EnqueueJob(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *entity.Job) (bool, error) {
+		assert.Equal(t, "mail", job.Queue)
+		assert.Equal(t, options.MaxAttempts, job.MaxAttempts)
+		assert.JSONEq(t, `{"name":"Ada"}`, string(job.Payload))
+		assert.Nil(t, job.UniqueKey)
+		return true, nil
+	})
+
+	_, err := runner.Enqueue(context.Background(), kindGreet, greeting{Name: "Ada"})
+	require.NoError(t, err)
+
+	_, err = runner.Enqueue(context.Background(), "test.unknown", nil)
+	assert.EqualError(t, err, "no handler for test.unknown")
+}
+
+func TestRunner_Backoff(t *testing.T) {
+	runner := jobs.NewRunner(nil, logrus.New(), options)
+
+	assert.Equal(t, time.Minute, runner.Backoff(1))
+	assert.Equal(t, 2*time.Minute, runner.Backoff(2))
+	assert.Equal(t, 8*time.Minute, runner.Backoff(4))
+	assert.Equal(t, 10*time.Minute, runner.Backoff(5))
+	assert.Equal(t, 10*time.Minute, runner.Backoff(50))
+}
+
+func TestRunner_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mocksrepository.NewMockJobRepository(ctrl)
+	runner := jobs.NewRunner(repo, logrus.New(), options)
+
+	done := make(chan struct{})
+	runner.Register(kindGreet, "mail", func(ctx context.Context, job *entity.Job) error {
+		close(done)
+		return nil
+	})
+
+	job := newJob(kindGreet, 1)
+	saved := make(chan struct{})
+
+	repo.EXPECT().ClaimJobs(gomock.Any(), jobs.DefaultQueue, []string{jobs.KindCleanup}, 1, gomock.Any()).
+		Return(nil, nil).AnyTimes()
+	gomock.InOrder(
+		repo.EXPECT().ClaimJobs(gomock.Any(), "mail", []string{kindGreet}, 2, options.Timeout+time.Minute).
+			Return([]*entity.Job{job}, nil),
+		repo.EXPECT().ClaimJobs(gomock.Any(), "mail", []string{kindGreet}, gomock.Any(), gomock.Any()).
+			Return(nil, nil).AnyTimes(),
+	)
+	repo.EXPECT().SaveJobResult(gomock.Any(), job).DoAndReturn(func(context.Context, *entity.Job) error {
+		close(saved)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		runner.Run(ctx)
+	}()
+
+	select {
+	case <-saved:
+	case <-time.After(time.Second):
+		t.Fatal("job was not run")
+	}
+	cancel()
+	<-stopped
+
+	<-done
+	assert.Equal(t, entity.JobSucceeded, job.Status)
+}
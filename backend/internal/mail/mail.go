@@ -0,0 +1,135 @@
+// Package mail sends email. A Mailer delivers a message right away; Queue
+// hands messages to the job runner so that callers don't wait for the mail
+// server and failed sends are retried.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Message struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+	// HTML is optional; when set, Text is sent as its plain-text alternative.
+	HTML    string            `json:"html,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Build renders a message in the RFC 5322 format, as a multipart/alternative
+// message when it has an HTML part.
+func Build(from string, msg *Message, date time.Time) ([]byte, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender %q: %w", from, err)
+	}
+	recipient, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient %q: %w", msg.To, err)
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", sender.String())
+	header.Set("To", recipient.String())
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header.Set("Date", date.Format(time.RFC1123Z))
+	header.Set("Message-ID", messageID(sender.Address))
+	header.Set("MIME-Version", "1.0")
+	for key, value := range msg.Headers {
+		header.Set(key, value)
+	}
+	for key, values := range header {
+		for _, value := range values {
+			if strings.ContainsAny(key+value, "\r\n") {
+				return nil, fmt.Errorf("invalid %s header", key)
+			}
+		}
+	}
+
+	var body bytes.Buffer
+	if msg.HTML == "" {
+		header.Set("Content-Type", "text/plain; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		if err := writeQuotedPrintable(&body, msg.Text); err != nil {
+			return nil, err
+		}
+	} else {
+		parts := multipart.NewWriter(&body)
+		header.Set("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+		if err := writePart(parts, "text/plain; charset=utf-8", msg.Text); err != nil {
+			return nil, err
+		}
+		if err := writePart(parts, "text/html; charset=utf-8", msg.HTML); err != nil {
+			return nil, err
+		}
+		if err := parts.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fmt.Fprintf(&out, "%s: %s\r\n", key, value)
+		}
+	}
+	out.WriteString("\r\n")
+	out.Write(body.Bytes())
+
+	return out.Bytes(), nil
+}
+
+func writePart(parts *multipart.Writer, contentType, content string) error {
+	part, err := parts.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeQuotedPrintable(part, content)
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	encoder := quotedprintable.NewWriter(w)
+	if _, err := encoder.Write([]byte(content)); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+func messageID(sender string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(sender, "@"); at >= 0 {
+		domain = sender[at+1:]
+	}
+
+	random := make([]byte, 12)
+	_, _ = rand.Read(random)
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(random), domain)
+}
@@ -0,0 +1,110 @@
+package mail_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/popeskul/awesome-blog/backend/internal/mail"
+)
+
+func TestBuild(t *testing.T) {
+	date := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		msg           *mail.Message
+		expected      []string
+		unexpected    []string
+		expectedError string
+	}{
+		{
+			name: "Plain text",
+			msg:  &mail.Message{To: "reader@example.com", Subject: "Hello", Text: "Hi there"},
+			expected: []string{
+				"From: \"Blog\" <no-reply@example.com>\r\n",
+				"To: <reader@example.com>\r\n",
+				"Subject: Hello\r\n",
+				"Date: Wed, 01 May 2024 08:00:00 +0000\r\n",
+				"Content-Type: text/plain; charset=utf-8\r\n",
+				"Hi there",
+			},
+			unexpected: []string{"multipart"},
+		},
+		{
+			name: "HTML with a text alternative",
+			msg: &mail.Message{
+				To:      "reader@example.com",
+				Subject: "Grüße",
+				Text:    "Hi there",
+				HTML:    "<p>Hi there</p>",
+				Headers: map[string]string{"List-Unsubscribe": "<https://example.com/u>"},
+			},
+			expected: []string{
+				"Content-Type: multipart/alternative; boundary=",
+				"Subject: =?utf-8?q?Gr=C3=BC=C3=9Fe?=\r\n",
+				"List-Unsubscribe: <https://example.com/u>\r\n",
+				"Content-Type: text/plain; charset=utf-8",
+				"Content-Type: text/html; charset=utf-8",
+				"<p>Hi there</p>",
+			},
+		},
+		{
+			name: "Header injection",
+			msg: &mail.Message{
+				To:      "reader@example.com",
+				Subject: "Hello",
+				Text:    "Hi there",
+				Headers: map[string]string{"X-Campaign": "a\r\nBcc: victim@example.com"},
+			},
+			expectedError: "invalid X-Campaign header",
+		},
+		{
+			name:          "Invalid recipient",
+			msg:           &mail.Message{To: "not an address", Subject: "Hello", Text: "Hi there"},
+			expectedError: "invalid recipient",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := mail.Build("Blog <no-reply@example.com>", tt.msg, date)
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, expected := range tt.expected {
+				assert.Contains(t, string(data), expected)
+			}
+			for _, unexpected := range tt.unexpected {
+				assert.NotContains(t, string(data), unexpected)
+			}
+		})
+	}
+}
+
+func TestFileMailer_Send(t *testing.T) {
+	dir := t.TempDir()
+	mailer, err := mail.NewFileMailer(dir, "Blog <no-reply@example.com>")
+	require.NoError(t, err)
+
+	err = mailer.Send(context.Background(), &mail.Message{To: "reader@example.com", Subject: "Hello", Text: "Hi there"})
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, strings.HasSuffix(entries[0].Name(), ".eml"))
+
+	data, err := os.ReadFile(dir + "/" + entries[0].Name())
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "Subject: Hello")
+}
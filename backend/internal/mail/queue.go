@@ -0,0 +1,30 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/popeskul/awesome-blog/backend/internal/jobs"
+)
+
+// KindSend is the job that sends one message.
+const KindSend = "mail.send"
+
+// Queue is a Mailer that sends in the background, retrying failures with
+// the runner's backoff. Called within a unit of work, the message is only
+// sent if the transaction commits.
+type Queue struct {
+	runner *jobs.Runner
+}
+
+func NewQueue(runner *jobs.Runner, mailer Mailer) *Queue {
+	runner.Register(KindSend, jobs.DefaultQueue, jobs.Typed(func(ctx context.Context, msg Message) error {
+		return mailer.Send(ctx, &msg)
+	}))
+
+	return &Queue{runner: runner}
+}
+
+func (q *Queue) Send(ctx context.Context, msg *Message) error {
+	_, err := q.runner.Enqueue(ctx, KindSend, msg)
+	return err
+}
@@ -0,0 +1,40 @@
+// Package metrics holds the Prometheus collectors of the server. They are
+// served on the health check port rather than the public one.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "blog"
+
+var (
+	// ActiveSessions is refreshed by the session sweeper, so it lags logins
+	// and logouts by up to one sweep.
+	ActiveSessions = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "sessions",
+		Name:      "active",
+		Help:      "Sessions that have not expired, as of the last sweep.",
+	})
+	ExpiredSessionsDeleted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sessions",
+		Name:      "expired_deleted_total",
+		Help:      "Expired sessions deleted by the sweeper.",
+	})
+	SessionsEvicted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "sessions",
+		Name:      "evicted_total",
+		Help:      "Sessions deleted because their user went over the per-user limit.",
+	})
+)
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,132 @@
+package newsletter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+// KindDigest is the job that emails every confirmed subscriber the posts
+// published since their last digest.
+const KindDigest = "newsletter.digest"
+
+type DigestOptions struct {
+	// BatchSize is how many subscribers are loaded at a time.
+	BatchSize int
+	// MaxPosts caps a single digest; older posts beyond it are left out.
+	MaxPosts int
+}
+
+type Digest struct {
+	repo    repository.NewsletterRepository
+	uow     repository.UnitOfWork
+	emailer *Emailer
+	logger  *logrus.Logger
+	opts    DigestOptions
+}
+
+func NewDigest(repo repository.NewsletterRepository, uow repository.UnitOfWork, emailer *Emailer, logger *logrus.Logger, opts DigestOptions) *Digest {
+	opts.BatchSize = max(opts.BatchSize, 1)
+	opts.MaxPosts = max(opts.MaxPosts, 1)
+
+	return &Digest{
+		repo:    repo,
+		uow:     uow,
+		emailer: emailer,
+		logger:  logger,
+		opts:    opts,
+	}
+}
+
+// Run is the handler of KindDigest jobs. Posts up to the time the job was
+// due are included. Every subscriber is marked as soon as their digest is
+// queued, so a retry only emails the ones that were missed.
+func (d *Digest) Run(ctx context.Context, job *entity.Job) error {
+	until := job.RunAt
+
+	var sent, failed int
+	after := uuid.Nil
+	for {
+		subscribers, err := d.repo.GetDigestSubscribers(ctx, after, d.opts.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, subscriber := range subscribers {
+			ok, err := d.send(ctx, subscriber, until)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				d.logger.WithError(err).WithField("subscriberID", subscriber.Id).Warn("Failed to send digest")
+				failed++
+				continue
+			}
+			if ok {
+				sent++
+			}
+		}
+
+		if len(subscribers) < d.opts.BatchSize {
+			break
+		}
+		after = subscribers[len(subscribers)-1].Id
+	}
+
+	if sent > 0 {
+		d.logger.WithField("digests", sent).Info("Sent newsletter digests")
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to send %d digests", failed)
+	}
+
+	return nil
+}
+
+// send emails one subscriber's digest and reports whether there was
+// anything to send.
+func (d *Digest) send(ctx context.Context, subscriber *entity.Subscriber, until time.Time) (bool, error) {
+	since := subscriber.CreatedAt
+	if subscriber.LastDigestAt != nil {
+		since = *subscriber.LastDigestAt
+	} else if subscriber.ConfirmedAt != nil {
+		since = *subscriber.ConfirmedAt
+	}
+	if !since.Before(until) {
+		return false, nil
+	}
+
+	posts, err := d.repo.GetDigestPosts(ctx, subscriber.Id, since, until, d.opts.MaxPosts)
+	if err != nil {
+		return false, err
+	}
+
+	err = d.transact(ctx, func(ctx context.Context) error {
+		if len(posts) > 0 {
+			if err := d.emailer.EmailDigest(ctx, subscriber, posts); err != nil {
+				return err
+			}
+		}
+
+		return d.repo.MarkDigestSent(ctx, subscriber.Id, until)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return len(posts) > 0, nil
+}
+
+func (d *Digest) transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	if d.uow == nil {
+		return fn(ctx)
+	}
+
+	return d.uow.Do(ctx, fn)
+}
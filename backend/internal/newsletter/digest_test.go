@@ -0,0 +1,142 @@
+package newsletter_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/mail"
+	"github.com/popeskul/awesome-blog/backend/internal/newsletter"
+)
+
+type fakeMailer struct {
+	sent []*mail.Message
+	err  error
+}
+
+func (m *fakeMailer) Send(_ context.Context, msg *mail.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestDigest_Run(t *testing.T) {
+	until := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	confirmedAt := until.Add(-48 * time.Hour)
+	lastDigestAt := until.Add(-24 * time.Hour)
+
+	first := &entity.Subscriber{Id: uuid.New(), Email: "first@example.com", ConfirmedAt: &confirmedAt}
+	second := &entity.Subscriber{Id: uuid.New(), Email: "second@example.com", ConfirmedAt: &confirmedAt, LastDigestAt: &lastDigestAt}
+	third := &entity.Subscriber{Id: uuid.New(), Email: "third@example.com", ConfirmedAt: &confirmedAt, LastDigestAt: &until}
+	post := &entity.Post{Id: uuid.New(), Title: "Hello", Content: "Some words about things."}
+
+	tests := []struct {
+		name          string
+		mailerErr     error
+		mockSetup     func(repo *mocksrepository.MockNewsletterRepository)
+		expectedSent  []string
+		expectedError string
+	}{
+		{
+			name: "Pages through subscribers and skips empty digests",
+			mockSetup: func(repo *mocksrepository.MockNewsletterRepository) {
+				gomock.InOrder(
+					repo.EXPECT().GetDigestSubscribers(gomock.Any(), uuid.Nil, 2).Return([]*entity.Subscriber{first, second}, nil),
+					repo.EXPECT().GetDigestSubscribers(gomock.Any(), second.Id, 2).Return([]*entity.Subscriber{third}, nil),
+				)
+				repo.EXPECT().GetDigestPosts(gomock.Any(), first.Id, confirmedAt, until, 5).Return([]*entity.Post{post}, nil)
+				repo.EXPECT().MarkDigestSent(gomock.Any(), first.Id, until).Return(nil)
+				repo.EXPECT().GetDigestPosts(gomock.Any(), second.Id, lastDigestAt, until, 5).Return(nil, nil)
+				repo.EXPECT().MarkDigestSent(gomock.Any(), second.Id, until).Return(nil)
+			},
+			expectedSent: []string{"first@example.com"},
+		},
+		{
+			name:      "Failed sends are reported",
+			mailerErr: errors.New("smtp down"),
+			mockSetup: func(repo *mocksrepository.MockNewsletterRepository) {
+				repo.EXPECT().GetDigestSubscribers(gomock.Any(), uuid.Nil, 2).Return([]*entity.Subscriber{first}, nil)
+				repo.EXPECT().GetDigestPosts(gomock.Any(), first.Id, confirmedAt, until, 5).Return([]*entity.Post{post}, nil)
+			},
+			expectedError: "failed to send 1 digests",
+		},
+		{
+			name: "Database error",
+			mockSetup: func(repo *mocksrepository.MockNewsletterRepository) {
+				repo.EXPECT().GetDigestSubscribers(gomock.Any(), uuid.Nil, 2).Return(nil, errors.New("database error"))
+			},
+			expectedError: "database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mocksrepository.NewMockNewsletterRepository(ctrl)
+			mailer := &fakeMailer{err: tt.mailerErr}
+			emailer := newsletter.NewEmailer(mailer, newsletter.NewSigner("secret"), newsletter.Options{
+				SiteName: "Blog",
+				SiteURL:  "https://blog.example.com",
+				APIURL:   "https://api.example.com",
+			})
+			digest := newsletter.NewDigest(repo, nil, emailer, logrus.New(), newsletter.DigestOptions{BatchSize: 2, MaxPosts: 5})
+
+			tt.mockSetup(repo)
+
+			err := digest.Run(context.Background(), &entity.Job{RunAt: until})
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, mailer.sent, len(tt.expectedSent))
+			for i, to := range tt.expectedSent {
+				assert.Equal(t, to, mailer.sent[i].To)
+			}
+		})
+	}
+}
+
+func TestEmailer_EmailDigest(t *testing.T) {
+	mailer := &fakeMailer{}
+	signer := newsletter.NewSigner("secret")
+	emailer := newsletter.NewEmailer(mailer, signer, newsletter.Options{
+		SiteName: "Blog",
+		SiteURL:  "https://blog.example.com",
+		APIURL:   "https://api.example.com",
+	})
+	subscriber := &entity.Subscriber{Id: uuid.New(), Email: "reader@example.com"}
+	post := &entity.Post{Id: uuid.New(), Title: "Hello", Content: "Some words about things."}
+
+	err := emailer.EmailDigest(context.Background(), subscriber, []*entity.Post{post})
+	require.NoError(t, err)
+	require.Len(t, mailer.sent, 1)
+
+	msg := mailer.sent[0]
+	assert.Equal(t, "Hello - Blog", msg.Subject)
+	assert.Contains(t, msg.Text, "https://blog.example.com/posts/"+post.Id.String())
+	assert.Contains(t, msg.HTML, "Hello")
+	assert.Equal(t, "List-Unsubscribe=One-Click", msg.Headers["List-Unsubscribe-Post"])
+
+	// The unsubscribe link carries a token for the subscriber.
+	link := strings.Trim(msg.Headers["List-Unsubscribe"], "<>")
+	assert.True(t, strings.HasPrefix(link, "https://api.example.com/api/v1/newsletter/unsubscribe?token="))
+	_, token, _ := strings.Cut(link, "token=")
+	id, err := signer.Verify(newsletter.PurposeUnsubscribe, token)
+	require.NoError(t, err)
+	assert.Equal(t, subscriber.Id, id)
+}
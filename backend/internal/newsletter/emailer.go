@@ -0,0 +1,167 @@
+package newsletter
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/mail"
+)
+
+// excerptLength is how much of each post a digest shows.
+const excerptLength = 280
+
+//go:embed templates
+var templates embed.FS
+
+type Options struct {
+	// SiteName is how emails refer to the blog.
+	SiteName string
+	// SiteURL is the frontend that post links point at.
+	SiteURL string
+	// APIURL is where confirmation and unsubscribe links point at.
+	APIURL string
+	// ConfirmTTL is how long confirmation links work.
+	ConfirmTTL time.Duration
+}
+
+// Emailer renders and sends the emails of the newsletter and the
+// notification center.
+type Emailer struct {
+	mailer mail.Mailer
+	signer *Signer
+	opts   Options
+	html   *htmltemplate.Template
+	text   *texttemplate.Template
+}
+
+func NewEmailer(mailer mail.Mailer, signer *Signer, opts Options) *Emailer {
+	return &Emailer{
+		mailer: mailer,
+		signer: signer,
+		opts:   opts,
+		html:   htmltemplate.Must(htmltemplate.ParseFS(templates, "templates/*.html.tmpl")),
+		text:   texttemplate.Must(texttemplate.ParseFS(templates, "templates/*.txt.tmpl")),
+	}
+}
+
+// EmailConfirmation sends the double opt-in link. scope describes what was
+// subscribed to, e.g. "posts tagged go"; empty means the whole blog.
+func (e *Emailer) EmailConfirmation(ctx context.Context, subscriber *entity.Subscriber, scope string) error {
+	data := map[string]any{
+		"Site":       e.opts.SiteName,
+		"Scope":      scope,
+		"ConfirmURL": e.apiURL("/api/v1/newsletter/confirm", e.signer.Sign(PurposeConfirm, subscriber.Id, e.opts.ConfirmTTL)),
+	}
+
+	return e.send(ctx, subscriber.Email, "Confirm your subscription to "+e.opts.SiteName, "confirm", data, nil)
+}
+
+// EmailDigest sends a digest of posts with a one-click unsubscribe link
+// (RFC 8058).
+func (e *Emailer) EmailDigest(ctx context.Context, subscriber *entity.Subscriber, posts []*entity.Post) error {
+	unsubscribeURL := e.apiURL("/api/v1/newsletter/unsubscribe", e.signer.Sign(PurposeUnsubscribe, subscriber.Id, 0))
+
+	type digestPost struct {
+		Title   string
+		Excerpt string
+		URL     string
+	}
+	items := make([]digestPost, 0, len(posts))
+	for _, post := range posts {
+		items = append(items, digestPost{
+			Title:   post.Title,
+			Excerpt: entity.Excerpt(post.Content, excerptLength),
+			URL:     e.postURL(post),
+		})
+	}
+
+	data := map[string]any{
+		"Site":           e.opts.SiteName,
+		"Posts":          items,
+		"UnsubscribeURL": unsubscribeURL,
+	}
+	headers := map[string]string{
+		"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+
+	subject := fmt.Sprintf("%d new posts on %s", len(posts), e.opts.SiteName)
+	if len(posts) == 1 {
+		subject = posts[0].Title + " - " + e.opts.SiteName
+	}
+
+	return e.send(ctx, subscriber.Email, subject, "digest", data, headers)
+}
+
+// EmailNotification implements usecase.NotificationEmailer.
+func (e *Emailer) EmailNotification(ctx context.Context, recipient *entity.User, notification *entity.Notification) error {
+	subject, summary := describeNotification(notification)
+
+	var link string
+	if notification.PostId != nil {
+		link = e.postURL(&entity.Post{Id: *notification.PostId})
+	}
+
+	data := map[string]any{
+		"Site":     e.opts.SiteName,
+		"Username": recipient.Username,
+		"Summary":  summary,
+		"URL":      link,
+	}
+
+	return e.send(ctx, recipient.Email, subject, "notification", data, nil)
+}
+
+func (e *Emailer) send(ctx context.Context, to, subject, template string, data any, headers map[string]string) error {
+	var text, html bytes.Buffer
+	if err := e.text.ExecuteTemplate(&text, template+".txt.tmpl", data); err != nil {
+		return fmt.Errorf("failed to render %s email: %w", template, err)
+	}
+	if err := e.html.ExecuteTemplate(&html, template+".html.tmpl", data); err != nil {
+		return fmt.Errorf("failed to render %s email: %w", template, err)
+	}
+
+	return e.mailer.Send(ctx, &mail.Message{
+		To:      to,
+		Subject: subject,
+		Text:    text.String(),
+		HTML:    html.String(),
+		Headers: headers,
+	})
+}
+
+func (e *Emailer) apiURL(path, token string) string {
+	return e.opts.APIURL + path + "?token=" + url.QueryEscape(token)
+}
+
+func (e *Emailer) postURL(post *entity.Post) string {
+	return e.opts.SiteURL + "/posts/" + post.Id.String()
+}
+
+func describeNotification(notification *entity.Notification) (subject, summary string) {
+	switch notification.Type {
+	case entity.NotificationTypeComment:
+		return "New comment on your post", "Someone commented on your post."
+	case entity.NotificationTypeReply:
+		return "New reply to your comment", "Someone replied to your comment."
+	case entity.NotificationTypeFollow:
+		return "You have a new follower", "Someone started following you."
+	case entity.NotificationTypeMention:
+		return "You were mentioned", "Someone mentioned you in a comment."
+	case entity.NotificationTypeModeration:
+		summary = "A moderator reviewed your comment."
+		if notification.Detail != "" {
+			summary = "A moderator reviewed your comment: " + notification.Detail + "."
+		}
+		return "Your comment was reviewed", summary
+	default:
+		return "New notification", "You have a new notification."
+	}
+}
@@ -0,0 +1,101 @@
+package newsletter_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/popeskul/awesome-blog/backend/internal/newsletter"
+)
+
+func TestSigner(t *testing.T) {
+	id := uuid.New()
+	signer := newsletter.NewSigner("secret")
+
+	tests := []struct {
+		name          string
+		token         func() string
+		purpose       newsletter.Purpose
+		expectedError error
+	}{
+		{
+			name:    "Valid token",
+			token:   func() string { return signer.Sign(newsletter.PurposeConfirm, id, time.Hour) },
+			purpose: newsletter.PurposeConfirm,
+		},
+		{
+			name:    "Token without expiry",
+			token:   func() string { return signer.Sign(newsletter.PurposeUnsubscribe, id, 0) },
+			purpose: newsletter.PurposeUnsubscribe,
+		},
+		{
+			name:          "Signed for another purpose",
+			token:         func() string { return signer.Sign(newsletter.PurposeUnsubscribe, id, 0) },
+			purpose:       newsletter.PurposeConfirm,
+			expectedError: newsletter.ErrInvalidToken,
+		},
+		{
+			name:          "Expired",
+			token:         func() string { return expiredToken("secret", newsletter.PurposeConfirm, id) },
+			purpose:       newsletter.PurposeConfirm,
+			expectedError: newsletter.ErrInvalidToken,
+		},
+		{
+			name:          "Signed with another secret",
+			token:         func() string { return newsletter.NewSigner("other").Sign(newsletter.PurposeConfirm, id, time.Hour) },
+			purpose:       newsletter.PurposeConfirm,
+			expectedError: newsletter.ErrInvalidToken,
+		},
+		{
+			name: "Tampered subscriber id",
+			token: func() string {
+				token := signer.Sign(newsletter.PurposeConfirm, id, time.Hour)
+				other := signer.Sign(newsletter.PurposeConfirm, uuid.New(), time.Hour)
+				return other[:32] + token[32:]
+			},
+			purpose:       newsletter.PurposeConfirm,
+			expectedError: newsletter.ErrInvalidToken,
+		},
+		{
+			name:          "Malformed",
+			token:         func() string { return "not-a-token" },
+			purpose:       newsletter.PurposeConfirm,
+			expectedError: newsletter.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := signer.Verify(tt.purpose, tt.token())
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Equal(t, uuid.Nil, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, id, result)
+		})
+	}
+}
+
+// expiredToken signs a token the way Signer does, with an expiry an hour in
+// the past.
+func expiredToken(secret string, purpose newsletter.Purpose, id uuid.UUID) string {
+	payload := make([]byte, 24)
+	copy(payload, id[:])
+	binary.BigEndian.PutUint64(payload[16:], uint64(time.Now().Add(-time.Hour).Unix()))
+
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte("newsletter:" + string(purpose) + ":"))
+	h.Write(payload)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,164 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+)
+
+// Publisher writes events to the outbox instead of handing them out. Used
+// with the context of a unit of work, an event is stored if and only if the
+// change it describes is committed.
+type Publisher struct {
+	repo repository.OutboxRepository
+}
+
+func NewPublisher(repo repository.OutboxRepository) *Publisher {
+	return &Publisher{repo: repo}
+}
+
+func (p *Publisher) Publish(ctx context.Context, topic, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode event payload: %w", err)
+	}
+
+	return p.repo.AddEvent(ctx, &entity.Event{Topic: topic, Type: eventType, Data: data})
+}
+
+type Options struct {
+	// PollInterval is how often the outbox is checked for new events.
+	PollInterval time.Duration
+	// BatchSize is how many events a consumer gets per transaction.
+	BatchSize int
+	// Retention is how long events are kept once every consumer has them.
+	Retention time.Duration
+}
+
+type consumer struct {
+	name      string
+	publisher events.Publisher
+}
+
+// Relay hands outbox events to the registered consumers. Each consumer gets a
+// batch inside a transaction that also records the batch as consumed, so a
+// consumer that writes through the repositories with the context it is given
+// sees every event exactly once. Consumers with other side effects see every
+// event at least once.
+type Relay struct {
+	uow       repository.UnitOfWork
+	repo      repository.OutboxRepository
+	logger    *logrus.Logger
+	opts      Options
+	consumers []consumer
+}
+
+func NewRelay(uow repository.UnitOfWork, repo repository.OutboxRepository, logger *logrus.Logger, opts Options) *Relay {
+	return &Relay{
+		uow:    uow,
+		repo:   repo,
+		logger: logger,
+		opts:   opts,
+	}
+}
+
+// Register adds a consumer before the relay starts. The name records what the
+// consumer has already seen, so it has to stay the same across releases.
+func (r *Relay) Register(name string, publisher events.Publisher) {
+	r.consumers = append(r.consumers, consumer{name: name, publisher: publisher})
+}
+
+// Run relays events until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Flush(ctx); err != nil && ctx.Err() == nil {
+			r.logger.WithError(err).Warn("Failed to relay outbox events")
+		}
+		r.cleanup(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Flush relays every pending event to every consumer. A consumer that fails
+// gets the same events again on the next flush; the others carry on.
+func (r *Relay) Flush(ctx context.Context) error {
+	var errs []error
+	for _, c := range r.consumers {
+		for ctx.Err() == nil {
+			relayed, err := r.relay(ctx, c)
+			if err != nil {
+				errs = append(errs, err)
+				break
+			}
+			if relayed < r.opts.BatchSize {
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Relay) relay(ctx context.Context, c consumer) (int, error) {
+	relayed := 0
+	err := r.uow.Do(ctx, func(ctx context.Context) error {
+		// Another replica is already working on this consumer.
+		locked, err := r.repo.LockConsumer(ctx, c.name)
+		if err != nil || !locked {
+			return err
+		}
+
+		pending, err := r.repo.GetPendingEvents(ctx, c.name, r.opts.BatchSize)
+		if err != nil || len(pending) == 0 {
+			return err
+		}
+
+		ids := make([]int64, 0, len(pending))
+		for _, event := range pending {
+			if err := c.publisher.Publish(ctx, event.Topic, event.Type, event.Data); err != nil {
+				return fmt.Errorf("consumer %s failed on event %d: %w", c.name, event.Id, err)
+			}
+			ids = append(ids, event.Id)
+		}
+
+		if err := r.repo.MarkConsumed(ctx, c.name, ids); err != nil {
+			return err
+		}
+		relayed = len(ids)
+
+		return nil
+	})
+
+	return relayed, err
+}
+
+func (r *Relay) cleanup(ctx context.Context) {
+	if len(r.consumers) == 0 || ctx.Err() != nil {
+		return
+	}
+
+	names := make([]string, 0, len(r.consumers))
+	for _, c := range r.consumers {
+		names = append(names, c.name)
+	}
+
+	if _, err := r.repo.DeleteConsumedEvents(ctx, names, time.Now().Add(-r.opts.Retention)); err != nil {
+		r.logger.WithError(err).Warn("Failed to delete consumed outbox events")
+	}
+}
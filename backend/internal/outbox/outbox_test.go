@@ -0,0 +1,166 @@
+package outbox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/events/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/outbox"
+)
+
+var options = outbox.Options{PollInterval: time.Second, BatchSize: 2, Retention: time.Hour}
+
+func newUnitOfWork(ctrl *gomock.Controller) *mocksrepository.MockUnitOfWork {
+	uow := mocksrepository.NewMockUnitOfWork(ctrl)
+	uow.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+	return uow
+}
+
+func TestPublisher_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mocksrepository.NewMockOutboxRepository(ctrl)
+
+	repo.EXPECT().AddEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event *entity.Event) error {
+			assert.Equal(t, entity.PostsTopic, event.Topic)
+			assert.Equal(t, entity.EventPostCreated, event.Type)
+			assert.JSONEq(t, `{"title":"Hello"}`, string(event.Data))
+			return nil
+		})
+
+	err := outbox.NewPublisher(repo).Publish(context.Background(), entity.PostsTopic, entity.EventPostCreated, map[string]string{"title": "Hello"})
+	assert.NoError(t, err)
+}
+
+func TestRelay_Flush(t *testing.T) {
+	first := &entity.Event{Id: 1, Topic: entity.PostsTopic, Type: entity.EventPostCreated, Data: json.RawMessage(`{"id":1}`)}
+	second := &entity.Event{Id: 2, Topic: entity.PostsTopic, Type: entity.EventPostDeleted, Data: json.RawMessage(`{"id":2}`)}
+	third := &entity.Event{Id: 3, Topic: entity.PostsTopic, Type: entity.EventPostCreated, Data: json.RawMessage(`{"id":3}`)}
+
+	tests := []struct {
+		name      string
+		mockSetup func(repo *mocksrepository.MockOutboxRepository, stream, webhooks *mocksevents.MockPublisher)
+		expectErr bool
+	}{
+		{
+			name: "Every consumer gets every event once",
+			mockSetup: func(repo *mocksrepository.MockOutboxRepository, stream, webhooks *mocksevents.MockPublisher) {
+				for _, consumer := range []string{"stream", "webhooks"} {
+					repo.EXPECT().LockConsumer(gomock.Any(), consumer).Return(true, nil).Times(2)
+					gomock.InOrder(
+						repo.EXPECT().GetPendingEvents(gomock.Any(), consumer, 2).Return([]*entity.Event{first, second}, nil),
+						repo.EXPECT().MarkConsumed(gomock.Any(), consumer, []int64{1, 2}).Return(nil),
+						repo.EXPECT().GetPendingEvents(gomock.Any(), consumer, 2).Return([]*entity.Event{third}, nil),
+						repo.EXPECT().MarkConsumed(gomock.Any(), consumer, []int64{3}).Return(nil),
+					)
+				}
+				for _, publisher := range []*mocksevents.MockPublisher{stream, webhooks} {
+					gomock.InOrder(
+						publisher.EXPECT().Publish(gomock.Any(), entity.PostsTopic, entity.EventPostCreated, first.Data).Return(nil),
+						publisher.EXPECT().Publish(gomock.Any(), entity.PostsTopic, entity.EventPostDeleted, second.Data).Return(nil),
+						publisher.EXPECT().Publish(gomock.Any(), entity.PostsTopic, entity.EventPostCreated, third.Data).Return(nil),
+					)
+				}
+			},
+		},
+		{
+			name: "A failing consumer is retried later without holding up the others",
+			mockSetup: func(repo *mocksrepository.MockOutboxRepository, stream, webhooks *mocksevents.MockPublisher) {
+				repo.EXPECT().LockConsumer(gomock.Any(), "stream").Return(true, nil)
+				repo.EXPECT().GetPendingEvents(gomock.Any(), "stream", 2).Return([]*entity.Event{third}, nil)
+				stream.EXPECT().Publish(gomock.Any(), entity.PostsTopic, entity.EventPostCreated, third.Data).Return(errors.New("database error"))
+
+				repo.EXPECT().LockConsumer(gomock.Any(), "webhooks").Return(true, nil)
+				repo.EXPECT().GetPendingEvents(gomock.Any(), "webhooks", 2).Return([]*entity.Event{third}, nil)
+				webhooks.EXPECT().Publish(gomock.Any(), entity.PostsTopic, entity.EventPostCreated, third.Data).Return(nil)
+				repo.EXPECT().MarkConsumed(gomock.Any(), "webhooks", []int64{3}).Return(nil)
+			},
+			expectErr: true,
+		},
+		{
+			name: "Consumers held by another relay are skipped",
+			mockSetup: func(repo *mocksrepository.MockOutboxRepository, stream, webhooks *mocksevents.MockPublisher) {
+				repo.EXPECT().LockConsumer(gomock.Any(), "stream").Return(false, nil)
+				repo.EXPECT().LockConsumer(gomock.Any(), "webhooks").Return(false, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mocksrepository.NewMockOutboxRepository(ctrl)
+			stream := mocksevents.NewMockPublisher(ctrl)
+			webhooks := mocksevents.NewMockPublisher(ctrl)
+
+			relay := outbox.NewRelay(newUnitOfWork(ctrl), repo, logrus.New(), options)
+			relay.Register("stream", stream)
+			relay.Register("webhooks", webhooks)
+
+			tt.mockSetup(repo, stream, webhooks)
+
+			err := relay.Flush(context.Background())
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRelay_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mocksrepository.NewMockOutboxRepository(ctrl)
+	stream := mocksevents.NewMockPublisher(ctrl)
+
+	relay := outbox.NewRelay(newUnitOfWork(ctrl), repo, logrus.New(), options)
+	relay.Register("stream", stream)
+
+	event := &entity.Event{Id: 7, Topic: entity.PostsTopic, Type: entity.EventPostCreated, Data: json.RawMessage(`{}`)}
+	published := make(chan struct{})
+
+	repo.EXPECT().LockConsumer(gomock.Any(), "stream").Return(true, nil).AnyTimes()
+	gomock.InOrder(
+		repo.EXPECT().GetPendingEvents(gomock.Any(), "stream", 2).Return([]*entity.Event{event}, nil),
+		repo.EXPECT().GetPendingEvents(gomock.Any(), "stream", 2).Return(nil, nil).AnyTimes(),
+	)
+	stream.EXPECT().Publish(gomock.Any(), entity.PostsTopic, entity.EventPostCreated, event.Data).
+		DoAndReturn(func(context.Context, string, string, any) error {
+			close(published)
+			return nil
+		})
+	repo.EXPECT().MarkConsumed(gomock.Any(), "stream", []int64{7}).Return(nil)
+	repo.EXPECT().DeleteConsumedEvents(gomock.Any(), []string{"stream"}, gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		relay.Run(ctx)
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		t.Fatal("event was not relayed")
+	}
+	cancel()
+	<-done
+
+	require.True(t, ctrl.Satisfied())
+}
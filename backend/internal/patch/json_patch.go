@@ -0,0 +1,295 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// operation is one step of an RFC 6902 JSON Patch.
+type operation struct {
+	op    string
+	path  pointer
+	from  pointer
+	value any
+}
+
+// jsonPatch is an RFC 6902 JSON Patch: operations applied in order, all or
+// nothing.
+type jsonPatch []operation
+
+func parseOperations(body []byte) (jsonPatch, error) {
+	// Members are kept raw so that a missing value can be told from null.
+	var members []map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+
+	operations := make(jsonPatch, len(members))
+	for i, m := range members {
+		o, err := parseOperation(m)
+		if err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %v", ErrInvalidPatch, i, err)
+		}
+		operations[i] = o
+	}
+
+	return operations, nil
+}
+
+func parseOperation(members map[string]json.RawMessage) (operation, error) {
+	var o operation
+	if err := json.Unmarshal(members["op"], &o.op); err != nil {
+		return o, fmt.Errorf("missing or invalid op")
+	}
+
+	var err error
+	if o.path, err = pointerMember(members, "path"); err != nil {
+		return o, err
+	}
+
+	switch o.op {
+	case "add", "replace", "test":
+		value, ok := members["value"]
+		if !ok {
+			return o, fmt.Errorf("%s needs a value", o.op)
+		}
+		if o.value, err = decode(value); err != nil {
+			return o, err
+		}
+	case "move", "copy":
+		if o.from, err = pointerMember(members, "from"); err != nil {
+			return o, err
+		}
+		if o.op == "move" && o.path.inside(o.from) {
+			return o, fmt.Errorf("cannot move %q into itself", o.from)
+		}
+	case "remove":
+	default:
+		return o, fmt.Errorf("unknown op %q", o.op)
+	}
+
+	return o, nil
+}
+
+func pointerMember(members map[string]json.RawMessage, name string) (pointer, error) {
+	var raw string
+	if err := json.Unmarshal(members[name], &raw); err != nil {
+		return nil, fmt.Errorf("missing or invalid %s", name)
+	}
+	return parsePointer(raw)
+}
+
+func (p jsonPatch) apply(doc any) (any, error) {
+	for i, o := range p {
+		var err error
+		if doc, err = o.apply(doc); err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %v", ErrConflict, i, err)
+		}
+	}
+	return doc, nil
+}
+
+func (o operation) apply(doc any) (any, error) {
+	switch o.op {
+	case "add":
+		return add(doc, o.path, clone(o.value))
+	case "remove":
+		return remove(doc, o.path)
+	case "replace":
+		if _, err := get(doc, o.path); err != nil {
+			return nil, err
+		}
+		doc, err := remove(doc, o.path)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, o.path, clone(o.value))
+	case "move":
+		value, err := get(doc, o.from)
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = remove(doc, o.from); err != nil {
+			return nil, err
+		}
+		return add(doc, o.path, value)
+	case "copy":
+		value, err := get(doc, o.from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, o.path, clone(value))
+	default:
+		value, err := get(doc, o.path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, o.value) {
+			return nil, fmt.Errorf("test of %q failed", o.path)
+		}
+		return doc, nil
+	}
+}
+
+// pointer is a parsed RFC 6901 JSON Pointer. The empty pointer is the
+// whole document.
+type pointer []string
+
+func parsePointer(raw string) (pointer, error) {
+	if raw == "" {
+		return pointer{}, nil
+	}
+	if !strings.HasPrefix(raw, "/") {
+		return nil, fmt.Errorf("pointer %q must start with /", raw)
+	}
+
+	tokens := strings.Split(raw[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+
+	return tokens, nil
+}
+
+// inside reports whether p points inside other.
+func (p pointer) inside(other pointer) bool {
+	return len(p) > len(other) && reflect.DeepEqual(p[:len(other)], other)
+}
+
+func (p pointer) String() string {
+	var b strings.Builder
+	for _, token := range p {
+		b.WriteString("/")
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return b.String()
+}
+
+func get(doc any, p pointer) (any, error) {
+	node := doc
+	for _, token := range p {
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("%q does not exist", p)
+			}
+			node = child
+		case []any:
+			i, err := index(token, len(n))
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("%q does not exist", p)
+		}
+	}
+	return node, nil
+}
+
+func add(doc any, p pointer, value any) (any, error) {
+	if len(p) == 0 {
+		return value, nil
+	}
+
+	return update(doc, p, func(parent any, token string) (any, error) {
+		switch n := parent.(type) {
+		case map[string]any:
+			n[token] = value
+			return n, nil
+		case []any:
+			if token == "-" {
+				return append(n, value), nil
+			}
+			i, err := index(token, len(n)+1)
+			if err != nil {
+				return nil, err
+			}
+			n = append(n, nil)
+			copy(n[i+1:], n[i:])
+			n[i] = value
+			return n, nil
+		default:
+			return nil, fmt.Errorf("cannot add to %q", p)
+		}
+	})
+}
+
+func remove(doc any, p pointer) (any, error) {
+	if len(p) == 0 {
+		return nil, fmt.Errorf("cannot remove the whole document")
+	}
+
+	return update(doc, p, func(parent any, token string) (any, error) {
+		switch n := parent.(type) {
+		case map[string]any:
+			if _, ok := n[token]; !ok {
+				return nil, fmt.Errorf("%q does not exist", p)
+			}
+			delete(n, token)
+			return n, nil
+		case []any:
+			i, err := index(token, len(n))
+			if err != nil {
+				return nil, err
+			}
+			return append(n[:i], n[i+1:]...), nil
+		default:
+			return nil, fmt.Errorf("%q does not exist", p)
+		}
+	})
+}
+
+// update calls fn with the container the last token of p is in and puts
+// what fn returns in its place, as adding to an array makes a new slice.
+func update(node any, p pointer, fn func(parent any, token string) (any, error)) (any, error) {
+	if len(p) == 1 {
+		return fn(node, p[0])
+	}
+
+	switch n := node.(type) {
+	case map[string]any:
+		child, ok := n[p[0]]
+		if !ok {
+			return nil, fmt.Errorf("%q does not exist", p[0])
+		}
+		updated, err := update(child, p[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		n[p[0]] = updated
+		return n, nil
+	case []any:
+		i, err := index(p[0], len(n))
+		if err != nil {
+			return nil, err
+		}
+		updated, err := update(n[i], p[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		n[i] = updated
+		return n, nil
+	default:
+		return nil, fmt.Errorf("%q does not exist", p[0])
+	}
+}
+
+// index parses an array index below limit. RFC 6901 doesn't allow leading
+// zeros.
+func index(token string, limit int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i >= limit {
+		return 0, fmt.Errorf("array index %q is out of range", token)
+	}
+
+	return i, nil
+}
@@ -0,0 +1,180 @@
+// Package patch applies JSON Merge Patch (RFC 7396) and JSON Patch
+// (RFC 6902) documents to the JSON form of a resource.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"reflect"
+	"sort"
+)
+
+const (
+	MergePatchType = "application/merge-patch+json"
+	JSONPatchType  = "application/json-patch+json"
+)
+
+var (
+	// ErrUnsupportedType is returned for a body that is neither kind of patch.
+	ErrUnsupportedType = errors.New("unsupported patch type")
+	// ErrInvalidPatch is returned for a malformed patch document.
+	ErrInvalidPatch = errors.New("invalid patch")
+	// ErrConflict is returned when a patch can't be applied to the resource
+	// as it is, e.g. it names a path that doesn't exist or a test fails.
+	ErrConflict = errors.New("patch does not apply")
+	// ErrInvalidResult is returned when the patched document is no longer
+	// the resource, e.g. a string was replaced with a number.
+	ErrInvalidResult = errors.New("patched document is invalid")
+)
+
+// Patch is a parsed patch document.
+type Patch interface {
+	apply(doc any) (any, error)
+}
+
+// Parse parses a patch body by its content type.
+func Parse(contentType string, body []byte) (Patch, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, contentType)
+	}
+
+	switch mediaType {
+	case MergePatchType:
+		value, err := decode(body)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+		return mergePatch{value}, nil
+	case JSONPatchType:
+		return parseOperations(body)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, mediaType)
+	}
+}
+
+// Apply patches the JSON form of current and decodes the result into
+// result, which must not have attributes current doesn't. It returns the
+// top-level attributes the patch changed, sorted.
+func Apply(p Patch, current any, result any) ([]string, error) {
+	encoded, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := decode(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	// The patch works on a copy so that before can be compared with it.
+	after, err := p.apply(clone(before))
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := json.Marshal(after)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(result); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResult, err)
+	}
+
+	return changed(before, after), nil
+}
+
+// changed lists the top-level attributes that differ between two objects.
+func changed(before, after any) []string {
+	b, _ := before.(map[string]any)
+	a, _ := after.(map[string]any)
+
+	var names []string
+	for name, value := range b {
+		if other, ok := a[name]; !ok || !reflect.DeepEqual(value, other) {
+			names = append(names, name)
+		}
+	}
+	for name := range a {
+		if _, ok := b[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// decode decodes a JSON value keeping numbers as they were written.
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("trailing data after JSON value")
+	}
+
+	return value, nil
+}
+
+// clone deep-copies a decoded JSON value.
+func clone(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for name, item := range v {
+			copied[name] = clone(item)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(v))
+		for i, item := range v {
+			copied[i] = clone(item)
+		}
+		return copied
+	default:
+		return v
+	}
+}
+
+// mergePatch is an RFC 7396 merge patch: objects are merged member by
+// member, null removes a member and anything else replaces the target.
+type mergePatch struct {
+	value any
+}
+
+func (p mergePatch) apply(doc any) (any, error) {
+	return merge(doc, p.value), nil
+}
+
+func merge(target, patch any) any {
+	members, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	object, ok := target.(map[string]any)
+	if !ok {
+		object = map[string]any{}
+	}
+
+	for name, value := range members {
+		if value == nil {
+			delete(object, name)
+			continue
+		}
+		object[name] = merge(object[name], value)
+	}
+
+	return object
+}
@@ -0,0 +1,180 @@
+package patch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/popeskul/awesome-blog/backend/internal/patch"
+)
+
+type document struct {
+	Title  string   `json:"title"`
+	Tags   []string `json:"tags"`
+	Status string   `json:"status,omitempty"`
+	Count  int      `json:"count"`
+}
+
+func TestApply_MergePatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expected    document
+		wantChanged []string
+		wantErr     error
+	}{
+		{
+			name:        "replaces a member",
+			body:        `{"title": "New"}`,
+			expected:    document{Title: "New", Tags: []string{"go", "sql"}, Status: "draft", Count: 2},
+			wantChanged: []string{"title"},
+		},
+		{
+			name:        "null clears a member",
+			body:        `{"tags": null, "status": null}`,
+			expected:    document{Title: "Old", Count: 2},
+			wantChanged: []string{"status", "tags"},
+		},
+		{
+			name:        "arrays are replaced whole",
+			body:        `{"tags": ["rust"]}`,
+			expected:    document{Title: "Old", Tags: []string{"rust"}, Status: "draft", Count: 2},
+			wantChanged: []string{"tags"},
+		},
+		{
+			name:     "no changes",
+			body:     `{"title": "Old"}`,
+			expected: document{Title: "Old", Tags: []string{"go", "sql"}, Status: "draft", Count: 2},
+		},
+		{
+			name:    "unknown member",
+			body:    `{"author": "tom"}`,
+			wantErr: patch.ErrInvalidResult,
+		},
+		{
+			name:    "wrong type",
+			body:    `{"count": "two"}`,
+			wantErr: patch.ErrInvalidResult,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := patch.Parse(patch.MergePatchType, []byte(tt.body))
+			require.NoError(t, err)
+
+			current := document{Title: "Old", Tags: []string{"go", "sql"}, Status: "draft", Count: 2}
+			var result document
+			changed, err := patch.Apply(p, &current, &result)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.wantChanged, changed)
+		})
+	}
+}
+
+func TestApply_JSONPatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expected    document
+		wantChanged []string
+		wantErr     error
+	}{
+		{
+			name:        "add, replace and remove",
+			body:        `[{"op": "add", "path": "/tags/-", "value": "web"}, {"op": "replace", "path": "/title", "value": "New"}, {"op": "remove", "path": "/tags/0"}]`,
+			expected:    document{Title: "New", Tags: []string{"sql", "web"}, Status: "draft", Count: 2},
+			wantChanged: []string{"tags", "title"},
+		},
+		{
+			name:        "insert into an array",
+			body:        `[{"op": "add", "path": "/tags/1", "value": "db"}]`,
+			expected:    document{Title: "Old", Tags: []string{"go", "db", "sql"}, Status: "draft", Count: 2},
+			wantChanged: []string{"tags"},
+		},
+		{
+			name:        "copy and move",
+			body:        `[{"op": "copy", "from": "/tags/0", "path": "/tags/-"}, {"op": "move", "from": "/status", "path": "/title"}]`,
+			expected:    document{Title: "draft", Tags: []string{"go", "sql", "go"}, Count: 2},
+			wantChanged: []string{"status", "tags", "title"},
+		},
+		{
+			name:        "passing test",
+			body:        `[{"op": "test", "path": "/count", "value": 2}, {"op": "replace", "path": "/count", "value": 3}]`,
+			expected:    document{Title: "Old", Tags: []string{"go", "sql"}, Status: "draft", Count: 3},
+			wantChanged: []string{"count"},
+		},
+		{
+			name:    "failing test",
+			body:    `[{"op": "replace", "path": "/title", "value": "New"}, {"op": "test", "path": "/count", "value": 5}]`,
+			wantErr: patch.ErrConflict,
+		},
+		{
+			name:    "missing path",
+			body:    `[{"op": "remove", "path": "/author"}]`,
+			wantErr: patch.ErrConflict,
+		},
+		{
+			name:    "index out of range",
+			body:    `[{"op": "replace", "path": "/tags/2", "value": "go"}]`,
+			wantErr: patch.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := patch.Parse(patch.JSONPatchType, []byte(tt.body))
+			require.NoError(t, err)
+
+			current := document{Title: "Old", Tags: []string{"go", "sql"}, Status: "draft", Count: 2}
+			var result document
+			changed, err := patch.Apply(p, &current, &result)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, []string{"go", "sql"}, current.Tags)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.wantChanged, changed)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantErr     error
+	}{
+		{name: "merge patch with charset", contentType: "application/merge-patch+json; charset=utf-8", body: `{"title": "New"}`},
+		{name: "null value", contentType: patch.JSONPatchType, body: `[{"op": "add", "path": "/status", "value": null}]`},
+		{name: "plain JSON", contentType: "application/json", body: `{}`, wantErr: patch.ErrUnsupportedType},
+		{name: "malformed merge patch", contentType: patch.MergePatchType, body: `{"title": `, wantErr: patch.ErrInvalidPatch},
+		{name: "not a list of operations", contentType: patch.JSONPatchType, body: `{"op": "add"}`, wantErr: patch.ErrInvalidPatch},
+		{name: "unknown op", contentType: patch.JSONPatchType, body: `[{"op": "merge", "path": "/title"}]`, wantErr: patch.ErrInvalidPatch},
+		{name: "missing value", contentType: patch.JSONPatchType, body: `[{"op": "add", "path": "/title"}]`, wantErr: patch.ErrInvalidPatch},
+		{name: "relative pointer", contentType: patch.JSONPatchType, body: `[{"op": "remove", "path": "title"}]`, wantErr: patch.ErrInvalidPatch},
+		{name: "move into itself", contentType: patch.JSONPatchType, body: `[{"op": "move", "from": "/tags", "path": "/tags/0"}]`, wantErr: patch.ErrInvalidPatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := patch.Parse(tt.contentType, []byte(tt.body))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
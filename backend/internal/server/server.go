@@ -3,9 +3,9 @@ package server
 import (
 	"context"
 	"fmt"
-	"github.com/google/uuid"
+	"io"
 	"net/http"
-	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
@@ -16,57 +16,104 @@ import (
 	"github.com/popeskul/awesome-blog/backend/internal/config"
 	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/handlers"
 	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/middleware"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/feed"
+	"github.com/popeskul/awesome-blog/backend/internal/metrics"
 )
 
 type Handler interface {
 	handlers.PostHandlers
 	handlers.CommentHandlers
+	handlers.ModerationHandlers
+	handlers.ReactionHandlers
+	handlers.BookmarkHandlers
+	handlers.ReadingListHandlers
+	handlers.AuthorHandlers
+	handlers.FollowHandlers
+	handlers.NotificationHandlers
+	handlers.StreamHandlers
+	handlers.PresenceHandlers
+	handlers.WebhookHandlers
+	handlers.JobHandlers
+	handlers.NewsletterHandlers
+	handlers.FeedHandlers
+	handlers.SitemapHandlers
 	handlers.UserHandlers
 	handlers.AuthHandlers
 }
 
 type Server struct {
-	httpServer *http.Server
-	cfg        *config.Config
-	logger     *logrus.Logger
-	handler    Handler
-	staticPath string
+	httpServer    *http.Server
+	metricsServer *http.Server
+	cfg           *config.Config
+	logger        *logrus.Logger
+	handler       Handler
+	validator     func(http.Handler) http.Handler
+	sessions      middleware.SessionChecker
+	staticPath    string
 }
 
-func NewServer(cfg *config.Config, logger *logrus.Logger, handler Handler, staticPath string) *Server {
+func NewServer(cfg *config.Config, logger *logrus.Logger, handler Handler, validator func(http.Handler) http.Handler, sessions middleware.SessionChecker, staticPath string) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
 	return &Server{
+		// Built here rather than in RunMetrics so that Shutdown never races
+		// with it.
+		metricsServer: &http.Server{
+			Addr:    fmt.Sprintf(":%d", cfg.Server.HealthCheckPort),
+			Handler: mux,
+		},
 		cfg:        cfg,
 		logger:     logger,
 		handler:    handler,
+		validator:  validator,
+		sessions:   sessions,
 		staticPath: staticPath,
 	}
 }
 
 func (s *Server) Run() error {
-	router := s.setupRouter()
-
 	s.httpServer = &http.Server{
 		Addr:    fmt.Sprintf(":%d", s.cfg.Server.Port),
-		Handler: router,
+		Handler: s.Router(),
 	}
 
 	s.logger.Infof("Starting server on %s", s.httpServer.Addr)
 	return s.httpServer.ListenAndServe()
 }
 
+// RunMetrics serves the Prometheus metrics on the health check port, which
+// is not meant to be exposed publicly.
+func (s *Server) RunMetrics() error {
+	s.logger.Infof("Serving metrics on %s", s.metricsServer.Addr)
+	return s.metricsServer.ListenAndServe()
+}
+
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info("Server is shutting down...")
-	return s.httpServer.Shutdown(ctx)
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	if err := s.metricsServer.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	// Shutdown does not wait for hijacked WebSocket connections.
+	return s.handler.Drain(ctx)
 }
 
-func (s *Server) setupRouter() *chi.Mux {
+// Router returns the handler of every route the server serves.
+func (s *Server) Router() http.Handler {
 	r := chi.NewRouter()
 
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "If-Match", "If-None-Match"},
+		ExposedHeaders:   []string{"Link", "ETag"},
 		AllowCredentials: false,
 		MaxAge:           300,
 	}))
@@ -74,96 +121,73 @@ func (s *Server) setupRouter() *chi.Mux {
 	r.Use(chiMiddleware.Recoverer)
 	r.Use(chiMiddleware.Logger)
 
-	r.Group(func(r chi.Router) {
-		r.Use(middleware.AuthMiddleware(s.cfg, s.logger))
-		r.Get("/auth/logout", s.handler.PostAuthLogout)
-		api.HandlerFromMuxWithBaseURL(s.handler, r, "")
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		problem.Error(w, r, http.StatusNotFound, "No such resource")
 	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		problem.Error(w, r, http.StatusMethodNotAllowed, r.Method+" is not allowed here")
+	})
+
+	r.Use(middleware.OptionalAuthMiddleware(s.cfg, s.logger, s.sessions))
+
+	strict := api.NewStrictHandlerWithOptions(s.handler, []api.StrictMiddlewareFunc{handlers.WithRequest}, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  handlers.RespondRequestError,
+		ResponseErrorHandlerFunc: handlers.RespondError,
+	})
+
+	r.With(middleware.AuthMiddleware(s.cfg, s.logger, s.sessions)).Get("/auth/logout", strict.PostAuthLogout)
 
 	r.Group(func(r chi.Router) {
-		r.Post("/auth/login", s.handler.PostAuthLogin)
-		r.Post("/auth/register", s.handler.PostAuthRegister)
-
-		r.Get("/api/v1/posts", func(w http.ResponseWriter, r *http.Request) {
-			queryParams := r.URL.Query()
-
-			pageStr := queryParams.Get("page")
-			limitStr := queryParams.Get("limit")
-			offsetStr := queryParams.Get("offset")
-			sortStr := queryParams.Get("sort")
-
-			var page, limit, offset int
-			var sort api.GetApiV1PostsParamsSort
-
-			if pageStr != "" {
-				page, _ = strconv.Atoi(pageStr)
-			}
-			if limitStr != "" {
-				limit, _ = strconv.Atoi(limitStr)
-			}
-			if offsetStr != "" {
-				offset, _ = strconv.Atoi(offsetStr)
-			}
-			if sortStr != "" {
-				sort = api.GetApiV1PostsParamsSort(sortStr)
-			}
-
-			s.handler.GetApiV1Posts(w, r, api.GetApiV1PostsParams{
-				Page:   &page,
-				Limit:  &limit,
-				Offset: &offset,
-				Sort:   &sort,
-			})
+		r.Use(s.validator)
+		r.Use(emptyBody)
+		api.HandlerWithOptions(strict, api.ChiServerOptions{
+			BaseRouter: r,
+			// Malformed path, query and header parameters.
+			ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+				problem.Error(w, r, http.StatusBadRequest, err.Error())
+			},
 		})
+	})
 
-		r.Get("/api/v1/posts/{postId}", func(w http.ResponseWriter, r *http.Request) {
-			postId, err := uuid.Parse(chi.URLParam(r, "postId"))
-			if err != nil {
-				http.Error(w, "Invalid post ID", http.StatusBadRequest)
-				return
-			}
-			s.handler.GetApiV1PostsPostId(w, r, postId)
+	for name, format := range map[string]feed.Format{"feed.xml": feed.RSS, "atom.xml": feed.Atom, "feed.json": feed.JSON} {
+		r.Get("/"+name, func(w http.ResponseWriter, r *http.Request) {
+			s.handler.GetFeed(w, r, format, entity.FeedQuery{})
 		})
-
-		r.Get("/api/v1/posts/{postId}/comments", func(w http.ResponseWriter, r *http.Request) {
-			postId, err := uuid.Parse(chi.URLParam(r, "postId"))
-			if err != nil {
-				http.Error(w, "Invalid post ID", http.StatusBadRequest)
-				return
-			}
-
-			queryParams := r.URL.Query()
-
-			pageStr := queryParams.Get("page")
-			limitStr := queryParams.Get("limit")
-			offsetStr := queryParams.Get("offset")
-			sortStr := queryParams.Get("sort")
-
-			var page, limit, offset int
-			var sort api.GetApiV1PostsPostIdCommentsParamsSort
-
-			if pageStr != "" {
-				page, _ = strconv.Atoi(pageStr)
-			}
-			if limitStr != "" {
-				limit, _ = strconv.Atoi(limitStr)
-			}
-			if offsetStr != "" {
-				offset, _ = strconv.Atoi(offsetStr)
-			}
-			if sortStr != "" {
-				sort = api.GetApiV1PostsPostIdCommentsParamsSort(sortStr)
-			}
-
-			s.handler.GetApiV1PostsPostIdComments(w, r, postId, api.GetApiV1PostsPostIdCommentsParams{
-				Page:   &page,
-				Limit:  &limit,
-				Offset: &offset,
-				Sort:   &sort,
-			})
+		r.Get("/authors/{username}/"+name, func(w http.ResponseWriter, r *http.Request) {
+			s.handler.GetFeed(w, r, format, entity.FeedQuery{Author: chi.URLParam(r, "username")})
+		})
+		r.Get("/tags/{tag}/"+name, func(w http.ResponseWriter, r *http.Request) {
+			s.handler.GetFeed(w, r, format, entity.FeedQuery{Tag: chi.URLParam(r, "tag")})
 		})
-		r.Handle("/swagger/*", handlers.SwaggerHandler(s.staticPath))
+	}
+
+	r.Get("/robots.txt", s.handler.GetRobots)
+	r.Get("/sitemap.xml", s.handler.GetSitemapIndex)
+	r.Get("/sitemaps/{file}", func(w http.ResponseWriter, r *http.Request) {
+		// Files are named <kind>.xml, or <kind>-<position>.xml for the
+		// chunks after the first.
+		name, ok := strings.CutSuffix(chi.URLParam(r, "file"), ".xml")
+		if !ok {
+			problem.Error(w, r, http.StatusNotFound, "No such sitemap")
+			return
+		}
+		kind, after, _ := strings.Cut(name, "-")
+		s.handler.GetSitemap(w, r, kind, after)
 	})
 
+	r.Handle("/swagger/*", handlers.SwaggerHandler(s.staticPath))
+
 	return r
 }
+
+// emptyBody lets the generated handlers decode a missing optional body as an
+// empty JSON object. Missing required bodies are rejected by the validator
+// before.
+func emptyBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength == 0 && (r.Method == http.MethodPut || r.Method == http.MethodPost) {
+			r.Body = io.NopCloser(strings.NewReader("{}"))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,718 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/websocket"
+
+	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/handlers"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/middleware"
+	"github.com/popeskul/awesome-blog/backend/internal/delivery/http/v1/problem"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+	"github.com/popeskul/awesome-blog/backend/internal/feed"
+	"github.com/popeskul/awesome-blog/backend/internal/server"
+	"github.com/popeskul/awesome-blog/backend/internal/sitemap"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/validator"
+)
+
+const secretKey = "test-secret"
+
+var (
+	userId    = uuid.MustParse("123e4567-e89b-12d3-a456-426614174000")
+	sessionId = uuid.MustParse("8f14e45f-ceea-467f-a9c3-2a9b1e3c4d5e")
+	// endedId is a session that was logged out or evicted; its token is
+	// still signed and unexpired.
+	endedId = uuid.MustParse("c9f0f895-fb98-4b91-9b1a-7d4e5a6b3c2d")
+	postId  = uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	created = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// activeSessions answers like sessions.Checker without a database.
+type activeSessions map[uuid.UUID]bool
+
+func (s activeSessions) Active(_ context.Context, sessionID uuid.UUID) (bool, error) {
+	return s[sessionID], nil
+}
+
+type mocks struct {
+	post     *mockusecase.MockUseCasePost
+	bookmark *mockusecase.MockUseCaseBookmark
+	profile  *mockusecase.MockUseCaseProfile
+	user     *mockusecase.MockUseCaseUser
+	auth     *mockusecase.MockUseCaseAuth
+	sitemap  *mockusecase.MockUseCaseSitemap
+	presence *mockusecase.MockUseCasePresence
+}
+
+// newRouter serves the real handlers on top of mocked usecases. Every
+// response is checked against the spec, so a handler that drifts from it
+// fails the test that reaches it.
+func newRouter(t *testing.T) (http.Handler, *mocks) {
+	ctrl := gomock.NewController(t)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	cfg := &config.Config{
+		JWT:      config.JWTConfig{SecretKey: secretKey},
+		Presence: config.PresenceConfig{TTL: time.Minute, MessageRate: 1, MessageBurst: 2},
+	}
+	v := validator.New()
+
+	m := &mocks{
+		post:     mockusecase.NewMockUseCasePost(ctrl),
+		bookmark: mockusecase.NewMockUseCaseBookmark(ctrl),
+		profile:  mockusecase.NewMockUseCaseProfile(ctrl),
+		user:     mockusecase.NewMockUseCaseUser(ctrl),
+		auth:     mockusecase.NewMockUseCaseAuth(ctrl),
+		sitemap:  mockusecase.NewMockUseCaseSitemap(ctrl),
+		presence: mockusecase.NewMockUseCasePresence(ctrl),
+	}
+
+	handler := handlers.NewHandler(
+		handlers.NewPostHandler(m.post, logger, v, false),
+		handlers.NewCommentHandler(mockusecase.NewMockUseCaseComment(ctrl), logger, v, false),
+		handlers.NewModerationHandler(mockusecase.NewMockUseCaseModeration(ctrl), logger, v),
+		handlers.NewReactionHandler(mockusecase.NewMockUseCaseReaction(ctrl), logger),
+		handlers.NewBookmarkHandler(m.bookmark, logger, v),
+		handlers.NewReadingListHandler(mockusecase.NewMockUseCaseReadingList(ctrl), logger, v),
+		handlers.NewAuthorHandler(m.profile, m.post, logger, v),
+		handlers.NewFollowHandler(mockusecase.NewMockUseCaseFollow(ctrl), logger),
+		handlers.NewNotificationHandler(mockusecase.NewMockUseCaseNotification(ctrl), logger, v),
+		handlers.NewStreamHandler(mockusecase.NewMockUseCaseStream(ctrl), logger, time.Minute),
+		handlers.NewPresenceHandler(m.presence, logger, cfg.Presence),
+		handlers.NewWebhookHandler(mockusecase.NewMockUseCaseWebhook(ctrl), logger, v),
+		handlers.NewJobHandler(mockusecase.NewMockUseCaseJob(ctrl), logger),
+		handlers.NewNewsletterHandler(mockusecase.NewMockUseCaseNewsletter(ctrl), logger, v),
+		handlers.NewFeedHandler(mockusecase.NewMockUseCaseFeed(ctrl), logger, feed.Options{}, "", false),
+		handlers.NewSitemapHandler(m.sitemap, logger, "", "", sitemap.RobotsOptions{}),
+		handlers.NewUserHandler(m.user, logger, v),
+		handlers.NewAuthHandler(m.auth, m.user, logger, v),
+	)
+
+	validate, err := middleware.NewOpenAPIValidator(logger, middleware.ValidateResponses(func(r *http.Request, err error) {
+		t.Errorf("%s %s: response does not match the spec: %v", r.Method, r.URL.Path, err)
+	}))
+	require.NoError(t, err)
+
+	sessions := activeSessions{sessionId: true}
+
+	return server.NewServer(cfg, logger, handler, validate, sessions, "").Router(), m
+}
+
+func token(t *testing.T, session uuid.UUID) string {
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id":    userId.String(),
+		"session_id": session.String(),
+		"exp":        time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(secretKey))
+	require.NoError(t, err)
+	return signed
+}
+
+func testPost() *entity.Post {
+	return &entity.Post{
+		Id:        postId,
+		Title:     "Hello World",
+		Content:   "This is my first post.",
+		AuthorId:  userId,
+		Status:    entity.PostStatusPublished,
+		Version:   3,
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+}
+
+func testUser() *entity.User {
+	return &entity.User{
+		Id:        userId,
+		Username:  "tom",
+		Email:     "tom@example.com",
+		Role:      entity.RoleUser,
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+}
+
+func TestRouter(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		contentType string
+		header      http.Header
+		auth        bool
+		session     uuid.UUID
+		mockSetup   func(m *mocks)
+		wantStatus  int
+		wantProblem *problem.Details
+		wantHeader  http.Header
+	}{
+		{
+			name:   "Lists posts anonymously",
+			method: http.MethodGet,
+			path:   "/api/v1/posts?limit=5&tag=go",
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					GetAllPosts(gomock.Any(), uuid.Nil, &entity.PostFilter{Tag: "go"}, gomock.Any(), gomock.Any()).
+					Return(&entity.Response[entity.Post]{
+						Data:       []*entity.Post{testPost()},
+						Pagination: &entity.Pagination{Limit: 5, Page: 1, Sort: "created_at_desc"},
+					}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Rejects a malformed query parameter",
+			method:     http.MethodGet,
+			path:       "/api/v1/posts?limit=ten",
+			wantStatus: http.StatusBadRequest,
+			wantProblem: &problem.Details{
+				Type:     problem.ValidationType,
+				Title:    "Validation failed",
+				Status:   http.StatusBadRequest,
+				Detail:   "The request is invalid",
+				Instance: "/api/v1/posts",
+				Errors: []problem.FieldError{
+					{Field: "limit", Rule: "type", Message: "value ten: an invalid integer: invalid syntax"},
+				},
+			},
+		},
+		{
+			name:       "Rejects a query parameter out of range",
+			method:     http.MethodGet,
+			path:       "/api/v1/posts?status=deleted",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Gets a post",
+			method: http.MethodGet,
+			path:   "/api/v1/posts/" + postId.String(),
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					GetPost(gomock.Any(), postId, uuid.Nil, gomock.Any()).
+					Return(testPost(), nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "Ignores an If-None-Match of another representation",
+			method: http.MethodGet,
+			path:   "/api/v1/posts/" + postId.String(),
+			header: http.Header{"If-None-Match": {`"3"`}},
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					GetPost(gomock.Any(), postId, uuid.Nil, gomock.Any()).
+					Return(testPost(), nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "Answers a missing post with 404",
+			method: http.MethodGet,
+			path:   "/api/v1/posts/" + postId.String(),
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					GetPost(gomock.Any(), postId, uuid.Nil, gomock.Any()).
+					Return(nil, usecase.ErrPostNotFound).Times(1)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Rejects a malformed path parameter",
+			method:     http.MethodGet,
+			path:       "/api/v1/posts/not-a-uuid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Requires a token",
+			method:      http.MethodPost,
+			path:        "/api/v1/posts",
+			body:        `{"title":"Hello","content":"World","authorId":"` + userId.String() + `"}`,
+			contentType: "application/json",
+			wantStatus:  http.StatusUnauthorized,
+			wantProblem: &problem.Details{
+				Type:     problem.BlankType,
+				Title:    "Unauthorized",
+				Status:   http.StatusUnauthorized,
+				Detail:   "A bearer token is required",
+				Instance: "/api/v1/posts",
+			},
+		},
+		{
+			name:        "Rejects an invalid token",
+			method:      http.MethodPost,
+			path:        "/api/v1/posts",
+			body:        `{"title":"Hello","content":"World","authorId":"` + userId.String() + `"}`,
+			contentType: "application/json",
+			header:      http.Header{"Authorization": {"Bearer nope"}},
+			wantStatus:  http.StatusUnauthorized,
+			wantProblem: &problem.Details{
+				Type:     problem.BlankType,
+				Title:    "Unauthorized",
+				Status:   http.StatusUnauthorized,
+				Detail:   "The bearer token is invalid or expired",
+				Instance: "/api/v1/posts",
+			},
+		},
+		{
+			name:        "Rejects the token of an ended session",
+			method:      http.MethodPost,
+			path:        "/api/v1/posts",
+			body:        `{"title":"Hello","content":"World","authorId":"` + userId.String() + `"}`,
+			contentType: "application/json",
+			auth:        true,
+			session:     endedId,
+			wantStatus:  http.StatusUnauthorized,
+			wantProblem: &problem.Details{
+				Type:     problem.BlankType,
+				Title:    "Unauthorized",
+				Status:   http.StatusUnauthorized,
+				Detail:   "The bearer token is invalid or expired",
+				Instance: "/api/v1/posts",
+			},
+		},
+		{
+			name:        "Lists the fields a body is missing",
+			method:      http.MethodPost,
+			path:        "/api/v1/posts",
+			body:        `{"content":"World","authorId":"` + userId.String() + `"}`,
+			contentType: "application/json",
+			auth:        true,
+			wantStatus:  http.StatusBadRequest,
+			wantProblem: &problem.Details{
+				Type:     problem.ValidationType,
+				Title:    "Validation failed",
+				Status:   http.StatusBadRequest,
+				Detail:   "The request is invalid",
+				Instance: "/api/v1/posts",
+				Errors: []problem.FieldError{
+					{Field: "title", Rule: "required", Message: `property "title" is missing`},
+				},
+			},
+		},
+		{
+			name:        "Creates a post",
+			method:      http.MethodPost,
+			path:        "/api/v1/posts",
+			body:        `{"title":"Hello World","content":"This is my first post.","authorId":"` + userId.String() + `"}`,
+			contentType: "application/json",
+			auth:        true,
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					CreatePost(gomock.Any(), &entity.NewPost{Title: "Hello World", Content: "This is my first post.", AuthorId: userId}).
+					Return(testPost(), nil).Times(1)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:        "Updates a post",
+			method:      http.MethodPut,
+			path:        "/api/v1/posts/" + postId.String(),
+			body:        `{"title":"Hello again","content":"An edited post."}`,
+			contentType: "application/json",
+			header:      http.Header{"If-Match": {`"3"`}},
+			auth:        true,
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					UpdatePost(gomock.Any(), &entity.Post{Id: postId, Title: "Hello again", Content: "An edited post.", Version: 3}, userId).
+					DoAndReturn(func(_ context.Context, post *entity.Post, _ uuid.UUID) error {
+						post.Version++
+						return nil
+					}).Times(1)
+				m.post.EXPECT().
+					GetPost(gomock.Any(), postId, userId, gomock.Any()).
+					Return(testPost(), nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "Rejects an empty update",
+			method:      http.MethodPut,
+			path:        "/api/v1/posts/" + postId.String(),
+			body:        `{}`,
+			contentType: "application/json",
+			auth:        true,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "Rejects an invalid patch result like an invalid body",
+			method:      http.MethodPatch,
+			path:        "/api/v1/posts/" + postId.String(),
+			body:        `{"tags":[""]}`,
+			contentType: "application/merge-patch+json",
+			auth:        true,
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					GetPost(gomock.Any(), postId, userId, gomock.Any()).
+					Return(testPost(), nil).Times(1)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Rejects a body of the wrong media type",
+			method:      http.MethodPatch,
+			path:        "/api/v1/posts/" + postId.String(),
+			body:        `title=Hello`,
+			contentType: "text/plain",
+			auth:        true,
+			wantStatus:  http.StatusUnsupportedMediaType,
+			wantHeader:  http.Header{"Accept-Patch": {"application/json-patch+json, application/merge-patch+json"}},
+		},
+		{
+			name:   "Deletes a post",
+			method: http.MethodDelete,
+			path:   "/api/v1/posts/" + postId.String(),
+			auth:   true,
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					DeletePost(gomock.Any(), postId, userId, entity.AnyVersion).
+					Return(nil).Times(1)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:   "Answers a stale If-Match with 412",
+			method: http.MethodDelete,
+			path:   "/api/v1/posts/" + postId.String(),
+			header: http.Header{"If-Match": {`"2"`}},
+			auth:   true,
+			mockSetup: func(m *mocks) {
+				m.post.EXPECT().
+					DeletePost(gomock.Any(), postId, userId, 2).
+					Return(usecase.ErrVersionMismatch).Times(1)
+			},
+			wantStatus: http.StatusPreconditionFailed,
+		},
+		{
+			name:   "Bookmarks a post without a body",
+			method: http.MethodPut,
+			path:   "/api/v1/posts/" + postId.String() + "/bookmark",
+			auth:   true,
+			mockSetup: func(m *mocks) {
+				m.bookmark.EXPECT().
+					AddBookmark(gomock.Any(), &entity.NewBookmark{UserId: userId, PostId: postId}).
+					Return(&entity.Bookmark{UserId: userId, PostId: postId, CreatedAt: created}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "Gets an author",
+			method: http.MethodGet,
+			path:   "/api/v1/authors/tom",
+			mockSetup: func(m *mocks) {
+				m.profile.EXPECT().
+					GetAuthor(gomock.Any(), "tom").
+					Return(&entity.Author{Id: userId, Username: "tom", PostCount: 1, JoinedAt: created}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "Logs in",
+			method:      http.MethodPost,
+			path:        "/auth/login",
+			body:        `{"username":"tom","password":"Passw0rd!"}`,
+			contentType: "application/json",
+			mockSetup: func(m *mocks) {
+				m.auth.EXPECT().
+					Authenticate(gomock.Any(), "tom", "Passw0rd!").
+					Return("token", nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:        "Rejects bad credentials",
+			method:      http.MethodPost,
+			path:        "/auth/login",
+			body:        `{"username":"tom","password":"wrong"}`,
+			contentType: "application/json",
+			mockSetup: func(m *mocks) {
+				m.auth.EXPECT().
+					Authenticate(gomock.Any(), "tom", "wrong").
+					Return("", errors.New("invalid password")).Times(1)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "Registers a user",
+			method:      http.MethodPost,
+			path:        "/auth/register",
+			body:        `{"username":"tom","email":"tom@example.com","password":"Passw0rd!"}`,
+			contentType: "application/json",
+			mockSetup: func(m *mocks) {
+				m.auth.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Return(testUser(), nil).Times(1)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:        "Answers a taken username with 409",
+			method:      http.MethodPost,
+			path:        "/auth/register",
+			body:        `{"username":"tom","email":"tom@example.com","password":"Passw0rd!"}`,
+			contentType: "application/json",
+			mockSetup: func(m *mocks) {
+				m.auth.EXPECT().
+					Register(gomock.Any(), gomock.Any()).
+					Return(nil, usecase.ErrUserExists).Times(1)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:   "Gets the current user",
+			method: http.MethodGet,
+			path:   "/auth/me",
+			auth:   true,
+			mockSetup: func(m *mocks) {
+				m.user.EXPECT().
+					GetUserByID(gomock.Any(), userId).
+					Return(testUser(), nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:   "Forbids managing another user",
+			method: http.MethodGet,
+			path:   "/api/v1/users/" + postId.String(),
+			auth:   true,
+			mockSetup: func(m *mocks) {
+				m.user.EXPECT().
+					CanManageUser(gomock.Any(), userId, postId).
+					Return(usecase.ErrForbidden).Times(1)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:   "Logs out",
+			method: http.MethodPost,
+			path:   "/auth/logout",
+			auth:   true,
+			mockSetup: func(m *mocks) {
+				m.auth.EXPECT().
+					Logout(gomock.Any(), sessionId).
+					Return(nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			// chi routes on the raw path, so the encoded slash reaches the
+			// follow handler, which the spec doesn't see as that operation.
+			name:       "Refuses requests the spec doesn't match",
+			method:     http.MethodPut,
+			path:       "/api/v1/authors/a%2Fb/follow",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:   "Serves sitemap chunks after the first from their position",
+			method: http.MethodGet,
+			path:   "/sitemaps/posts-" + entity.Cursor{CreatedAt: created, Id: postId}.Encode() + ".xml",
+			mockSetup: func(m *mocks) {
+				m.sitemap.EXPECT().
+					GetSitemap(gomock.Any(), entity.SitemapChunk{
+						Kind:  entity.SitemapPosts,
+						After: &entity.Cursor{CreatedAt: created, Id: postId},
+					}).
+					Return([]*entity.SitemapEntry{{Key: postId.String(), LastMod: created}}, nil).Times(1)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Answers sitemap chunks with a bad position with 404",
+			method:     http.MethodGet,
+			path:       "/sitemaps/posts-nope.xml",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "Answers unknown routes with 404",
+			method:     http.MethodGet,
+			path:       "/api/v1/nope",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, m := newRouter(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(m)
+			}
+
+			var body io.Reader
+			if tt.body != "" {
+				body = strings.NewReader(tt.body)
+			}
+			r := httptest.NewRequest(tt.method, tt.path, body)
+			for key, values := range tt.header {
+				r.Header[key] = values
+			}
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.auth {
+				session := sessionId
+				if tt.session != uuid.Nil {
+					session = tt.session
+				}
+				r.Header.Set("Authorization", "Bearer "+token(t, session))
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, r)
+
+			assert.Equal(t, tt.wantStatus, w.Code, w.Body.String())
+			for key, values := range tt.wantHeader {
+				assert.Equal(t, values, w.Header().Values(key), key)
+			}
+			if w.Code >= http.StatusBadRequest {
+				assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+			}
+			if tt.wantProblem != nil {
+				var got problem.Details
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+				assert.Equal(t, *tt.wantProblem, got)
+			}
+		})
+	}
+}
+
+// TestRouter_ETag follows a client that caches a post: the ETag names the
+// body it got, and writes only compare its version.
+func TestRouter_ETag(t *testing.T) {
+	router, m := newRouter(t)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/posts/"+postId.String(), nil)
+		if ifNoneMatch != "" {
+			r.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	m.post.EXPECT().
+		GetPost(gomock.Any(), postId, uuid.Nil, gomock.Any()).
+		Return(testPost(), nil).Times(2)
+
+	first := get("")
+	require.Equal(t, http.StatusOK, first.Code)
+	etag := first.Header().Get("ETag")
+	assert.True(t, strings.HasPrefix(etag, `"3-`), etag)
+
+	again := get(etag)
+	assert.Equal(t, http.StatusNotModified, again.Code)
+	assert.Equal(t, etag, again.Header().Get("ETag"))
+
+	// A reaction changes the body but not the version.
+	reacted := testPost()
+	reacted.Reactions = &entity.ReactionSummary{Counts: map[string]int{"like": 1}, Mine: []string{}}
+	m.post.EXPECT().
+		GetPost(gomock.Any(), postId, uuid.Nil, gomock.Any()).
+		Return(reacted, nil).Times(1)
+
+	changed := get(etag)
+	assert.Equal(t, http.StatusOK, changed.Code)
+	assert.NotEqual(t, etag, changed.Header().Get("ETag"))
+
+	m.post.EXPECT().
+		UpdatePost(gomock.Any(), &entity.Post{Id: postId, Title: "Hello again", Content: "An edited post.", Version: 3}, userId).
+		DoAndReturn(func(_ context.Context, post *entity.Post, _ uuid.UUID) error {
+			post.Version++
+			return nil
+		}).Times(1)
+
+	// The response is tagged like the stored post a later GET returns, not
+	// like the request body.
+	updated := testPost()
+	updated.Title, updated.Content, updated.Version = "Hello again", "An edited post.", 4
+	m.post.EXPECT().
+		GetPost(gomock.Any(), postId, userId, gomock.Any()).
+		Return(updated, nil).Times(1)
+
+	r := httptest.NewRequest(http.MethodPut, "/api/v1/posts/"+postId.String(), strings.NewReader(`{"title":"Hello again","content":"An edited post."}`))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Authorization", "Bearer "+token(t, sessionId))
+	r.Header.Set("If-Match", etag)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.True(t, strings.HasPrefix(w.Header().Get("ETag"), `"4-`), w.Header().Get("ETag"))
+
+	m.post.EXPECT().
+		GetPost(gomock.Any(), postId, uuid.Nil, gomock.Any()).
+		Return(updated, nil).Times(1)
+
+	assert.Equal(t, http.StatusNotModified, get(w.Header().Get("ETag")).Code)
+}
+
+func TestRouter_PresenceRateLimit(t *testing.T) {
+	router, m := newRouter(t)
+
+	ctrl := gomock.NewController(t)
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	userRepo := mocksrepository.NewMockUserRepository(ctrl)
+	postRepo.EXPECT().GetPostById(gomock.Any(), postId).Return(testPost(), nil).Times(1)
+	userRepo.EXPECT().GetUserById(gomock.Any(), userId).Return(testUser(), nil).Times(1)
+
+	broker := events.NewMemoryBroker(10)
+	defer broker.Close()
+	presence := usecase.NewPresenceUseCase(postRepo, userRepo, broker, logrus.New(), &config.Config{Presence: config.PresenceConfig{TTL: time.Minute}})
+	m.presence.EXPECT().
+		Join(gomock.Any(), postId, userId).
+		DoAndReturn(presence.Join).Times(1)
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	wsConfig, err := websocket.NewConfig("ws"+strings.TrimPrefix(srv.URL, "http")+"/api/v1/posts/"+postId.String()+"/presence", srv.URL)
+	require.NoError(t, err)
+	wsConfig.Header.Set("Authorization", "Bearer "+token(t, sessionId))
+	conn, err := websocket.DialConfig(wsConfig)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	var msg map[string]any
+	require.NoError(t, websocket.JSON.Receive(conn, &msg))
+	assert.Equal(t, "presence", msg["type"])
+
+	// The burst allows two messages; the third one ends the connection
+	// instead of being answered.
+	for range 3 {
+		require.NoError(t, websocket.JSON.Send(conn, map[string]string{"type": "ping"}))
+	}
+
+	var received []any
+	for {
+		msg = nil
+		if err = websocket.JSON.Receive(conn, &msg); err != nil {
+			break
+		}
+		received = append(received, msg["type"])
+	}
+
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, []any{"pong", "pong", "closing"}, received)
+}
@@ -0,0 +1,80 @@
+package sessions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+// maxCachedSessions bounds the memory the checker uses. Once it is reached,
+// expired answers are dropped, and all of them if that isn't enough.
+const maxCachedSessions = 10000
+
+// Checker tells the auth middleware whether the session a token was issued
+// for is still active, so that tokens of ended sessions stop working. Every
+// authenticated request asks, so answers are kept for ttl: a session that
+// ends keeps working for at most that long. A zero ttl disables the cache.
+type Checker struct {
+	repo repository.SessionRepository
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	answers map[uuid.UUID]answer
+}
+
+type answer struct {
+	active  bool
+	expires time.Time
+}
+
+func NewChecker(repo repository.SessionRepository, ttl time.Duration) *Checker {
+	return &Checker{
+		repo:    repo,
+		ttl:     ttl,
+		answers: map[uuid.UUID]answer{},
+	}
+}
+
+func (c *Checker) Active(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	cached, ok := c.answers[sessionID]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.active, nil
+	}
+
+	active, err := c.repo.IsSessionActive(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	if c.ttl > 0 {
+		c.remember(sessionID, answer{active: active, expires: now.Add(c.ttl)}, now)
+	}
+
+	return active, nil
+}
+
+func (c *Checker) remember(sessionID uuid.UUID, a answer, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.answers) >= maxCachedSessions {
+		for id, cached := range c.answers {
+			if !now.Before(cached.expires) {
+				delete(c.answers, id)
+			}
+		}
+		if len(c.answers) >= maxCachedSessions {
+			clear(c.answers)
+		}
+	}
+
+	c.answers[sessionID] = a
+}
@@ -0,0 +1,77 @@
+package sessions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/sessions"
+)
+
+func TestChecker_Active(t *testing.T) {
+	sessionId := uuid.New()
+
+	tests := []struct {
+		name          string
+		ttl           time.Duration
+		mockSetup     func(repo *mocksrepository.MockSessionRepository)
+		expected      []bool
+		expectedError string
+	}{
+		{
+			name: "Answers are cached",
+			ttl:  time.Minute,
+			mockSetup: func(repo *mocksrepository.MockSessionRepository) {
+				repo.EXPECT().IsSessionActive(gomock.Any(), sessionId).Return(true, nil).Times(1)
+			},
+			expected: []bool{true, true},
+		},
+		{
+			name: "A zero ttl asks every time",
+			mockSetup: func(repo *mocksrepository.MockSessionRepository) {
+				gomock.InOrder(
+					repo.EXPECT().IsSessionActive(gomock.Any(), sessionId).Return(true, nil),
+					repo.EXPECT().IsSessionActive(gomock.Any(), sessionId).Return(false, nil),
+				)
+			},
+			expected: []bool{true, false},
+		},
+		{
+			name: "Errors are not cached",
+			ttl:  time.Minute,
+			mockSetup: func(repo *mocksrepository.MockSessionRepository) {
+				repo.EXPECT().IsSessionActive(gomock.Any(), sessionId).Return(false, errors.New("database error")).Times(2)
+			},
+			expected:      []bool{false, false},
+			expectedError: "database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocksrepository.NewMockSessionRepository(ctrl)
+			tt.mockSetup(repo)
+
+			checker := sessions.NewChecker(repo, tt.ttl)
+
+			for _, expected := range tt.expected {
+				active, err := checker.Active(context.Background(), sessionId)
+				if tt.expectedError != "" {
+					assert.EqualError(t, err, tt.expectedError)
+				} else {
+					assert.NoError(t, err)
+				}
+				assert.Equal(t, expected, active)
+			}
+		})
+	}
+}
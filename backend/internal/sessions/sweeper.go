@@ -0,0 +1,63 @@
+package sessions
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/metrics"
+)
+
+// KindSweep is the job that deletes expired sessions.
+const KindSweep = "sessions.sweep"
+
+// Sweeper deletes expired sessions in batches, so that a large backlog
+// doesn't hold locks on the table for long.
+type Sweeper struct {
+	repo      repository.SessionRepository
+	logger    *logrus.Logger
+	batchSize int
+}
+
+func NewSweeper(repo repository.SessionRepository, logger *logrus.Logger, batchSize int) *Sweeper {
+	return &Sweeper{
+		repo:      repo,
+		logger:    logger,
+		batchSize: max(batchSize, 1),
+	}
+}
+
+// Sweep is the handler of KindSweep jobs. It deletes batches until none is
+// left or the job is cancelled, then refreshes the active sessions gauge.
+func (s *Sweeper) Sweep(ctx context.Context, _ *entity.Job) error {
+	var total int64
+	for ctx.Err() == nil {
+		deleted, err := s.repo.DeleteExpiredSessions(ctx, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		total += deleted
+		metrics.ExpiredSessionsDeleted.Add(float64(deleted))
+		if deleted < int64(s.batchSize) {
+			break
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if total > 0 {
+		s.logger.WithField("sessions", total).Info("Deleted expired sessions")
+	}
+
+	active, err := s.repo.CountActiveSessions(ctx)
+	if err != nil {
+		return err
+	}
+	metrics.ActiveSessions.Set(float64(active))
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package sessions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/metrics"
+	"github.com/popeskul/awesome-blog/backend/internal/sessions"
+)
+
+func TestSweeper_Sweep(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(repo *mocksrepository.MockSessionRepository)
+		expectedError string
+		expectedGauge float64
+	}{
+		{
+			name: "Batches until the backlog is gone",
+			mockSetup: func(repo *mocksrepository.MockSessionRepository) {
+				gomock.InOrder(
+					repo.EXPECT().DeleteExpiredSessions(gomock.Any(), 2).Return(int64(2), nil),
+					repo.EXPECT().DeleteExpiredSessions(gomock.Any(), 2).Return(int64(2), nil),
+					repo.EXPECT().DeleteExpiredSessions(gomock.Any(), 2).Return(int64(1), nil),
+					repo.EXPECT().CountActiveSessions(gomock.Any()).Return(7, nil),
+				)
+			},
+			expectedGauge: 7,
+		},
+		{
+			name: "Nothing expired",
+			mockSetup: func(repo *mocksrepository.MockSessionRepository) {
+				repo.EXPECT().DeleteExpiredSessions(gomock.Any(), 2).Return(int64(0), nil)
+				repo.EXPECT().CountActiveSessions(gomock.Any()).Return(3, nil)
+			},
+			expectedGauge: 3,
+		},
+		{
+			name: "Database error",
+			mockSetup: func(repo *mocksrepository.MockSessionRepository) {
+				repo.EXPECT().DeleteExpiredSessions(gomock.Any(), 2).Return(int64(0), errors.New("database error"))
+			},
+			expectedError: "database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mocksrepository.NewMockSessionRepository(ctrl)
+			sweeper := sessions.NewSweeper(repo, logrus.New(), 2)
+
+			tt.mockSetup(repo)
+
+			err := sweeper.Sweep(context.Background(), nil)
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedGauge, testutil.ToFloat64(metrics.ActiveSessions))
+		})
+	}
+}
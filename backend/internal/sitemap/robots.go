@@ -0,0 +1,42 @@
+package sitemap
+
+import (
+	"bufio"
+	"io"
+)
+
+type RobotsOptions struct {
+	Allow    []string
+	Disallow []string
+	// DisallowAll keeps every crawler out, e.g. on a staging server.
+	DisallowAll bool
+	// SitemapURL is listed when set.
+	SitemapURL string
+}
+
+// WriteRobots writes a robots.txt with a single group for all crawlers.
+func WriteRobots(w io.Writer, opts RobotsOptions) error {
+	out := bufio.NewWriter(w)
+
+	out.WriteString("User-agent: *\n")
+	switch {
+	case opts.DisallowAll:
+		out.WriteString("Disallow: /\n")
+	case len(opts.Allow) == 0 && len(opts.Disallow) == 0:
+		// An empty rule allows everything; a group needs at least one.
+		out.WriteString("Disallow:\n")
+	default:
+		for _, path := range opts.Allow {
+			out.WriteString("Allow: " + path + "\n")
+		}
+		for _, path := range opts.Disallow {
+			out.WriteString("Disallow: " + path + "\n")
+		}
+	}
+
+	if opts.SitemapURL != "" {
+		out.WriteString("\nSitemap: " + opts.SitemapURL + "\n")
+	}
+
+	return out.Flush()
+}
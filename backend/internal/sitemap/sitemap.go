@@ -0,0 +1,94 @@
+// Package sitemap writes sitemaps (sitemaps.org protocol 0.9) and
+// robots.txt files.
+package sitemap
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// MaxURLs is the most URLs a single sitemap may list.
+const MaxURLs = 50000
+
+const namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type url struct {
+	XMLName xml.Name `xml:"url"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+// URLSet writes a sitemap one URL at a time.
+type URLSet struct {
+	encoder *xml.Encoder
+}
+
+// NewURLSet starts a sitemap; Close must be called to finish it.
+func NewURLSet(w io.Writer) (*URLSet, error) {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return nil, err
+	}
+
+	encoder := xml.NewEncoder(w)
+	start := xml.StartElement{
+		Name: xml.Name{Local: "urlset"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: namespace}},
+	}
+	if err := encoder.EncodeToken(start); err != nil {
+		return nil, err
+	}
+
+	return &URLSet{encoder: encoder}, nil
+}
+
+// Add writes a URL. A zero lastMod is left out.
+func (s *URLSet) Add(loc string, lastMod time.Time) error {
+	return s.encoder.Encode(url{Loc: loc, LastMod: formatTime(lastMod)})
+}
+
+func (s *URLSet) Close() error {
+	if err := s.encoder.EncodeToken(xml.EndElement{Name: xml.Name{Local: "urlset"}}); err != nil {
+		return err
+	}
+
+	return s.encoder.Close()
+}
+
+type index struct {
+	XMLName  xml.Name  `xml:"sitemapindex"`
+	XMLNS    string    `xml:"xmlns,attr"`
+	Sitemaps []sitemap `xml:"sitemap"`
+}
+
+type sitemap struct {
+	Loc string `xml:"loc"`
+}
+
+// WriteIndex writes a sitemap index listing the sitemaps at locs.
+func WriteIndex(w io.Writer, locs []string) error {
+	doc := index{XMLNS: namespace}
+	for _, loc := range locs {
+		doc.Sitemaps = append(doc.Sitemaps, sitemap{Loc: loc})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
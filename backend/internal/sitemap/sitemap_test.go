@@ -0,0 +1,109 @@
+package sitemap_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/popeskul/awesome-blog/backend/internal/sitemap"
+)
+
+type urlSet struct {
+	XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+	URLs    []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"url"`
+}
+
+func TestURLSet(t *testing.T) {
+	var buf bytes.Buffer
+	urls, err := sitemap.NewURLSet(&buf)
+	require.NoError(t, err)
+
+	lastMod := time.Date(2024, 5, 1, 10, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	require.NoError(t, urls.Add("https://blog.example.com/posts/1", lastMod))
+	require.NoError(t, urls.Add("https://blog.example.com/tags/c&c", time.Time{}))
+	require.NoError(t, urls.Close())
+
+	var doc urlSet
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	require.Len(t, doc.URLs, 2)
+	assert.Equal(t, "https://blog.example.com/posts/1", doc.URLs[0].Loc)
+	assert.Equal(t, "2024-05-01T08:00:00Z", doc.URLs[0].LastMod)
+	assert.Equal(t, "https://blog.example.com/tags/c&c", doc.URLs[1].Loc)
+	assert.Empty(t, doc.URLs[1].LastMod)
+	assert.Contains(t, buf.String(), "<loc>https://blog.example.com/tags/c&amp;c</loc>")
+}
+
+func TestURLSet_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	urls, err := sitemap.NewURLSet(&buf)
+	require.NoError(t, err)
+	require.NoError(t, urls.Close())
+
+	var doc urlSet
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+	assert.Empty(t, doc.URLs)
+}
+
+func TestWriteIndex(t *testing.T) {
+	var buf bytes.Buffer
+	err := sitemap.WriteIndex(&buf, []string{"https://api.example.com/sitemaps/posts-1.xml", "https://api.example.com/sitemaps/tags-1.xml"})
+	require.NoError(t, err)
+
+	var doc struct {
+		XMLName  xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+		Sitemaps []struct {
+			Loc string `xml:"loc"`
+		} `xml:"sitemap"`
+	}
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	require.Len(t, doc.Sitemaps, 2)
+	assert.Equal(t, "https://api.example.com/sitemaps/tags-1.xml", doc.Sitemaps[1].Loc)
+}
+
+func TestWriteRobots(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     sitemap.RobotsOptions
+		expected string
+	}{
+		{
+			name: "Rules and sitemap",
+			opts: sitemap.RobotsOptions{
+				Allow:      []string{"/api/v1/posts"},
+				Disallow:   []string{"/api/", "/auth/"},
+				SitemapURL: "https://api.example.com/sitemap.xml",
+			},
+			expected: "User-agent: *\nAllow: /api/v1/posts\nDisallow: /api/\nDisallow: /auth/\n\nSitemap: https://api.example.com/sitemap.xml\n",
+		},
+		{
+			name:     "No rules",
+			expected: "User-agent: *\nDisallow:\n",
+		},
+		{
+			name: "Everything disallowed",
+			opts: sitemap.RobotsOptions{
+				Disallow:    []string{"/api/"},
+				DisallowAll: true,
+				SitemapURL:  "https://api.example.com/sitemap.xml",
+			},
+			expected: "User-agent: *\nDisallow: /\n\nSitemap: https://api.example.com/sitemap.xml\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, sitemap.WriteRobots(&buf, tt.opts))
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const DefaultAkismetURL = "https://rest.akismet.com"
+
+// AkismetChecker asks an Akismet-compatible service about content. Content the
+// service marks as spam is held for review, unless it also advises discarding
+// it, in which case it is rejected.
+type AkismetChecker struct {
+	baseURL string
+	apiKey  string
+	site    string
+	client  *http.Client
+}
+
+func NewAkismetChecker(baseURL, apiKey, site string, timeout time.Duration) *AkismetChecker {
+	if baseURL == "" {
+		baseURL = DefaultAkismetURL
+	}
+
+	return &AkismetChecker{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		site:    site,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *AkismetChecker) Check(ctx context.Context, content *Content) (Verdict, error) {
+	form := url.Values{
+		"api_key":              {c.apiKey},
+		"blog":                 {c.site},
+		"user_ip":              {content.IP},
+		"user_agent":           {content.UserAgent},
+		"comment_author":       {content.Username},
+		"comment_author_email": {content.Email},
+		"comment_content":      {content.Body},
+	}
+
+	switch content.Kind {
+	case KindRegistration:
+		form.Set("comment_type", "signup")
+	default:
+		form.Set("comment_type", "comment")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/1.1/comment-check", strings.NewReader(form.Encode()))
+	if err != nil {
+		return Ham, fmt.Errorf("failed to build akismet request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Ham, fmt.Errorf("failed to call akismet: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return Ham, fmt.Errorf("failed to read akismet response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return Ham, fmt.Errorf("akismet returned status %d", resp.StatusCode)
+	}
+
+	switch strings.TrimSpace(string(body)) {
+	case "true":
+		if resp.Header.Get("X-akismet-pro-tip") == "discard" {
+			return Spam, nil
+		}
+		return Suspicious, nil
+	case "false":
+		return Ham, nil
+	default:
+		return Ham, fmt.Errorf("unexpected akismet response %q: %s", body, resp.Header.Get("X-akismet-debug-help"))
+	}
+}
@@ -0,0 +1,140 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+const (
+	minTokenLength = 3
+	maxTokenLength = 64
+	maxTokens      = 200
+)
+
+type BayesOptions struct {
+	// MinSamples is how many spam and how many ham decisions must be recorded
+	// before the classifier starts voting.
+	MinSamples int
+	// SpamThreshold and SuspiciousThreshold are spam probabilities above
+	// which content is rejected or held for review.
+	SpamThreshold       float64
+	SuspiciousThreshold float64
+}
+
+// BayesChecker is a naive Bayes classifier over comment words. Its model is
+// the token counts collected from moderator decisions, so it only ever scores
+// comments; registrations always pass.
+type BayesChecker struct {
+	repo repository.SpamRepository
+	opts BayesOptions
+}
+
+func NewBayesChecker(repo repository.SpamRepository, opts BayesOptions) *BayesChecker {
+	return &BayesChecker{
+		repo: repo,
+		opts: opts,
+	}
+}
+
+func (c *BayesChecker) Check(ctx context.Context, content *Content) (Verdict, error) {
+	if content.Kind != KindComment {
+		return Ham, nil
+	}
+
+	tokens := tokenize(content.Body)
+	if len(tokens) == 0 {
+		return Ham, nil
+	}
+
+	spamTotal, hamTotal, err := c.repo.GetSampleTotals(ctx)
+	if err != nil {
+		return Ham, fmt.Errorf("failed to get training totals: %w", err)
+	}
+
+	if spamTotal < c.opts.MinSamples || hamTotal < c.opts.MinSamples || spamTotal == 0 || hamTotal == 0 {
+		return Ham, nil
+	}
+
+	counts, err := c.repo.GetTokenCounts(ctx, tokens)
+	if err != nil {
+		return Ham, fmt.Errorf("failed to get token counts: %w", err)
+	}
+
+	p := spamProbability(counts, spamTotal, hamTotal)
+
+	switch {
+	case c.opts.SpamThreshold > 0 && p >= c.opts.SpamThreshold:
+		return Spam, nil
+	case c.opts.SuspiciousThreshold > 0 && p >= c.opts.SuspiciousThreshold:
+		return Suspicious, nil
+	default:
+		return Ham, nil
+	}
+}
+
+func (c *BayesChecker) Train(ctx context.Context, commentID uuid.UUID, body string, isSpam bool) error {
+	sample := &entity.SpamSample{
+		CommentId: commentID,
+		IsSpam:    isSpam,
+		Tokens:    tokenize(body),
+	}
+
+	if err := c.repo.AddSample(ctx, sample); err != nil {
+		return fmt.Errorf("failed to add spam sample: %w", err)
+	}
+
+	return nil
+}
+
+// spamProbability combines per-token likelihoods with Laplace smoothing.
+// Tokens never seen in training carry no information and are skipped.
+func spamProbability(counts map[string]entity.SpamTokenCount, spamTotal, hamTotal int) float64 {
+	total := float64(spamTotal + hamTotal)
+	logSpam := math.Log(float64(spamTotal) / total)
+	logHam := math.Log(float64(hamTotal) / total)
+
+	for _, count := range counts {
+		if count.SpamCount+count.HamCount == 0 {
+			continue
+		}
+		logSpam += math.Log(float64(count.SpamCount+1) / float64(spamTotal+2))
+		logHam += math.Log(float64(count.HamCount+1) / float64(hamTotal+2))
+	}
+
+	return 1 / (1 + math.Exp(logHam-logSpam))
+}
+
+// tokenize splits text into unique lower-cased words.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]struct{}, len(words))
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		length := utf8.RuneCountInString(word)
+		if length < minTokenLength || length > maxTokenLength {
+			continue
+		}
+		if _, ok := seen[word]; ok {
+			continue
+		}
+		seen[word] = struct{}{}
+		tokens = append(tokens, word)
+		if len(tokens) == maxTokens {
+			break
+		}
+	}
+
+	return tokens
+}
@@ -0,0 +1,42 @@
+package spam
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Chain runs several checkers and returns the most severe verdict. A checker
+// that fails counts as Suspicious: one unavailable backend neither blocks
+// every comment nor lets them all through, they are held for review.
+type Chain struct {
+	checkers []SpamChecker
+	logger   *logrus.Logger
+}
+
+func NewChain(logger *logrus.Logger, checkers ...SpamChecker) *Chain {
+	return &Chain{
+		checkers: checkers,
+		logger:   logger,
+	}
+}
+
+func (c *Chain) Check(ctx context.Context, content *Content) (Verdict, error) {
+	verdict := Ham
+	for _, checker := range c.checkers {
+		v, err := checker.Check(ctx, content)
+		if err != nil {
+			c.logger.WithError(err).WithField("kind", content.Kind).Warn("Spam checker failed, treating content as suspicious")
+			v = Suspicious
+		}
+
+		if v > verdict {
+			verdict = v
+		}
+		if verdict == Spam {
+			break
+		}
+	}
+
+	return verdict, nil
+}
@@ -0,0 +1,49 @@
+package spam
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+var linkPattern = regexp.MustCompile(`(?i)https?://|www\.`)
+
+// HeuristicChecker flags content with too many links and rejects content
+// containing blocklisted terms. Blocklist entries are matched
+// case-insensitively against the body, username and email, so both words and
+// email domains can be listed.
+type HeuristicChecker struct {
+	maxLinks  int
+	blocklist []string
+}
+
+// NewHeuristicChecker returns a checker that treats more than maxLinks links
+// as suspicious. Zero disables the link check.
+func NewHeuristicChecker(maxLinks int, blocklist []string) *HeuristicChecker {
+	terms := make([]string, 0, len(blocklist))
+	for _, term := range blocklist {
+		if term = strings.ToLower(strings.TrimSpace(term)); term != "" {
+			terms = append(terms, term)
+		}
+	}
+
+	return &HeuristicChecker{
+		maxLinks:  maxLinks,
+		blocklist: terms,
+	}
+}
+
+func (c *HeuristicChecker) Check(_ context.Context, content *Content) (Verdict, error) {
+	fields := strings.ToLower(strings.Join([]string{content.Body, content.Username, content.Email}, "\n"))
+	for _, term := range c.blocklist {
+		if strings.Contains(fields, term) {
+			return Spam, nil
+		}
+	}
+
+	if c.maxLinks > 0 && len(linkPattern.FindAllStringIndex(content.Body, -1)) > c.maxLinks {
+		return Suspicious, nil
+	}
+
+	return Ham, nil
+}
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: spam.go
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_spam.go -package=mocksspam -source=spam.go
+//
+
+// Package mocksspam is a generated GoMock package.
+package mocksspam
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	spam "github.com/popeskul/awesome-blog/backend/internal/spam"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSpamChecker is a mock of SpamChecker interface.
+type MockSpamChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpamCheckerMockRecorder
+}
+
+// MockSpamCheckerMockRecorder is the mock recorder for MockSpamChecker.
+type MockSpamCheckerMockRecorder struct {
+	mock *MockSpamChecker
+}
+
+// NewMockSpamChecker creates a new mock instance.
+func NewMockSpamChecker(ctrl *gomock.Controller) *MockSpamChecker {
+	mock := &MockSpamChecker{ctrl: ctrl}
+	mock.recorder = &MockSpamCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpamChecker) EXPECT() *MockSpamCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockSpamChecker) Check(ctx context.Context, content *spam.Content) (spam.Verdict, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, content)
+	ret0, _ := ret[0].(spam.Verdict)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockSpamCheckerMockRecorder) Check(ctx, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockSpamChecker)(nil).Check), ctx, content)
+}
+
+// MockTrainer is a mock of Trainer interface.
+type MockTrainer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrainerMockRecorder
+}
+
+// MockTrainerMockRecorder is the mock recorder for MockTrainer.
+type MockTrainerMockRecorder struct {
+	mock *MockTrainer
+}
+
+// NewMockTrainer creates a new mock instance.
+func NewMockTrainer(ctrl *gomock.Controller) *MockTrainer {
+	mock := &MockTrainer{ctrl: ctrl}
+	mock.recorder = &MockTrainerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrainer) EXPECT() *MockTrainerMockRecorder {
+	return m.recorder
+}
+
+// Train mocks base method.
+func (m *MockTrainer) Train(ctx context.Context, commentID uuid.UUID, body string, isSpam bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Train", ctx, commentID, body, isSpam)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Train indicates an expected call of Train.
+func (mr *MockTrainerMockRecorder) Train(ctx, commentID, body, isSpam any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Train", reflect.TypeOf((*MockTrainer)(nil).Train), ctx, commentID, body, isSpam)
+}
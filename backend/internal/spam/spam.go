@@ -0,0 +1,62 @@
+package spam
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_spam.go -package=mocksspam -source=spam.go
+
+// Verdict is the outcome of a spam check, ordered by severity.
+type Verdict int
+
+const (
+	// Ham is content that looks legitimate.
+	Ham Verdict = iota
+	// Suspicious content is accepted but held for a moderator.
+	Suspicious
+	// Spam is rejected outright.
+	Spam
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case Ham:
+		return "ham"
+	case Suspicious:
+		return "suspicious"
+	case Spam:
+		return "spam"
+	}
+	return "unknown"
+}
+
+// Kind tells checkers what sort of content they are looking at.
+type Kind string
+
+const (
+	KindComment      Kind = "comment"
+	KindRegistration Kind = "registration"
+)
+
+// Content is what gets checked. Fields that do not apply to a kind are left empty.
+type Content struct {
+	Kind      Kind
+	AuthorId  uuid.UUID
+	Username  string
+	Email     string
+	Body      string
+	IP        string
+	UserAgent string
+}
+
+// SpamChecker classifies user-submitted content.
+type SpamChecker interface {
+	Check(ctx context.Context, content *Content) (Verdict, error)
+}
+
+// Trainer learns from moderator decisions on comments.
+type Trainer interface {
+	Train(ctx context.Context, commentID uuid.UUID, body string, isSpam bool) error
+}
@@ -0,0 +1,268 @@
+package spam_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/spam"
+	"github.com/popeskul/awesome-blog/backend/internal/spam/mocks"
+)
+
+var commentId = uuid.New()
+
+func TestHeuristicChecker(t *testing.T) {
+	checker := spam.NewHeuristicChecker(2, []string{"casino", " @spam.example "})
+
+	tests := []struct {
+		name     string
+		content  *spam.Content
+		expected spam.Verdict
+	}{
+		{
+			name:     "Plain comment",
+			content:  &spam.Content{Kind: spam.KindComment, Body: "Thanks, this helped a lot."},
+			expected: spam.Ham,
+		},
+		{
+			name:     "Links within the limit",
+			content:  &spam.Content{Kind: spam.KindComment, Body: "See https://go.dev and www.golang.org"},
+			expected: spam.Ham,
+		},
+		{
+			name:     "Too many links",
+			content:  &spam.Content{Kind: spam.KindComment, Body: "http://a.example http://b.example HTTPS://c.example"},
+			expected: spam.Suspicious,
+		},
+		{
+			name:     "Blocklisted word in body",
+			content:  &spam.Content{Kind: spam.KindComment, Body: "Best CASINO bonuses"},
+			expected: spam.Spam,
+		},
+		{
+			name:     "Blocklisted email domain on registration",
+			content:  &spam.Content{Kind: spam.KindRegistration, Username: "bob", Email: "bob@spam.example"},
+			expected: spam.Spam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict, err := checker.Check(context.Background(), tt.content)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, verdict)
+		})
+	}
+}
+
+func TestBayesChecker_Check(t *testing.T) {
+	opts := spam.BayesOptions{MinSamples: 5, SpamThreshold: 0.99, SuspiciousThreshold: 0.8}
+
+	tests := []struct {
+		name      string
+		content   *spam.Content
+		mockSetup func(repo *mocksrepository.MockSpamRepository)
+		expected  spam.Verdict
+		expectErr bool
+	}{
+		{
+			name:    "Registrations are not scored",
+			content: &spam.Content{Kind: spam.KindRegistration, Username: "viagra"},
+			mockSetup: func(repo *mocksrepository.MockSpamRepository) {
+			},
+			expected: spam.Ham,
+		},
+		{
+			name:    "Not enough training data",
+			content: &spam.Content{Kind: spam.KindComment, Body: "cheap pills online"},
+			mockSetup: func(repo *mocksrepository.MockSpamRepository) {
+				repo.EXPECT().GetSampleTotals(gomock.Any()).Return(3, 100, nil).Times(1)
+			},
+			expected: spam.Ham,
+		},
+		{
+			name:    "Spammy words",
+			content: &spam.Content{Kind: spam.KindComment, Body: "Cheap pills online, cheap!"},
+			mockSetup: func(repo *mocksrepository.MockSpamRepository) {
+				repo.EXPECT().GetSampleTotals(gomock.Any()).Return(50, 50, nil).Times(1)
+				repo.EXPECT().
+					GetTokenCounts(gomock.Any(), []string{"cheap", "pills", "online"}).
+					Return(map[string]entity.SpamTokenCount{
+						"cheap":  {Token: "cheap", SpamCount: 40, HamCount: 1},
+						"pills":  {Token: "pills", SpamCount: 45, HamCount: 0},
+						"online": {Token: "online", SpamCount: 30, HamCount: 5},
+					}, nil).Times(1)
+			},
+			expected: spam.Spam,
+		},
+		{
+			name:    "Leaning towards spam",
+			content: &spam.Content{Kind: spam.KindComment, Body: "online deals"},
+			mockSetup: func(repo *mocksrepository.MockSpamRepository) {
+				repo.EXPECT().GetSampleTotals(gomock.Any()).Return(50, 50, nil).Times(1)
+				repo.EXPECT().
+					GetTokenCounts(gomock.Any(), []string{"online", "deals"}).
+					Return(map[string]entity.SpamTokenCount{
+						"online": {Token: "online", SpamCount: 30, HamCount: 5},
+					}, nil).Times(1)
+			},
+			expected: spam.Suspicious,
+		},
+		{
+			name:    "Ordinary words",
+			content: &spam.Content{Kind: spam.KindComment, Body: "Great article about goroutines"},
+			mockSetup: func(repo *mocksrepository.MockSpamRepository) {
+				repo.EXPECT().GetSampleTotals(gomock.Any()).Return(50, 50, nil).Times(1)
+				repo.EXPECT().
+					GetTokenCounts(gomock.Any(), []string{"great", "article", "about", "goroutines"}).
+					Return(map[string]entity.SpamTokenCount{
+						"great":      {Token: "great", SpamCount: 2, HamCount: 20},
+						"goroutines": {Token: "goroutines", SpamCount: 0, HamCount: 12},
+					}, nil).Times(1)
+			},
+			expected: spam.Ham,
+		},
+		{
+			name:    "Repository error",
+			content: &spam.Content{Kind: spam.KindComment, Body: "hello there"},
+			mockSetup: func(repo *mocksrepository.MockSpamRepository) {
+				repo.EXPECT().GetSampleTotals(gomock.Any()).Return(0, 0, errors.New("db error")).Times(1)
+			},
+			expected:  spam.Ham,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocksrepository.NewMockSpamRepository(ctrl)
+			tt.mockSetup(repo)
+
+			verdict, err := spam.NewBayesChecker(repo, opts).Check(context.Background(), tt.content)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, verdict)
+		})
+	}
+}
+
+func TestBayesChecker_Train(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocksrepository.NewMockSpamRepository(ctrl)
+	repo.EXPECT().
+		AddSample(gomock.Any(), &entity.SpamSample{
+			CommentId: commentId,
+			IsSpam:    true,
+			Tokens:    []string{"buy", "now", "http", "example", "com"},
+		}).
+		Return(nil).Times(1)
+
+	err := spam.NewBayesChecker(repo, spam.BayesOptions{}).Train(context.Background(), commentId, "BUY now: http://example.com, buy!", true)
+	assert.NoError(t, err)
+}
+
+func TestAkismetChecker(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      spam.Kind
+		body      string
+		header    string
+		status    int
+		expected  spam.Verdict
+		expectErr bool
+	}{
+		{name: "Not spam", kind: spam.KindComment, body: "false", status: http.StatusOK, expected: spam.Ham},
+		{name: "Spam", kind: spam.KindComment, body: "true", status: http.StatusOK, expected: spam.Suspicious},
+		{name: "Blatant spam", kind: spam.KindRegistration, body: "true", header: "discard", status: http.StatusOK, expected: spam.Spam},
+		{name: "Invalid key", kind: spam.KindComment, body: "invalid", status: http.StatusOK, expectErr: true},
+		{name: "Server error", kind: spam.KindComment, body: "oops", status: http.StatusInternalServerError, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/1.1/comment-check", r.URL.Path)
+				assert.NoError(t, r.ParseForm())
+				assert.Equal(t, "secret", r.PostForm.Get("api_key"))
+				assert.Equal(t, "https://blog.example", r.PostForm.Get("blog"))
+				assert.Equal(t, "198.51.100.1", r.PostForm.Get("user_ip"))
+				if tt.kind == spam.KindRegistration {
+					assert.Equal(t, "signup", r.PostForm.Get("comment_type"))
+				} else {
+					assert.Equal(t, "comment", r.PostForm.Get("comment_type"))
+				}
+
+				if tt.header != "" {
+					w.Header().Set("X-akismet-pro-tip", tt.header)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			checker := spam.NewAkismetChecker(server.URL+"/", "secret", "https://blog.example", time.Second)
+			verdict, err := checker.Check(context.Background(), &spam.Content{
+				Kind: tt.kind,
+				Body: "Hello",
+				IP:   "198.51.100.1",
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, verdict)
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	failing := mocksspam.NewMockSpamChecker(ctrl)
+	suspicious := mocksspam.NewMockSpamChecker(ctrl)
+	blocking := mocksspam.NewMockSpamChecker(ctrl)
+	never := mocksspam.NewMockSpamChecker(ctrl)
+
+	failing.EXPECT().Check(gomock.Any(), gomock.Any()).Return(spam.Ham, errors.New("timeout")).Times(2)
+	suspicious.EXPECT().Check(gomock.Any(), gomock.Any()).Return(spam.Suspicious, nil).Times(1)
+	blocking.EXPECT().Check(gomock.Any(), gomock.Any()).Return(spam.Spam, nil).Times(1)
+	never.EXPECT().Check(gomock.Any(), gomock.Any()).Times(0)
+
+	logger := logrus.New()
+	content := &spam.Content{Kind: spam.KindComment, Body: strings.Repeat("x", 10)}
+
+	verdict, err := spam.NewChain(logger, failing, suspicious).Check(context.Background(), content)
+	assert.NoError(t, err)
+	assert.Equal(t, spam.Suspicious, verdict)
+
+	verdict, err = spam.NewChain(logger, failing).Check(context.Background(), content)
+	assert.NoError(t, err)
+	assert.Equal(t, spam.Suspicious, verdict)
+
+	verdict, err = spam.NewChain(logger, blocking, never).Check(context.Background(), content)
+	assert.NoError(t, err)
+	assert.Equal(t, spam.Spam, verdict)
+}
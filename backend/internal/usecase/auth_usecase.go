@@ -15,6 +15,8 @@ import (
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
 	"github.com/popeskul/awesome-blog/backend/internal/hash"
+	"github.com/popeskul/awesome-blog/backend/internal/metrics"
+	"github.com/popeskul/awesome-blog/backend/internal/spam"
 )
 
 type authUseCase struct {
@@ -22,7 +24,9 @@ type authUseCase struct {
 	sessionRepo repository.SessionRepository
 	logger      *logrus.Logger
 	jwtSecret   []byte
+	maxSessions int
 	hash        hash.HashService
+	spamChecker spam.SpamChecker
 }
 
 func NewAuthUseCase(
@@ -31,13 +35,16 @@ func NewAuthUseCase(
 	logger *logrus.Logger,
 	cfg *config.Config,
 	hash hash.HashService,
+	spamChecker spam.SpamChecker,
 ) UseCaseAuth {
 	return &authUseCase{
 		userRepo:    userRepo,
 		sessionRepo: sessionRepo,
 		logger:      logger,
 		jwtSecret:   []byte(cfg.JWT.SecretKey),
+		maxSessions: cfg.Sessions.MaxPerUser,
 		hash:        hash,
+		spamChecker: spamChecker,
 	}
 }
 
@@ -75,6 +82,8 @@ func (uc *authUseCase) Authenticate(ctx context.Context, username, password stri
 		return "", fmt.Errorf("failed to create session: %w", err)
 	}
 
+	uc.evictSessions(ctx, user.Id)
+
 	return token, nil
 }
 
@@ -84,6 +93,10 @@ func (uc *authUseCase) Register(ctx context.Context, newUser entity.NewUser) (*e
 		return nil, fmt.Errorf("user already exists")
 	}
 
+	if uc.isSpamRegistration(ctx, newUser) {
+		return nil, ErrSpamDetected
+	}
+
 	passwordHash, err := uc.hash.HashPassword(newUser.PasswordHash)
 	if err != nil {
 		uc.logger.WithError(err).Error("Failed to hash password")
@@ -135,6 +148,58 @@ func (uc *authUseCase) Logout(ctx context.Context, sessionId uuid.UUID) error {
 	return nil
 }
 
+// evictSessions ends the oldest sessions of a user who is over the limit. The
+// login has already succeeded, so a failure is only logged.
+func (uc *authUseCase) evictSessions(ctx context.Context, userID uuid.UUID) {
+	if uc.maxSessions <= 0 {
+		return
+	}
+
+	evicted, err := uc.sessionRepo.EvictSessions(ctx, userID, uc.maxSessions)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Warn("Failed to evict old sessions")
+		return
+	}
+
+	if evicted > 0 {
+		metrics.SessionsEvicted.Add(float64(evicted))
+		uc.logger.WithFields(logrus.Fields{
+			"userID":   userID,
+			"sessions": evicted,
+		}).Info("Evicted old sessions")
+	}
+}
+
+// isSpamRegistration rejects sign-ups the checker is sure about. There is no
+// review queue for accounts, so suspicious ones are only logged, and a failing
+// checker lets the registration through.
+func (uc *authUseCase) isSpamRegistration(ctx context.Context, newUser entity.NewUser) bool {
+	if uc.spamChecker == nil {
+		return false
+	}
+
+	verdict, err := uc.spamChecker.Check(ctx, &spam.Content{
+		Kind:      spam.KindRegistration,
+		Username:  newUser.Username,
+		Email:     newUser.Email,
+		IP:        newUser.IP,
+		UserAgent: newUser.UserAgent,
+	})
+	if err != nil {
+		uc.logger.WithError(err).WithField("username", newUser.Username).Warn("Spam check failed, allowing registration")
+		return false
+	}
+
+	if verdict != spam.Ham {
+		uc.logger.WithFields(logrus.Fields{
+			"username": newUser.Username,
+			"verdict":  verdict,
+		}).Warn("Registration flagged by spam checker")
+	}
+
+	return verdict == spam.Spam
+}
+
 func (uc *authUseCase) generateToken(userID uuid.UUID, sessionID uuid.UUID) (string, error) {
 	claims := jwt.MapClaims{
 		"user_id":    userID,
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+const maxBookmarkNoteLength = 1000
+
+type bookmarkUseCase struct {
+	bookmarkRepo repository.BookmarkRepository
+	postRepo     repository.PostRepository
+	logger       *logrus.Logger
+}
+
+func NewBookmarkUseCase(bookmarkRepo repository.BookmarkRepository, postRepo repository.PostRepository, logger *logrus.Logger) UseCaseBookmark {
+	return &bookmarkUseCase{
+		bookmarkRepo: bookmarkRepo,
+		postRepo:     postRepo,
+		logger:       logger,
+	}
+}
+
+func (uc *bookmarkUseCase) AddBookmark(ctx context.Context, bookmark *entity.NewBookmark) (*entity.Bookmark, error) {
+	if bookmark == nil || len(bookmark.Note) > maxBookmarkNoteLength {
+		return nil, ErrInvalidBookmark
+	}
+
+	post, err := uc.postRepo.GetPostById(ctx, bookmark.PostId)
+	if err != nil {
+		uc.logger.WithError(err).WithField("postID", bookmark.PostId).Error("Failed to get post")
+		return nil, ErrPostNotFound
+	}
+	if !post.VisibleTo(bookmark.UserId) {
+		return nil, ErrPostNotFound
+	}
+
+	created, err := uc.bookmarkRepo.AddBookmark(ctx, bookmark)
+	if err != nil {
+		uc.logger.WithError(err).WithField("postID", bookmark.PostId).Error("Failed to add bookmark")
+		return nil, fmt.Errorf("failed to add bookmark: %w", err)
+	}
+
+	return created, nil
+}
+
+func (uc *bookmarkUseCase) RemoveBookmark(ctx context.Context, userID, postID uuid.UUID) error {
+	if err := uc.bookmarkRepo.DeleteBookmark(ctx, userID, postID); err != nil {
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to remove bookmark")
+		return fmt.Errorf("failed to remove bookmark: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *bookmarkUseCase) GetBookmarks(ctx context.Context, userID uuid.UUID, pagination *entity.Pagination) (*entity.Response[entity.Bookmark], error) {
+	if err := entity.ValidatePagination(pagination); err != nil {
+		return nil, err
+	}
+
+	rows, err := uc.bookmarkRepo.GetBookmarks(ctx, userID, pagination.Lookahead())
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get bookmarks")
+		return nil, fmt.Errorf("failed to get bookmarks: %w", err)
+	}
+	bookmarks, more := entity.TrimPage(rows, pagination)
+
+	var total int
+	if pagination.WithTotal {
+		total, err = uc.bookmarkRepo.GetTotalBookmarks(ctx, userID)
+		if err != nil {
+			uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get total bookmarks")
+			return nil, fmt.Errorf("failed to get total bookmarks: %w", err)
+		}
+	}
+
+	bookmarked := true
+	for _, bookmark := range bookmarks {
+		if bookmark.Post != nil {
+			bookmark.Post.Bookmarked = &bookmarked
+		}
+	}
+
+	response := entity.NewResponse(bookmarks, more, pagination, func(bookmark *entity.Bookmark) entity.Cursor {
+		return entity.Cursor{CreatedAt: bookmark.CreatedAt, Id: bookmark.PostId}
+	})
+	response.Pagination.Total = total
+
+	return response, nil
+}
+
+// attachBookmarks flags the posts the viewer has bookmarked. Anonymous
+// viewers get no flag at all rather than false everywhere.
+func attachBookmarks(ctx context.Context, repo repository.BookmarkRepository, posts []*entity.Post, viewerID uuid.UUID) error {
+	if viewerID == uuid.Nil || len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.Id)
+	}
+
+	bookmarked, err := repo.GetBookmarkedPostIds(ctx, viewerID, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get bookmarks: %w", err)
+	}
+
+	for _, post := range posts {
+		flag := bookmarked[post.Id]
+		post.Bookmarked = &flag
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_bookmark_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseBookmark
+
+type UseCaseBookmark interface {
+	AddBookmark(ctx context.Context, bookmark *entity.NewBookmark) (*entity.Bookmark, error)
+	RemoveBookmark(ctx context.Context, userID, postID uuid.UUID) error
+	GetBookmarks(ctx context.Context, userID uuid.UUID, pagination *entity.Pagination) (*entity.Response[entity.Bookmark], error)
+}
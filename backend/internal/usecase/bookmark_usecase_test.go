@@ -0,0 +1,141 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestAddBookmark(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(bookmarkRepo *mocksrepository.MockBookmarkRepository, postRepo *mocksrepository.MockPostRepository)
+		bookmark      *entity.NewBookmark
+		expectedError error
+	}{
+		{
+			name: "Bookmark a post",
+			mockSetup: func(bookmarkRepo *mocksrepository.MockBookmarkRepository, postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId1).
+					Return(&entity.Post{Id: postId1}, nil).Times(1)
+				bookmarkRepo.EXPECT().
+					AddBookmark(gomock.Any(), gomock.Any()).
+					Return(&entity.Bookmark{UserId: authorId1, PostId: postId1, Note: "later"}, nil).Times(1)
+			},
+			bookmark: &entity.NewBookmark{UserId: authorId1, PostId: postId1, Note: "later"},
+		},
+		{
+			name: "Post not found",
+			mockSetup: func(bookmarkRepo *mocksrepository.MockBookmarkRepository, postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId2).
+					Return(nil, errors.New("post not found")).Times(1)
+			},
+			bookmark:      &entity.NewBookmark{UserId: authorId1, PostId: postId2},
+			expectedError: usecase.ErrPostNotFound,
+		},
+		{
+			name: "Someone else's draft",
+			mockSetup: func(bookmarkRepo *mocksrepository.MockBookmarkRepository, postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId2).
+					Return(&entity.Post{Id: postId2, AuthorId: authorId2, Status: entity.PostStatusDraft}, nil).Times(1)
+			},
+			bookmark:      &entity.NewBookmark{UserId: authorId1, PostId: postId2},
+			expectedError: usecase.ErrPostNotFound,
+		},
+		{
+			name: "Note too long",
+			mockSetup: func(bookmarkRepo *mocksrepository.MockBookmarkRepository, postRepo *mocksrepository.MockPostRepository) {
+			},
+			bookmark:      &entity.NewBookmark{UserId: authorId1, PostId: postId1, Note: strings.Repeat("a", 1001)},
+			expectedError: usecase.ErrInvalidBookmark,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			bookmarkRepo := mocksrepository.NewMockBookmarkRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			uc := usecase.NewBookmarkUseCase(bookmarkRepo, postRepo, logrus.New())
+
+			tt.mockSetup(bookmarkRepo, postRepo)
+
+			bookmark, err := uc.AddBookmark(context.Background(), tt.bookmark)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, bookmark)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.bookmark.Note, bookmark.Note)
+		})
+	}
+}
+
+func TestGetBookmarks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookmarkRepo := mocksrepository.NewMockBookmarkRepository(ctrl)
+	uc := usecase.NewBookmarkUseCase(bookmarkRepo, nil, logrus.New())
+
+	pagination := &entity.Pagination{Page: 1, Limit: 10, WithTotal: true}
+
+	bookmarkRepo.EXPECT().
+		GetBookmarks(gomock.Any(), authorId1, pagination.Lookahead()).
+		Return([]*entity.Bookmark{{UserId: authorId1, PostId: postId1, Post: &entity.Post{Id: postId1}}}, nil).Times(1)
+	bookmarkRepo.EXPECT().
+		GetTotalBookmarks(gomock.Any(), authorId1).
+		Return(1, nil).Times(1)
+
+	result, err := uc.GetBookmarks(context.Background(), authorId1, pagination)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Pagination.Total)
+	assert.Empty(t, result.NextCursor)
+	assert.True(t, *result.Data[0].Post.Bookmarked)
+}
+
+func TestGetBookmarks_Cursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookmarkRepo := mocksrepository.NewMockBookmarkRepository(ctrl)
+	uc := usecase.NewBookmarkUseCase(bookmarkRepo, nil, logrus.New())
+
+	// Without include_total nothing is counted.
+	pagination := &entity.Pagination{Page: 1, Limit: 1}
+
+	bookmarkRepo.EXPECT().
+		GetBookmarks(gomock.Any(), authorId1, pagination.Lookahead()).
+		Return([]*entity.Bookmark{
+			{UserId: authorId1, PostId: postId1, Post: &entity.Post{Id: postId1}},
+			{UserId: authorId1, PostId: postId2, Post: &entity.Post{Id: postId2}},
+		}, nil).Times(1)
+
+	result, err := uc.GetBookmarks(context.Background(), authorId1, pagination)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Data, 1)
+	assert.Zero(t, result.Pagination.Total)
+
+	cursor, err := entity.DecodeCursor(result.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, postId1, cursor.Id)
+}
@@ -3,33 +3,63 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
+	"github.com/popeskul/awesome-blog/backend/internal/config"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+	"github.com/popeskul/awesome-blog/backend/internal/spam"
 )
 
 type commentUseCase struct {
-	commentRepo repository.CommentRepository
-	postRepo    repository.PostRepository
-	userRepo    repository.UserRepository
-	logger      *logrus.Logger
+	commentRepo  repository.CommentRepository
+	postRepo     repository.PostRepository
+	userRepo     repository.UserRepository
+	reactionRepo repository.ReactionRepository
+	uow          repository.UnitOfWork
+	logger       *logrus.Logger
+	spamChecker  spam.SpamChecker
+	editWindow   time.Duration
+	moderation   entity.ModerationMode
+	trustAfter   int
+	notifier     Notifier
+	publisher    events.Publisher
 }
 
+const maxMentions = 10
+
+var mentionPattern = regexp.MustCompile(`(?:^|\s)@([A-Za-z0-9_]+(?:[.-][A-Za-z0-9_]+)*)`)
+
 func NewCommentUseCase(
 	commentRepo repository.CommentRepository,
 	postRepo repository.PostRepository,
 	userRepo repository.UserRepository,
+	reactionRepo repository.ReactionRepository,
+	uow repository.UnitOfWork,
 	logger *logrus.Logger,
+	cfg *config.Config,
+	spamChecker spam.SpamChecker,
+	notifier Notifier,
+	publisher events.Publisher,
 ) UseCaseComment {
 	return &commentUseCase{
-		commentRepo: commentRepo,
-		postRepo:    postRepo,
-		userRepo:    userRepo,
-		logger:      logger,
+		commentRepo:  commentRepo,
+		postRepo:     postRepo,
+		userRepo:     userRepo,
+		reactionRepo: reactionRepo,
+		uow:          uow,
+		logger:       logger,
+		spamChecker:  spamChecker,
+		editWindow:   cfg.Comments.EditWindow,
+		moderation:   entity.ModerationMode(cfg.Comments.Moderation),
+		trustAfter:   cfg.Comments.TrustedAfter,
+		notifier:     notifier,
+		publisher:    publisher,
 	}
 }
 
@@ -38,20 +68,61 @@ func (uc *commentUseCase) CreateComment(ctx context.Context, comment *entity.New
 		return nil, ErrInvalidComment
 	}
 
-	if _, err := uc.userRepo.GetUserById(ctx, comment.AuthorId); err != nil {
+	author, err := uc.userRepo.GetUserById(ctx, comment.AuthorId)
+	if err != nil {
 		uc.logger.WithError(err).WithField("userID", comment.AuthorId).Error("Failed to get user")
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
-	if _, err := uc.postRepo.GetPostById(ctx, comment.PostId); err != nil {
+	post, err := uc.postRepo.GetPostById(ctx, comment.PostId)
+	if err != nil {
 		uc.logger.WithError(err).WithField("postID", comment.PostId).Error("Failed to get post")
 		return nil, fmt.Errorf("failed to get post: %w", err)
 	}
+	if !post.VisibleTo(comment.AuthorId) {
+		return nil, ErrPostNotFound
+	}
 
-	createdComment, err := uc.commentRepo.CreateComment(ctx, comment)
+	var parent *entity.Comment
+	if comment.ParentId != nil {
+		parent, err = uc.commentRepo.GetCommentById(ctx, *comment.ParentId)
+		if err != nil || parent.PostId != comment.PostId || parent.Status != entity.CommentStatusApproved {
+			return nil, ErrInvalidComment
+		}
+	}
+
+	status, err := uc.initialStatus(ctx, comment.PostId, author)
 	if err != nil {
-		uc.logger.WithError(err).Error("Failed to create comment")
-		return nil, fmt.Errorf("failed to create comment: %w", err)
+		return nil, err
+	}
+
+	if !author.CanModerate() {
+		switch uc.checkSpam(ctx, comment, author) {
+		case spam.Spam:
+			return nil, ErrSpamDetected
+		case spam.Suspicious:
+			status = entity.CommentStatusPending
+		}
+	}
+	comment.Status = status
+
+	var createdComment *entity.Comment
+	err = transact(ctx, uc.uow, func(ctx context.Context) error {
+		created, err := uc.commentRepo.CreateComment(ctx, comment)
+		if err != nil {
+			uc.logger.WithError(err).Error("Failed to create comment")
+			return fmt.Errorf("failed to create comment: %w", err)
+		}
+		createdComment = created
+
+		if createdComment.Status != entity.CommentStatusApproved {
+			return nil
+		}
+
+		return record(ctx, uc.publisher, entity.PostCommentsTopic(createdComment.PostId), entity.EventCommentCreated, createdComment)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	uc.logger.WithFields(logrus.Fields{
@@ -64,45 +135,84 @@ func (uc *commentUseCase) CreateComment(ctx context.Context, comment *entity.New
 		"author_id": createdComment.AuthorId,
 	}).Info("Comment created successfully")
 
+	if createdComment.Status == entity.CommentStatusApproved {
+		notifyNewComment(ctx, uc.notifier, uc.userRepo, uc.logger, post, parent, createdComment)
+	}
+
 	return createdComment, nil
 }
 
-func (uc *commentUseCase) GetCommentByID(ctx context.Context, id uuid.UUID) (*entity.Comment, error) {
+func (uc *commentUseCase) GetCommentByID(ctx context.Context, id uuid.UUID, viewerID uuid.UUID) (*entity.Comment, error) {
 	comment, err := uc.commentRepo.GetCommentById(ctx, id)
 	if err != nil {
 		uc.logger.WithError(err).WithField("commentID", id).Error("Failed to get comment")
-		return nil, fmt.Errorf("failed to get comment: %w", err)
+		return nil, ErrCommentNotFound
+	}
+
+	visible, err := uc.visibleTo(ctx, comment, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, ErrCommentNotFound
+	}
+
+	if err := uc.attachReactions(ctx, []*entity.Comment{comment}, viewerID); err != nil {
+		return nil, err
 	}
 
 	return comment, nil
 }
 
-func (uc *commentUseCase) GetComments(ctx context.Context, postID uuid.UUID, pagination *entity.Pagination) (*entity.Response[entity.Comment], error) {
+func (uc *commentUseCase) GetComments(ctx context.Context, postID uuid.UUID, viewerID uuid.UUID, pagination *entity.Pagination, include entity.CommentInclude) (*entity.Response[entity.Comment], error) {
 	if err := uc.validatePagination(pagination); err != nil {
 		return nil, fmt.Errorf("invalid pagination: %w", err)
 	}
 
-	comments, err := uc.commentRepo.GetComments(ctx, postID, pagination)
+	// Comments of a draft are as private as the draft itself.
+	post, err := uc.postRepo.GetPostById(ctx, postID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to get post")
+		return nil, ErrPostNotFound
+	}
+	if !post.VisibleTo(viewerID) {
+		return nil, ErrPostNotFound
+	}
+
+	rows, err := uc.commentRepo.GetComments(ctx, postID, viewerID, pagination.Lookahead())
 	if err != nil {
 		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to get comments")
 		return nil, fmt.Errorf("failed to get comments: %w", err)
 	}
+	comments, more := entity.TrimPage(rows, pagination)
+
+	var total int
+	if pagination.WithTotal {
+		total, err = uc.commentRepo.GetTotalCommentsByPostID(ctx, postID, viewerID)
+		if err != nil {
+			uc.logger.WithError(err).WithField("postID", postID).Error("Failed to get total comments")
+			return nil, fmt.Errorf("failed to get total comments: %w", err)
+		}
+	}
 
-	total, err := uc.commentRepo.GetTotalCommentsByPostID(ctx, postID)
-	if err != nil {
-		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to get total comments")
-		return nil, fmt.Errorf("failed to get total comments: %w", err)
+	if pagination.Fields.Has("reactions") {
+		if err := uc.attachReactions(ctx, comments, viewerID); err != nil {
+			return nil, err
+		}
 	}
 
-	return &entity.Response[entity.Comment]{
-		Data: comments,
-		Pagination: &entity.Pagination{
-			Total:  total,
-			Page:   pagination.Page,
-			Limit:  pagination.Limit,
-			Offset: pagination.Offset,
-		},
-	}, nil
+	if include.Author && pagination.Fields.Has("author") {
+		if err := uc.attachAuthors(ctx, comments); err != nil {
+			return nil, err
+		}
+	}
+
+	response := entity.NewResponse(comments, more, pagination, func(comment *entity.Comment) entity.Cursor {
+		return entity.Cursor{CreatedAt: comment.CreatedAt, Id: comment.Id}
+	})
+	response.Pagination.Total = total
+
+	return response, nil
 }
 
 func (uc *commentUseCase) UpdateComment(ctx context.Context, comment *entity.UpdateComment) error {
@@ -121,16 +231,57 @@ func (uc *commentUseCase) UpdateComment(ctx context.Context, comment *entity.Upd
 		return ErrUnauthorized
 	}
 
+	if uc.editWindow > 0 && time.Since(existingComment.CreatedAt) > uc.editWindow {
+		return ErrEditWindowExpired
+	}
+
+	if err := checkVersion(existingComment.Version, comment.Version); err != nil {
+		return err
+	}
+
+	status, err := uc.editedStatus(ctx, existingComment)
+	if err != nil {
+		return err
+	}
+
 	existingComment.Content = comment.Content
 	existingComment.UpdatedAt = time.Now()
 
-	err = uc.commentRepo.UpdateComment(ctx, &entity.UpdateComment{
-		Id:      existingComment.Id,
-		Content: existingComment.Content,
+	err = transact(ctx, uc.uow, func(ctx context.Context) error {
+		update := &entity.UpdateComment{
+			Id:      existingComment.Id,
+			Content: existingComment.Content,
+			Version: comment.Version,
+		}
+		if status != existingComment.Status {
+			update.Status = status
+		}
+		if err := uc.commentRepo.UpdateComment(ctx, update); err != nil {
+			uc.logger.WithError(err).WithField("commentID", comment.Id).Error("Failed to update comment")
+			return fmt.Errorf("failed to update comment: %w", versionError(err))
+		}
+		comment.Version = update.Version
+		existingComment.Version = update.Version
+
+		wasApproved := existingComment.Status == entity.CommentStatusApproved
+		existingComment.Status = status
+		if !wasApproved {
+			return nil
+		}
+
+		topic := entity.PostCommentsTopic(existingComment.PostId)
+		if status != entity.CommentStatusApproved {
+			// The edit went back to the queue, so viewers lose the comment.
+			return record(ctx, uc.publisher, topic, entity.EventCommentDeleted, map[string]uuid.UUID{"id": existingComment.Id})
+		}
+
+		now := existingComment.UpdatedAt
+		existingComment.Edited = true
+		existingComment.EditedAt = &now
+		return record(ctx, uc.publisher, topic, entity.EventCommentUpdated, existingComment)
 	})
 	if err != nil {
-		uc.logger.WithError(err).WithField("commentID", comment.Id).Error("Failed to update comment")
-		return fmt.Errorf("failed to update comment: %w", err)
+		return err
 	}
 
 	uc.logger.WithFields(logrus.Fields{
@@ -143,7 +294,19 @@ func (uc *commentUseCase) UpdateComment(ctx context.Context, comment *entity.Upd
 	return nil
 }
 
-func (uc *commentUseCase) DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+// commentPatchFields are the attributes of a comment its author may patch.
+// Its status is changed through moderation.
+var commentPatchFields = []string{"content"}
+
+func (uc *commentUseCase) PatchComment(ctx context.Context, comment *entity.UpdateComment, changed []string) error {
+	if err := checkPatchFields(changed, commentPatchFields); err != nil {
+		return err
+	}
+
+	return uc.UpdateComment(ctx, comment)
+}
+
+func (uc *commentUseCase) DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.UUID, version int) error {
 	comment, err := uc.commentRepo.GetCommentById(ctx, id)
 	if err != nil {
 		uc.logger.WithError(err).WithField("commentID", id).Error("Failed to get comment")
@@ -154,10 +317,24 @@ func (uc *commentUseCase) DeleteComment(ctx context.Context, id uuid.UUID, userI
 		return ErrUnauthorized
 	}
 
-	err = uc.commentRepo.DeleteCommentById(ctx, id)
+	if err := checkVersion(comment.Version, version); err != nil {
+		return err
+	}
+
+	err = transact(ctx, uc.uow, func(ctx context.Context) error {
+		if err := uc.commentRepo.DeleteCommentById(ctx, id, version); err != nil {
+			uc.logger.WithError(err).WithField("commentID", id).Error("Failed to delete comment")
+			return fmt.Errorf("failed to delete comment: %w", versionError(err))
+		}
+
+		if comment.Status != entity.CommentStatusApproved {
+			return nil
+		}
+
+		return record(ctx, uc.publisher, entity.PostCommentsTopic(comment.PostId), entity.EventCommentDeleted, map[string]uuid.UUID{"id": id})
+	})
 	if err != nil {
-		uc.logger.WithError(err).WithField("commentID", id).Error("Failed to delete comment")
-		return fmt.Errorf("failed to delete comment: %w", err)
+		return err
 	}
 
 	uc.logger.WithField("commentID", id).Info("Comment deleted successfully")
@@ -165,6 +342,231 @@ func (uc *commentUseCase) DeleteComment(ctx context.Context, id uuid.UUID, userI
 	return nil
 }
 
+// visibleTo reports whether the viewer may see the comment. Comments that
+// aren't approved are only shown to their author and to moderators, like
+// the moderation queue.
+func (uc *commentUseCase) visibleTo(ctx context.Context, comment *entity.Comment, viewerID uuid.UUID) (bool, error) {
+	if comment.Status == entity.CommentStatusApproved || comment.AuthorId == viewerID {
+		return true, nil
+	}
+
+	if viewerID == uuid.Nil {
+		return false, nil
+	}
+
+	viewer, err := uc.userRepo.GetUserById(ctx, viewerID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", viewerID).Error("Failed to get user")
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return viewer.CanModerate(), nil
+}
+
+// initialStatus decides whether a new comment is published right away or held
+// for review, based on the post's moderation mode and how trusted the author is.
+func (uc *commentUseCase) initialStatus(ctx context.Context, postID uuid.UUID, author *entity.User) (entity.CommentStatus, error) {
+	mode, err := uc.moderationMode(ctx, postID)
+	if err != nil {
+		return "", err
+	}
+
+	switch mode {
+	case entity.ModerationClosed:
+		return "", ErrCommentsClosed
+	case entity.ModerationModerated:
+		return uc.moderatedStatus(ctx, author)
+	default:
+		return entity.CommentStatusApproved, nil
+	}
+}
+
+// editedStatus tells which status a comment gets when its author edits it.
+// Approved comments on moderated posts go back to the queue, unless their
+// author would skip it with a new comment; other comments keep their status.
+func (uc *commentUseCase) editedStatus(ctx context.Context, comment *entity.Comment) (entity.CommentStatus, error) {
+	if comment.Status != entity.CommentStatusApproved {
+		return comment.Status, nil
+	}
+
+	mode, err := uc.moderationMode(ctx, comment.PostId)
+	if err != nil {
+		return "", err
+	}
+	if mode != entity.ModerationModerated {
+		return comment.Status, nil
+	}
+
+	author, err := uc.userRepo.GetUserById(ctx, comment.AuthorId)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", comment.AuthorId).Error("Failed to get user")
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return uc.moderatedStatus(ctx, author)
+}
+
+// moderationMode returns the moderation mode of the post, falling back to
+// the global one.
+func (uc *commentUseCase) moderationMode(ctx context.Context, postID uuid.UUID) (entity.ModerationMode, error) {
+	mode, err := uc.postRepo.GetCommentModeration(ctx, postID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to get comment moderation")
+		return "", fmt.Errorf("failed to get comment moderation: %w", err)
+	}
+
+	if mode == entity.ModerationInherit {
+		mode = uc.moderation
+	}
+
+	return mode, nil
+}
+
+// moderatedStatus returns the status of the author's comments on a
+// moderated post: moderators and trusted authors skip the queue.
+func (uc *commentUseCase) moderatedStatus(ctx context.Context, author *entity.User) (entity.CommentStatus, error) {
+	if author.CanModerate() {
+		return entity.CommentStatusApproved, nil
+	}
+
+	approved, err := uc.commentRepo.CountApprovedCommentsByAuthor(ctx, author.Id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", author.Id).Error("Failed to count approved comments")
+		return "", fmt.Errorf("failed to count approved comments: %w", err)
+	}
+
+	if uc.trustAfter > 0 && approved >= uc.trustAfter {
+		return entity.CommentStatusApproved, nil
+	}
+
+	return entity.CommentStatusPending, nil
+}
+
+// notifyNewComment tells the author of the replied-to comment, any mentioned
+// users and the post author about a published comment. Everyone gets at
+// most one notification, the most specific one.
+func notifyNewComment(ctx context.Context, notifier Notifier, userRepo repository.UserRepository, logger *logrus.Logger, post *entity.Post, parent *entity.Comment, comment *entity.Comment) {
+	if notifier == nil {
+		return
+	}
+
+	notified := map[uuid.UUID]bool{comment.AuthorId: true}
+	send := func(recipientID uuid.UUID, notificationType entity.NotificationType) {
+		if notified[recipientID] {
+			return
+		}
+		notified[recipientID] = true
+
+		notify(ctx, notifier, logger, &entity.Notification{
+			RecipientId: recipientID,
+			ActorId:     &comment.AuthorId,
+			Type:        notificationType,
+			PostId:      &comment.PostId,
+			CommentId:   &comment.Id,
+		})
+	}
+
+	if parent != nil {
+		send(parent.AuthorId, entity.NotificationTypeReply)
+	}
+
+	for _, username := range mentionedUsernames(comment.Content) {
+		user, err := userRepo.GetUserByUsername(ctx, username)
+		if err != nil {
+			continue
+		}
+		send(user.Id, entity.NotificationTypeMention)
+	}
+
+	send(post.AuthorId, entity.NotificationTypeComment)
+}
+
+func mentionedUsernames(content string) []string {
+	seen := map[string]bool{}
+	var usernames []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		if seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		usernames = append(usernames, match[1])
+		if len(usernames) == maxMentions {
+			break
+		}
+	}
+	return usernames
+}
+
+func (uc *commentUseCase) attachReactions(ctx context.Context, comments []*entity.Comment, viewerID uuid.UUID) error {
+	ids := make([]uuid.UUID, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.Id)
+	}
+
+	summaries, err := loadReactions(ctx, uc.reactionRepo, entity.ReactionTargetComment, ids, viewerID)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get comment reactions")
+		return err
+	}
+
+	for _, comment := range comments {
+		comment.Reactions = summaries[comment.Id]
+	}
+
+	return nil
+}
+
+func (uc *commentUseCase) attachAuthors(ctx context.Context, comments []*entity.Comment) error {
+	ids := make([]uuid.UUID, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.AuthorId)
+	}
+
+	authors, err := loadAuthors(ctx, uc.userRepo, ids)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get comment authors")
+		return err
+	}
+
+	for _, comment := range comments {
+		comment.Author = authors[comment.AuthorId]
+	}
+
+	return nil
+}
+
+// checkSpam holds the comment for review when the checker itself fails, so an
+// unavailable backend never lets spam through nor blocks legitimate comments.
+func (uc *commentUseCase) checkSpam(ctx context.Context, comment *entity.NewComment, author *entity.User) spam.Verdict {
+	if uc.spamChecker == nil {
+		return spam.Ham
+	}
+
+	verdict, err := uc.spamChecker.Check(ctx, &spam.Content{
+		Kind:      spam.KindComment,
+		AuthorId:  author.Id,
+		Username:  author.Username,
+		Email:     author.Email,
+		Body:      comment.Content,
+		IP:        comment.IP,
+		UserAgent: comment.UserAgent,
+	})
+	if err != nil {
+		uc.logger.WithError(err).WithField("postID", comment.PostId).Warn("Spam check failed, holding comment for review")
+		return spam.Suspicious
+	}
+
+	if verdict != spam.Ham {
+		uc.logger.WithFields(logrus.Fields{
+			"postID":   comment.PostId,
+			"authorID": author.Id,
+			"verdict":  verdict,
+		}).Info("Comment flagged by spam checker")
+	}
+
+	return verdict
+}
+
 func (uc *commentUseCase) validatePagination(pagination *entity.Pagination) error {
 	if pagination.Page <= 0 {
 		return ErrInvalidPage
@@ -174,5 +576,9 @@ func (uc *commentUseCase) validatePagination(pagination *entity.Pagination) erro
 		return ErrInvalidLimit
 	}
 
+	if _, err := entity.ParseCommentSort(pagination.Sort); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -11,8 +11,13 @@ import (
 
 type UseCaseComment interface {
 	CreateComment(ctx context.Context, comment *entity.NewComment) (*entity.Comment, error)
-	GetComments(ctx context.Context, postID uuid.UUID, pagination *entity.Pagination) (*entity.Response[entity.Comment], error)
+	GetComments(ctx context.Context, postID uuid.UUID, viewerID uuid.UUID, pagination *entity.Pagination, include entity.CommentInclude) (*entity.Response[entity.Comment], error)
+	// UpdateComment and DeleteComment fail with ErrVersionMismatch unless the
+	// comment has the expected version or it is entity.AnyVersion.
 	UpdateComment(ctx context.Context, comment *entity.UpdateComment) error
-	DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
-	GetCommentByID(ctx context.Context, id uuid.UUID) (*entity.Comment, error)
+	DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.UUID, version int) error
+	// PatchComment saves a comment a patch was applied to. Only the content
+	// may be changed, or it fails with ErrFieldNotWritable.
+	PatchComment(ctx context.Context, comment *entity.UpdateComment, changed []string) error
+	GetCommentByID(ctx context.Context, id uuid.UUID, viewerID uuid.UUID) (*entity.Comment, error)
 }
@@ -12,8 +12,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/popeskul/awesome-blog/backend/internal/config"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/events/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/spam"
+	"github.com/popeskul/awesome-blog/backend/internal/spam/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase/mocks"
 )
 
 func TestCreateComment_Success(t *testing.T) {
@@ -24,7 +29,7 @@ func TestCreateComment_Success(t *testing.T) {
 	postRepo := mocksrepository.NewMockPostRepository(ctrl)
 	userRepo := mocksrepository.NewMockUserRepository(ctrl)
 	logger := logrus.New()
-	uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, logger)
+	uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, nil, nil, logger, &config.Config{}, nil, nil, nil)
 
 	newComment := &entity.NewComment{
 		AuthorId: authorId1,
@@ -49,6 +54,10 @@ func TestCreateComment_Success(t *testing.T) {
 		GetPostById(gomock.Any(), newComment.PostId).
 		Return(&entity.Post{Id: newComment.PostId}, nil).Times(1)
 
+	postRepo.EXPECT().
+		GetCommentModeration(gomock.Any(), newComment.PostId).
+		Return(entity.ModerationInherit, nil).Times(1)
+
 	commentRepo.EXPECT().
 		CreateComment(gomock.Any(), newComment).
 		Return(createdComment, nil).Times(1)
@@ -120,6 +129,32 @@ func TestCreateComment_Fail(t *testing.T) {
 			},
 			expectedError: "failed to get post",
 		},
+		{
+			name: "Draft of another author",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), gomock.Any()).
+					Return(&entity.User{
+						Id: authorId1,
+					}, nil).Times(1)
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), gomock.Any()).
+					Return(&entity.Post{
+						Id:       postId1,
+						AuthorId: authorId2,
+						Status:   entity.PostStatusDraft,
+					}, nil).Times(1)
+				commentRepo.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			comment: &entity.NewComment{
+				AuthorId: authorId1,
+				PostId:   postId1,
+				Content:  "This is a comment",
+			},
+			expectedError: usecase.ErrPostNotFound.Error(),
+		},
 		{
 			name: "Failed to create comment",
 			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {
@@ -133,6 +168,9 @@ func TestCreateComment_Fail(t *testing.T) {
 					Return(&entity.Post{
 						Id: postId1,
 					}, nil).Times(1)
+				postRepo.EXPECT().
+					GetCommentModeration(gomock.Any(), gomock.Any()).
+					Return(entity.ModerationInherit, nil).Times(1)
 				commentRepo.EXPECT().
 					CreateComment(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("db error")).Times(1)
@@ -155,7 +193,7 @@ func TestCreateComment_Fail(t *testing.T) {
 			postRepo := mocksrepository.NewMockPostRepository(ctrl)
 			userRepo := mocksrepository.NewMockUserRepository(ctrl)
 			logger := logrus.New()
-			uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, logger)
+			uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, nil, nil, logger, &config.Config{}, nil, nil, nil)
 
 			tt.mockSetup(commentRepo, postRepo, userRepo)
 
@@ -177,14 +215,16 @@ func TestGetCommentByID_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+	reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
 	logger := logrus.New()
-	uc := usecase.NewCommentUseCase(commentRepo, nil, nil, logger)
+	uc := usecase.NewCommentUseCase(commentRepo, nil, nil, reactionRepo, nil, logger, &config.Config{}, nil, nil, nil)
 
 	expectedComment := &entity.Comment{
 		Id:        commentId1,
 		PostId:    postId1,
 		AuthorId:  authorId1,
 		Content:   "This is a comment",
+		Status:    entity.CommentStatusApproved,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -193,9 +233,15 @@ func TestGetCommentByID_Success(t *testing.T) {
 		GetCommentById(gomock.Any(), expectedComment.Id).
 		Return(expectedComment, nil).Times(1)
 
-	result, err := uc.GetCommentByID(context.Background(), expectedComment.Id)
+	summary := &entity.ReactionSummary{Counts: map[string]int{"like": 2}, Mine: []string{"like"}}
+	reactionRepo.EXPECT().
+		GetReactionSummaries(gomock.Any(), entity.ReactionTargetComment, []uuid.UUID{commentId1}, authorId2).
+		Return(map[uuid.UUID]*entity.ReactionSummary{commentId1: summary}, nil).Times(1)
+
+	result, err := uc.GetCommentByID(context.Background(), expectedComment.Id, authorId2)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedComment, result)
+	assert.Equal(t, summary, result.Reactions)
 }
 
 func TestGetCommentByID_Fail(t *testing.T) {
@@ -213,7 +259,7 @@ func TestGetCommentByID_Fail(t *testing.T) {
 					Return(nil, errors.New("db error")).Times(1)
 			},
 			commentID:     commentId1,
-			expectedError: "failed to get comment: db error",
+			expectedError: usecase.ErrCommentNotFound.Error(),
 		},
 	}
 
@@ -224,11 +270,11 @@ func TestGetCommentByID_Fail(t *testing.T) {
 
 			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
 			logger := logrus.New()
-			uc := usecase.NewCommentUseCase(commentRepo, nil, nil, logger)
+			uc := usecase.NewCommentUseCase(commentRepo, nil, nil, nil, nil, logger, &config.Config{}, nil, nil, nil)
 
 			tt.mockSetup(commentRepo)
 
-			result, err := uc.GetCommentByID(context.Background(), tt.commentID)
+			result, err := uc.GetCommentByID(context.Background(), tt.commentID, uuid.Nil)
 
 			if tt.expectedError != "" {
 				assert.ErrorContains(t, err, tt.expectedError)
@@ -241,52 +287,425 @@ func TestGetCommentByID_Fail(t *testing.T) {
 	}
 }
 
+func TestGetCommentByID_Visibility(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        entity.CommentStatus
+		viewerID      uuid.UUID
+		mockSetup     func(userRepo *mocksrepository.MockUserRepository)
+		expectedError error
+	}{
+		{
+			name:          "Anonymous viewers don't see pending comments",
+			status:        entity.CommentStatusPending,
+			viewerID:      uuid.Nil,
+			expectedError: usecase.ErrCommentNotFound,
+		},
+		{
+			name:     "Other users don't see spam",
+			status:   entity.CommentStatusSpam,
+			viewerID: authorId2,
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId2).
+					Return(&entity.User{Id: authorId2, Role: entity.RoleUser}, nil).Times(1)
+			},
+			expectedError: usecase.ErrCommentNotFound,
+		},
+		{
+			name:     "Authors see their pending comments",
+			status:   entity.CommentStatusPending,
+			viewerID: authorId1,
+		},
+		{
+			name:     "Moderators see rejected comments",
+			status:   entity.CommentStatusRejected,
+			viewerID: authorId2,
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId2).
+					Return(&entity.User{Id: authorId2, Role: entity.RoleModerator}, nil).Times(1)
+			},
+		},
+		{
+			name:     "Anonymous viewers see approved comments",
+			status:   entity.CommentStatusApproved,
+			viewerID: uuid.Nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+			logger := logrus.New()
+			uc := usecase.NewCommentUseCase(commentRepo, nil, userRepo, reactionRepo, nil, logger, &config.Config{}, nil, nil, nil)
+
+			commentRepo.EXPECT().
+				GetCommentById(gomock.Any(), commentId1).
+				Return(&entity.Comment{Id: commentId1, PostId: postId1, AuthorId: authorId1, Status: tt.status}, nil).Times(1)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(userRepo)
+			}
+
+			if tt.expectedError == nil {
+				reactionRepo.EXPECT().
+					GetReactionSummaries(gomock.Any(), entity.ReactionTargetComment, []uuid.UUID{commentId1}, tt.viewerID).
+					Return(map[uuid.UUID]*entity.ReactionSummary{}, nil).Times(1)
+			}
+
+			result, err := uc.GetCommentByID(context.Background(), commentId1, tt.viewerID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, commentId1, result.Id)
+			}
+		})
+	}
+}
+
+func TestCreateComment_Moderation(t *testing.T) {
+	tests := []struct {
+		name           string
+		globalMode     string
+		trustedAfter   int
+		postMode       entity.ModerationMode
+		author         *entity.User
+		approvedCount  int
+		expectCount    bool
+		expectedStatus entity.CommentStatus
+		expectedError  error
+	}{
+		{
+			name:           "Open by default",
+			postMode:       entity.ModerationInherit,
+			author:         &entity.User{Id: authorId1},
+			expectedStatus: entity.CommentStatusApproved,
+		},
+		{
+			name:          "Closed post rejects new comments",
+			globalMode:    "open",
+			postMode:      entity.ModerationClosed,
+			author:        &entity.User{Id: authorId1},
+			expectedError: usecase.ErrCommentsClosed,
+		},
+		{
+			name:           "Moderated globally holds new authors for review",
+			globalMode:     "moderated",
+			trustedAfter:   3,
+			postMode:       entity.ModerationInherit,
+			author:         &entity.User{Id: authorId1},
+			approvedCount:  1,
+			expectCount:    true,
+			expectedStatus: entity.CommentStatusPending,
+		},
+		{
+			name:           "Moderated post trusts authors with enough approved comments",
+			globalMode:     "open",
+			trustedAfter:   3,
+			postMode:       entity.ModerationModerated,
+			author:         &entity.User{Id: authorId1},
+			approvedCount:  3,
+			expectCount:    true,
+			expectedStatus: entity.CommentStatusApproved,
+		},
+		{
+			name:           "Moderators skip the queue",
+			globalMode:     "moderated",
+			postMode:       entity.ModerationInherit,
+			author:         &entity.User{Id: authorId1, Role: entity.RoleModerator},
+			expectedStatus: entity.CommentStatusApproved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			logger := logrus.New()
+
+			cfg := &config.Config{}
+			cfg.Comments.Moderation = tt.globalMode
+			cfg.Comments.TrustedAfter = tt.trustedAfter
+			uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, nil, nil, logger, cfg, nil, nil, nil)
+
+			newComment := &entity.NewComment{
+				AuthorId: authorId1,
+				PostId:   postId1,
+				Content:  "This is a comment",
+			}
+
+			userRepo.EXPECT().GetUserById(gomock.Any(), authorId1).Return(tt.author, nil).Times(1)
+			postRepo.EXPECT().GetPostById(gomock.Any(), postId1).Return(&entity.Post{Id: postId1}, nil).Times(1)
+			postRepo.EXPECT().GetCommentModeration(gomock.Any(), postId1).Return(tt.postMode, nil).Times(1)
+
+			if tt.expectCount {
+				commentRepo.EXPECT().
+					CountApprovedCommentsByAuthor(gomock.Any(), authorId1).
+					Return(tt.approvedCount, nil).Times(1)
+			}
+
+			if tt.expectedError == nil {
+				commentRepo.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *entity.NewComment) (*entity.Comment, error) {
+						return &entity.Comment{Id: commentId1, PostId: c.PostId, AuthorId: c.AuthorId, Content: c.Content, Status: c.Status}, nil
+					}).Times(1)
+			}
+
+			result, err := uc.CreateComment(context.Background(), newComment)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+		})
+	}
+}
+
+func TestCreateComment_Spam(t *testing.T) {
+	tests := []struct {
+		name           string
+		author         *entity.User
+		verdict        spam.Verdict
+		checkErr       error
+		expectCheck    bool
+		expectedStatus entity.CommentStatus
+		expectedError  error
+	}{
+		{
+			name:           "Ham is published",
+			author:         &entity.User{Id: authorId1},
+			verdict:        spam.Ham,
+			expectCheck:    true,
+			expectedStatus: entity.CommentStatusApproved,
+		},
+		{
+			name:           "Suspicious comment is held for review",
+			author:         &entity.User{Id: authorId1},
+			verdict:        spam.Suspicious,
+			expectCheck:    true,
+			expectedStatus: entity.CommentStatusPending,
+		},
+		{
+			name:          "Spam is rejected",
+			author:        &entity.User{Id: authorId1},
+			verdict:       spam.Spam,
+			expectCheck:   true,
+			expectedError: usecase.ErrSpamDetected,
+		},
+		{
+			name:           "Checker failure holds the comment for review",
+			author:         &entity.User{Id: authorId1},
+			checkErr:       errors.New("akismet unavailable"),
+			expectCheck:    true,
+			expectedStatus: entity.CommentStatusPending,
+		},
+		{
+			name:           "Moderators are not checked",
+			author:         &entity.User{Id: authorId1, Role: entity.RoleModerator},
+			expectedStatus: entity.CommentStatusApproved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			spamChecker := mocksspam.NewMockSpamChecker(ctrl)
+			logger := logrus.New()
+			uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, nil, nil, logger, &config.Config{}, spamChecker, nil, nil)
+
+			newComment := &entity.NewComment{
+				AuthorId:  authorId1,
+				PostId:    postId1,
+				Content:   "Buy cheap watches at http://example.com",
+				IP:        "203.0.113.7",
+				UserAgent: "test-agent",
+			}
+
+			userRepo.EXPECT().GetUserById(gomock.Any(), authorId1).Return(tt.author, nil).Times(1)
+			postRepo.EXPECT().GetPostById(gomock.Any(), postId1).Return(&entity.Post{Id: postId1}, nil).Times(1)
+			postRepo.EXPECT().GetCommentModeration(gomock.Any(), postId1).Return(entity.ModerationInherit, nil).Times(1)
+
+			if tt.expectCheck {
+				spamChecker.EXPECT().
+					Check(gomock.Any(), &spam.Content{
+						Kind:      spam.KindComment,
+						AuthorId:  authorId1,
+						Body:      newComment.Content,
+						IP:        newComment.IP,
+						UserAgent: newComment.UserAgent,
+					}).
+					Return(tt.verdict, tt.checkErr).Times(1)
+			}
+
+			if tt.expectedError == nil {
+				commentRepo.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *entity.NewComment) (*entity.Comment, error) {
+						return &entity.Comment{Id: commentId1, PostId: c.PostId, AuthorId: c.AuthorId, Content: c.Content, Status: c.Status}, nil
+					}).Times(1)
+			}
+
+			result, err := uc.CreateComment(context.Background(), newComment)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+		})
+	}
+}
+
 func TestGetComments_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
 	logger := logrus.New()
-	uc := usecase.NewCommentUseCase(commentRepo, nil, nil, logger)
+	uc := usecase.NewCommentUseCase(commentRepo, postRepo, nil, reactionRepo, nil, logger, &config.Config{}, nil, nil, nil)
 
-	pagination := &entity.Pagination{Page: 1, Limit: 10}
-	expectedComments := &entity.CommentList{
-		Data: []*entity.Comment{
-			{
-				Id:       commentId1,
-				PostId:   postId1,
-				AuthorId: authorId1,
-				Content:  "Comment 1",
-			},
-			{
-				Id:       commentId2,
-				PostId:   postId2,
-				AuthorId: authorId2,
-				Content:  "Comment 2",
-			},
+	pagination := &entity.Pagination{Page: 1, Limit: 10, WithTotal: true}
+	expectedComments := []*entity.Comment{
+		{
+			Id:       commentId1,
+			PostId:   postId1,
+			AuthorId: authorId1,
+			Content:  "Comment 1",
+		},
+		{
+			Id:       commentId2,
+			PostId:   postId2,
+			AuthorId: authorId2,
+			Content:  "Comment 2",
 		},
 	}
 
+	postRepo.EXPECT().
+		GetPostById(gomock.Any(), postId1).
+		Return(&entity.Post{Id: postId1, Status: entity.PostStatusPublished}, nil).Times(1)
+
 	commentRepo.EXPECT().
-		GetComments(gomock.Any(), gomock.Any(), gomock.Any()).
+		GetComments(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(expectedComments, nil).Times(1)
 
-	result, err := uc.GetComments(context.Background(), postId1, pagination)
+	commentRepo.EXPECT().
+		GetTotalCommentsByPostID(gomock.Any(), postId1, authorId1).
+		Return(2, nil).Times(1)
+
+	reactionRepo.EXPECT().
+		GetReactionSummaries(gomock.Any(), entity.ReactionTargetComment, []uuid.UUID{commentId1, commentId2}, authorId1).
+		Return(map[uuid.UUID]*entity.ReactionSummary{
+			commentId1: {Counts: map[string]int{"like": 1}, Mine: []string{}},
+			commentId2: entity.NewReactionSummary(),
+		}, nil).Times(1)
+
+	result, err := uc.GetComments(context.Background(), postId1, authorId1, pagination, entity.CommentInclude{})
 	assert.NoError(t, err)
-	assert.Equal(t, expectedComments, result)
+	assert.Equal(t, &entity.Response[entity.Comment]{
+		Data: expectedComments,
+		Pagination: &entity.Pagination{
+			Total: 2,
+			Page:  pagination.Page,
+			Limit: pagination.Limit,
+		},
+	}, result)
+	assert.Equal(t, 1, result.Data[0].Reactions.Counts["like"])
+	assert.Empty(t, result.Data[1].Reactions.Counts)
+}
+
+func TestGetComments_PostSort(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Sorts that only posts have are rejected before anything is read.
+	commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+	uc := usecase.NewCommentUseCase(commentRepo, nil, nil, nil, nil, logrus.New(), &config.Config{}, nil, nil, nil)
+
+	for _, sort := range []string{"popular", "title_asc", "updated_at_desc"} {
+		t.Run(sort, func(t *testing.T) {
+			result, err := uc.GetComments(context.Background(), postId1, uuid.Nil, &entity.Pagination{Page: 1, Limit: 10, Sort: sort}, entity.CommentInclude{})
+
+			assert.ErrorIs(t, err, entity.ErrInvalidSort)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestGetComments_IncludeAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	userRepo := mocksrepository.NewMockUserRepository(ctrl)
+	reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+	uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, reactionRepo, nil, logrus.New(), &config.Config{}, nil, nil, nil)
+
+	postRepo.EXPECT().
+		GetPostById(gomock.Any(), postId1).
+		Return(&entity.Post{Id: postId1, Status: entity.PostStatusPublished}, nil).Times(1)
+
+	commentRepo.EXPECT().
+		GetComments(gomock.Any(), postId1, uuid.Nil, gomock.Any()).
+		Return([]*entity.Comment{
+			{Id: commentId1, PostId: postId1, AuthorId: authorId1},
+			{Id: commentId2, PostId: postId1, AuthorId: authorId1},
+		}, nil).Times(1)
+
+	reactionRepo.EXPECT().
+		GetReactionSummaries(gomock.Any(), entity.ReactionTargetComment, gomock.Any(), uuid.Nil).
+		Return(map[uuid.UUID]*entity.ReactionSummary{}, nil).Times(1)
+
+	userRepo.EXPECT().
+		GetAuthorsByIds(gomock.Any(), []uuid.UUID{authorId1}).
+		Return(map[uuid.UUID]*entity.AuthorSummary{authorId1: {Id: authorId1, Username: "tom"}}, nil).Times(1)
+
+	result, err := uc.GetComments(context.Background(), postId1, uuid.Nil, &entity.Pagination{Page: 1, Limit: 10}, entity.CommentInclude{Author: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "tom", result.Data[0].Author.Username)
+	assert.Same(t, result.Data[0].Author, result.Data[1].Author)
 }
 
 func TestGetComments_Fail(t *testing.T) {
 	tests := []struct {
 		name          string
-		mockSetup     func(commentRepo *mocksrepository.MockCommentRepository)
+		mockSetup     func(commentRepo *mocksrepository.MockCommentRepository, postRepo *mocksrepository.MockPostRepository)
 		postID        uuid.UUID
 		pagination    *entity.Pagination
 		expectedError string
 	}{
 		{
 			name: "Invalid pagination parameters",
-			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository) {
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, postRepo *mocksrepository.MockPostRepository) {
 			},
 			postID: postId1,
 			pagination: &entity.Pagination{
@@ -297,15 +716,40 @@ func TestGetComments_Fail(t *testing.T) {
 		},
 		{
 			name: "Failed to get comments",
-			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository) {
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId1).
+					Return(&entity.Post{Id: postId1, Status: entity.PostStatusPublished}, nil).Times(1)
 				commentRepo.EXPECT().
-					GetComments(gomock.Any(), gomock.Any(), gomock.Any()).
+					GetComments(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("db error")).Times(1)
 			},
 			postID:        postId1,
 			pagination:    &entity.Pagination{Page: 1, Limit: 10},
 			expectedError: "failed to get comments: db error",
 		},
+		{
+			name: "Post not found",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId2).
+					Return(nil, errors.New("post not found")).Times(1)
+			},
+			postID:        postId2,
+			pagination:    &entity.Pagination{Page: 1, Limit: 10},
+			expectedError: usecase.ErrPostNotFound.Error(),
+		},
+		{
+			name: "Draft of another author",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId1).
+					Return(&entity.Post{Id: postId1, AuthorId: authorId2, Status: entity.PostStatusDraft}, nil).Times(1)
+			},
+			postID:        postId1,
+			pagination:    &entity.Pagination{Page: 1, Limit: 10},
+			expectedError: usecase.ErrPostNotFound.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -314,12 +758,13 @@ func TestGetComments_Fail(t *testing.T) {
 			defer ctrl.Finish()
 
 			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
 			logger := logrus.New()
-			uc := usecase.NewCommentUseCase(commentRepo, nil, nil, logger)
+			uc := usecase.NewCommentUseCase(commentRepo, postRepo, nil, nil, nil, logger, &config.Config{}, nil, nil, nil)
 
-			tt.mockSetup(commentRepo)
+			tt.mockSetup(commentRepo, postRepo)
 
-			result, err := uc.GetComments(context.Background(), tt.postID, tt.pagination)
+			result, err := uc.GetComments(context.Background(), tt.postID, uuid.Nil, tt.pagination, entity.CommentInclude{})
 
 			if tt.expectedError != "" {
 				assert.ErrorContains(t, err, tt.expectedError)
@@ -347,6 +792,29 @@ func TestUpdateComment_Fail(t *testing.T) {
 			comment:       &entity.UpdateComment{Content: ""}, // Invalid content
 			expectedError: usecase.ErrInvalidComment.Error(),
 		},
+		{
+			name: "Comment not found",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository) {
+				commentRepo.EXPECT().
+					GetCommentById(gomock.Any(), commentId1).
+					Return(nil, errors.New("comment not found")).Times(1)
+			},
+			comment:       &entity.UpdateComment{Id: commentId1, AuthorId: authorId1, Content: "Updated"},
+			expectedError: usecase.ErrCommentNotFound.Error(),
+		},
+		{
+			name: "Not the author",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository) {
+				commentRepo.EXPECT().
+					GetCommentById(gomock.Any(), commentId1).
+					Return(&entity.Comment{Id: commentId1, AuthorId: authorId2, CreatedAt: time.Now()}, nil).Times(1)
+				commentRepo.EXPECT().
+					UpdateComment(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			comment:       &entity.UpdateComment{Id: commentId1, AuthorId: authorId1, Content: "Updated"},
+			expectedError: usecase.ErrUnauthorized.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -356,7 +824,7 @@ func TestUpdateComment_Fail(t *testing.T) {
 
 			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
 			logger := logrus.New()
-			uc := usecase.NewCommentUseCase(commentRepo, nil, nil, logger)
+			uc := usecase.NewCommentUseCase(commentRepo, nil, nil, nil, nil, logger, &config.Config{}, nil, nil, nil)
 
 			tt.mockSetup(commentRepo)
 
@@ -370,3 +838,300 @@ func TestUpdateComment_Fail(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateComment_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+	logger := logrus.New()
+	cfg := &config.Config{Comments: config.CommentsConfig{EditWindow: 15 * time.Minute}}
+	uc := usecase.NewCommentUseCase(commentRepo, nil, nil, nil, nil, logger, cfg, nil, nil, nil)
+
+	commentRepo.EXPECT().
+		GetCommentById(gomock.Any(), commentId1).
+		Return(&entity.Comment{Id: commentId1, AuthorId: authorId1, CreatedAt: time.Now().Add(-time.Minute)}, nil).Times(1)
+
+	commentRepo.EXPECT().
+		UpdateComment(gomock.Any(), &entity.UpdateComment{Id: commentId1, Content: "Updated"}).
+		Return(nil).Times(1)
+
+	err := uc.UpdateComment(context.Background(), &entity.UpdateComment{Id: commentId1, AuthorId: authorId1, Content: "Updated"})
+	assert.NoError(t, err)
+}
+
+func TestUpdateComment_EditWindowExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+	logger := logrus.New()
+	cfg := &config.Config{Comments: config.CommentsConfig{EditWindow: 15 * time.Minute}}
+	uc := usecase.NewCommentUseCase(commentRepo, nil, nil, nil, nil, logger, cfg, nil, nil, nil)
+
+	commentRepo.EXPECT().
+		GetCommentById(gomock.Any(), commentId1).
+		Return(&entity.Comment{Id: commentId1, AuthorId: authorId1, CreatedAt: time.Now().Add(-time.Hour)}, nil).Times(1)
+
+	commentRepo.EXPECT().
+		UpdateComment(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := uc.UpdateComment(context.Background(), &entity.UpdateComment{Id: commentId1, AuthorId: authorId1, Content: "Updated"})
+	assert.ErrorIs(t, err, usecase.ErrEditWindowExpired)
+}
+
+func TestUpdateComment_Version(t *testing.T) {
+	tests := []struct {
+		name      string
+		expected  int
+		repoErr   error
+		wantErr   error
+		updateRun int
+	}{
+		{name: "any version", expected: entity.AnyVersion, updateRun: 1},
+		{name: "current version", expected: 2, updateRun: 1},
+		{name: "stale version", expected: 1, wantErr: usecase.ErrVersionMismatch},
+		{name: "changed while updating", expected: 2, repoErr: entity.ErrVersionConflict, wantErr: usecase.ErrVersionMismatch, updateRun: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			cfg := &config.Config{Comments: config.CommentsConfig{EditWindow: 15 * time.Minute}}
+			uc := usecase.NewCommentUseCase(commentRepo, nil, nil, nil, nil, logrus.New(), cfg, nil, nil, nil)
+
+			commentRepo.EXPECT().
+				GetCommentById(gomock.Any(), commentId1).
+				Return(&entity.Comment{Id: commentId1, AuthorId: authorId1, Version: 2, CreatedAt: time.Now().Add(-time.Minute)}, nil).Times(1)
+
+			commentRepo.EXPECT().
+				UpdateComment(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, update *entity.UpdateComment) error {
+					assert.Equal(t, tt.expected, update.Version)
+					if tt.repoErr != nil {
+						return tt.repoErr
+					}
+					update.Version = 3
+					return nil
+				}).Times(tt.updateRun)
+
+			comment := &entity.UpdateComment{Id: commentId1, AuthorId: authorId1, Content: "Updated", Version: tt.expected}
+			err := uc.UpdateComment(context.Background(), comment)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 3, comment.Version)
+		})
+	}
+}
+
+func TestUpdateComment_Moderation(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         entity.CommentStatus
+		postMode       entity.ModerationMode
+		author         *entity.User
+		approvedCount  int
+		expectedStatus entity.CommentStatus
+		expectedEvent  string
+	}{
+		{
+			name:           "Edits on open posts stay published",
+			status:         entity.CommentStatusApproved,
+			postMode:       entity.ModerationOpen,
+			expectedEvent:  entity.EventCommentUpdated,
+			expectedStatus: entity.CommentStatusApproved,
+		},
+		{
+			name:           "Edits on moderated posts go back to the queue",
+			status:         entity.CommentStatusApproved,
+			postMode:       entity.ModerationModerated,
+			author:         &entity.User{Id: authorId1},
+			approvedCount:  1,
+			expectedEvent:  entity.EventCommentDeleted,
+			expectedStatus: entity.CommentStatusPending,
+		},
+		{
+			name:           "Trusted authors keep their edits published",
+			status:         entity.CommentStatusApproved,
+			postMode:       entity.ModerationInherit,
+			author:         &entity.User{Id: authorId1},
+			approvedCount:  3,
+			expectedEvent:  entity.EventCommentUpdated,
+			expectedStatus: entity.CommentStatusApproved,
+		},
+		{
+			name:           "Held comments stay in the queue",
+			status:         entity.CommentStatusPending,
+			expectedStatus: entity.CommentStatusPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			publisher := mocksevents.NewMockPublisher(ctrl)
+
+			cfg := &config.Config{}
+			cfg.Comments.Moderation = "moderated"
+			cfg.Comments.TrustedAfter = 3
+			uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, nil, nil, logrus.New(), cfg, nil, nil, publisher)
+
+			commentRepo.EXPECT().
+				GetCommentById(gomock.Any(), commentId1).
+				Return(&entity.Comment{Id: commentId1, PostId: postId1, AuthorId: authorId1, Status: tt.status, CreatedAt: time.Now()}, nil).Times(1)
+
+			if tt.postMode != "" || tt.author != nil {
+				postRepo.EXPECT().GetCommentModeration(gomock.Any(), postId1).Return(tt.postMode, nil).Times(1)
+			}
+			if tt.author != nil {
+				userRepo.EXPECT().GetUserById(gomock.Any(), authorId1).Return(tt.author, nil).Times(1)
+				commentRepo.EXPECT().
+					CountApprovedCommentsByAuthor(gomock.Any(), authorId1).
+					Return(tt.approvedCount, nil).Times(1)
+			}
+
+			commentRepo.EXPECT().
+				UpdateComment(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, update *entity.UpdateComment) error {
+					if tt.expectedStatus != tt.status {
+						assert.Equal(t, tt.expectedStatus, update.Status)
+					} else {
+						assert.Empty(t, update.Status)
+					}
+					return nil
+				}).Times(1)
+
+			if tt.expectedEvent != "" {
+				publisher.EXPECT().
+					Publish(gomock.Any(), entity.PostCommentsTopic(postId1), tt.expectedEvent, gomock.Any()).
+					Return(nil).Times(1)
+			}
+
+			err := uc.UpdateComment(context.Background(), &entity.UpdateComment{Id: commentId1, AuthorId: authorId1, Content: "Updated"})
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCreateComment_Notifications(t *testing.T) {
+	aliceID := uuid.New()
+	otherPostComment := &entity.Comment{Id: commentId2, PostId: postId2, AuthorId: authorId2, Status: entity.CommentStatusApproved}
+	parent := &entity.Comment{Id: commentId2, PostId: postId1, AuthorId: authorId2, Status: entity.CommentStatusApproved}
+
+	tests := []struct {
+		name          string
+		parent        *entity.Comment
+		content       string
+		mockSetup     func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository, notifier *mockusecase.MockNotifier)
+		expectedError error
+	}{
+		{
+			name:    "Post author is told once, mentions and unknown users are resolved",
+			content: "Thanks @alice and @ghost",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository, notifier *mockusecase.MockNotifier) {
+				commentRepo.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Return(&entity.Comment{Id: commentId1, PostId: postId1, AuthorId: authorId1, Content: "Thanks @alice and @ghost", Status: entity.CommentStatusApproved}, nil).Times(1)
+				userRepo.EXPECT().
+					GetUserByUsername(gomock.Any(), "alice").
+					Return(&entity.User{Id: aliceID, Username: "alice"}, nil).Times(1)
+				userRepo.EXPECT().
+					GetUserByUsername(gomock.Any(), "ghost").
+					Return(nil, errors.New("user not found")).Times(1)
+				notifier.EXPECT().
+					Notify(gomock.Any(), notificationFor(aliceID, entity.NotificationTypeMention)).
+					Return(nil).Times(1)
+				notifier.EXPECT().
+					Notify(gomock.Any(), notificationFor(authorId2, entity.NotificationTypeComment)).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name:    "Reply wins over the comment notification for the same user",
+			parent:  parent,
+			content: "Agreed",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository, notifier *mockusecase.MockNotifier) {
+				commentRepo.EXPECT().
+					GetCommentById(gomock.Any(), commentId2).
+					Return(parent, nil).Times(1)
+				commentRepo.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Return(&entity.Comment{Id: commentId1, PostId: postId1, AuthorId: authorId1, ParentId: &parent.Id, Status: entity.CommentStatusApproved}, nil).Times(1)
+				notifier.EXPECT().
+					Notify(gomock.Any(), notificationFor(authorId2, entity.NotificationTypeReply)).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name:    "Parent on another post",
+			parent:  otherPostComment,
+			content: "Agreed",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository, notifier *mockusecase.MockNotifier) {
+				commentRepo.EXPECT().
+					GetCommentById(gomock.Any(), commentId2).
+					Return(otherPostComment, nil).Times(1)
+			},
+			expectedError: usecase.ErrInvalidComment,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			notifier := mockusecase.NewMockNotifier(ctrl)
+			uc := usecase.NewCommentUseCase(commentRepo, postRepo, userRepo, nil, nil, logrus.New(), &config.Config{}, nil, notifier, nil)
+
+			userRepo.EXPECT().
+				GetUserById(gomock.Any(), authorId1).
+				Return(&entity.User{Id: authorId1}, nil).Times(1)
+			postRepo.EXPECT().
+				GetPostById(gomock.Any(), postId1).
+				Return(&entity.Post{Id: postId1, AuthorId: authorId2}, nil).Times(1)
+			if tt.expectedError == nil {
+				postRepo.EXPECT().
+					GetCommentModeration(gomock.Any(), postId1).
+					Return(entity.ModerationInherit, nil).Times(1)
+			}
+			tt.mockSetup(commentRepo, userRepo, notifier)
+
+			newComment := &entity.NewComment{AuthorId: authorId1, PostId: postId1, Content: tt.content}
+			if tt.parent != nil {
+				newComment.ParentId = &tt.parent.Id
+			}
+
+			_, err := uc.CreateComment(context.Background(), newComment)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func notificationFor(recipientID uuid.UUID, notificationType entity.NotificationType) gomock.Matcher {
+	return gomock.Cond(func(x any) bool {
+		n, ok := x.(*entity.Notification)
+		return ok && n.RecipientId == recipientID && n.Type == notificationType
+	})
+}
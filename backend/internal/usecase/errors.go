@@ -3,15 +3,49 @@ package usecase
 import "errors"
 
 var (
-	ErrCommentNotFound     = errors.New("comment not found")
-	ErrEmptyTitleOrContent = errors.New("title and content are required")
-	ErrPostNotFound        = errors.New("post not found")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrUnauthorized        = errors.New("unauthorized to modify this post")
-	ErrEmptyCredentials    = errors.New("username and password are required")
-	ErrUserExists          = errors.New("username already exists")
-	ErrCreateUserFailed    = errors.New("failed to create user")
-	ErrInvalidPage         = errors.New("invalid page number")
-	ErrInvalidLimit        = errors.New("invalid limit number")
-	ErrInvalidComment      = errors.New("invalid comment")
+	ErrCommentNotFound               = errors.New("comment not found")
+	ErrEmptyTitleOrContent           = errors.New("title and content are required")
+	ErrPostNotFound                  = errors.New("post not found")
+	ErrUserNotFound                  = errors.New("user not found")
+	ErrUnauthorized                  = errors.New("unauthorized to modify this post")
+	ErrEmptyCredentials              = errors.New("username and password are required")
+	ErrUserExists                    = errors.New("username already exists")
+	ErrCreateUserFailed              = errors.New("failed to create user")
+	ErrInvalidPage                   = errors.New("invalid page number")
+	ErrInvalidLimit                  = errors.New("invalid limit number")
+	ErrInvalidComment                = errors.New("invalid comment")
+	ErrEditWindowExpired             = errors.New("edit window has expired")
+	ErrCommentsClosed                = errors.New("comments are closed for this post")
+	ErrNotModerator                  = errors.New("moderator role required")
+	ErrInvalidStatus                 = errors.New("invalid comment status")
+	ErrInvalidModeration             = errors.New("invalid moderation settings")
+	ErrSpamDetected                  = errors.New("content rejected as spam")
+	ErrInvalidReaction               = errors.New("invalid reaction")
+	ErrInvalidBookmark               = errors.New("invalid bookmark")
+	ErrReadingListNotFound           = errors.New("reading list not found")
+	ErrInvalidReadingList            = errors.New("invalid reading list")
+	ErrInvalidReadingListOrder       = errors.New("order must contain every post on the list exactly once")
+	ErrInvalidProfile                = errors.New("invalid profile")
+	ErrForbidden                     = errors.New("not allowed to manage this user")
+	ErrCannotFollowSelf              = errors.New("users cannot follow themselves")
+	ErrNotificationNotFound          = errors.New("notification not found")
+	ErrInvalidNotificationPreference = errors.New("invalid notification preference")
+	ErrInvalidTopic                  = errors.New("invalid stream topic")
+	ErrTopicForbidden                = errors.New("not allowed to subscribe to this topic")
+	ErrInvalidPresenceState          = errors.New("invalid presence state")
+	ErrAdminRequired                 = errors.New("admin role required")
+	ErrWebhookNotFound               = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound       = errors.New("webhook delivery not found")
+	ErrInvalidWebhook                = errors.New("invalid webhook")
+	ErrJobNotFound                   = errors.New("job not found")
+	ErrJobNotRetryable               = errors.New("only failed jobs can be retried")
+	ErrInvalidJobStatus              = errors.New("invalid job status")
+	ErrNewsletterDisabled            = errors.New("the newsletter is not available")
+	ErrInvalidSubscription           = errors.New("invalid subscription")
+	ErrInvalidNewsletterToken        = errors.New("invalid or expired link")
+	ErrInvalidFeedTag                = errors.New("invalid tag")
+	ErrSitemapNotFound               = errors.New("sitemap not found")
+	ErrInvalidPostFilter             = errors.New("invalid post filter")
+	ErrVersionMismatch               = errors.New("the resource was changed by someone else")
+	ErrFieldNotWritable              = errors.New("field cannot be changed")
 )
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+type feedUseCase struct {
+	feedRepo repository.FeedRepository
+	userRepo repository.UserRepository
+	logger   *logrus.Logger
+	limit    int
+}
+
+// NewFeedUseCase creates the syndication feeds; limit is how many posts a
+// feed carries.
+func NewFeedUseCase(
+	feedRepo repository.FeedRepository,
+	userRepo repository.UserRepository,
+	logger *logrus.Logger,
+	limit int,
+) UseCaseFeed {
+	return &feedUseCase{
+		feedRepo: feedRepo,
+		userRepo: userRepo,
+		logger:   logger,
+		limit:    max(limit, 1),
+	}
+}
+
+func (uc *feedUseCase) GetFeed(ctx context.Context, query *entity.FeedQuery) ([]*entity.FeedEntry, error) {
+	var authorID *uuid.UUID
+	if query.Author != "" {
+		author, err := uc.userRepo.GetUserByUsername(ctx, query.Author)
+		if err != nil {
+			uc.logger.WithError(err).WithField("username", query.Author).Info("Author not found")
+			return nil, ErrUserNotFound
+		}
+		authorID = &author.Id
+	}
+
+	var tag string
+	if query.Tag != "" {
+		tags := entity.NormalizeTags([]string{query.Tag})
+		if len(tags) == 0 {
+			return nil, ErrInvalidFeedTag
+		}
+		tag = tags[0]
+	}
+
+	entries, err := uc.feedRepo.GetFeedEntries(ctx, authorID, tag, uc.limit)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get feed entries")
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_feed_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseFeed
+
+type UseCaseFeed interface {
+	GetFeed(ctx context.Context, query *entity.FeedQuery) ([]*entity.FeedEntry, error)
+}
@@ -0,0 +1,88 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestGetSyndicationFeed(t *testing.T) {
+	entries := []*entity.FeedEntry{{Post: entity.Post{Id: postId1, AuthorId: authorId1}, AuthorUsername: "alice"}}
+
+	tests := []struct {
+		name          string
+		query         *entity.FeedQuery
+		mockSetup     func(feedRepo *mocksrepository.MockFeedRepository, userRepo *mocksrepository.MockUserRepository)
+		expectedError error
+	}{
+		{
+			name:  "Whole blog",
+			query: &entity.FeedQuery{},
+			mockSetup: func(feedRepo *mocksrepository.MockFeedRepository, userRepo *mocksrepository.MockUserRepository) {
+				feedRepo.EXPECT().GetFeedEntries(gomock.Any(), (*uuid.UUID)(nil), "", 20).Return(entries, nil).Times(1)
+			},
+		},
+		{
+			name:  "Author",
+			query: &entity.FeedQuery{Author: "alice"},
+			mockSetup: func(feedRepo *mocksrepository.MockFeedRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().GetUserByUsername(gomock.Any(), "alice").Return(&entity.User{Id: authorId1, Username: "alice"}, nil).Times(1)
+				feedRepo.EXPECT().GetFeedEntries(gomock.Any(), &authorId1, "", 20).Return(entries, nil).Times(1)
+			},
+		},
+		{
+			name:  "Tag is normalized",
+			query: &entity.FeedQuery{Tag: "Go"},
+			mockSetup: func(feedRepo *mocksrepository.MockFeedRepository, userRepo *mocksrepository.MockUserRepository) {
+				feedRepo.EXPECT().GetFeedEntries(gomock.Any(), (*uuid.UUID)(nil), "go", 20).Return(entries, nil).Times(1)
+			},
+		},
+		{
+			name:          "Blank tag",
+			query:         &entity.FeedQuery{Tag: " "},
+			mockSetup:     func(*mocksrepository.MockFeedRepository, *mocksrepository.MockUserRepository) {},
+			expectedError: usecase.ErrInvalidFeedTag,
+		},
+		{
+			name:  "Unknown author",
+			query: &entity.FeedQuery{Author: "nobody"},
+			mockSetup: func(feedRepo *mocksrepository.MockFeedRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().GetUserByUsername(gomock.Any(), "nobody").Return(nil, errors.New("user not found")).Times(1)
+			},
+			expectedError: usecase.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			feedRepo := mocksrepository.NewMockFeedRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewFeedUseCase(feedRepo, userRepo, logrus.New(), 20)
+
+			tt.mockSetup(feedRepo, userRepo)
+
+			result, err := uc.GetFeed(context.Background(), tt.query)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entries, result)
+		})
+	}
+}
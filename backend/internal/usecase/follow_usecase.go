@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+type followUseCase struct {
+	followRepo   repository.FollowRepository
+	userRepo     repository.UserRepository
+	reactionRepo repository.ReactionRepository
+	bookmarkRepo repository.BookmarkRepository
+	logger       *logrus.Logger
+	notifier     Notifier
+}
+
+func NewFollowUseCase(
+	followRepo repository.FollowRepository,
+	userRepo repository.UserRepository,
+	reactionRepo repository.ReactionRepository,
+	bookmarkRepo repository.BookmarkRepository,
+	logger *logrus.Logger,
+	notifier Notifier,
+) UseCaseFollow {
+	return &followUseCase{
+		followRepo:   followRepo,
+		userRepo:     userRepo,
+		reactionRepo: reactionRepo,
+		bookmarkRepo: bookmarkRepo,
+		logger:       logger,
+		notifier:     notifier,
+	}
+}
+
+func (uc *followUseCase) Follow(ctx context.Context, followerID uuid.UUID, username string) error {
+	followee, err := uc.followee(ctx, followerID, username)
+	if err != nil {
+		return err
+	}
+
+	created, err := uc.followRepo.Follow(ctx, followerID, followee.Id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("followeeID", followee.Id).Error("Failed to follow user")
+		return fmt.Errorf("failed to follow user: %w", err)
+	}
+
+	if created {
+		notify(ctx, uc.notifier, uc.logger, &entity.Notification{
+			RecipientId: followee.Id,
+			ActorId:     &followerID,
+			Type:        entity.NotificationTypeFollow,
+		})
+	}
+
+	return nil
+}
+
+func (uc *followUseCase) Unfollow(ctx context.Context, followerID uuid.UUID, username string) error {
+	followee, err := uc.followee(ctx, followerID, username)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.followRepo.Unfollow(ctx, followerID, followee.Id); err != nil {
+		uc.logger.WithError(err).WithField("followeeID", followee.Id).Error("Failed to unfollow user")
+		return fmt.Errorf("failed to unfollow user: %w", err)
+	}
+
+	return nil
+}
+
+// GetFeed fetches one extra post to learn whether another page exists, so
+// the last page never hands out a cursor that leads to an empty response.
+func (uc *followUseCase) GetFeed(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*entity.CursorPage[entity.Post], error) {
+	if limit <= 0 {
+		limit = entity.DefaultLimit
+	}
+	if limit > entity.MaxLimit {
+		return nil, ErrInvalidLimit
+	}
+
+	var after *entity.Cursor
+	if cursor != "" {
+		decoded, err := entity.DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = decoded
+	}
+
+	posts, err := uc.followRepo.GetFeed(ctx, userID, after, limit+1)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get feed")
+		return nil, fmt.Errorf("failed to get feed: %w", err)
+	}
+
+	page := &entity.CursorPage[entity.Post]{Data: posts}
+	if len(posts) > limit {
+		page.Data = posts[:limit]
+		last := page.Data[limit-1]
+		page.NextCursor = entity.Cursor{CreatedAt: last.CreatedAt, Id: last.Id}.Encode()
+	}
+
+	if err := attachPostViewerState(ctx, uc.reactionRepo, uc.bookmarkRepo, page.Data, userID); err != nil {
+		uc.logger.WithError(err).Error("Failed to get post viewer state")
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (uc *followUseCase) followee(ctx context.Context, followerID uuid.UUID, username string) (*entity.User, error) {
+	followee, err := uc.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		uc.logger.WithError(err).WithField("username", username).Info("User to follow not found")
+		return nil, ErrUserNotFound
+	}
+
+	if followee.Id == followerID {
+		return nil, ErrCannotFollowSelf
+	}
+
+	return followee, nil
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_follow_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseFollow
+
+type UseCaseFollow interface {
+	Follow(ctx context.Context, followerID uuid.UUID, username string) error
+	Unfollow(ctx context.Context, followerID uuid.UUID, username string) error
+	GetFeed(ctx context.Context, userID uuid.UUID, cursor string, limit int) (*entity.CursorPage[entity.Post], error)
+}
@@ -0,0 +1,172 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestFollow(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(followRepo *mocksrepository.MockFollowRepository, userRepo *mocksrepository.MockUserRepository)
+		username      string
+		expectedError error
+	}{
+		{
+			name: "Follow an author",
+			mockSetup: func(followRepo *mocksrepository.MockFollowRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserByUsername(gomock.Any(), "tom").
+					Return(&entity.User{Id: authorId2, Username: "tom"}, nil).Times(1)
+				followRepo.EXPECT().
+					Follow(gomock.Any(), authorId1, authorId2).
+					Return(true, nil).Times(1)
+			},
+			username: "tom",
+		},
+		{
+			name: "Follow yourself",
+			mockSetup: func(followRepo *mocksrepository.MockFollowRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserByUsername(gomock.Any(), "me").
+					Return(&entity.User{Id: authorId1, Username: "me"}, nil).Times(1)
+			},
+			username:      "me",
+			expectedError: usecase.ErrCannotFollowSelf,
+		},
+		{
+			name: "Unknown author",
+			mockSetup: func(followRepo *mocksrepository.MockFollowRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserByUsername(gomock.Any(), "ghost").
+					Return(nil, errors.New("user not found")).Times(1)
+			},
+			username:      "ghost",
+			expectedError: usecase.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			followRepo := mocksrepository.NewMockFollowRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewFollowUseCase(followRepo, userRepo, nil, nil, logrus.New(), nil)
+
+			tt.mockSetup(followRepo, userRepo)
+
+			err := uc.Follow(context.Background(), authorId1, tt.username)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetFeed(t *testing.T) {
+	now := time.Now()
+	older := now.Add(-time.Hour)
+
+	tests := []struct {
+		name               string
+		mockSetup          func(followRepo *mocksrepository.MockFollowRepository, reactionRepo *mocksrepository.MockReactionRepository, bookmarkRepo *mocksrepository.MockBookmarkRepository)
+		cursor             string
+		limit              int
+		expectedLen        int
+		expectedNextCursor string
+		expectedError      error
+	}{
+		{
+			name: "More posts than the limit",
+			mockSetup: func(followRepo *mocksrepository.MockFollowRepository, reactionRepo *mocksrepository.MockReactionRepository, bookmarkRepo *mocksrepository.MockBookmarkRepository) {
+				followRepo.EXPECT().
+					GetFeed(gomock.Any(), authorId1, nil, 2).
+					Return([]*entity.Post{
+						{Id: postId1, CreatedAt: now},
+						{Id: postId2, CreatedAt: older},
+					}, nil).Times(1)
+				reactionRepo.EXPECT().
+					GetReactionSummaries(gomock.Any(), entity.ReactionTargetPost, gomock.Any(), authorId1).
+					Return(nil, nil).Times(1)
+				bookmarkRepo.EXPECT().
+					GetBookmarkedPostIds(gomock.Any(), authorId1, gomock.Any()).
+					Return(nil, nil).Times(1)
+			},
+			limit:              1,
+			expectedLen:        1,
+			expectedNextCursor: entity.Cursor{CreatedAt: now, Id: postId1}.Encode(),
+		},
+		{
+			name: "Last page",
+			mockSetup: func(followRepo *mocksrepository.MockFollowRepository, reactionRepo *mocksrepository.MockReactionRepository, bookmarkRepo *mocksrepository.MockBookmarkRepository) {
+				followRepo.EXPECT().
+					GetFeed(gomock.Any(), authorId1, &entity.Cursor{CreatedAt: now.UTC(), Id: postId1}, 11).
+					Return([]*entity.Post{{Id: postId2, CreatedAt: older}}, nil).Times(1)
+				reactionRepo.EXPECT().
+					GetReactionSummaries(gomock.Any(), entity.ReactionTargetPost, gomock.Any(), authorId1).
+					Return(nil, nil).Times(1)
+				bookmarkRepo.EXPECT().
+					GetBookmarkedPostIds(gomock.Any(), authorId1, gomock.Any()).
+					Return(nil, nil).Times(1)
+			},
+			cursor:      entity.Cursor{CreatedAt: now, Id: postId1}.Encode(),
+			expectedLen: 1,
+		},
+		{
+			name: "Invalid cursor",
+			mockSetup: func(*mocksrepository.MockFollowRepository, *mocksrepository.MockReactionRepository, *mocksrepository.MockBookmarkRepository) {
+			},
+			cursor:        "not-a-cursor",
+			expectedError: entity.ErrInvalidCursor,
+		},
+		{
+			name: "Limit too large",
+			mockSetup: func(*mocksrepository.MockFollowRepository, *mocksrepository.MockReactionRepository, *mocksrepository.MockBookmarkRepository) {
+			},
+			limit:         entity.MaxLimit + 1,
+			expectedError: usecase.ErrInvalidLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			followRepo := mocksrepository.NewMockFollowRepository(ctrl)
+			reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+			bookmarkRepo := mocksrepository.NewMockBookmarkRepository(ctrl)
+			uc := usecase.NewFollowUseCase(followRepo, nil, reactionRepo, bookmarkRepo, logrus.New(), nil)
+
+			tt.mockSetup(followRepo, reactionRepo, bookmarkRepo)
+
+			page, err := uc.GetFeed(context.Background(), authorId1, tt.cursor, tt.limit)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, page)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, page.Data, tt.expectedLen)
+			assert.Equal(t, tt.expectedNextCursor, page.NextCursor)
+		})
+	}
+}
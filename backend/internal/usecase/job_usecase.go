@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+type jobUseCase struct {
+	jobRepo  repository.JobRepository
+	userRepo repository.UserRepository
+	logger   *logrus.Logger
+}
+
+func NewJobUseCase(jobRepo repository.JobRepository, userRepo repository.UserRepository, logger *logrus.Logger) UseCaseJob {
+	return &jobUseCase{
+		jobRepo:  jobRepo,
+		userRepo: userRepo,
+		logger:   logger,
+	}
+}
+
+func (uc *jobUseCase) GetJobs(ctx context.Context, adminID uuid.UUID, status entity.JobStatus, queue string, pagination *entity.Pagination) (*entity.Response[entity.Job], error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	if status != "" && !status.Valid() {
+		return nil, ErrInvalidJobStatus
+	}
+
+	if err := entity.ValidatePagination(pagination); err != nil {
+		return nil, err
+	}
+
+	jobs, err := uc.jobRepo.GetJobs(ctx, status, queue, pagination)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get jobs")
+		return nil, err
+	}
+
+	total, err := uc.jobRepo.GetTotalJobs(ctx, status, queue)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get total jobs")
+		return nil, err
+	}
+
+	return &entity.Response[entity.Job]{
+		Data: jobs,
+		Pagination: &entity.Pagination{
+			Total:  total,
+			Page:   pagination.Page,
+			Limit:  pagination.Limit,
+			Offset: pagination.Offset,
+		},
+	}, nil
+}
+
+func (uc *jobUseCase) GetJob(ctx context.Context, adminID, id uuid.UUID) (*entity.Job, error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	job, err := uc.jobRepo.GetJob(ctx, id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("jobID", id).Error("Failed to get job")
+		return nil, ErrJobNotFound
+	}
+
+	return job, nil
+}
+
+func (uc *jobUseCase) RetryJob(ctx context.Context, adminID, id uuid.UUID) (*entity.Job, error) {
+	job, err := uc.GetJob(ctx, adminID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status != entity.JobFailed {
+		return nil, ErrJobNotRetryable
+	}
+
+	retried, err := uc.jobRepo.RetryJob(ctx, id)
+	if err != nil {
+		// The job was retried or deleted in the meantime.
+		uc.logger.WithError(err).WithField("jobID", id).Error("Failed to retry job")
+		return nil, ErrJobNotRetryable
+	}
+
+	return retried, nil
+}
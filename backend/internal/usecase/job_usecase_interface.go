@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_job_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseJob
+
+// UseCaseJob lets admins inspect the background job queue.
+type UseCaseJob interface {
+	// GetJobs lists jobs newest first. An empty status or queue matches every
+	// job.
+	GetJobs(ctx context.Context, adminID uuid.UUID, status entity.JobStatus, queue string, pagination *entity.Pagination) (*entity.Response[entity.Job], error)
+	GetJob(ctx context.Context, adminID, id uuid.UUID) (*entity.Job, error)
+	// RetryJob queues a failed job again with a fresh set of attempts.
+	RetryJob(ctx context.Context, adminID, id uuid.UUID) (*entity.Job, error)
+}
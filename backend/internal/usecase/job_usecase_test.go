@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestGetJobs(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        entity.JobStatus
+		mockSetup     func(jobRepo *mocksrepository.MockJobRepository, userRepo *mocksrepository.MockUserRepository)
+		expectedError error
+	}{
+		{
+			name:   "Admin lists failed jobs",
+			status: entity.JobFailed,
+			mockSetup: func(jobRepo *mocksrepository.MockJobRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+				jobRepo.EXPECT().
+					GetJobs(gomock.Any(), entity.JobFailed, "default", gomock.Any()).
+					Return([]*entity.Job{{Id: uuid.New(), Status: entity.JobFailed}}, nil).Times(1)
+				jobRepo.EXPECT().
+					GetTotalJobs(gomock.Any(), entity.JobFailed, "default").
+					Return(1, nil).Times(1)
+			},
+		},
+		{
+			name:   "Regular users cannot see jobs",
+			status: entity.JobFailed,
+			mockSetup: func(jobRepo *mocksrepository.MockJobRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+			},
+			expectedError: usecase.ErrAdminRequired,
+		},
+		{
+			name:   "Unknown status",
+			status: "stuck",
+			mockSetup: func(jobRepo *mocksrepository.MockJobRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+			},
+			expectedError: usecase.ErrInvalidJobStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			jobRepo := mocksrepository.NewMockJobRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewJobUseCase(jobRepo, userRepo, logrus.New())
+
+			tt.mockSetup(jobRepo, userRepo)
+
+			result, err := uc.GetJobs(context.Background(), authorId1, tt.status, "default", &entity.Pagination{Page: 1, Limit: 10})
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result.Data, 1)
+			assert.Equal(t, 1, result.Pagination.Total)
+		})
+	}
+}
+
+func TestRetryJob(t *testing.T) {
+	jobId := uuid.New()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(jobRepo *mocksrepository.MockJobRepository)
+		expectedError error
+	}{
+		{
+			name: "Failed job is queued again",
+			mockSetup: func(jobRepo *mocksrepository.MockJobRepository) {
+				jobRepo.EXPECT().GetJob(gomock.Any(), jobId).
+					Return(&entity.Job{Id: jobId, Status: entity.JobFailed, Attempts: 10}, nil).Times(1)
+				jobRepo.EXPECT().RetryJob(gomock.Any(), jobId).
+					Return(&entity.Job{Id: jobId, Status: entity.JobQueued}, nil).Times(1)
+			},
+		},
+		{
+			name: "Job not found",
+			mockSetup: func(jobRepo *mocksrepository.MockJobRepository) {
+				jobRepo.EXPECT().GetJob(gomock.Any(), jobId).
+					Return(nil, errors.New("job not found")).Times(1)
+			},
+			expectedError: usecase.ErrJobNotFound,
+		},
+		{
+			name: "Succeeded jobs cannot be retried",
+			mockSetup: func(jobRepo *mocksrepository.MockJobRepository) {
+				jobRepo.EXPECT().GetJob(gomock.Any(), jobId).
+					Return(&entity.Job{Id: jobId, Status: entity.JobSucceeded}, nil).Times(1)
+			},
+			expectedError: usecase.ErrJobNotRetryable,
+		},
+		{
+			name: "Job retried in the meantime",
+			mockSetup: func(jobRepo *mocksrepository.MockJobRepository) {
+				jobRepo.EXPECT().GetJob(gomock.Any(), jobId).
+					Return(&entity.Job{Id: jobId, Status: entity.JobFailed}, nil).Times(1)
+				jobRepo.EXPECT().RetryJob(gomock.Any(), jobId).
+					Return(nil, errors.New("failed job not found")).Times(1)
+			},
+			expectedError: usecase.ErrJobNotRetryable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			jobRepo := mocksrepository.NewMockJobRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewJobUseCase(jobRepo, userRepo, logrus.New())
+
+			userRepo.EXPECT().
+				GetUserById(gomock.Any(), authorId1).
+				Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+			tt.mockSetup(jobRepo)
+
+			job, err := uc.RetryJob(context.Background(), authorId1, jobId)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, job)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.JobQueued, job.Status)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	uuid "github.com/google/uuid"
 	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -55,6 +56,20 @@ func (mr *MockUseCaseAuthMockRecorder) Authenticate(arg0, arg1, arg2 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockUseCaseAuth)(nil).Authenticate), arg0, arg1, arg2)
 }
 
+// Logout mocks base method.
+func (m *MockUseCaseAuth) Logout(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockUseCaseAuthMockRecorder) Logout(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUseCaseAuth)(nil).Logout), arg0, arg1)
+}
+
 // Register mocks base method.
 func (m *MockUseCaseAuth) Register(arg0 context.Context, arg1 entity.NewUser) (*entity.User, error) {
 	m.ctrl.T.Helper()
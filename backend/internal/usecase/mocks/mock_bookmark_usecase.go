@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseBookmark)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_bookmark_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseBookmark
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCaseBookmark is a mock of UseCaseBookmark interface.
+type MockUseCaseBookmark struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseBookmarkMockRecorder
+}
+
+// MockUseCaseBookmarkMockRecorder is the mock recorder for MockUseCaseBookmark.
+type MockUseCaseBookmarkMockRecorder struct {
+	mock *MockUseCaseBookmark
+}
+
+// NewMockUseCaseBookmark creates a new mock instance.
+func NewMockUseCaseBookmark(ctrl *gomock.Controller) *MockUseCaseBookmark {
+	mock := &MockUseCaseBookmark{ctrl: ctrl}
+	mock.recorder = &MockUseCaseBookmarkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseBookmark) EXPECT() *MockUseCaseBookmarkMockRecorder {
+	return m.recorder
+}
+
+// AddBookmark mocks base method.
+func (m *MockUseCaseBookmark) AddBookmark(arg0 context.Context, arg1 *entity.NewBookmark) (*entity.Bookmark, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBookmark", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Bookmark)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBookmark indicates an expected call of AddBookmark.
+func (mr *MockUseCaseBookmarkMockRecorder) AddBookmark(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookmark", reflect.TypeOf((*MockUseCaseBookmark)(nil).AddBookmark), arg0, arg1)
+}
+
+// GetBookmarks mocks base method.
+func (m *MockUseCaseBookmark) GetBookmarks(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.Pagination) (*entity.Response[entity.Bookmark], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookmarks", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Response[entity.Bookmark])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookmarks indicates an expected call of GetBookmarks.
+func (mr *MockUseCaseBookmarkMockRecorder) GetBookmarks(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookmarks", reflect.TypeOf((*MockUseCaseBookmark)(nil).GetBookmarks), arg0, arg1, arg2)
+}
+
+// RemoveBookmark mocks base method.
+func (m *MockUseCaseBookmark) RemoveBookmark(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBookmark", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBookmark indicates an expected call of RemoveBookmark.
+func (mr *MockUseCaseBookmarkMockRecorder) RemoveBookmark(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBookmark", reflect.TypeOf((*MockUseCaseBookmark)(nil).RemoveBookmark), arg0, arg1, arg2)
+}
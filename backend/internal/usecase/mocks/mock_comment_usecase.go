@@ -11,11 +11,10 @@ package mockusecase
 
 import (
 	context "context"
-	reflect "reflect"
-
 	uuid "github.com/google/uuid"
 	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
 )
 
 // MockUseCaseComment is a mock of UseCaseComment interface.
@@ -57,47 +56,61 @@ func (mr *MockUseCaseCommentMockRecorder) CreateComment(arg0, arg1 any) *gomock.
 }
 
 // DeleteComment mocks base method.
-func (m *MockUseCaseComment) DeleteComment(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+func (m *MockUseCaseComment) DeleteComment(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteComment", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "DeleteComment", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteComment indicates an expected call of DeleteComment.
-func (mr *MockUseCaseCommentMockRecorder) DeleteComment(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockUseCaseCommentMockRecorder) DeleteComment(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockUseCaseComment)(nil).DeleteComment), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockUseCaseComment)(nil).DeleteComment), arg0, arg1, arg2, arg3)
 }
 
 // GetCommentByID mocks base method.
-func (m *MockUseCaseComment) GetCommentByID(arg0 context.Context, arg1 uuid.UUID) (*entity.Comment, error) {
+func (m *MockUseCaseComment) GetCommentByID(arg0 context.Context, arg1, arg2 uuid.UUID) (*entity.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommentByID", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetCommentByID", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*entity.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCommentByID indicates an expected call of GetCommentByID.
-func (mr *MockUseCaseCommentMockRecorder) GetCommentByID(arg0, arg1 any) *gomock.Call {
+func (mr *MockUseCaseCommentMockRecorder) GetCommentByID(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockUseCaseComment)(nil).GetCommentByID), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockUseCaseComment)(nil).GetCommentByID), arg0, arg1, arg2)
 }
 
 // GetComments mocks base method.
-func (m *MockUseCaseComment) GetComments(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.Pagination) (*entity.CommentList, error) {
+func (m *MockUseCaseComment) GetComments(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 *entity.Pagination, arg4 entity.CommentInclude) (*entity.Response[entity.Comment], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetComments", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*entity.CommentList)
+	ret := m.ctrl.Call(m, "GetComments", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*entity.Response[entity.Comment])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetComments indicates an expected call of GetComments.
-func (mr *MockUseCaseCommentMockRecorder) GetComments(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockUseCaseCommentMockRecorder) GetComments(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockUseCaseComment)(nil).GetComments), arg0, arg1, arg2, arg3, arg4)
+}
+
+// PatchComment mocks base method.
+func (m *MockUseCaseComment) PatchComment(arg0 context.Context, arg1 *entity.UpdateComment, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchComment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchComment indicates an expected call of PatchComment.
+func (mr *MockUseCaseCommentMockRecorder) PatchComment(arg0, arg1, arg2 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComments", reflect.TypeOf((*MockUseCaseComment)(nil).GetComments), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchComment", reflect.TypeOf((*MockUseCaseComment)(nil).PatchComment), arg0, arg1, arg2)
 }
 
 // UpdateComment mocks base method.
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseFeed)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_feed_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseFeed
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCaseFeed is a mock of UseCaseFeed interface.
+type MockUseCaseFeed struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseFeedMockRecorder
+}
+
+// MockUseCaseFeedMockRecorder is the mock recorder for MockUseCaseFeed.
+type MockUseCaseFeedMockRecorder struct {
+	mock *MockUseCaseFeed
+}
+
+// NewMockUseCaseFeed creates a new mock instance.
+func NewMockUseCaseFeed(ctrl *gomock.Controller) *MockUseCaseFeed {
+	mock := &MockUseCaseFeed{ctrl: ctrl}
+	mock.recorder = &MockUseCaseFeedMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseFeed) EXPECT() *MockUseCaseFeedMockRecorder {
+	return m.recorder
+}
+
+// GetFeed mocks base method.
+func (m *MockUseCaseFeed) GetFeed(arg0 context.Context, arg1 *entity.FeedQuery) ([]*entity.FeedEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.FeedEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockUseCaseFeedMockRecorder) GetFeed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockUseCaseFeed)(nil).GetFeed), arg0, arg1)
+}
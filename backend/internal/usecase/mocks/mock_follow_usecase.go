@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseFollow)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_follow_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseFollow
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCaseFollow is a mock of UseCaseFollow interface.
+type MockUseCaseFollow struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseFollowMockRecorder
+}
+
+// MockUseCaseFollowMockRecorder is the mock recorder for MockUseCaseFollow.
+type MockUseCaseFollowMockRecorder struct {
+	mock *MockUseCaseFollow
+}
+
+// NewMockUseCaseFollow creates a new mock instance.
+func NewMockUseCaseFollow(ctrl *gomock.Controller) *MockUseCaseFollow {
+	mock := &MockUseCaseFollow{ctrl: ctrl}
+	mock.recorder = &MockUseCaseFollowMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseFollow) EXPECT() *MockUseCaseFollowMockRecorder {
+	return m.recorder
+}
+
+// Follow mocks base method.
+func (m *MockUseCaseFollow) Follow(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockUseCaseFollowMockRecorder) Follow(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockUseCaseFollow)(nil).Follow), arg0, arg1, arg2)
+}
+
+// GetFeed mocks base method.
+func (m *MockUseCaseFollow) GetFeed(arg0 context.Context, arg1 uuid.UUID, arg2 string, arg3 int) (*entity.CursorPage[entity.Post], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*entity.CursorPage[entity.Post])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockUseCaseFollowMockRecorder) GetFeed(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockUseCaseFollow)(nil).GetFeed), arg0, arg1, arg2, arg3)
+}
+
+// Unfollow mocks base method.
+func (m *MockUseCaseFollow) Unfollow(arg0 context.Context, arg1 uuid.UUID, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockUseCaseFollowMockRecorder) Unfollow(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockUseCaseFollow)(nil).Unfollow), arg0, arg1, arg2)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseJob)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_job_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseJob
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCaseJob is a mock of UseCaseJob interface.
+type MockUseCaseJob struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseJobMockRecorder
+}
+
+// MockUseCaseJobMockRecorder is the mock recorder for MockUseCaseJob.
+type MockUseCaseJobMockRecorder struct {
+	mock *MockUseCaseJob
+}
+
+// NewMockUseCaseJob creates a new mock instance.
+func NewMockUseCaseJob(ctrl *gomock.Controller) *MockUseCaseJob {
+	mock := &MockUseCaseJob{ctrl: ctrl}
+	mock.recorder = &MockUseCaseJobMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseJob) EXPECT() *MockUseCaseJobMockRecorder {
+	return m.recorder
+}
+
+// GetJob mocks base method.
+func (m *MockUseCaseJob) GetJob(arg0 context.Context, arg1, arg2 uuid.UUID) (*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockUseCaseJobMockRecorder) GetJob(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockUseCaseJob)(nil).GetJob), arg0, arg1, arg2)
+}
+
+// GetJobs mocks base method.
+func (m *MockUseCaseJob) GetJobs(arg0 context.Context, arg1 uuid.UUID, arg2 entity.JobStatus, arg3 string, arg4 *entity.Pagination) (*entity.Response[entity.Job], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobs", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*entity.Response[entity.Job])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobs indicates an expected call of GetJobs.
+func (mr *MockUseCaseJobMockRecorder) GetJobs(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockUseCaseJob)(nil).GetJobs), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RetryJob mocks base method.
+func (m *MockUseCaseJob) RetryJob(arg0 context.Context, arg1, arg2 uuid.UUID) (*entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryJob indicates an expected call of RetryJob.
+func (mr *MockUseCaseJobMockRecorder) RetryJob(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryJob", reflect.TypeOf((*MockUseCaseJob)(nil).RetryJob), arg0, arg1, arg2)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseModeration)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_moderation_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseModeration
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCaseModeration is a mock of UseCaseModeration interface.
+type MockUseCaseModeration struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseModerationMockRecorder
+}
+
+// MockUseCaseModerationMockRecorder is the mock recorder for MockUseCaseModeration.
+type MockUseCaseModerationMockRecorder struct {
+	mock *MockUseCaseModeration
+}
+
+// NewMockUseCaseModeration creates a new mock instance.
+func NewMockUseCaseModeration(ctrl *gomock.Controller) *MockUseCaseModeration {
+	mock := &MockUseCaseModeration{ctrl: ctrl}
+	mock.recorder = &MockUseCaseModerationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseModeration) EXPECT() *MockUseCaseModerationMockRecorder {
+	return m.recorder
+}
+
+// GetQueue mocks base method.
+func (m *MockUseCaseModeration) GetQueue(arg0 context.Context, arg1 uuid.UUID, arg2 entity.CommentStatus, arg3 *entity.Pagination) (*entity.Response[entity.Comment], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*entity.Response[entity.Comment])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockUseCaseModerationMockRecorder) GetQueue(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockUseCaseModeration)(nil).GetQueue), arg0, arg1, arg2, arg3)
+}
+
+// ModerateComments mocks base method.
+func (m *MockUseCaseModeration) ModerateComments(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.ModerationDecision) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateComments", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateComments indicates an expected call of ModerateComments.
+func (mr *MockUseCaseModerationMockRecorder) ModerateComments(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateComments", reflect.TypeOf((*MockUseCaseModeration)(nil).ModerateComments), arg0, arg1, arg2)
+}
+
+// SetPostModeration mocks base method.
+func (m *MockUseCaseModeration) SetPostModeration(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 entity.ModerationMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPostModeration", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPostModeration indicates an expected call of SetPostModeration.
+func (mr *MockUseCaseModerationMockRecorder) SetPostModeration(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostModeration", reflect.TypeOf((*MockUseCaseModeration)(nil).SetPostModeration), arg0, arg1, arg2, arg3)
+}
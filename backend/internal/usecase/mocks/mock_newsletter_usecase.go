@@ -0,0 +1,136 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseNewsletter,NewsletterEmailer)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_newsletter_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseNewsletter,NewsletterEmailer
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCaseNewsletter is a mock of UseCaseNewsletter interface.
+type MockUseCaseNewsletter struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseNewsletterMockRecorder
+}
+
+// MockUseCaseNewsletterMockRecorder is the mock recorder for MockUseCaseNewsletter.
+type MockUseCaseNewsletterMockRecorder struct {
+	mock *MockUseCaseNewsletter
+}
+
+// NewMockUseCaseNewsletter creates a new mock instance.
+func NewMockUseCaseNewsletter(ctrl *gomock.Controller) *MockUseCaseNewsletter {
+	mock := &MockUseCaseNewsletter{ctrl: ctrl}
+	mock.recorder = &MockUseCaseNewsletterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseNewsletter) EXPECT() *MockUseCaseNewsletterMockRecorder {
+	return m.recorder
+}
+
+// CheckUnsubscribe mocks base method.
+func (m *MockUseCaseNewsletter) CheckUnsubscribe(arg0 context.Context, arg1 string) (*entity.Subscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUnsubscribe", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Subscriber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckUnsubscribe indicates an expected call of CheckUnsubscribe.
+func (mr *MockUseCaseNewsletterMockRecorder) CheckUnsubscribe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUnsubscribe", reflect.TypeOf((*MockUseCaseNewsletter)(nil).CheckUnsubscribe), arg0, arg1)
+}
+
+// Confirm mocks base method.
+func (m *MockUseCaseNewsletter) Confirm(arg0 context.Context, arg1 string) (*entity.Subscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Subscriber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockUseCaseNewsletterMockRecorder) Confirm(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockUseCaseNewsletter)(nil).Confirm), arg0, arg1)
+}
+
+// Subscribe mocks base method.
+func (m *MockUseCaseNewsletter) Subscribe(arg0 context.Context, arg1 *entity.NewSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockUseCaseNewsletterMockRecorder) Subscribe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUseCaseNewsletter)(nil).Subscribe), arg0, arg1)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockUseCaseNewsletter) Unsubscribe(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockUseCaseNewsletterMockRecorder) Unsubscribe(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockUseCaseNewsletter)(nil).Unsubscribe), arg0, arg1)
+}
+
+// MockNewsletterEmailer is a mock of NewsletterEmailer interface.
+type MockNewsletterEmailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNewsletterEmailerMockRecorder
+}
+
+// MockNewsletterEmailerMockRecorder is the mock recorder for MockNewsletterEmailer.
+type MockNewsletterEmailerMockRecorder struct {
+	mock *MockNewsletterEmailer
+}
+
+// NewMockNewsletterEmailer creates a new mock instance.
+func NewMockNewsletterEmailer(ctrl *gomock.Controller) *MockNewsletterEmailer {
+	mock := &MockNewsletterEmailer{ctrl: ctrl}
+	mock.recorder = &MockNewsletterEmailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNewsletterEmailer) EXPECT() *MockNewsletterEmailerMockRecorder {
+	return m.recorder
+}
+
+// EmailConfirmation mocks base method.
+func (m *MockNewsletterEmailer) EmailConfirmation(arg0 context.Context, arg1 *entity.Subscriber, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmailConfirmation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EmailConfirmation indicates an expected call of EmailConfirmation.
+func (mr *MockNewsletterEmailerMockRecorder) EmailConfirmation(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmailConfirmation", reflect.TypeOf((*MockNewsletterEmailer)(nil).EmailConfirmation), arg0, arg1, arg2)
+}
@@ -0,0 +1,203 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseNotification,Notifier,NotificationEmailer)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_notification_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseNotification,Notifier,NotificationEmailer
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCaseNotification is a mock of UseCaseNotification interface.
+type MockUseCaseNotification struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseNotificationMockRecorder
+}
+
+// MockUseCaseNotificationMockRecorder is the mock recorder for MockUseCaseNotification.
+type MockUseCaseNotificationMockRecorder struct {
+	mock *MockUseCaseNotification
+}
+
+// NewMockUseCaseNotification creates a new mock instance.
+func NewMockUseCaseNotification(ctrl *gomock.Controller) *MockUseCaseNotification {
+	mock := &MockUseCaseNotification{ctrl: ctrl}
+	mock.recorder = &MockUseCaseNotificationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseNotification) EXPECT() *MockUseCaseNotificationMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockUseCaseNotification) GetNotifications(arg0 context.Context, arg1 uuid.UUID, arg2 bool, arg3 *entity.Pagination) (*entity.Response[entity.Notification], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*entity.Response[entity.Notification])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockUseCaseNotificationMockRecorder) GetNotifications(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockUseCaseNotification)(nil).GetNotifications), arg0, arg1, arg2, arg3)
+}
+
+// GetPreferences mocks base method.
+func (m *MockUseCaseNotification) GetPreferences(arg0 context.Context, arg1 uuid.UUID) ([]*entity.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockUseCaseNotificationMockRecorder) GetPreferences(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockUseCaseNotification)(nil).GetPreferences), arg0, arg1)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockUseCaseNotification) MarkAllRead(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockUseCaseNotificationMockRecorder) MarkAllRead(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockUseCaseNotification)(nil).MarkAllRead), arg0, arg1)
+}
+
+// MarkRead mocks base method.
+func (m *MockUseCaseNotification) MarkRead(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockUseCaseNotificationMockRecorder) MarkRead(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockUseCaseNotification)(nil).MarkRead), arg0, arg1, arg2)
+}
+
+// Notify mocks base method.
+func (m *MockUseCaseNotification) Notify(arg0 context.Context, arg1 *entity.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockUseCaseNotificationMockRecorder) Notify(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockUseCaseNotification)(nil).Notify), arg0, arg1)
+}
+
+// UpdatePreferences mocks base method.
+func (m *MockUseCaseNotification) UpdatePreferences(arg0 context.Context, arg1 uuid.UUID, arg2 []*entity.NotificationPreference) ([]*entity.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*entity.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockUseCaseNotificationMockRecorder) UpdatePreferences(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockUseCaseNotification)(nil).UpdatePreferences), arg0, arg1, arg2)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(arg0 context.Context, arg1 *entity.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), arg0, arg1)
+}
+
+// MockNotificationEmailer is a mock of NotificationEmailer interface.
+type MockNotificationEmailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationEmailerMockRecorder
+}
+
+// MockNotificationEmailerMockRecorder is the mock recorder for MockNotificationEmailer.
+type MockNotificationEmailerMockRecorder struct {
+	mock *MockNotificationEmailer
+}
+
+// NewMockNotificationEmailer creates a new mock instance.
+func NewMockNotificationEmailer(ctrl *gomock.Controller) *MockNotificationEmailer {
+	mock := &MockNotificationEmailer{ctrl: ctrl}
+	mock.recorder = &MockNotificationEmailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationEmailer) EXPECT() *MockNotificationEmailerMockRecorder {
+	return m.recorder
+}
+
+// EmailNotification mocks base method.
+func (m *MockNotificationEmailer) EmailNotification(arg0 context.Context, arg1 *entity.User, arg2 *entity.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmailNotification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EmailNotification indicates an expected call of EmailNotification.
+func (mr *MockNotificationEmailerMockRecorder) EmailNotification(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmailNotification", reflect.TypeOf((*MockNotificationEmailer)(nil).EmailNotification), arg0, arg1, arg2)
+}
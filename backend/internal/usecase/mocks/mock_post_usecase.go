@@ -11,11 +11,10 @@ package mockusecase
 
 import (
 	context "context"
-	reflect "reflect"
-
 	uuid "github.com/google/uuid"
 	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
 )
 
 // MockUseCasePost is a mock of UseCasePost interface.
@@ -57,47 +56,76 @@ func (mr *MockUseCasePostMockRecorder) CreatePost(arg0, arg1 any) *gomock.Call {
 }
 
 // DeletePost mocks base method.
-func (m *MockUseCasePost) DeletePost(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+func (m *MockUseCasePost) DeletePost(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePost", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "DeletePost", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePost indicates an expected call of DeletePost.
-func (mr *MockUseCasePostMockRecorder) DeletePost(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockUseCasePostMockRecorder) DeletePost(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockUseCasePost)(nil).DeletePost), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockUseCasePost)(nil).DeletePost), arg0, arg1, arg2, arg3)
 }
 
 // GetAllPosts mocks base method.
-func (m *MockUseCasePost) GetAllPosts(arg0 context.Context, arg1 *entity.Pagination) (*entity.PostList, error) {
+func (m *MockUseCasePost) GetAllPosts(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.PostFilter, arg3 *entity.Pagination, arg4 entity.PostInclude) (*entity.Response[entity.Post], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllPosts", arg0, arg1)
-	ret0, _ := ret[0].(*entity.PostList)
+	ret := m.ctrl.Call(m, "GetAllPosts", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*entity.Response[entity.Post])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllPosts indicates an expected call of GetAllPosts.
-func (mr *MockUseCasePostMockRecorder) GetAllPosts(arg0, arg1 any) *gomock.Call {
+func (mr *MockUseCasePostMockRecorder) GetAllPosts(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPosts", reflect.TypeOf((*MockUseCasePost)(nil).GetAllPosts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPosts", reflect.TypeOf((*MockUseCasePost)(nil).GetAllPosts), arg0, arg1, arg2, arg3, arg4)
 }
 
 // GetPost mocks base method.
-func (m *MockUseCasePost) GetPost(arg0 context.Context, arg1 uuid.UUID) (*entity.Post, error) {
+func (m *MockUseCasePost) GetPost(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 entity.PostInclude) (*entity.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPost", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetPost", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*entity.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPost indicates an expected call of GetPost.
-func (mr *MockUseCasePostMockRecorder) GetPost(arg0, arg1 any) *gomock.Call {
+func (mr *MockUseCasePostMockRecorder) GetPost(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*MockUseCasePost)(nil).GetPost), arg0, arg1, arg2, arg3)
+}
+
+// GetPostsByAuthor mocks base method.
+func (m *MockUseCasePost) GetPostsByAuthor(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 *entity.Pagination, arg4 entity.PostInclude) (*entity.Response[entity.Post], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostsByAuthor", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*entity.Response[entity.Post])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostsByAuthor indicates an expected call of GetPostsByAuthor.
+func (mr *MockUseCasePostMockRecorder) GetPostsByAuthor(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostsByAuthor", reflect.TypeOf((*MockUseCasePost)(nil).GetPostsByAuthor), arg0, arg1, arg2, arg3, arg4)
+}
+
+// PatchPost mocks base method.
+func (m *MockUseCasePost) PatchPost(arg0 context.Context, arg1 *entity.Post, arg2 []string, arg3 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchPost", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PatchPost indicates an expected call of PatchPost.
+func (mr *MockUseCasePostMockRecorder) PatchPost(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*MockUseCasePost)(nil).GetPost), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchPost", reflect.TypeOf((*MockUseCasePost)(nil).PatchPost), arg0, arg1, arg2, arg3)
 }
 
 // UpdatePost mocks base method.
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCasePresence)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_presence_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCasePresence
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	usecase "github.com/popeskul/awesome-blog/backend/internal/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCasePresence is a mock of UseCasePresence interface.
+type MockUseCasePresence struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCasePresenceMockRecorder
+}
+
+// MockUseCasePresenceMockRecorder is the mock recorder for MockUseCasePresence.
+type MockUseCasePresenceMockRecorder struct {
+	mock *MockUseCasePresence
+}
+
+// NewMockUseCasePresence creates a new mock instance.
+func NewMockUseCasePresence(ctrl *gomock.Controller) *MockUseCasePresence {
+	mock := &MockUseCasePresence{ctrl: ctrl}
+	mock.recorder = &MockUseCasePresenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCasePresence) EXPECT() *MockUseCasePresenceMockRecorder {
+	return m.recorder
+}
+
+// Join mocks base method.
+func (m *MockUseCasePresence) Join(arg0 context.Context, arg1, arg2 uuid.UUID) (*usecase.PresenceSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Join", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*usecase.PresenceSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Join indicates an expected call of Join.
+func (mr *MockUseCasePresenceMockRecorder) Join(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Join", reflect.TypeOf((*MockUseCasePresence)(nil).Join), arg0, arg1, arg2)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseProfile)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_profile_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseProfile
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCaseProfile is a mock of UseCaseProfile interface.
+type MockUseCaseProfile struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseProfileMockRecorder
+}
+
+// MockUseCaseProfileMockRecorder is the mock recorder for MockUseCaseProfile.
+type MockUseCaseProfileMockRecorder struct {
+	mock *MockUseCaseProfile
+}
+
+// NewMockUseCaseProfile creates a new mock instance.
+func NewMockUseCaseProfile(ctrl *gomock.Controller) *MockUseCaseProfile {
+	mock := &MockUseCaseProfile{ctrl: ctrl}
+	mock.recorder = &MockUseCaseProfileMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseProfile) EXPECT() *MockUseCaseProfileMockRecorder {
+	return m.recorder
+}
+
+// GetAuthor mocks base method.
+func (m *MockUseCaseProfile) GetAuthor(arg0 context.Context, arg1 string) (*entity.Author, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthor", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Author)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthor indicates an expected call of GetAuthor.
+func (mr *MockUseCaseProfileMockRecorder) GetAuthor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthor", reflect.TypeOf((*MockUseCaseProfile)(nil).GetAuthor), arg0, arg1)
+}
+
+// GetProfile mocks base method.
+func (m *MockUseCaseProfile) GetProfile(arg0 context.Context, arg1 uuid.UUID) (*entity.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockUseCaseProfileMockRecorder) GetProfile(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockUseCaseProfile)(nil).GetProfile), arg0, arg1)
+}
+
+// UpdateProfile mocks base method.
+func (m *MockUseCaseProfile) UpdateProfile(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.UpdateProfile) (*entity.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUseCaseProfileMockRecorder) UpdateProfile(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUseCaseProfile)(nil).UpdateProfile), arg0, arg1, arg2)
+}
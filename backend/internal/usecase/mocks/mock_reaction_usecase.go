@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseReaction)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_reaction_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseReaction
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCaseReaction is a mock of UseCaseReaction interface.
+type MockUseCaseReaction struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseReactionMockRecorder
+}
+
+// MockUseCaseReactionMockRecorder is the mock recorder for MockUseCaseReaction.
+type MockUseCaseReactionMockRecorder struct {
+	mock *MockUseCaseReaction
+}
+
+// NewMockUseCaseReaction creates a new mock instance.
+func NewMockUseCaseReaction(ctrl *gomock.Controller) *MockUseCaseReaction {
+	mock := &MockUseCaseReaction{ctrl: ctrl}
+	mock.recorder = &MockUseCaseReactionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseReaction) EXPECT() *MockUseCaseReactionMockRecorder {
+	return m.recorder
+}
+
+// AddReaction mocks base method.
+func (m *MockUseCaseReaction) AddReaction(arg0 context.Context, arg1 *entity.Reaction) (*entity.ReactionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReactionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockUseCaseReactionMockRecorder) AddReaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockUseCaseReaction)(nil).AddReaction), arg0, arg1)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockUseCaseReaction) RemoveReaction(arg0 context.Context, arg1 *entity.Reaction) (*entity.ReactionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReactionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockUseCaseReactionMockRecorder) RemoveReaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockUseCaseReaction)(nil).RemoveReaction), arg0, arg1)
+}
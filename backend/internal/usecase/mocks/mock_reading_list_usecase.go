@@ -0,0 +1,175 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseReadingList)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_reading_list_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseReadingList
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCaseReadingList is a mock of UseCaseReadingList interface.
+type MockUseCaseReadingList struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseReadingListMockRecorder
+}
+
+// MockUseCaseReadingListMockRecorder is the mock recorder for MockUseCaseReadingList.
+type MockUseCaseReadingListMockRecorder struct {
+	mock *MockUseCaseReadingList
+}
+
+// NewMockUseCaseReadingList creates a new mock instance.
+func NewMockUseCaseReadingList(ctrl *gomock.Controller) *MockUseCaseReadingList {
+	mock := &MockUseCaseReadingList{ctrl: ctrl}
+	mock.recorder = &MockUseCaseReadingListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseReadingList) EXPECT() *MockUseCaseReadingListMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockUseCaseReadingList) AddItem(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockUseCaseReadingListMockRecorder) AddItem(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockUseCaseReadingList)(nil).AddItem), arg0, arg1, arg2, arg3)
+}
+
+// CreateList mocks base method.
+func (m *MockUseCaseReadingList) CreateList(arg0 context.Context, arg1 *entity.NewReadingList) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockUseCaseReadingListMockRecorder) CreateList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockUseCaseReadingList)(nil).CreateList), arg0, arg1)
+}
+
+// DeleteList mocks base method.
+func (m *MockUseCaseReadingList) DeleteList(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockUseCaseReadingListMockRecorder) DeleteList(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockUseCaseReadingList)(nil).DeleteList), arg0, arg1, arg2)
+}
+
+// GetList mocks base method.
+func (m *MockUseCaseReadingList) GetList(arg0 context.Context, arg1, arg2 uuid.UUID) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockUseCaseReadingListMockRecorder) GetList(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockUseCaseReadingList)(nil).GetList), arg0, arg1, arg2)
+}
+
+// GetLists mocks base method.
+func (m *MockUseCaseReadingList) GetLists(arg0 context.Context, arg1 uuid.UUID) ([]*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLists", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLists indicates an expected call of GetLists.
+func (mr *MockUseCaseReadingListMockRecorder) GetLists(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLists", reflect.TypeOf((*MockUseCaseReadingList)(nil).GetLists), arg0, arg1)
+}
+
+// GetSharedList mocks base method.
+func (m *MockUseCaseReadingList) GetSharedList(arg0 context.Context, arg1 string) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedList", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedList indicates an expected call of GetSharedList.
+func (mr *MockUseCaseReadingListMockRecorder) GetSharedList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedList", reflect.TypeOf((*MockUseCaseReadingList)(nil).GetSharedList), arg0, arg1)
+}
+
+// RemoveItem mocks base method.
+func (m *MockUseCaseReadingList) RemoveItem(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockUseCaseReadingListMockRecorder) RemoveItem(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockUseCaseReadingList)(nil).RemoveItem), arg0, arg1, arg2, arg3)
+}
+
+// ReorderItems mocks base method.
+func (m *MockUseCaseReadingList) ReorderItems(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 []uuid.UUID) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderItems", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderItems indicates an expected call of ReorderItems.
+func (mr *MockUseCaseReadingListMockRecorder) ReorderItems(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderItems", reflect.TypeOf((*MockUseCaseReadingList)(nil).ReorderItems), arg0, arg1, arg2, arg3)
+}
+
+// UpdateList mocks base method.
+func (m *MockUseCaseReadingList) UpdateList(arg0 context.Context, arg1 *entity.UpdateReadingList) (*entity.ReadingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateList", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReadingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockUseCaseReadingListMockRecorder) UpdateList(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockUseCaseReadingList)(nil).UpdateList), arg0, arg1)
+}
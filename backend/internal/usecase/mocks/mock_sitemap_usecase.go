@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseSitemap)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_sitemap_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseSitemap
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCaseSitemap is a mock of UseCaseSitemap interface.
+type MockUseCaseSitemap struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseSitemapMockRecorder
+}
+
+// MockUseCaseSitemapMockRecorder is the mock recorder for MockUseCaseSitemap.
+type MockUseCaseSitemapMockRecorder struct {
+	mock *MockUseCaseSitemap
+}
+
+// NewMockUseCaseSitemap creates a new mock instance.
+func NewMockUseCaseSitemap(ctrl *gomock.Controller) *MockUseCaseSitemap {
+	mock := &MockUseCaseSitemap{ctrl: ctrl}
+	mock.recorder = &MockUseCaseSitemapMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseSitemap) EXPECT() *MockUseCaseSitemapMockRecorder {
+	return m.recorder
+}
+
+// GetSitemap mocks base method.
+func (m *MockUseCaseSitemap) GetSitemap(arg0 context.Context, arg1 entity.SitemapChunk) ([]*entity.SitemapEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSitemap", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.SitemapEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSitemap indicates an expected call of GetSitemap.
+func (mr *MockUseCaseSitemapMockRecorder) GetSitemap(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSitemap", reflect.TypeOf((*MockUseCaseSitemap)(nil).GetSitemap), arg0, arg1)
+}
+
+// GetSitemapIndex mocks base method.
+func (m *MockUseCaseSitemap) GetSitemapIndex(arg0 context.Context) ([]entity.SitemapChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSitemapIndex", arg0)
+	ret0, _ := ret[0].([]entity.SitemapChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSitemapIndex indicates an expected call of GetSitemapIndex.
+func (mr *MockUseCaseSitemapMockRecorder) GetSitemapIndex(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSitemapIndex", reflect.TypeOf((*MockUseCaseSitemap)(nil).GetSitemapIndex), arg0)
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseStream)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_stream_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseStream
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	events "github.com/popeskul/awesome-blog/backend/internal/events"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCaseStream is a mock of UseCaseStream interface.
+type MockUseCaseStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseStreamMockRecorder
+}
+
+// MockUseCaseStreamMockRecorder is the mock recorder for MockUseCaseStream.
+type MockUseCaseStreamMockRecorder struct {
+	mock *MockUseCaseStream
+}
+
+// NewMockUseCaseStream creates a new mock instance.
+func NewMockUseCaseStream(ctrl *gomock.Controller) *MockUseCaseStream {
+	mock := &MockUseCaseStream{ctrl: ctrl}
+	mock.recorder = &MockUseCaseStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseStream) EXPECT() *MockUseCaseStreamMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockUseCaseStream) Subscribe(arg0 context.Context, arg1 uuid.UUID, arg2 []string, arg3 int64) (*events.Subscription, []*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*events.Subscription)
+	ret1, _ := ret[1].([]*entity.Event)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockUseCaseStreamMockRecorder) Subscribe(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUseCaseStream)(nil).Subscribe), arg0, arg1, arg2, arg3)
+}
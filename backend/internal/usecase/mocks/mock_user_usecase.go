@@ -11,11 +11,10 @@ package mockusecase
 
 import (
 	context "context"
-	reflect "reflect"
-
 	uuid "github.com/google/uuid"
 	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
 )
 
 // MockUseCaseUser is a mock of UseCaseUser interface.
@@ -41,6 +40,20 @@ func (m *MockUseCaseUser) EXPECT() *MockUseCaseUserMockRecorder {
 	return m.recorder
 }
 
+// CanManageUser mocks base method.
+func (m *MockUseCaseUser) CanManageUser(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanManageUser", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CanManageUser indicates an expected call of CanManageUser.
+func (mr *MockUseCaseUserMockRecorder) CanManageUser(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanManageUser", reflect.TypeOf((*MockUseCaseUser)(nil).CanManageUser), arg0, arg1, arg2)
+}
+
 // CreateUser mocks base method.
 func (m *MockUseCaseUser) CreateUser(arg0 context.Context, arg1 *entity.NewUser) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -71,10 +84,10 @@ func (mr *MockUseCaseUserMockRecorder) DeleteUserByID(arg0, arg1 any) *gomock.Ca
 }
 
 // GetAllUsers mocks base method.
-func (m *MockUseCaseUser) GetAllUsers(arg0 context.Context, arg1 *entity.Pagination) (*entity.UserList, error) {
+func (m *MockUseCaseUser) GetAllUsers(arg0 context.Context, arg1 *entity.Pagination) (*entity.Response[entity.User], error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllUsers", arg0, arg1)
-	ret0, _ := ret[0].(*entity.UserList)
+	ret0, _ := ret[0].(*entity.Response[entity.User])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -100,6 +113,21 @@ func (mr *MockUseCaseUserMockRecorder) GetUserByID(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUseCaseUser)(nil).GetUserByID), arg0, arg1)
 }
 
+// PatchUserByID mocks base method.
+func (m *MockUseCaseUser) PatchUserByID(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 *entity.User, arg4 []string) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchUserByID", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchUserByID indicates an expected call of PatchUserByID.
+func (mr *MockUseCaseUserMockRecorder) PatchUserByID(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUserByID", reflect.TypeOf((*MockUseCaseUser)(nil).PatchUserByID), arg0, arg1, arg2, arg3, arg4)
+}
+
 // UpdateUserByID mocks base method.
 func (m *MockUseCaseUser) UpdateUserByID(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.UpdateUser) (*entity.User, error) {
 	m.ctrl.T.Helper()
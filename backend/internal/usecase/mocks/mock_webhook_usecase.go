@@ -0,0 +1,225 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/popeskul/awesome-blog/backend/internal/usecase (interfaces: UseCaseWebhook,WebhookDeliverer)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_webhook_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseWebhook,WebhookDeliverer
+//
+
+// Package mockusecase is a generated GoMock package.
+package mockusecase
+
+import (
+	context "context"
+	uuid "github.com/google/uuid"
+	entity "github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+	reflect "reflect"
+)
+
+// MockUseCaseWebhook is a mock of UseCaseWebhook interface.
+type MockUseCaseWebhook struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseWebhookMockRecorder
+}
+
+// MockUseCaseWebhookMockRecorder is the mock recorder for MockUseCaseWebhook.
+type MockUseCaseWebhookMockRecorder struct {
+	mock *MockUseCaseWebhook
+}
+
+// NewMockUseCaseWebhook creates a new mock instance.
+func NewMockUseCaseWebhook(ctrl *gomock.Controller) *MockUseCaseWebhook {
+	mock := &MockUseCaseWebhook{ctrl: ctrl}
+	mock.recorder = &MockUseCaseWebhookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCaseWebhook) EXPECT() *MockUseCaseWebhookMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockUseCaseWebhook) CreateWebhook(arg0 context.Context, arg1 uuid.UUID, arg2 *entity.NewWebhook) (*entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockUseCaseWebhookMockRecorder) CreateWebhook(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockUseCaseWebhook)(nil).CreateWebhook), arg0, arg1, arg2)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockUseCaseWebhook) DeleteWebhook(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockUseCaseWebhookMockRecorder) DeleteWebhook(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockUseCaseWebhook)(nil).DeleteWebhook), arg0, arg1, arg2)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockUseCaseWebhook) GetDeliveries(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 entity.WebhookDeliveryStatus, arg4 *entity.Pagination) (*entity.Response[entity.WebhookDelivery], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*entity.Response[entity.WebhookDelivery])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockUseCaseWebhookMockRecorder) GetDeliveries(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockUseCaseWebhook)(nil).GetDeliveries), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetWebhook mocks base method.
+func (m *MockUseCaseWebhook) GetWebhook(arg0 context.Context, arg1, arg2 uuid.UUID) (*entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockUseCaseWebhookMockRecorder) GetWebhook(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockUseCaseWebhook)(nil).GetWebhook), arg0, arg1, arg2)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockUseCaseWebhook) GetWebhooks(arg0 context.Context, arg1 uuid.UUID) ([]*entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockUseCaseWebhookMockRecorder) GetWebhooks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockUseCaseWebhook)(nil).GetWebhooks), arg0, arg1)
+}
+
+// Publish mocks base method.
+func (m *MockUseCaseWebhook) Publish(arg0 context.Context, arg1, arg2 string, arg3 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockUseCaseWebhookMockRecorder) Publish(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockUseCaseWebhook)(nil).Publish), arg0, arg1, arg2, arg3)
+}
+
+// Redeliver mocks base method.
+func (m *MockUseCaseWebhook) Redeliver(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockUseCaseWebhookMockRecorder) Redeliver(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockUseCaseWebhook)(nil).Redeliver), arg0, arg1, arg2, arg3)
+}
+
+// TestWebhook mocks base method.
+func (m *MockUseCaseWebhook) TestWebhook(arg0 context.Context, arg1, arg2 uuid.UUID) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestWebhook", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestWebhook indicates an expected call of TestWebhook.
+func (mr *MockUseCaseWebhookMockRecorder) TestWebhook(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestWebhook", reflect.TypeOf((*MockUseCaseWebhook)(nil).TestWebhook), arg0, arg1, arg2)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockUseCaseWebhook) UpdateWebhook(arg0 context.Context, arg1, arg2 uuid.UUID, arg3 *entity.UpdateWebhook) (*entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockUseCaseWebhookMockRecorder) UpdateWebhook(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockUseCaseWebhook)(nil).UpdateWebhook), arg0, arg1, arg2, arg3)
+}
+
+// MockWebhookDeliverer is a mock of WebhookDeliverer interface.
+type MockWebhookDeliverer struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDelivererMockRecorder
+}
+
+// MockWebhookDelivererMockRecorder is the mock recorder for MockWebhookDeliverer.
+type MockWebhookDelivererMockRecorder struct {
+	mock *MockWebhookDeliverer
+}
+
+// NewMockWebhookDeliverer creates a new mock instance.
+func NewMockWebhookDeliverer(ctrl *gomock.Controller) *MockWebhookDeliverer {
+	mock := &MockWebhookDeliverer{ctrl: ctrl}
+	mock.recorder = &MockWebhookDelivererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliverer) EXPECT() *MockWebhookDelivererMockRecorder {
+	return m.recorder
+}
+
+// Deliver mocks base method.
+func (m *MockWebhookDeliverer) Deliver(arg0 context.Context, arg1 *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockWebhookDelivererMockRecorder) Deliver(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockWebhookDeliverer)(nil).Deliver), arg0, arg1)
+}
+
+// Enqueue mocks base method.
+func (m *MockWebhookDeliverer) Enqueue(arg0 context.Context, arg1 *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockWebhookDelivererMockRecorder) Enqueue(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockWebhookDeliverer)(nil).Enqueue), arg0, arg1)
+}
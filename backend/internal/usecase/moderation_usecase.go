@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+	"github.com/popeskul/awesome-blog/backend/internal/spam"
+)
+
+type moderationUseCase struct {
+	commentRepo repository.CommentRepository
+	postRepo    repository.PostRepository
+	userRepo    repository.UserRepository
+	uow         repository.UnitOfWork
+	logger      *logrus.Logger
+	trainer     spam.Trainer
+	notifier    Notifier
+	publisher   events.Publisher
+}
+
+func NewModerationUseCase(
+	commentRepo repository.CommentRepository,
+	postRepo repository.PostRepository,
+	userRepo repository.UserRepository,
+	uow repository.UnitOfWork,
+	logger *logrus.Logger,
+	trainer spam.Trainer,
+	notifier Notifier,
+	publisher events.Publisher,
+) UseCaseModeration {
+	return &moderationUseCase{
+		commentRepo: commentRepo,
+		postRepo:    postRepo,
+		userRepo:    userRepo,
+		uow:         uow,
+		logger:      logger,
+		trainer:     trainer,
+		notifier:    notifier,
+		publisher:   publisher,
+	}
+}
+
+func (uc *moderationUseCase) GetQueue(ctx context.Context, moderatorID uuid.UUID, status entity.CommentStatus, pagination *entity.Pagination) (*entity.Response[entity.Comment], error) {
+	if _, err := uc.requireModerator(ctx, moderatorID); err != nil {
+		return nil, err
+	}
+
+	if status == "" {
+		status = entity.CommentStatusPending
+	}
+
+	if !status.IsValid() {
+		return nil, ErrInvalidStatus
+	}
+
+	if err := entity.ValidatePagination(pagination); err != nil {
+		return nil, err
+	}
+	if _, err := entity.ParseCommentSort(pagination.Sort); err != nil {
+		return nil, err
+	}
+
+	rows, err := uc.commentRepo.GetCommentsByStatus(ctx, status, pagination.Lookahead())
+	if err != nil {
+		uc.logger.WithError(err).WithField("status", status).Error("Failed to get moderation queue")
+		return nil, fmt.Errorf("failed to get moderation queue: %w", err)
+	}
+	comments, more := entity.TrimPage(rows, pagination)
+
+	var total int
+	if pagination.WithTotal {
+		total, err = uc.commentRepo.GetTotalCommentsByStatus(ctx, status)
+		if err != nil {
+			uc.logger.WithError(err).WithField("status", status).Error("Failed to get moderation queue size")
+			return nil, fmt.Errorf("failed to get moderation queue size: %w", err)
+		}
+	}
+
+	response := entity.NewResponse(comments, more, pagination, func(comment *entity.Comment) entity.Cursor {
+		return entity.Cursor{CreatedAt: comment.CreatedAt, Id: comment.Id}
+	})
+	response.Pagination.Total = total
+
+	return response, nil
+}
+
+func (uc *moderationUseCase) ModerateComments(ctx context.Context, moderatorID uuid.UUID, decision *entity.ModerationDecision) (int64, error) {
+	if _, err := uc.requireModerator(ctx, moderatorID); err != nil {
+		return 0, err
+	}
+
+	if decision == nil || len(decision.CommentIds) == 0 {
+		return 0, ErrInvalidModeration
+	}
+
+	status, ok := decision.Action.Status()
+	if !ok {
+		return 0, ErrInvalidModeration
+	}
+
+	var comments, published []*entity.Comment
+	var updated int64
+	err := transact(ctx, uc.uow, func(ctx context.Context) error {
+		var err error
+		comments, err = uc.commentRepo.GetCommentsByIds(ctx, decision.CommentIds)
+		if err != nil {
+			uc.logger.WithError(err).Error("Failed to load comments to moderate")
+			return fmt.Errorf("failed to moderate comments: %w", err)
+		}
+
+		updated, err = uc.commentRepo.UpdateCommentsStatus(ctx, decision.CommentIds, status, moderatorID)
+		if err != nil {
+			uc.logger.WithError(err).Error("Failed to moderate comments")
+			return fmt.Errorf("failed to moderate comments: %w", err)
+		}
+
+		published, err = uc.recordDecision(ctx, comments, status)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	uc.afterDecision(ctx, moderatorID, comments, published, status)
+
+	uc.logger.WithFields(logrus.Fields{
+		"moderatorID": moderatorID,
+		"action":      decision.Action,
+		"requested":   len(decision.CommentIds),
+		"updated":     updated,
+	}).Info("Comments moderated successfully")
+
+	return updated, nil
+}
+
+func (uc *moderationUseCase) SetPostModeration(ctx context.Context, userID uuid.UUID, postID uuid.UUID, mode entity.ModerationMode) error {
+	if !mode.IsValid() {
+		return ErrInvalidModeration
+	}
+
+	post, err := uc.postRepo.GetPostById(ctx, postID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to get post")
+		return ErrPostNotFound
+	}
+
+	user, err := uc.userRepo.GetUserById(ctx, userID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get user")
+		return ErrUserNotFound
+	}
+
+	if post.AuthorId != user.Id && !user.CanModerate() {
+		return ErrUnauthorized
+	}
+
+	if err := uc.postRepo.SetCommentModeration(ctx, postID, mode); err != nil {
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to set comment moderation")
+		return fmt.Errorf("failed to set comment moderation: %w", err)
+	}
+
+	return nil
+}
+
+// recordDecision announces the comments the decision changed for readers:
+// approved ones appear like any new comment and ones taken down are gone.
+// It returns the comments that were published by the decision.
+func (uc *moderationUseCase) recordDecision(ctx context.Context, comments []*entity.Comment, status entity.CommentStatus) ([]*entity.Comment, error) {
+	var published []*entity.Comment
+	for _, comment := range comments {
+		wasApproved := comment.Status == entity.CommentStatusApproved
+		comment.Status = status
+		comment.Version++
+
+		topic := entity.PostCommentsTopic(comment.PostId)
+		switch {
+		case status == entity.CommentStatusApproved && !wasApproved:
+			published = append(published, comment)
+			if err := record(ctx, uc.publisher, topic, entity.EventCommentCreated, comment); err != nil {
+				return nil, err
+			}
+		case status != entity.CommentStatusApproved && wasApproved:
+			if err := record(ctx, uc.publisher, topic, entity.EventCommentDeleted, map[string]uuid.UUID{"id": comment.Id}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return published, nil
+}
+
+// afterDecision trains the spam classifier and tells the authors about the
+// decision. Comments it published are announced to the people a comment
+// published right away would have notified. The decision itself is already
+// stored, so problems here are only logged.
+func (uc *moderationUseCase) afterDecision(ctx context.Context, moderatorID uuid.UUID, comments, published []*entity.Comment, status entity.CommentStatus) {
+	uc.train(ctx, comments, status)
+
+	if uc.notifier == nil {
+		return
+	}
+
+	for _, comment := range comments {
+		notify(ctx, uc.notifier, uc.logger, &entity.Notification{
+			RecipientId: comment.AuthorId,
+			ActorId:     &moderatorID,
+			Type:        entity.NotificationTypeModeration,
+			PostId:      &comment.PostId,
+			CommentId:   &comment.Id,
+			Detail:      string(status),
+		})
+	}
+
+	for _, comment := range published {
+		post, err := uc.postRepo.GetPostById(ctx, comment.PostId)
+		if err != nil {
+			uc.logger.WithError(err).WithField("postID", comment.PostId).Warn("Failed to get post of approved comment")
+			continue
+		}
+
+		var parent *entity.Comment
+		if comment.ParentId != nil {
+			parent, err = uc.commentRepo.GetCommentById(ctx, *comment.ParentId)
+			if err != nil {
+				uc.logger.WithError(err).WithField("commentID", *comment.ParentId).Warn("Failed to get parent of approved comment")
+			}
+		}
+
+		notifyNewComment(ctx, uc.notifier, uc.userRepo, uc.logger, post, parent, comment)
+	}
+}
+
+// train feeds a decision to the spam classifier. Approved comments are ham,
+// rejected ones and spam are spam.
+func (uc *moderationUseCase) train(ctx context.Context, comments []*entity.Comment, status entity.CommentStatus) {
+	if uc.trainer == nil {
+		return
+	}
+
+	isSpam := status != entity.CommentStatusApproved
+	for _, comment := range comments {
+		if err := uc.trainer.Train(ctx, comment.Id, comment.Content, isSpam); err != nil {
+			uc.logger.WithError(err).WithField("commentID", comment.Id).Warn("Failed to train spam classifier")
+		}
+	}
+}
+
+func (uc *moderationUseCase) requireModerator(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	user, err := uc.userRepo.GetUserById(ctx, userID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get user")
+		return nil, ErrUserNotFound
+	}
+
+	if !user.CanModerate() {
+		return nil, ErrNotModerator
+	}
+
+	return user, nil
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_moderation_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseModeration
+
+type UseCaseModeration interface {
+	GetQueue(ctx context.Context, moderatorID uuid.UUID, status entity.CommentStatus, pagination *entity.Pagination) (*entity.Response[entity.Comment], error)
+	ModerateComments(ctx context.Context, moderatorID uuid.UUID, decision *entity.ModerationDecision) (int64, error)
+	SetPostModeration(ctx context.Context, userID uuid.UUID, postID uuid.UUID, mode entity.ModerationMode) error
+}
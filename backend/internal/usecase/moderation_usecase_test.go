@@ -0,0 +1,436 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/events/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/spam/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase/mocks"
+)
+
+func TestGetQueue(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository)
+		status        entity.CommentStatus
+		pagination    *entity.Pagination
+		expectedLen   int
+		expectedTotal int
+		expectNext    bool
+		expectedError error
+	}{
+		{
+			name: "Pending comments by default",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+				commentRepo.EXPECT().
+					GetCommentsByStatus(gomock.Any(), entity.CommentStatusPending, gomock.Any()).
+					Return([]*entity.Comment{{Id: commentId1, Status: entity.CommentStatusPending}}, nil).Times(1)
+				commentRepo.EXPECT().
+					GetTotalCommentsByStatus(gomock.Any(), entity.CommentStatusPending).
+					Return(1, nil).Times(1)
+			},
+			pagination:    &entity.Pagination{Page: 1, Limit: 10, WithTotal: true},
+			expectedLen:   1,
+			expectedTotal: 1,
+		},
+		{
+			name: "A full page has a next cursor and no total unless asked",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+				commentRepo.EXPECT().
+					GetCommentsByStatus(gomock.Any(), entity.CommentStatusPending, &entity.Pagination{Page: 1, Limit: 2}).
+					Return([]*entity.Comment{{Id: commentId1}, {Id: commentId2}}, nil).Times(1)
+			},
+			pagination:  &entity.Pagination{Page: 1, Limit: 1},
+			expectedLen: 1,
+			expectNext:  true,
+		},
+		{
+			name: "Regular users cannot see the queue",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleUser}, nil).Times(1)
+			},
+			pagination:    &entity.Pagination{Page: 1, Limit: 10},
+			expectedError: usecase.ErrNotModerator,
+		},
+		{
+			name: "Invalid status",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+			},
+			status:        "deleted",
+			pagination:    &entity.Pagination{Page: 1, Limit: 10},
+			expectedError: usecase.ErrInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewModerationUseCase(commentRepo, postRepo, userRepo, nil, logrus.New(), nil, nil, nil)
+
+			tt.mockSetup(commentRepo, userRepo)
+
+			result, err := uc.GetQueue(context.Background(), authorId1, tt.status, tt.pagination)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedTotal, result.Pagination.Total)
+			assert.Len(t, result.Data, tt.expectedLen)
+			assert.Equal(t, tt.expectNext, result.NextCursor != "")
+		})
+	}
+}
+
+func TestModerateComments(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockSetup       func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository)
+		decision        *entity.ModerationDecision
+		expectedUpdated int64
+		expectedError   string
+	}{
+		{
+			name: "Approve comments",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+				commentRepo.EXPECT().
+					GetCommentsByIds(gomock.Any(), []uuid.UUID{commentId1, commentId2}).
+					Return([]*entity.Comment{{Id: commentId1}, {Id: commentId2}}, nil).Times(1)
+				commentRepo.EXPECT().
+					UpdateCommentsStatus(gomock.Any(), []uuid.UUID{commentId1, commentId2}, entity.CommentStatusApproved, authorId1).
+					Return(int64(2), nil).Times(1)
+			},
+			decision: &entity.ModerationDecision{
+				CommentIds: []uuid.UUID{commentId1, commentId2},
+				Action:     entity.ModerationActionApprove,
+			},
+			expectedUpdated: 2,
+		},
+		{
+			name: "Mark as spam",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+				commentRepo.EXPECT().
+					GetCommentsByIds(gomock.Any(), []uuid.UUID{commentId1}).
+					Return([]*entity.Comment{{Id: commentId1}}, nil).Times(1)
+				commentRepo.EXPECT().
+					UpdateCommentsStatus(gomock.Any(), []uuid.UUID{commentId1}, entity.CommentStatusSpam, authorId1).
+					Return(int64(1), nil).Times(1)
+			},
+			decision: &entity.ModerationDecision{
+				CommentIds: []uuid.UUID{commentId1},
+				Action:     entity.ModerationActionSpam,
+			},
+			expectedUpdated: 1,
+		},
+		{
+			name: "Not a moderator",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleUser}, nil).Times(1)
+			},
+			decision: &entity.ModerationDecision{
+				CommentIds: []uuid.UUID{commentId1},
+				Action:     entity.ModerationActionApprove,
+			},
+			expectedError: usecase.ErrNotModerator.Error(),
+		},
+		{
+			name: "Unknown action",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+			},
+			decision: &entity.ModerationDecision{
+				CommentIds: []uuid.UUID{commentId1},
+				Action:     "delete",
+			},
+			expectedError: usecase.ErrInvalidModeration.Error(),
+		},
+		{
+			name: "Repository error",
+			mockSetup: func(commentRepo *mocksrepository.MockCommentRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+				commentRepo.EXPECT().
+					GetCommentsByIds(gomock.Any(), gomock.Any()).
+					Return([]*entity.Comment{{Id: commentId1}}, nil).Times(1)
+				commentRepo.EXPECT().
+					UpdateCommentsStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(int64(0), errors.New("db error")).Times(1)
+			},
+			decision: &entity.ModerationDecision{
+				CommentIds: []uuid.UUID{commentId1},
+				Action:     entity.ModerationActionReject,
+			},
+			expectedError: "failed to moderate comments: db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewModerationUseCase(commentRepo, postRepo, userRepo, nil, logrus.New(), nil, nil, nil)
+
+			tt.mockSetup(commentRepo, userRepo)
+
+			updated, err := uc.ModerateComments(context.Background(), authorId1, tt.decision)
+
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				assert.Zero(t, updated)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedUpdated, updated)
+		})
+	}
+}
+
+func TestModerateComments_TrainsClassifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   entity.ModerationAction
+		status   entity.CommentStatus
+		isSpam   bool
+		trainErr error
+	}{
+		{name: "Approval is ham", action: entity.ModerationActionApprove, status: entity.CommentStatusApproved},
+		{name: "Rejection is spam", action: entity.ModerationActionReject, status: entity.CommentStatusRejected, isSpam: true},
+		{name: "Spam is spam", action: entity.ModerationActionSpam, status: entity.CommentStatusSpam, isSpam: true},
+		{
+			name:     "Training failure does not fail the decision",
+			action:   entity.ModerationActionSpam,
+			status:   entity.CommentStatusSpam,
+			isSpam:   true,
+			trainErr: errors.New("db error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			trainer := mocksspam.NewMockTrainer(ctrl)
+			uc := usecase.NewModerationUseCase(commentRepo, postRepo, userRepo, nil, logrus.New(), trainer, nil, nil)
+
+			ids := []uuid.UUID{commentId1}
+
+			userRepo.EXPECT().
+				GetUserById(gomock.Any(), authorId1).
+				Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+			commentRepo.EXPECT().
+				UpdateCommentsStatus(gomock.Any(), ids, tt.status, authorId1).
+				Return(int64(1), nil).Times(1)
+			commentRepo.EXPECT().
+				GetCommentsByIds(gomock.Any(), ids).
+				Return([]*entity.Comment{{Id: commentId1, Content: "Great post"}}, nil).Times(1)
+			trainer.EXPECT().
+				Train(gomock.Any(), commentId1, "Great post", tt.isSpam).
+				Return(tt.trainErr).Times(1)
+
+			updated, err := uc.ModerateComments(context.Background(), authorId1, &entity.ModerationDecision{
+				CommentIds: ids,
+				Action:     tt.action,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), updated)
+		})
+	}
+}
+
+func TestModerateComments_Approval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	userRepo := mocksrepository.NewMockUserRepository(ctrl)
+	uow := mocksrepository.NewMockUnitOfWork(ctrl)
+	notifier := mockusecase.NewMockNotifier(ctrl)
+	publisher := mocksevents.NewMockPublisher(ctrl)
+	uc := usecase.NewModerationUseCase(commentRepo, postRepo, userRepo, uow, logrus.New(), nil, notifier, publisher)
+
+	moderatorId := uuid.New()
+	parentAuthorId := uuid.New()
+	parentId := uuid.New()
+	ids := []uuid.UUID{commentId1, commentId2}
+	inTx := func(ctx context.Context) bool { return ctx.Value(txContextKey{}) != nil }
+
+	userRepo.EXPECT().
+		GetUserById(gomock.Any(), moderatorId).
+		Return(&entity.User{Id: moderatorId, Role: entity.RoleModerator}, nil).Times(1)
+	uow.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(context.WithValue(ctx, txContextKey{}, true))
+		}).Times(1)
+	commentRepo.EXPECT().
+		GetCommentsByIds(gomock.Any(), ids).
+		Return([]*entity.Comment{
+			{Id: commentId1, PostId: postId1, AuthorId: authorId2, ParentId: &parentId, Content: "Agreed", Status: entity.CommentStatusPending},
+			{Id: commentId2, PostId: postId1, AuthorId: authorId2, Content: "Already out", Status: entity.CommentStatusApproved},
+		}, nil).Times(1)
+	commentRepo.EXPECT().
+		UpdateCommentsStatus(gomock.Any(), ids, entity.CommentStatusApproved, moderatorId).
+		Return(int64(2), nil).Times(1)
+	publisher.EXPECT().
+		Publish(gomock.Any(), entity.PostCommentsTopic(postId1), entity.EventCommentCreated, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _, _ string, payload any) error {
+			assert.True(t, inTx(ctx))
+			assert.Equal(t, commentId1, payload.(*entity.Comment).Id)
+			assert.Equal(t, entity.CommentStatusApproved, payload.(*entity.Comment).Status)
+			return nil
+		}).Times(1)
+
+	postRepo.EXPECT().
+		GetPostById(gomock.Any(), postId1).
+		Return(&entity.Post{Id: postId1, AuthorId: authorId1}, nil).Times(1)
+	commentRepo.EXPECT().
+		GetCommentById(gomock.Any(), parentId).
+		Return(&entity.Comment{Id: parentId, PostId: postId1, AuthorId: parentAuthorId}, nil).Times(1)
+
+	var sent []entity.NotificationType
+	notifier.EXPECT().
+		Notify(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification *entity.Notification) error {
+			sent = append(sent, notification.Type)
+			return nil
+		}).Times(4)
+
+	updated, err := uc.ModerateComments(context.Background(), moderatorId, &entity.ModerationDecision{
+		CommentIds: ids,
+		Action:     entity.ModerationActionApprove,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updated)
+	assert.Equal(t, []entity.NotificationType{
+		entity.NotificationTypeModeration,
+		entity.NotificationTypeModeration,
+		entity.NotificationTypeReply,
+		entity.NotificationTypeComment,
+	}, sent)
+}
+
+func TestSetPostModeration(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository)
+		mode          entity.ModerationMode
+		expectedError error
+	}{
+		{
+			name: "Post author closes comments",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {
+				postRepo.EXPECT().GetPostById(gomock.Any(), postId1).Return(&entity.Post{Id: postId1, AuthorId: authorId1}, nil).Times(1)
+				userRepo.EXPECT().GetUserById(gomock.Any(), authorId1).Return(&entity.User{Id: authorId1}, nil).Times(1)
+				postRepo.EXPECT().SetCommentModeration(gomock.Any(), postId1, entity.ModerationClosed).Return(nil).Times(1)
+			},
+			mode: entity.ModerationClosed,
+		},
+		{
+			name: "Moderator resets to the global setting",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {
+				postRepo.EXPECT().GetPostById(gomock.Any(), postId1).Return(&entity.Post{Id: postId1, AuthorId: authorId2}, nil).Times(1)
+				userRepo.EXPECT().GetUserById(gomock.Any(), authorId1).Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+				postRepo.EXPECT().SetCommentModeration(gomock.Any(), postId1, entity.ModerationInherit).Return(nil).Times(1)
+			},
+			mode: entity.ModerationInherit,
+		},
+		{
+			name: "Other users are rejected",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {
+				postRepo.EXPECT().GetPostById(gomock.Any(), postId1).Return(&entity.Post{Id: postId1, AuthorId: authorId2}, nil).Times(1)
+				userRepo.EXPECT().GetUserById(gomock.Any(), authorId1).Return(&entity.User{Id: authorId1}, nil).Times(1)
+			},
+			mode:          entity.ModerationModerated,
+			expectedError: usecase.ErrUnauthorized,
+		},
+		{
+			name:          "Invalid mode",
+			mockSetup:     func(postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {},
+			mode:          "locked",
+			expectedError: usecase.ErrInvalidModeration,
+		},
+		{
+			name: "Post not found",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {
+				postRepo.EXPECT().GetPostById(gomock.Any(), postId1).Return(nil, errors.New("post not found")).Times(1)
+			},
+			mode:          entity.ModerationOpen,
+			expectedError: usecase.ErrPostNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewModerationUseCase(commentRepo, postRepo, userRepo, nil, logrus.New(), nil, nil, nil)
+
+			tt.mockSetup(postRepo, userRepo)
+
+			err := uc.SetPostModeration(context.Background(), authorId1, postId1, tt.mode)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
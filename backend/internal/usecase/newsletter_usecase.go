@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/newsletter"
+)
+
+type newsletterUseCase struct {
+	newsletterRepo repository.NewsletterRepository
+	userRepo       repository.UserRepository
+	uow            repository.UnitOfWork
+	logger         *logrus.Logger
+	emailer        NewsletterEmailer
+	signer         *newsletter.Signer
+}
+
+// NewNewsletterUseCase creates the newsletter sign-up flow. emailer may be
+// nil when no mailer is configured, in which case sign-ups are refused.
+func NewNewsletterUseCase(
+	newsletterRepo repository.NewsletterRepository,
+	userRepo repository.UserRepository,
+	uow repository.UnitOfWork,
+	logger *logrus.Logger,
+	emailer NewsletterEmailer,
+	signer *newsletter.Signer,
+) UseCaseNewsletter {
+	return &newsletterUseCase{
+		newsletterRepo: newsletterRepo,
+		userRepo:       userRepo,
+		uow:            uow,
+		logger:         logger,
+		emailer:        emailer,
+		signer:         signer,
+	}
+}
+
+// Subscribe adds an unconfirmed subscription and emails the confirmation
+// link. It succeeds whether or not the address was already subscribed, so
+// that it can't be used to find out who is.
+func (uc *newsletterUseCase) Subscribe(ctx context.Context, subscription *entity.NewSubscription) error {
+	if uc.emailer == nil {
+		return ErrNewsletterDisabled
+	}
+
+	if subscription.AuthorId != nil && subscription.Tag != nil {
+		return ErrInvalidSubscription
+	}
+
+	var scope string
+	var tag *string
+	switch {
+	case subscription.AuthorId != nil:
+		author, err := uc.userRepo.GetUserById(ctx, *subscription.AuthorId)
+		if err != nil {
+			uc.logger.WithError(err).WithField("authorID", *subscription.AuthorId).Error("Failed to get user")
+			return ErrUserNotFound
+		}
+		scope = "posts by " + author.Username
+	case subscription.Tag != nil:
+		tags := entity.NormalizeTags([]string{*subscription.Tag})
+		if len(tags) == 0 {
+			return ErrInvalidSubscription
+		}
+		tag = &tags[0]
+		scope = "posts tagged " + *tag
+	}
+
+	email := strings.ToLower(strings.TrimSpace(subscription.Email))
+
+	return transact(ctx, uc.uow, func(ctx context.Context) error {
+		subscriber, err := uc.newsletterRepo.UpsertSubscriber(ctx, email)
+		if err != nil {
+			uc.logger.WithError(err).Error("Failed to upsert subscriber")
+			return err
+		}
+
+		if err := uc.newsletterRepo.AddSubscription(ctx, subscriber.Id, subscription.AuthorId, tag); err != nil {
+			uc.logger.WithError(err).WithField("subscriberID", subscriber.Id).Error("Failed to add subscription")
+			return err
+		}
+
+		if err := uc.emailer.EmailConfirmation(ctx, subscriber, scope); err != nil {
+			uc.logger.WithError(err).WithField("subscriberID", subscriber.Id).Error("Failed to email confirmation")
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (uc *newsletterUseCase) Confirm(ctx context.Context, token string) (*entity.Subscriber, error) {
+	id, err := uc.signer.Verify(newsletter.PurposeConfirm, token)
+	if err != nil {
+		return nil, ErrInvalidNewsletterToken
+	}
+
+	subscriber, err := uc.newsletterRepo.ConfirmSubscriber(ctx, id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("subscriberID", id).Error("Failed to confirm subscriber")
+		return nil, ErrInvalidNewsletterToken
+	}
+
+	return subscriber, nil
+}
+
+func (uc *newsletterUseCase) CheckUnsubscribe(ctx context.Context, token string) (*entity.Subscriber, error) {
+	id, err := uc.signer.Verify(newsletter.PurposeUnsubscribe, token)
+	if err != nil {
+		return nil, ErrInvalidNewsletterToken
+	}
+
+	subscriber, err := uc.newsletterRepo.GetSubscriber(ctx, id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("subscriberID", id).Error("Failed to get subscriber")
+		return nil, ErrInvalidNewsletterToken
+	}
+
+	return subscriber, nil
+}
+
+func (uc *newsletterUseCase) Unsubscribe(ctx context.Context, token string) error {
+	id, err := uc.signer.Verify(newsletter.PurposeUnsubscribe, token)
+	if err != nil {
+		return ErrInvalidNewsletterToken
+	}
+
+	if err := uc.newsletterRepo.Unsubscribe(ctx, id); err != nil {
+		uc.logger.WithError(err).WithField("subscriberID", id).Error("Failed to unsubscribe")
+		return ErrInvalidNewsletterToken
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_newsletter_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseNewsletter,NewsletterEmailer
+
+// NewsletterEmailer sends the double opt-in email. scope describes what was
+// subscribed to; empty means the whole blog.
+type NewsletterEmailer interface {
+	EmailConfirmation(ctx context.Context, subscriber *entity.Subscriber, scope string) error
+}
+
+// UseCaseNewsletter handles anonymous newsletter sign-ups. Confirm,
+// CheckUnsubscribe and Unsubscribe take the signed token from the emailed
+// link.
+type UseCaseNewsletter interface {
+	Subscribe(ctx context.Context, subscription *entity.NewSubscription) error
+	Confirm(ctx context.Context, token string) (*entity.Subscriber, error)
+	// CheckUnsubscribe returns the subscriber an unsubscribe link is for,
+	// changing nothing, so the reader can confirm before Unsubscribe.
+	CheckUnsubscribe(ctx context.Context, token string) (*entity.Subscriber, error)
+	Unsubscribe(ctx context.Context, token string) error
+}
@@ -0,0 +1,214 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/newsletter"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase/mocks"
+)
+
+func TestSubscribe(t *testing.T) {
+	subscriberId := uuid.New()
+	tag := " Go "
+	normalizedTag := "go"
+
+	tests := []struct {
+		name          string
+		subscription  *entity.NewSubscription
+		disabled      bool
+		mockSetup     func(newsletterRepo *mocksrepository.MockNewsletterRepository, userRepo *mocksrepository.MockUserRepository, emailer *mockusecase.MockNewsletterEmailer)
+		expectedError error
+	}{
+		{
+			name:         "Whole blog",
+			subscription: &entity.NewSubscription{Email: " Reader@Example.com "},
+			mockSetup: func(newsletterRepo *mocksrepository.MockNewsletterRepository, userRepo *mocksrepository.MockUserRepository, emailer *mockusecase.MockNewsletterEmailer) {
+				subscriber := &entity.Subscriber{Id: subscriberId, Email: "reader@example.com"}
+				newsletterRepo.EXPECT().UpsertSubscriber(gomock.Any(), "reader@example.com").Return(subscriber, nil).Times(1)
+				newsletterRepo.EXPECT().AddSubscription(gomock.Any(), subscriberId, nil, nil).Return(nil).Times(1)
+				emailer.EXPECT().EmailConfirmation(gomock.Any(), subscriber, "").Return(nil).Times(1)
+			},
+		},
+		{
+			name:         "Author",
+			subscription: &entity.NewSubscription{Email: "reader@example.com", AuthorId: &authorId1},
+			mockSetup: func(newsletterRepo *mocksrepository.MockNewsletterRepository, userRepo *mocksrepository.MockUserRepository, emailer *mockusecase.MockNewsletterEmailer) {
+				subscriber := &entity.Subscriber{Id: subscriberId, Email: "reader@example.com"}
+				userRepo.EXPECT().GetUserById(gomock.Any(), authorId1).Return(&entity.User{Id: authorId1, Username: "alice"}, nil).Times(1)
+				newsletterRepo.EXPECT().UpsertSubscriber(gomock.Any(), "reader@example.com").Return(subscriber, nil).Times(1)
+				newsletterRepo.EXPECT().AddSubscription(gomock.Any(), subscriberId, &authorId1, nil).Return(nil).Times(1)
+				emailer.EXPECT().EmailConfirmation(gomock.Any(), subscriber, "posts by alice").Return(nil).Times(1)
+			},
+		},
+		{
+			name:         "Tag is normalized",
+			subscription: &entity.NewSubscription{Email: "reader@example.com", Tag: &tag},
+			mockSetup: func(newsletterRepo *mocksrepository.MockNewsletterRepository, userRepo *mocksrepository.MockUserRepository, emailer *mockusecase.MockNewsletterEmailer) {
+				subscriber := &entity.Subscriber{Id: subscriberId, Email: "reader@example.com"}
+				newsletterRepo.EXPECT().UpsertSubscriber(gomock.Any(), "reader@example.com").Return(subscriber, nil).Times(1)
+				newsletterRepo.EXPECT().AddSubscription(gomock.Any(), subscriberId, nil, &normalizedTag).Return(nil).Times(1)
+				emailer.EXPECT().EmailConfirmation(gomock.Any(), subscriber, "posts tagged go").Return(nil).Times(1)
+			},
+		},
+		{
+			name:         "Author and tag at once",
+			subscription: &entity.NewSubscription{Email: "reader@example.com", AuthorId: &authorId1, Tag: &tag},
+			mockSetup: func(*mocksrepository.MockNewsletterRepository, *mocksrepository.MockUserRepository, *mockusecase.MockNewsletterEmailer) {
+			},
+			expectedError: usecase.ErrInvalidSubscription,
+		},
+		{
+			name:         "Unknown author",
+			subscription: &entity.NewSubscription{Email: "reader@example.com", AuthorId: &authorId2},
+			mockSetup: func(newsletterRepo *mocksrepository.MockNewsletterRepository, userRepo *mocksrepository.MockUserRepository, emailer *mockusecase.MockNewsletterEmailer) {
+				userRepo.EXPECT().GetUserById(gomock.Any(), authorId2).Return(nil, errors.New("user not found")).Times(1)
+			},
+			expectedError: usecase.ErrUserNotFound,
+		},
+		{
+			name:         "No mailer configured",
+			subscription: &entity.NewSubscription{Email: "reader@example.com"},
+			disabled:     true,
+			mockSetup: func(*mocksrepository.MockNewsletterRepository, *mocksrepository.MockUserRepository, *mockusecase.MockNewsletterEmailer) {
+			},
+			expectedError: usecase.ErrNewsletterDisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			newsletterRepo := mocksrepository.NewMockNewsletterRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			emailer := mockusecase.NewMockNewsletterEmailer(ctrl)
+
+			var newsletterEmailer usecase.NewsletterEmailer = emailer
+			if tt.disabled {
+				newsletterEmailer = nil
+			}
+			uc := usecase.NewNewsletterUseCase(newsletterRepo, userRepo, nil, logrus.New(), newsletterEmailer, newsletter.NewSigner("secret"))
+
+			tt.mockSetup(newsletterRepo, userRepo, emailer)
+
+			err := uc.Subscribe(context.Background(), tt.subscription)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConfirmSubscription(t *testing.T) {
+	subscriberId := uuid.New()
+	signer := newsletter.NewSigner("secret")
+
+	tests := []struct {
+		name          string
+		token         string
+		mockSetup     func(newsletterRepo *mocksrepository.MockNewsletterRepository)
+		expectedError error
+	}{
+		{
+			name:  "Valid token",
+			token: signer.Sign(newsletter.PurposeConfirm, subscriberId, time.Hour),
+			mockSetup: func(newsletterRepo *mocksrepository.MockNewsletterRepository) {
+				newsletterRepo.EXPECT().
+					ConfirmSubscriber(gomock.Any(), subscriberId).
+					Return(&entity.Subscriber{Id: subscriberId, Status: entity.SubscriberConfirmed}, nil).Times(1)
+			},
+		},
+		{
+			name:          "Unsubscribe token",
+			token:         signer.Sign(newsletter.PurposeUnsubscribe, subscriberId, 0),
+			mockSetup:     func(*mocksrepository.MockNewsletterRepository) {},
+			expectedError: usecase.ErrInvalidNewsletterToken,
+		},
+		{
+			name:  "Subscriber is gone",
+			token: signer.Sign(newsletter.PurposeConfirm, subscriberId, time.Hour),
+			mockSetup: func(newsletterRepo *mocksrepository.MockNewsletterRepository) {
+				newsletterRepo.EXPECT().
+					ConfirmSubscriber(gomock.Any(), subscriberId).
+					Return(nil, errors.New("subscriber not found")).Times(1)
+			},
+			expectedError: usecase.ErrInvalidNewsletterToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			newsletterRepo := mocksrepository.NewMockNewsletterRepository(ctrl)
+			uc := usecase.NewNewsletterUseCase(newsletterRepo, nil, nil, logrus.New(), nil, signer)
+
+			tt.mockSetup(newsletterRepo)
+
+			result, err := uc.Confirm(context.Background(), tt.token)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.SubscriberConfirmed, result.Status)
+		})
+	}
+}
+
+func TestCheckUnsubscribe(t *testing.T) {
+	subscriberId := uuid.New()
+	signer := newsletter.NewSigner("secret")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Checking the link reads the subscriber and leaves it subscribed.
+	newsletterRepo := mocksrepository.NewMockNewsletterRepository(ctrl)
+	newsletterRepo.EXPECT().
+		GetSubscriber(gomock.Any(), subscriberId).
+		Return(&entity.Subscriber{Id: subscriberId, Email: "reader@example.com", Status: entity.SubscriberConfirmed}, nil).Times(1)
+	uc := usecase.NewNewsletterUseCase(newsletterRepo, nil, nil, logrus.New(), nil, signer)
+
+	subscriber, err := uc.CheckUnsubscribe(context.Background(), signer.Sign(newsletter.PurposeUnsubscribe, subscriberId, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "reader@example.com", subscriber.Email)
+
+	_, err = uc.CheckUnsubscribe(context.Background(), signer.Sign(newsletter.PurposeConfirm, subscriberId, time.Hour))
+	assert.ErrorIs(t, err, usecase.ErrInvalidNewsletterToken)
+}
+
+func TestUnsubscribe(t *testing.T) {
+	subscriberId := uuid.New()
+	signer := newsletter.NewSigner("secret")
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newsletterRepo := mocksrepository.NewMockNewsletterRepository(ctrl)
+	newsletterRepo.EXPECT().Unsubscribe(gomock.Any(), subscriberId).Return(nil).Times(1)
+	uc := usecase.NewNewsletterUseCase(newsletterRepo, nil, nil, logrus.New(), nil, signer)
+
+	assert.NoError(t, uc.Unsubscribe(context.Background(), signer.Sign(newsletter.PurposeUnsubscribe, subscriberId, 0)))
+	assert.ErrorIs(t, uc.Unsubscribe(context.Background(), "garbage"), usecase.ErrInvalidNewsletterToken)
+}
@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+)
+
+type notificationUseCase struct {
+	notificationRepo repository.NotificationRepository
+	userRepo         repository.UserRepository
+	emailer          NotificationEmailer
+	logger           *logrus.Logger
+	publisher        events.Publisher
+}
+
+// NewNotificationUseCase creates the notification center. emailer may be nil,
+// in which case email preferences are stored but nothing is sent.
+func NewNotificationUseCase(
+	notificationRepo repository.NotificationRepository,
+	userRepo repository.UserRepository,
+	emailer NotificationEmailer,
+	logger *logrus.Logger,
+	publisher events.Publisher,
+) UseCaseNotification {
+	return &notificationUseCase{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+		emailer:          emailer,
+		logger:           logger,
+		publisher:        publisher,
+	}
+}
+
+// Notify stores and emails a notification as the recipient's preferences
+// for its type allow. Users are never notified about their own actions.
+func (uc *notificationUseCase) Notify(ctx context.Context, notification *entity.Notification) error {
+	if notification.ActorId != nil && *notification.ActorId == notification.RecipientId {
+		return nil
+	}
+
+	pref, err := uc.preference(ctx, notification.RecipientId, notification.Type)
+	if err != nil {
+		return err
+	}
+
+	if pref.InApp {
+		if err := uc.notificationRepo.CreateNotification(ctx, notification); err != nil {
+			uc.logger.WithError(err).WithField("recipientID", notification.RecipientId).Error("Failed to create notification")
+			return fmt.Errorf("failed to create notification: %w", err)
+		}
+
+		publish(ctx, uc.publisher, uc.logger, entity.UserNotificationsTopic(notification.RecipientId), entity.EventNotificationCreated, notification)
+	}
+
+	if pref.Email && uc.emailer != nil {
+		recipient, err := uc.userRepo.GetUserById(ctx, notification.RecipientId)
+		if err != nil {
+			uc.logger.WithError(err).WithField("recipientID", notification.RecipientId).Error("Failed to get user")
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		if err := uc.emailer.EmailNotification(ctx, recipient, notification); err != nil {
+			uc.logger.WithError(err).WithField("recipientID", notification.RecipientId).Error("Failed to email notification")
+			return fmt.Errorf("failed to email notification: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (uc *notificationUseCase) GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, pagination *entity.Pagination) (*entity.Response[entity.Notification], error) {
+	if err := entity.ValidatePagination(pagination); err != nil {
+		return nil, err
+	}
+
+	notifications, err := uc.notificationRepo.GetNotifications(ctx, userID, unreadOnly, pagination)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get notifications")
+		return nil, fmt.Errorf("failed to get notifications: %w", err)
+	}
+
+	total, err := uc.notificationRepo.GetTotalNotifications(ctx, userID, unreadOnly)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get total notifications")
+		return nil, fmt.Errorf("failed to get total notifications: %w", err)
+	}
+
+	return &entity.Response[entity.Notification]{
+		Data: notifications,
+		Pagination: &entity.Pagination{
+			Total:  total,
+			Page:   pagination.Page,
+			Limit:  pagination.Limit,
+			Offset: pagination.Offset,
+		},
+	}, nil
+}
+
+func (uc *notificationUseCase) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	if err := uc.notificationRepo.MarkRead(ctx, userID, id); err != nil {
+		uc.logger.WithError(err).WithField("notificationID", id).Info("Failed to mark notification as read")
+		return ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+func (uc *notificationUseCase) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	updated, err := uc.notificationRepo.MarkAllRead(ctx, userID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to mark notifications as read")
+		return 0, fmt.Errorf("failed to mark notifications as read: %w", err)
+	}
+
+	return updated, nil
+}
+
+// GetPreferences returns a preference for every notification type, filling
+// in the defaults for types the user never configured.
+func (uc *notificationUseCase) GetPreferences(ctx context.Context, userID uuid.UUID) ([]*entity.NotificationPreference, error) {
+	stored, err := uc.notificationRepo.GetPreferences(ctx, userID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get notification preferences")
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	byType := make(map[entity.NotificationType]*entity.NotificationPreference, len(stored))
+	for _, pref := range stored {
+		byType[pref.Type] = pref
+	}
+
+	prefs := make([]*entity.NotificationPreference, 0, len(entity.NotificationTypes))
+	for _, t := range entity.NotificationTypes {
+		if pref, ok := byType[t]; ok {
+			prefs = append(prefs, pref)
+			continue
+		}
+		prefs = append(prefs, entity.DefaultNotificationPreference(t))
+	}
+
+	return prefs, nil
+}
+
+// UpdatePreferences changes only the types that are listed.
+func (uc *notificationUseCase) UpdatePreferences(ctx context.Context, userID uuid.UUID, prefs []*entity.NotificationPreference) ([]*entity.NotificationPreference, error) {
+	seen := make(map[entity.NotificationType]bool, len(prefs))
+	for _, pref := range prefs {
+		if pref == nil || !pref.Type.Valid() || seen[pref.Type] {
+			return nil, ErrInvalidNotificationPreference
+		}
+		seen[pref.Type] = true
+	}
+
+	if len(prefs) > 0 {
+		if err := uc.notificationRepo.UpsertPreferences(ctx, userID, prefs); err != nil {
+			uc.logger.WithError(err).WithField("userID", userID).Error("Failed to save notification preferences")
+			return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+		}
+	}
+
+	return uc.GetPreferences(ctx, userID)
+}
+
+func (uc *notificationUseCase) preference(ctx context.Context, userID uuid.UUID, t entity.NotificationType) (*entity.NotificationPreference, error) {
+	prefs, err := uc.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pref := range prefs {
+		if pref.Type == t {
+			return pref, nil
+		}
+	}
+
+	return entity.DefaultNotificationPreference(t), nil
+}
+
+// notify is the producer side helper: it tolerates a missing notifier and
+// only logs failures, because the triggering action has already succeeded.
+func notify(ctx context.Context, notifier Notifier, logger *logrus.Logger, notification *entity.Notification) {
+	if notifier == nil {
+		return
+	}
+
+	if err := notifier.Notify(ctx, notification); err != nil {
+		logger.WithError(err).WithFields(logrus.Fields{
+			"recipientID": notification.RecipientId,
+			"type":        notification.Type,
+		}).Warn("Failed to send notification")
+	}
+}
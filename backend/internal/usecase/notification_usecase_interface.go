@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_notification_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseNotification,Notifier,NotificationEmailer
+
+// Notifier is what other usecases use to raise notifications. Failing to
+// notify must never fail the action that triggered it.
+type Notifier interface {
+	Notify(ctx context.Context, notification *entity.Notification) error
+}
+
+// NotificationEmailer delivers a notification by email to users who opted in.
+type NotificationEmailer interface {
+	EmailNotification(ctx context.Context, recipient *entity.User, notification *entity.Notification) error
+}
+
+type UseCaseNotification interface {
+	Notifier
+	GetNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, pagination *entity.Pagination) (*entity.Response[entity.Notification], error)
+	MarkRead(ctx context.Context, userID, id uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+	GetPreferences(ctx context.Context, userID uuid.UUID) ([]*entity.NotificationPreference, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, prefs []*entity.NotificationPreference) ([]*entity.NotificationPreference, error)
+}
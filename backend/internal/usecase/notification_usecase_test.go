@@ -0,0 +1,145 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase/mocks"
+)
+
+func TestNotify(t *testing.T) {
+	tests := []struct {
+		name         string
+		notification *entity.Notification
+		mockSetup    func(notificationRepo *mocksrepository.MockNotificationRepository, userRepo *mocksrepository.MockUserRepository, emailer *mockusecase.MockNotificationEmailer)
+	}{
+		{
+			name:         "Stored by default",
+			notification: &entity.Notification{RecipientId: authorId1, ActorId: &authorId2, Type: entity.NotificationTypeFollow},
+			mockSetup: func(notificationRepo *mocksrepository.MockNotificationRepository, userRepo *mocksrepository.MockUserRepository, emailer *mockusecase.MockNotificationEmailer) {
+				notificationRepo.EXPECT().
+					GetPreferences(gomock.Any(), authorId1).
+					Return(nil, nil).Times(1)
+				notificationRepo.EXPECT().
+					CreateNotification(gomock.Any(), gomock.Any()).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name:         "Email only",
+			notification: &entity.Notification{RecipientId: authorId1, ActorId: &authorId2, Type: entity.NotificationTypeComment},
+			mockSetup: func(notificationRepo *mocksrepository.MockNotificationRepository, userRepo *mocksrepository.MockUserRepository, emailer *mockusecase.MockNotificationEmailer) {
+				notificationRepo.EXPECT().
+					GetPreferences(gomock.Any(), authorId1).
+					Return([]*entity.NotificationPreference{
+						{Type: entity.NotificationTypeComment, InApp: false, Email: true},
+					}, nil).Times(1)
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Email: "tom@example.com"}, nil).Times(1)
+				emailer.EXPECT().
+					EmailNotification(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			name:         "Own action",
+			notification: &entity.Notification{RecipientId: authorId1, ActorId: &authorId1, Type: entity.NotificationTypeComment},
+			mockSetup: func(*mocksrepository.MockNotificationRepository, *mocksrepository.MockUserRepository, *mockusecase.MockNotificationEmailer) {
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationRepo := mocksrepository.NewMockNotificationRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			emailer := mockusecase.NewMockNotificationEmailer(ctrl)
+			uc := usecase.NewNotificationUseCase(notificationRepo, userRepo, emailer, logrus.New(), nil)
+
+			tt.mockSetup(notificationRepo, userRepo, emailer)
+
+			assert.NoError(t, uc.Notify(context.Background(), tt.notification))
+		})
+	}
+}
+
+func TestUpdateNotificationPreferences(t *testing.T) {
+	tests := []struct {
+		name          string
+		prefs         []*entity.NotificationPreference
+		mockSetup     func(notificationRepo *mocksrepository.MockNotificationRepository)
+		expectedEmail map[entity.NotificationType]bool
+		expectedError error
+	}{
+		{
+			name:  "Unlisted types keep their defaults",
+			prefs: []*entity.NotificationPreference{{Type: entity.NotificationTypeFollow, InApp: true, Email: true}},
+			mockSetup: func(notificationRepo *mocksrepository.MockNotificationRepository) {
+				notificationRepo.EXPECT().
+					UpsertPreferences(gomock.Any(), authorId1, gomock.Any()).
+					Return(nil).Times(1)
+				notificationRepo.EXPECT().
+					GetPreferences(gomock.Any(), authorId1).
+					Return([]*entity.NotificationPreference{{Type: entity.NotificationTypeFollow, InApp: true, Email: true}}, nil).Times(1)
+			},
+			expectedEmail: map[entity.NotificationType]bool{
+				entity.NotificationTypeFollow:  true,
+				entity.NotificationTypeComment: false,
+			},
+		},
+		{
+			name:          "Unknown type",
+			prefs:         []*entity.NotificationPreference{{Type: "digest", InApp: true}},
+			mockSetup:     func(*mocksrepository.MockNotificationRepository) {},
+			expectedError: usecase.ErrInvalidNotificationPreference,
+		},
+		{
+			name: "Duplicate type",
+			prefs: []*entity.NotificationPreference{
+				{Type: entity.NotificationTypeFollow, InApp: true},
+				{Type: entity.NotificationTypeFollow, InApp: false},
+			},
+			mockSetup:     func(*mocksrepository.MockNotificationRepository) {},
+			expectedError: usecase.ErrInvalidNotificationPreference,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			notificationRepo := mocksrepository.NewMockNotificationRepository(ctrl)
+			uc := usecase.NewNotificationUseCase(notificationRepo, nil, nil, logrus.New(), nil)
+
+			tt.mockSetup(notificationRepo)
+
+			prefs, err := uc.UpdatePreferences(context.Background(), authorId1, tt.prefs)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, prefs)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, prefs, len(entity.NotificationTypes))
+			for _, pref := range prefs {
+				if expected, ok := tt.expectedEmail[pref.Type]; ok {
+					assert.Equal(t, expected, pref.Email, pref.Type)
+				}
+			}
+		})
+	}
+}
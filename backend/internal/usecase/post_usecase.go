@@ -3,25 +3,53 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// maxFilterTagLength matches the length of the post_tags.tag column.
+	maxFilterTagLength   = 50
+	maxTitlePrefixLength = 255
+)
+
 type postUseCase struct {
-	postRepo repository.PostRepository
-	userRepo repository.UserRepository
-	logger   *logrus.Logger
+	postRepo     repository.PostRepository
+	userRepo     repository.UserRepository
+	reactionRepo repository.ReactionRepository
+	bookmarkRepo repository.BookmarkRepository
+	tagRepo      repository.TagRepository
+	uow          repository.UnitOfWork
+	logger       *logrus.Logger
+	publisher    events.Publisher
 }
 
-func NewPostUseCase(postRepo repository.PostRepository, userRepo repository.UserRepository, logger *logrus.Logger) UseCasePost {
+func NewPostUseCase(
+	postRepo repository.PostRepository,
+	userRepo repository.UserRepository,
+	reactionRepo repository.ReactionRepository,
+	bookmarkRepo repository.BookmarkRepository,
+	tagRepo repository.TagRepository,
+	uow repository.UnitOfWork,
+	logger *logrus.Logger,
+	publisher events.Publisher,
+) UseCasePost {
 	return &postUseCase{
-		postRepo: postRepo,
-		userRepo: userRepo,
-		logger:   logger,
+		postRepo:     postRepo,
+		userRepo:     userRepo,
+		reactionRepo: reactionRepo,
+		bookmarkRepo: bookmarkRepo,
+		tagRepo:      tagRepo,
+		uow:          uow,
+		logger:       logger,
+		publisher:    publisher,
 	}
 }
 
@@ -35,57 +63,160 @@ func (uc *postUseCase) CreatePost(ctx context.Context, post *entity.NewPost) (*e
 		return nil, ErrUserNotFound
 	}
 
-	createdPost, err := uc.postRepo.CreatePost(ctx, post)
+	post.Tags = entity.NormalizeTags(post.Tags)
+
+	var result *entity.Post
+	err := transact(ctx, uc.uow, func(ctx context.Context) error {
+		createdPost, err := uc.postRepo.CreatePost(ctx, post)
+		if err != nil {
+			uc.logger.WithError(err).Error("Failed to create post")
+			return err
+		}
+
+		result = &entity.Post{
+			Id:        createdPost.Id,
+			Title:     createdPost.Title,
+			Content:   createdPost.Content,
+			AuthorId:  createdPost.AuthorId,
+			Status:    createdPost.Status,
+			CreatedAt: createdPost.CreatedAt,
+			UpdatedAt: createdPost.UpdatedAt,
+		}
+
+		if len(post.Tags) > 0 {
+			if err := uc.setTags(ctx, result.Id, post.Tags); err != nil {
+				return err
+			}
+			result.Tags = post.Tags
+		}
+
+		if !result.IsPublished() {
+			return nil
+		}
+
+		return record(ctx, uc.publisher, entity.PostsTopic, entity.EventPostCreated, result)
+	})
 	if err != nil {
-		uc.logger.WithError(err).Error("Failed to create post")
 		return nil, err
 	}
 
-	return &entity.Post{
-		Id:        createdPost.Id,
-		Title:     createdPost.Title,
-		Content:   createdPost.Content,
-		AuthorId:  createdPost.AuthorId,
-		CreatedAt: createdPost.CreatedAt,
-		UpdatedAt: createdPost.UpdatedAt,
-	}, nil
+	return result, nil
 }
 
-func (uc *postUseCase) GetPost(ctx context.Context, id uuid.UUID) (*entity.Post, error) {
+func (uc *postUseCase) GetPost(ctx context.Context, id uuid.UUID, viewerID uuid.UUID, include entity.PostInclude) (*entity.Post, error) {
 	post, err := uc.postRepo.GetPostById(ctx, id)
 	if err != nil {
 		uc.logger.WithError(err).WithField("postID", id).Error("Failed to get post")
 		return nil, ErrPostNotFound
 	}
+
+	if !post.VisibleTo(viewerID) {
+		return nil, ErrPostNotFound
+	}
+
+	if err := uc.attachRelated(ctx, []*entity.Post{post}, viewerID, include, nil); err != nil {
+		return nil, err
+	}
+
 	return post, nil
 }
 
-func (uc *postUseCase) GetAllPosts(ctx context.Context, params *entity.Pagination) (*entity.Response[entity.Post], error) {
+func (uc *postUseCase) GetAllPosts(ctx context.Context, viewerID uuid.UUID, filter *entity.PostFilter, params *entity.Pagination, include entity.PostInclude) (*entity.Response[entity.Post], error) {
+	if filter == nil {
+		filter = &entity.PostFilter{}
+	}
+	if err := validatePostFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return uc.listPosts(ctx, filter, viewerID, params, include)
+}
+
+func (uc *postUseCase) GetPostsByAuthor(ctx context.Context, authorID uuid.UUID, viewerID uuid.UUID, params *entity.Pagination, include entity.PostInclude) (*entity.Response[entity.Post], error) {
+	return uc.listPosts(ctx, &entity.PostFilter{AuthorID: &authorID}, viewerID, params, include)
+}
+
+func (uc *postUseCase) listPosts(ctx context.Context, filter *entity.PostFilter, viewerID uuid.UUID, params *entity.Pagination, include entity.PostInclude) (*entity.Response[entity.Post], error) {
 	if err := entity.ValidatePagination(params); err != nil {
 		return nil, err
 	}
 
-	posts, err := uc.postRepo.GetAll(ctx, params)
+	sortFields, err := entity.ParsePostSort(params.Sort)
 	if err != nil {
-		uc.logger.WithError(err).Error("Failed to get posts")
 		return nil, err
 	}
 
-	total, err := uc.postRepo.GetTotalPosts(ctx)
+	filter.ViewerID = viewerID
+
+	rows, err := uc.postRepo.GetAll(ctx, filter, params.Lookahead())
 	if err != nil {
-		uc.logger.WithError(err).Error("Failed to get total posts")
+		uc.logger.WithError(err).Error("Failed to get posts")
+		return nil, err
+	}
+	posts, more := entity.TrimPage(rows, params)
+
+	var total int64
+	if params.WithTotal {
+		total, err = uc.postRepo.GetTotalPosts(ctx, filter)
+		if err != nil {
+			uc.logger.WithError(err).Error("Failed to get total posts")
+			return nil, err
+		}
+	}
+
+	if params.Fields.Lists(entity.FieldExcerpt) {
+		for _, post := range posts {
+			post.Excerpt = entity.Excerpt(post.Excerpt, entity.ExcerptLength)
+		}
+	}
+
+	// Cursors of popular lists are made from the reactions, so they are
+	// loaded even when the fieldset leaves them out of the response.
+	loaded := params.Fields
+	if !loaded.Has("reactions") && slices.ContainsFunc(sortFields, func(field entity.SortField) bool {
+		return field.Field == entity.PostSortReactions
+	}) {
+		loaded = append(slices.Clip(loaded), "reactions")
+	}
+
+	if err := uc.attachRelated(ctx, posts, viewerID, include, loaded); err != nil {
 		return nil, err
 	}
 
-	return &entity.Response[entity.Post]{
-		Data: posts,
-		Pagination: &entity.Pagination{
-			Total:  int(total),
-			Page:   params.Page,
-			Limit:  params.Limit,
-			Offset: params.Offset,
-		},
-	}, nil
+	// Popular lists key on the reaction count, so the cursors are made
+	// after the viewer state is attached.
+	response := entity.NewResponse(posts, more, params, func(post *entity.Post) entity.Cursor {
+		return entity.Cursor{CreatedAt: post.CreatedAt, Id: post.Id, Keys: entity.PostSortKeys(post, sortFields)}
+	})
+	response.Pagination.Total = int(total)
+
+	return response, nil
+}
+
+// validatePostFilter checks a filter from a client and normalizes its tag
+// and title prefix.
+func validatePostFilter(filter *entity.PostFilter) error {
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidPostFilter, filter.Status)
+	}
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return fmt.Errorf("%w: created_after must be before created_before", ErrInvalidPostFilter)
+	}
+
+	if filter.Tag != "" {
+		tags := entity.NormalizeTags([]string{filter.Tag})
+		if len(tags) == 0 || len(tags[0]) > maxFilterTagLength {
+			return fmt.Errorf("%w: invalid tag", ErrInvalidPostFilter)
+		}
+		filter.Tag = tags[0]
+	}
+
+	filter.TitlePrefix = strings.TrimSpace(filter.TitlePrefix)
+	if len(filter.TitlePrefix) > maxTitlePrefixLength {
+		return fmt.Errorf("%w: q is longer than %d characters", ErrInvalidPostFilter, maxTitlePrefixLength)
+	}
+
+	return nil
 }
 
 func (uc *postUseCase) UpdatePost(ctx context.Context, post *entity.Post, userID uuid.UUID) error {
@@ -109,18 +240,83 @@ func (uc *postUseCase) UpdatePost(ctx context.Context, post *entity.Post, userID
 		return ErrUnauthorized
 	}
 
+	if err := checkVersion(existingPost.Version, post.Version); err != nil {
+		return err
+	}
+
 	post.UpdatedAt = time.Now()
 
-	if err := uc.postRepo.Update(ctx, post); err != nil {
-		uc.logger.WithError(err).WithField("postID", post.Id).Error("Failed to update post")
+	// Tags are left alone when the update doesn't mention them.
+	if post.Tags != nil {
+		post.Tags = entity.NormalizeTags(post.Tags)
+	} else if err := uc.attachTags(ctx, []*entity.Post{existingPost}); err != nil {
 		return err
 	}
 
-	return nil
+	updated := *existingPost
+	updated.Title = post.Title
+	updated.Content = post.Content
+	updated.UpdatedAt = post.UpdatedAt
+	if post.Status != "" {
+		updated.Status = post.Status
+	}
+	if post.Tags != nil {
+		updated.Tags = post.Tags
+	}
+
+	return transact(ctx, uc.uow, func(ctx context.Context) error {
+		if err := uc.postRepo.Update(ctx, post); err != nil {
+			uc.logger.WithError(err).WithField("postID", post.Id).Error("Failed to update post")
+			return versionError(err)
+		}
+		updated.Version = post.Version
+
+		if post.Tags != nil {
+			if err := uc.setTags(ctx, post.Id, post.Tags); err != nil {
+				return err
+			}
+		}
+
+		return uc.recordUpdate(ctx, existingPost, &updated)
+	})
 }
 
-func (uc *postUseCase) DeletePost(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
-	if _, err := uc.postRepo.GetPostById(ctx, id); err != nil {
+// recordUpdate announces a change of a post as its readers see it: a draft
+// that gets published is a new post and a post that is unpublished is gone.
+// Changes to posts that stay unpublished are not announced at all.
+func (uc *postUseCase) recordUpdate(ctx context.Context, before, after *entity.Post) error {
+	switch {
+	case after.IsPublished() && !before.IsPublished():
+		return record(ctx, uc.publisher, entity.PostsTopic, entity.EventPostCreated, after)
+	case after.IsPublished():
+		return record(ctx, uc.publisher, entity.PostTopic(after.Id), entity.EventPostUpdated, after)
+	case before.IsPublished():
+		return record(ctx, uc.publisher, entity.PostTopic(after.Id), entity.EventPostDeleted, map[string]uuid.UUID{"id": after.Id})
+	default:
+		return nil
+	}
+}
+
+// postPatchFields are the attributes of a post its author may patch.
+var postPatchFields = []string{"title", "content", "status", "tags"}
+
+func (uc *postUseCase) PatchPost(ctx context.Context, post *entity.Post, changed []string, userID uuid.UUID) error {
+	if err := checkPatchFields(changed, postPatchFields); err != nil {
+		return err
+	}
+
+	// A patch that removed every tag leaves none, where a nil Tags would
+	// leave them alone.
+	if post.Tags == nil && slices.Contains(changed, "tags") {
+		post.Tags = []string{}
+	}
+
+	return uc.UpdatePost(ctx, post, userID)
+}
+
+func (uc *postUseCase) DeletePost(ctx context.Context, id uuid.UUID, userID uuid.UUID, version int) error {
+	existingPost, err := uc.postRepo.GetPostById(ctx, id)
+	if err != nil {
 		uc.logger.WithError(err).WithField("postID", id).Error("Failed to get post")
 		return ErrPostNotFound
 	}
@@ -130,10 +326,163 @@ func (uc *postUseCase) DeletePost(ctx context.Context, id uuid.UUID, userID uuid
 		return ErrUserNotFound
 	}
 
-	if err := uc.postRepo.Delete(ctx, id); err != nil {
-		uc.logger.WithError(err).WithField("postID", id).Error("Failed to delete post")
+	if err := checkVersion(existingPost.Version, version); err != nil {
+		return err
+	}
+
+	return transact(ctx, uc.uow, func(ctx context.Context) error {
+		if err := uc.postRepo.Delete(ctx, id, version); err != nil {
+			uc.logger.WithError(err).WithField("postID", id).Error("Failed to delete post")
+			return versionError(err)
+		}
+
+		if !existingPost.IsPublished() {
+			return nil
+		}
+
+		return record(ctx, uc.publisher, entity.PostTopic(id), entity.EventPostDeleted, map[string]uuid.UUID{"id": id})
+	})
+}
+
+// attachRelated embeds the included resources in a page of posts, one
+// query per resource, and adds the viewer's reactions and bookmarks. Only
+// what the fieldset asks for is loaded.
+func (uc *postUseCase) attachRelated(ctx context.Context, posts []*entity.Post, viewerID uuid.UUID, include entity.PostInclude, fields entity.Fields) error {
+	if include.Tags && fields.Has("tags") {
+		if err := uc.attachTags(ctx, posts); err != nil {
+			return err
+		}
+	}
+
+	if include.Author && fields.Has("author") {
+		if err := uc.attachAuthors(ctx, posts); err != nil {
+			return err
+		}
+	}
+
+	if include.CommentCount && fields.Has("commentCount") {
+		if err := uc.attachCommentCounts(ctx, posts); err != nil {
+			return err
+		}
+	}
+
+	if !fields.Has("reactions") && !fields.Has("bookmarked") {
+		return nil
+	}
+
+	if err := attachPostViewerState(ctx, uc.reactionRepo, uc.bookmarkRepo, posts, viewerID); err != nil {
+		uc.logger.WithError(err).Error("Failed to get post viewer state")
+		return err
+	}
+
+	return nil
+}
+
+// attachTags loads the tags of a page of posts in one query. Without a tag
+// repository posts are untagged.
+func (uc *postUseCase) attachTags(ctx context.Context, posts []*entity.Post) error {
+	if uc.tagRepo == nil || len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.Id)
+	}
+
+	tags, err := uc.tagRepo.GetTagsByPostIds(ctx, ids)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get post tags")
+		return err
+	}
+
+	for _, post := range posts {
+		post.Tags = tags[post.Id]
+	}
+
+	return nil
+}
+
+func (uc *postUseCase) attachAuthors(ctx context.Context, posts []*entity.Post) error {
+	ids := make([]uuid.UUID, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.AuthorId)
+	}
+
+	authors, err := loadAuthors(ctx, uc.userRepo, ids)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get post authors")
+		return err
+	}
+
+	for _, post := range posts {
+		post.Author = authors[post.AuthorId]
+	}
+
+	return nil
+}
+
+// attachCommentCounts counts the approved comments of a page of posts in one
+// query.
+func (uc *postUseCase) attachCommentCounts(ctx context.Context, posts []*entity.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.Id)
+	}
+
+	counts, err := uc.postRepo.GetCommentCounts(ctx, ids)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get comment counts")
+		return err
+	}
+
+	for _, post := range posts {
+		count := counts[post.Id]
+		post.CommentCount = &count
+	}
+
+	return nil
+}
+
+func (uc *postUseCase) setTags(ctx context.Context, postID uuid.UUID, tags []string) error {
+	if uc.tagRepo == nil {
+		return nil
+	}
+
+	if err := uc.tagRepo.SetPostTags(ctx, postID, tags); err != nil {
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to set post tags")
 		return err
 	}
 
 	return nil
 }
+
+// attachPostViewerState adds the reaction counts and, for signed-in viewers,
+// the bookmark flag to a page of posts.
+func attachPostViewerState(
+	ctx context.Context,
+	reactionRepo repository.ReactionRepository,
+	bookmarkRepo repository.BookmarkRepository,
+	posts []*entity.Post,
+	viewerID uuid.UUID,
+) error {
+	ids := make([]uuid.UUID, 0, len(posts))
+	for _, post := range posts {
+		ids = append(ids, post.Id)
+	}
+
+	summaries, err := loadReactions(ctx, reactionRepo, entity.ReactionTargetPost, ids, viewerID)
+	if err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		post.Reactions = summaries[post.Id]
+	}
+
+	return attachBookmarks(ctx, bookmarkRepo, posts, viewerID)
+}
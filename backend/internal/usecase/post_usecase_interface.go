@@ -11,8 +11,16 @@ import (
 
 type UseCasePost interface {
 	CreatePost(ctx context.Context, post *entity.NewPost) (*entity.Post, error)
-	GetPost(ctx context.Context, id uuid.UUID) (*entity.Post, error)
-	GetAllPosts(ctx context.Context, params *entity.Pagination) (*entity.Response[entity.Post], error)
+	GetPost(ctx context.Context, id uuid.UUID, viewerID uuid.UUID, include entity.PostInclude) (*entity.Post, error)
+	GetAllPosts(ctx context.Context, viewerID uuid.UUID, filter *entity.PostFilter, params *entity.Pagination, include entity.PostInclude) (*entity.Response[entity.Post], error)
+	GetPostsByAuthor(ctx context.Context, authorID uuid.UUID, viewerID uuid.UUID, params *entity.Pagination, include entity.PostInclude) (*entity.Response[entity.Post], error)
+	// UpdatePost and DeletePost fail with ErrVersionMismatch unless the post
+	// has the expected version or it is entity.AnyVersion. UpdatePost takes
+	// post.Version as the expected version and sets it to the new one.
 	UpdatePost(ctx context.Context, post *entity.Post, userID uuid.UUID) error
-	DeletePost(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+	DeletePost(ctx context.Context, id uuid.UUID, userID uuid.UUID, version int) error
+	// PatchPost saves a post a patch was applied to. changed lists the
+	// attributes the patch changed; only title, content, status and tags
+	// may be, or it fails with ErrFieldNotWritable.
+	PatchPost(ctx context.Context, post *entity.Post, changed []string, userID uuid.UUID) error
 }
@@ -2,17 +2,22 @@ package usecase_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"github.com/google/uuid"
 	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/events/mocks"
 )
 
 func TestCreatePost_Success(t *testing.T) {
@@ -22,7 +27,7 @@ func TestCreatePost_Success(t *testing.T) {
 	postRepo := mocksrepository.NewMockPostRepository(ctrl)
 	userRepo := mocksrepository.NewMockUserRepository(ctrl)
 	logger := logrus.New()
-	uc := usecase.NewPostUseCase(postRepo, userRepo, logger)
+	uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, nil, nil, logger, nil)
 
 	newPost := &entity.NewPost{
 		AuthorId: authorId1,
@@ -120,7 +125,7 @@ func TestCreatePost_Fail(t *testing.T) {
 			postRepo := mocksrepository.NewMockPostRepository(ctrl)
 			userRepo := mocksrepository.NewMockUserRepository(ctrl)
 			logger := logrus.New()
-			uc := usecase.NewPostUseCase(postRepo, userRepo, logger)
+			uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, nil, nil, logger, nil)
 
 			tt.mockSetup(userRepo, postRepo)
 
@@ -142,8 +147,9 @@ func TestGetPost_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
 	logger := logrus.New()
-	uc := usecase.NewPostUseCase(postRepo, nil, logger)
+	uc := usecase.NewPostUseCase(postRepo, nil, reactionRepo, nil, nil, nil, logger, nil)
 
 	expectedPost := &entity.Post{
 		Id:      postId1,
@@ -155,10 +161,16 @@ func TestGetPost_Success(t *testing.T) {
 		GetPostById(gomock.Any(), postId1).
 		Return(expectedPost, nil).Times(1)
 
-	foundPost, err := uc.GetPost(context.Background(), postId1)
+	summary := &entity.ReactionSummary{Counts: map[string]int{"love": 4}, Mine: []string{}}
+	reactionRepo.EXPECT().
+		GetReactionSummaries(gomock.Any(), entity.ReactionTargetPost, []uuid.UUID{postId1}, uuid.Nil).
+		Return(map[uuid.UUID]*entity.ReactionSummary{postId1: summary}, nil).Times(1)
+
+	foundPost, err := uc.GetPost(context.Background(), postId1, uuid.Nil, entity.DefaultPostInclude)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPost, foundPost)
+	assert.Equal(t, summary, foundPost.Reactions)
 }
 
 func TestGetPost_Fail(t *testing.T) {
@@ -180,6 +192,17 @@ func TestGetPost_Fail(t *testing.T) {
 			expectedError: usecase.ErrPostNotFound.Error(),
 			expectedPost:  nil,
 		},
+		{
+			name: "Someone else's draft",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId1).
+					Return(&entity.Post{Id: postId1, AuthorId: authorId2, Status: entity.PostStatusDraft}, nil).Times(1)
+			},
+			postID:        postId1,
+			expectedError: usecase.ErrPostNotFound.Error(),
+			expectedPost:  nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -189,11 +212,11 @@ func TestGetPost_Fail(t *testing.T) {
 
 			postRepo := mocksrepository.NewMockPostRepository(ctrl)
 			logger := logrus.New()
-			uc := usecase.NewPostUseCase(postRepo, nil, logger)
+			uc := usecase.NewPostUseCase(postRepo, nil, nil, nil, nil, nil, logger, nil)
 
 			tt.mockSetup(postRepo)
 
-			foundPost, err := uc.GetPost(context.Background(), tt.postID)
+			foundPost, err := uc.GetPost(context.Background(), tt.postID, uuid.Nil, entity.DefaultPostInclude)
 
 			if tt.expectedError != "" {
 				assert.ErrorContains(t, err, tt.expectedError)
@@ -211,14 +234,17 @@ func TestGetAllPosts_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+	bookmarkRepo := mocksrepository.NewMockBookmarkRepository(ctrl)
 	logger := logrus.New()
-	uc := usecase.NewPostUseCase(postRepo, nil, logger)
+	uc := usecase.NewPostUseCase(postRepo, nil, reactionRepo, bookmarkRepo, nil, nil, logger, nil)
 
 	paginationParams := &entity.Pagination{
-		Page:   1,
-		Limit:  10,
-		Offset: 0,
-		Total:  100,
+		Page:      1,
+		Limit:     10,
+		Offset:    0,
+		Total:     100,
+		WithTotal: true,
 	}
 
 	expectedPosts := []*entity.Post{
@@ -227,19 +253,32 @@ func TestGetAllPosts_Success(t *testing.T) {
 	}
 
 	postRepo.EXPECT().
-		GetAll(gomock.Any(), paginationParams).
+		GetAll(gomock.Any(), &entity.PostFilter{ViewerID: authorId1}, paginationParams.Lookahead()).
 		Return(expectedPosts, nil).Times(1)
 
 	postRepo.EXPECT().
-		GetTotalPosts(gomock.Any()).
-		Return(paginationParams.Total, nil).Times(1)
+		GetTotalPosts(gomock.Any(), &entity.PostFilter{ViewerID: authorId1}).
+		Return(int64(paginationParams.Total), nil).Times(1)
+
+	reactionRepo.EXPECT().
+		GetReactionSummaries(gomock.Any(), entity.ReactionTargetPost, []uuid.UUID{postId1, postId2}, authorId1).
+		Return(map[uuid.UUID]*entity.ReactionSummary{
+			postId1: entity.NewReactionSummary(),
+			postId2: entity.NewReactionSummary(),
+		}, nil).Times(1)
 
-	result, err := uc.GetAllPosts(context.Background(), paginationParams)
+	bookmarkRepo.EXPECT().
+		GetBookmarkedPostIds(gomock.Any(), authorId1, []uuid.UUID{postId1, postId2}).
+		Return(map[uuid.UUID]bool{postId2: true}, nil).Times(1)
+
+	result, err := uc.GetAllPosts(context.Background(), authorId1, nil, paginationParams, entity.DefaultPostInclude)
 
 	assert.NoError(t, err)
-	assert.Equal(t, &entity.PostList{
-		Posts: expectedPosts,
-		Pagination: entity.Pagination{
+	assert.False(t, *result.Data[0].Bookmarked)
+	assert.True(t, *result.Data[1].Bookmarked)
+	assert.Equal(t, &entity.Response[entity.Post]{
+		Data: expectedPosts,
+		Pagination: &entity.Pagination{
 			Total:  paginationParams.Total,
 			Page:   paginationParams.Page,
 			Limit:  paginationParams.Limit,
@@ -248,13 +287,249 @@ func TestGetAllPosts_Success(t *testing.T) {
 	}, result)
 }
 
+func TestGetAllPosts_Include(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	userRepo := mocksrepository.NewMockUserRepository(ctrl)
+	reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+	tagRepo := mocksrepository.NewMockTagRepository(ctrl)
+	uc := usecase.NewPostUseCase(postRepo, userRepo, reactionRepo, nil, tagRepo, nil, logrus.New(), nil)
+
+	postId3 := uuid.New()
+	posts := []*entity.Post{
+		{Id: postId1, AuthorId: authorId1},
+		{Id: postId2, AuthorId: authorId2},
+		{Id: postId3, AuthorId: authorId1},
+	}
+
+	postRepo.EXPECT().
+		GetAll(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(posts, nil).Times(1)
+
+	// Each author is looked up once for the whole page, and tags are not
+	// loaded because they weren't asked for.
+	userRepo.EXPECT().
+		GetAuthorsByIds(gomock.Any(), []uuid.UUID{authorId1, authorId2}).
+		Return(map[uuid.UUID]*entity.AuthorSummary{
+			authorId1: {Id: authorId1, Username: "tom"},
+			authorId2: {Id: authorId2, Username: "zoe"},
+		}, nil).Times(1)
+
+	postRepo.EXPECT().
+		GetCommentCounts(gomock.Any(), []uuid.UUID{postId1, postId2, postId3}).
+		Return(map[uuid.UUID]int{postId1: 2}, nil).Times(1)
+
+	reactionRepo.EXPECT().
+		GetReactionSummaries(gomock.Any(), entity.ReactionTargetPost, gomock.Any(), uuid.Nil).
+		Return(map[uuid.UUID]*entity.ReactionSummary{}, nil).Times(1)
+
+	include := entity.PostInclude{Author: true, CommentCount: true}
+	result, err := uc.GetAllPosts(context.Background(), uuid.Nil, nil, &entity.Pagination{Page: 1, Limit: 10}, include)
+
+	require.NoError(t, err)
+	assert.Equal(t, "tom", result.Data[0].Author.Username)
+	assert.Equal(t, "zoe", result.Data[1].Author.Username)
+	assert.Equal(t, "tom", result.Data[2].Author.Username)
+	assert.Equal(t, 2, *result.Data[0].CommentCount)
+	assert.Equal(t, 0, *result.Data[1].CommentCount)
+	assert.Nil(t, result.Data[0].Tags)
+}
+
+func TestGetAllPosts_Fields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+	tagRepo := mocksrepository.NewMockTagRepository(ctrl)
+	uc := usecase.NewPostUseCase(postRepo, nil, reactionRepo, nil, tagRepo, nil, logrus.New(), nil)
+
+	postRepo.EXPECT().
+		GetAll(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]*entity.Post{{Id: postId1, Excerpt: strings.Repeat("word ", 100)}}, nil).Times(1)
+
+	// Neither tags nor reactions are asked for, so neither is loaded.
+	params := &entity.Pagination{Page: 1, Limit: 10, Fields: entity.Fields{"id", entity.FieldExcerpt}}
+	result, err := uc.GetAllPosts(context.Background(), uuid.Nil, nil, params, entity.DefaultPostInclude)
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, len([]rune(result.Data[0].Excerpt)), entity.ExcerptLength+1)
+
+	encoded, err := json.Marshal(result)
+	require.NoError(t, err)
+
+	var body struct {
+		Data []map[string]any `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(encoded, &body))
+	require.Len(t, body.Data, 1)
+	assert.Len(t, body.Data[0], 2)
+	assert.Equal(t, postId1.String(), body.Data[0]["id"])
+	assert.Contains(t, body.Data[0], "excerpt")
+}
+
+func TestGetAllPosts_Cursor(t *testing.T) {
+	older := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	postId3 := uuid.New()
+
+	tests := []struct {
+		name       string
+		params     *entity.Pagination
+		rows       []*entity.Post
+		wantIds    []uuid.UUID
+		wantNext   *entity.Cursor
+		wantPrev   *entity.Cursor
+		wantNoNext bool
+	}{
+		{
+			name:   "first page with more to come",
+			params: &entity.Pagination{Page: 1, Limit: 2, Sort: "created_at_desc"},
+			rows: []*entity.Post{
+				{Id: postId1, CreatedAt: newer},
+				{Id: postId2, CreatedAt: older},
+				{Id: postId3, CreatedAt: older},
+			},
+			wantIds:  []uuid.UUID{postId1, postId2},
+			wantNext: &entity.Cursor{CreatedAt: older, Id: postId2, Sort: "created_at_desc", Keys: []string{older.Format(time.RFC3339Nano)}},
+		},
+		{
+			name:   "last page after a cursor",
+			params: &entity.Pagination{Page: 1, Limit: 2, Sort: "title_asc", Cursor: &entity.Cursor{Keys: []string{"A"}, Id: postId3, Sort: "title_asc"}},
+			rows: []*entity.Post{
+				{Id: postId1, Title: "B", CreatedAt: newer},
+			},
+			wantIds:    []uuid.UUID{postId1},
+			wantPrev:   &entity.Cursor{CreatedAt: newer, Id: postId1, Sort: "title_asc", Keys: []string{"B"}, Backward: true},
+			wantNoNext: true,
+		},
+		{
+			name:   "page before a cursor comes back in list order",
+			params: &entity.Pagination{Page: 1, Limit: 2, Sort: "created_at_desc", Cursor: &entity.Cursor{CreatedAt: older, Id: postId3, Backward: true}},
+			rows: []*entity.Post{
+				{Id: postId2, CreatedAt: older},
+				{Id: postId1, CreatedAt: newer},
+			},
+			wantIds:  []uuid.UUID{postId1, postId2},
+			wantNext: &entity.Cursor{CreatedAt: older, Id: postId2, Sort: "created_at_desc", Keys: []string{older.Format(time.RFC3339Nano)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+			uc := usecase.NewPostUseCase(postRepo, nil, reactionRepo, nil, nil, nil, logrus.New(), nil)
+
+			reactionRepo.EXPECT().
+				GetReactionSummaries(gomock.Any(), entity.ReactionTargetPost, gomock.Any(), uuid.Nil).
+				Return(map[uuid.UUID]*entity.ReactionSummary{}, nil).Times(1)
+
+			// Totals are opt-in, so GetTotalPosts must not be called.
+			postRepo.EXPECT().
+				GetAll(gomock.Any(), gomock.Any(), tt.params.Lookahead()).
+				Return(tt.rows, nil).Times(1)
+
+			result, err := uc.GetAllPosts(context.Background(), uuid.Nil, nil, tt.params, entity.DefaultPostInclude)
+			require.NoError(t, err)
+
+			var ids []uuid.UUID
+			for _, post := range result.Data {
+				ids = append(ids, post.Id)
+			}
+			assert.Equal(t, tt.wantIds, ids)
+			assert.Zero(t, result.Pagination.Total)
+
+			if tt.wantNoNext {
+				assert.Empty(t, result.NextCursor)
+			}
+			if tt.wantNext != nil {
+				next, err := entity.DecodeCursor(result.NextCursor)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantNext, next)
+			}
+			if tt.wantPrev != nil {
+				prev, err := entity.DecodeCursor(result.PrevCursor)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantPrev, prev)
+			}
+		})
+	}
+}
+
+func TestGetAllPosts_Filter(t *testing.T) {
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := after.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name          string
+		filter        *entity.PostFilter
+		expectedQuery *entity.PostFilter
+		expectedError error
+	}{
+		{
+			name:          "normalizes the tag and title prefix",
+			filter:        &entity.PostFilter{Tag: " Go ", TitlePrefix: "  intro ", CreatedAfter: &after, CreatedBefore: &before},
+			expectedQuery: &entity.PostFilter{Tag: "go", TitlePrefix: "intro", CreatedAfter: &after, CreatedBefore: &before, ViewerID: authorId1},
+		},
+		{
+			name:          "unknown status",
+			filter:        &entity.PostFilter{Status: "deleted"},
+			expectedError: usecase.ErrInvalidPostFilter,
+		},
+		{
+			name:          "empty date range",
+			filter:        &entity.PostFilter{CreatedAfter: &before, CreatedBefore: &after},
+			expectedError: usecase.ErrInvalidPostFilter,
+		},
+		{
+			name:          "title prefix too long",
+			filter:        &entity.PostFilter{TitlePrefix: strings.Repeat("a", 256)},
+			expectedError: usecase.ErrInvalidPostFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+			uc := usecase.NewPostUseCase(postRepo, nil, reactionRepo, nil, nil, nil, logrus.New(), nil)
+
+			if tt.expectedQuery != nil {
+				postRepo.EXPECT().
+					GetAll(gomock.Any(), tt.expectedQuery, gomock.Any()).
+					Return([]*entity.Post{}, nil).Times(1)
+				reactionRepo.EXPECT().
+					GetReactionSummaries(gomock.Any(), entity.ReactionTargetPost, gomock.Any(), authorId1).
+					Return(map[uuid.UUID]*entity.ReactionSummary{}, nil).Times(1)
+			}
+
+			_, err := uc.GetAllPosts(context.Background(), authorId1, tt.filter, &entity.Pagination{Page: 1, Limit: 10}, entity.DefaultPostInclude)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestGetAllPosts_Fail(t *testing.T) {
 	tests := []struct {
 		name          string
 		mockSetup     func(postRepo *mocksrepository.MockPostRepository)
 		params        *entity.Pagination
 		expectedError string
-		expectedPosts *entity.PostList
+		expectedPosts *entity.Response[entity.Post]
 	}{
 		{
 			name: "Invalid pagination parameters",
@@ -269,7 +544,7 @@ func TestGetAllPosts_Fail(t *testing.T) {
 			name: "Failed to get posts",
 			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
 				postRepo.EXPECT().
-					GetAll(gomock.Any(), gomock.Any()).
+					GetAll(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("failed to get posts: db error")).Times(1)
 			},
 			params:        &entity.Pagination{Page: 1, Limit: 10},
@@ -285,11 +560,11 @@ func TestGetAllPosts_Fail(t *testing.T) {
 
 			postRepo := mocksrepository.NewMockPostRepository(ctrl)
 			logger := logrus.New()
-			uc := usecase.NewPostUseCase(postRepo, nil, logger)
+			uc := usecase.NewPostUseCase(postRepo, nil, nil, nil, nil, nil, logger, nil)
 
 			tt.mockSetup(postRepo)
 
-			result, err := uc.GetAllPosts(context.Background(), tt.params)
+			result, err := uc.GetAllPosts(context.Background(), uuid.Nil, nil, tt.params, entity.DefaultPostInclude)
 
 			if tt.expectedError != "" {
 				assert.ErrorContains(t, err, tt.expectedError)
@@ -309,7 +584,7 @@ func TestUpdatePost_Success(t *testing.T) {
 	postRepo := mocksrepository.NewMockPostRepository(ctrl)
 	userRepo := mocksrepository.NewMockUserRepository(ctrl)
 	logger := logrus.New()
-	uc := usecase.NewPostUseCase(postRepo, userRepo, logger)
+	uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, nil, nil, logger, nil)
 
 	updatedPost := &entity.Post{
 		Id:       postId1,
@@ -414,6 +689,47 @@ func TestUpdatePost_Fail(t *testing.T) {
 			userID:        authorId1,
 			expectedError: "failed to update post: db error",
 		},
+		{
+			name: "Stale version",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), gomock.Any()).
+					Return(&entity.Post{Id: postId1, AuthorId: authorId1, Version: 3}, nil).Times(1)
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), gomock.Any()).
+					Return(&entity.User{Id: authorId1}, nil).Times(1)
+			},
+			post: &entity.Post{
+				Id:      postId1,
+				Title:   "Test Title",
+				Content: "Test Content",
+				Version: 2,
+			},
+			userID:        authorId1,
+			expectedError: usecase.ErrVersionMismatch.Error(),
+		},
+		{
+			name: "Changed while updating",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository, userRepo *mocksrepository.MockUserRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), gomock.Any()).
+					Return(&entity.Post{Id: postId1, AuthorId: authorId1, Version: 3}, nil).Times(1)
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), gomock.Any()).
+					Return(&entity.User{Id: authorId1}, nil).Times(1)
+				postRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					Return(entity.ErrVersionConflict).Times(1)
+			},
+			post: &entity.Post{
+				Id:      postId1,
+				Title:   "Test Title",
+				Content: "Test Content",
+				Version: 3,
+			},
+			userID:        authorId1,
+			expectedError: usecase.ErrVersionMismatch.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -424,7 +740,7 @@ func TestUpdatePost_Fail(t *testing.T) {
 			postRepo := mocksrepository.NewMockPostRepository(ctrl)
 			userRepo := mocksrepository.NewMockUserRepository(ctrl)
 			logger := logrus.New()
-			uc := usecase.NewPostUseCase(postRepo, userRepo, logger)
+			uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, nil, nil, logger, nil)
 
 			tt.mockSetup(postRepo, userRepo)
 
@@ -438,3 +754,230 @@ func TestUpdatePost_Fail(t *testing.T) {
 		})
 	}
 }
+
+func TestDeletePost_VersionMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	userRepo := mocksrepository.NewMockUserRepository(ctrl)
+	uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, nil, nil, logrus.New(), nil)
+
+	postRepo.EXPECT().
+		GetPostById(gomock.Any(), postId1).
+		Return(&entity.Post{Id: postId1, AuthorId: authorId1, Version: 4}, nil).Times(1)
+	userRepo.EXPECT().
+		GetUserById(gomock.Any(), authorId1).
+		Return(&entity.User{Id: authorId1}, nil).Times(1)
+	postRepo.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := uc.DeletePost(context.Background(), postId1, authorId1, 3)
+	assert.ErrorIs(t, err, usecase.ErrVersionMismatch)
+}
+
+func TestPatchPost(t *testing.T) {
+	t.Run("Only title, content, status and tags can be patched", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		postRepo := mocksrepository.NewMockPostRepository(ctrl)
+		uc := usecase.NewPostUseCase(postRepo, nil, nil, nil, nil, nil, logrus.New(), nil)
+
+		postRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+		post := &entity.Post{Id: postId1, Title: "Title", Content: "Content", AuthorId: authorId2}
+		err := uc.PatchPost(context.Background(), post, []string{"authorId", "title"}, authorId1)
+		assert.ErrorIs(t, err, usecase.ErrFieldNotWritable)
+	})
+
+	t.Run("Removing every tag clears them", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		postRepo := mocksrepository.NewMockPostRepository(ctrl)
+		userRepo := mocksrepository.NewMockUserRepository(ctrl)
+		tagRepo := mocksrepository.NewMockTagRepository(ctrl)
+		uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, tagRepo, nil, logrus.New(), nil)
+
+		postRepo.EXPECT().
+			GetPostById(gomock.Any(), postId1).
+			Return(&entity.Post{Id: postId1, AuthorId: authorId1, Version: 2}, nil).Times(1)
+		userRepo.EXPECT().
+			GetUserById(gomock.Any(), authorId1).
+			Return(&entity.User{Id: authorId1}, nil).Times(1)
+		postRepo.EXPECT().
+			Update(gomock.Any(), gomock.Any()).
+			Return(nil).Times(1)
+		tagRepo.EXPECT().
+			SetPostTags(gomock.Any(), postId1, []string{}).
+			Return(nil).Times(1)
+
+		post := &entity.Post{Id: postId1, Title: "Title", Content: "Content", AuthorId: authorId1, Version: 2}
+		err := uc.PatchPost(context.Background(), post, []string{"tags"}, authorId1)
+		assert.NoError(t, err)
+	})
+}
+
+type txContextKey struct{}
+
+func TestCreatePost_UnitOfWork(t *testing.T) {
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError bool
+	}{
+		{
+			name: "Post and event are written in one transaction",
+		},
+		{
+			name:          "Failing to store the event fails the change",
+			publishErr:    errors.New("outbox unavailable"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uow := mocksrepository.NewMockUnitOfWork(ctrl)
+			publisher := mocksevents.NewMockPublisher(ctrl)
+			uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, nil, uow, logrus.New(), publisher)
+
+			newPost := &entity.NewPost{AuthorId: authorId1, Title: "Test Title", Content: "Test Content"}
+			inTx := func(ctx context.Context) bool { return ctx.Value(txContextKey{}) != nil }
+
+			userRepo.EXPECT().
+				GetUserById(gomock.Any(), authorId1).
+				Return(&entity.User{Id: authorId1}, nil).Times(1)
+			uow.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(context.WithValue(ctx, txContextKey{}, true))
+				}).Times(1)
+			postRepo.EXPECT().
+				CreatePost(gomock.Any(), newPost).
+				DoAndReturn(func(ctx context.Context, _ *entity.NewPost) (*entity.Post, error) {
+					assert.True(t, inTx(ctx))
+					return &entity.Post{Id: postId1, Title: newPost.Title, Content: newPost.Content, Status: entity.PostStatusPublished}, nil
+				}).Times(1)
+			publisher.EXPECT().
+				Publish(gomock.Any(), entity.PostsTopic, entity.EventPostCreated, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _, _ string, _ any) error {
+					assert.True(t, inTx(ctx))
+					return tt.publishErr
+				}).Times(1)
+
+			post, err := uc.CreatePost(context.Background(), newPost)
+
+			if tt.expectedError {
+				assert.ErrorIs(t, err, tt.publishErr)
+				assert.Nil(t, post)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, postId1, post.Id)
+		})
+	}
+}
+
+func TestPostEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		before    entity.PostStatus
+		after     entity.PostStatus
+		topic     string
+		eventType string
+	}{
+		{
+			name:      "Publishing a draft announces a new post",
+			before:    entity.PostStatusDraft,
+			after:     entity.PostStatusPublished,
+			topic:     entity.PostsTopic,
+			eventType: entity.EventPostCreated,
+		},
+		{
+			name:      "Editing a published post announces the update",
+			before:    entity.PostStatusPublished,
+			after:     entity.PostStatusPublished,
+			topic:     entity.PostTopic(postId1),
+			eventType: entity.EventPostUpdated,
+		},
+		{
+			name:      "Unpublishing a post announces it is gone",
+			before:    entity.PostStatusPublished,
+			after:     entity.PostStatusArchived,
+			topic:     entity.PostTopic(postId1),
+			eventType: entity.EventPostDeleted,
+		},
+		{
+			name:   "Editing a draft is not announced",
+			before: entity.PostStatusDraft,
+			after:  entity.PostStatusDraft,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			tagRepo := mocksrepository.NewMockTagRepository(ctrl)
+			publisher := mocksevents.NewMockPublisher(ctrl)
+			uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, tagRepo, nil, logrus.New(), publisher)
+
+			postRepo.EXPECT().
+				GetPostById(gomock.Any(), postId1).
+				Return(&entity.Post{Id: postId1, AuthorId: authorId1, Status: tt.before}, nil).Times(1)
+			userRepo.EXPECT().
+				GetUserById(gomock.Any(), authorId1).
+				Return(&entity.User{Id: authorId1}, nil).Times(1)
+			tagRepo.EXPECT().
+				GetTagsByPostIds(gomock.Any(), []uuid.UUID{postId1}).
+				Return(map[uuid.UUID][]string{}, nil).Times(1)
+			postRepo.EXPECT().
+				Update(gomock.Any(), gomock.Any()).
+				Return(nil).Times(1)
+
+			if tt.eventType != "" {
+				publisher.EXPECT().
+					Publish(gomock.Any(), tt.topic, tt.eventType, gomock.Any()).
+					Return(nil).Times(1)
+			}
+
+			post := &entity.Post{Id: postId1, Title: "Title", Content: "Content", Status: tt.after}
+			err := uc.UpdatePost(context.Background(), post, authorId1)
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("Drafts are created silently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		postRepo := mocksrepository.NewMockPostRepository(ctrl)
+		userRepo := mocksrepository.NewMockUserRepository(ctrl)
+		publisher := mocksevents.NewMockPublisher(ctrl)
+		uc := usecase.NewPostUseCase(postRepo, userRepo, nil, nil, nil, nil, logrus.New(), publisher)
+
+		newPost := &entity.NewPost{AuthorId: authorId1, Title: "Title", Content: "Content", Status: entity.PostStatusDraft}
+
+		userRepo.EXPECT().
+			GetUserById(gomock.Any(), authorId1).
+			Return(&entity.User{Id: authorId1}, nil).Times(1)
+		postRepo.EXPECT().
+			CreatePost(gomock.Any(), newPost).
+			Return(&entity.Post{Id: postId1, AuthorId: authorId1, Status: entity.PostStatusDraft}, nil).Times(1)
+
+		_, err := uc.CreatePost(context.Background(), newPost)
+		assert.NoError(t, err)
+	})
+}
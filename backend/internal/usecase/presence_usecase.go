@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+)
+
+type presenceUseCase struct {
+	postRepo repository.PostRepository
+	userRepo repository.UserRepository
+	broker   events.Broker
+	logger   *logrus.Logger
+	ttl      time.Duration
+}
+
+func NewPresenceUseCase(
+	postRepo repository.PostRepository,
+	userRepo repository.UserRepository,
+	broker events.Broker,
+	logger *logrus.Logger,
+	cfg *config.Config,
+) UseCasePresence {
+	return &presenceUseCase{
+		postRepo: postRepo,
+		userRepo: userRepo,
+		broker:   broker,
+		logger:   logger,
+		ttl:      cfg.Presence.TTL,
+	}
+}
+
+func (uc *presenceUseCase) Join(ctx context.Context, postID uuid.UUID, userID uuid.UUID) (*PresenceSession, error) {
+	post, err := uc.postRepo.GetPostById(ctx, postID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to get post")
+		return nil, ErrPostNotFound
+	}
+	if !post.VisibleTo(userID) {
+		return nil, ErrPostNotFound
+	}
+
+	user, err := uc.userRepo.GetUserById(ctx, userID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get user")
+		return nil, ErrUserNotFound
+	}
+
+	session := &PresenceSession{
+		broker:   uc.broker,
+		logger:   uc.logger,
+		ttl:      uc.ttl,
+		authorID: post.AuthorId,
+		self: entity.Presence{
+			ConnectionId: uuid.New(),
+			PostId:       postID,
+			UserId:       user.Id,
+			Username:     user.Username,
+			State:        entity.PresenceViewing,
+		},
+		others: map[uuid.UUID]*entity.Presence{},
+	}
+
+	// Subscribe first so the answers to our own announcement are not missed.
+	session.sub = uc.broker.Subscribe([]string{entity.PostTopic(postID), entity.PostPresenceTopic(postID)})
+
+	if err := session.publish(ctx, entity.EventPresenceJoined); err != nil {
+		session.sub.Close()
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to announce presence")
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// PresenceSession is one connection's view of who else has a post open. It
+// also receives the post's own events, so editors learn about updates made
+// elsewhere. A session belongs to a single connection loop and is not safe
+// for concurrent use.
+type PresenceSession struct {
+	broker   events.Broker
+	logger   *logrus.Logger
+	ttl      time.Duration
+	authorID uuid.UUID
+	self     entity.Presence
+	sub      *events.Subscription
+	others   map[uuid.UUID]*entity.Presence
+}
+
+// Events delivers presence changes and post updates. The channel is closed
+// when the broker drops or shuts down the subscription.
+func (s *PresenceSession) Events() <-chan *entity.Event {
+	return s.sub.Events()
+}
+
+// SetState switches between viewing and editing. Only the post's author may
+// edit.
+func (s *PresenceSession) SetState(ctx context.Context, state entity.PresenceState) error {
+	if !state.Valid() {
+		return ErrInvalidPresenceState
+	}
+
+	if state == entity.PresenceEditing && s.self.UserId != s.authorID {
+		return ErrUnauthorized
+	}
+
+	if state == s.self.State {
+		return nil
+	}
+
+	s.self.State = state
+
+	return s.publish(ctx, entity.EventPresenceUpdated)
+}
+
+// Apply folds a presence event from another connection into the session and
+// reports whether the list of viewers changed. Post events leave it as is.
+func (s *PresenceSession) Apply(ctx context.Context, event *entity.Event) bool {
+	if event.Topic != entity.PostPresenceTopic(s.self.PostId) {
+		return false
+	}
+
+	var presence entity.Presence
+	if err := json.Unmarshal(event.Data, &presence); err != nil {
+		s.logger.WithError(err).WithField("eventID", event.Id).Warn("Failed to decode presence event")
+		return false
+	}
+
+	if presence.ConnectionId == s.self.ConnectionId {
+		return false
+	}
+
+	switch event.Type {
+	case entity.EventPresenceJoined, entity.EventPresenceUpdated:
+		// A newcomer only knows about those who answer its announcement.
+		if event.Type == entity.EventPresenceJoined {
+			if err := s.publish(ctx, entity.EventPresenceUpdated); err != nil {
+				s.logger.WithError(err).Warn("Failed to answer presence announcement")
+			}
+		}
+
+		presence.LastSeen = time.Now()
+		existing, ok := s.others[presence.ConnectionId]
+		s.others[presence.ConnectionId] = &presence
+		return !ok || existing.State != presence.State
+	case entity.EventPresenceLeft:
+		if _, ok := s.others[presence.ConnectionId]; !ok {
+			return false
+		}
+		delete(s.others, presence.ConnectionId)
+		return true
+	}
+
+	return false
+}
+
+// Heartbeat re-announces the connection so that other replicas keep listing
+// it, and forgets connections that have not been heard from within the TTL,
+// which happens when their replica goes away without saying goodbye. It
+// reports whether the list of viewers changed.
+func (s *PresenceSession) Heartbeat(ctx context.Context) bool {
+	if err := s.publish(ctx, entity.EventPresenceUpdated); err != nil {
+		s.logger.WithError(err).Warn("Failed to refresh presence")
+	}
+
+	changed := false
+	cutoff := time.Now().Add(-s.ttl)
+	for id, presence := range s.others {
+		if presence.LastSeen.Before(cutoff) {
+			delete(s.others, id)
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// Viewers lists everyone on the post once, including the session's own user.
+// Someone editing in any of their tabs is shown as editing.
+func (s *PresenceSession) Viewers() []*entity.Presence {
+	connections := make([]*entity.Presence, 0, len(s.others)+1)
+	connections = append(connections, &s.self)
+	for _, presence := range s.others {
+		connections = append(connections, presence)
+	}
+
+	byUser := map[uuid.UUID]*entity.Presence{}
+	for _, presence := range connections {
+		existing, ok := byUser[presence.UserId]
+		if !ok || (existing.State != entity.PresenceEditing && presence.State == entity.PresenceEditing) {
+			viewer := *presence
+			byUser[presence.UserId] = &viewer
+		}
+	}
+
+	viewers := make([]*entity.Presence, 0, len(byUser))
+	for _, viewer := range byUser {
+		viewers = append(viewers, viewer)
+	}
+	sort.Slice(viewers, func(i, j int) bool {
+		if viewers[i].Username != viewers[j].Username {
+			return viewers[i].Username < viewers[j].Username
+		}
+		return viewers[i].UserId.String() < viewers[j].UserId.String()
+	})
+
+	return viewers
+}
+
+// Leave ends the subscription and tells the others the connection is gone.
+func (s *PresenceSession) Leave(ctx context.Context) {
+	s.sub.Close()
+
+	if err := s.publish(ctx, entity.EventPresenceLeft); err != nil {
+		s.logger.WithError(err).Warn("Failed to announce leaving")
+	}
+}
+
+// publish announces the connection as a signal: presence is re-sent until
+// it goes away, so it isn't stored or replayed like the post's events.
+func (s *PresenceSession) publish(ctx context.Context, eventType string) error {
+	return s.broker.Signal(ctx, entity.PostPresenceTopic(s.self.PostId), eventType, &s.self)
+}
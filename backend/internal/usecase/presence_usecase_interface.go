@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_presence_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCasePresence
+
+type UseCasePresence interface {
+	// Join announces the user on the post and returns the session that tracks
+	// everyone else who has it open.
+	Join(ctx context.Context, postID uuid.UUID, userID uuid.UUID) (*PresenceSession, error)
+}
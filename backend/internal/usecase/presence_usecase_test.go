@@ -0,0 +1,179 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func newPresenceUseCase(t *testing.T, broker events.Broker, ttl time.Duration) usecase.UseCasePresence {
+	ctrl := gomock.NewController(t)
+
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	userRepo := mocksrepository.NewMockUserRepository(ctrl)
+
+	postRepo.EXPECT().
+		GetPostById(gomock.Any(), postId1).
+		Return(&entity.Post{Id: postId1, AuthorId: authorId1}, nil).AnyTimes()
+	postRepo.EXPECT().
+		GetPostById(gomock.Any(), postId2).
+		Return(nil, errors.New("post not found")).AnyTimes()
+	userRepo.EXPECT().
+		GetUserById(gomock.Any(), authorId1).
+		Return(&entity.User{Id: authorId1, Username: "alice"}, nil).AnyTimes()
+	userRepo.EXPECT().
+		GetUserById(gomock.Any(), authorId2).
+		Return(&entity.User{Id: authorId2, Username: "bob"}, nil).AnyTimes()
+
+	cfg := &config.Config{Presence: config.PresenceConfig{TTL: ttl}}
+	return usecase.NewPresenceUseCase(postRepo, userRepo, broker, logrus.New(), cfg)
+}
+
+// drain applies every event already delivered to the session.
+func drain(session *usecase.PresenceSession) {
+	for {
+		select {
+		case event := <-session.Events():
+			session.Apply(context.Background(), event)
+		default:
+			return
+		}
+	}
+}
+
+func viewerStates(viewers []*entity.Presence) map[string]entity.PresenceState {
+	states := map[string]entity.PresenceState{}
+	for _, viewer := range viewers {
+		states[viewer.Username] = viewer.State
+	}
+	return states
+}
+
+func TestPresenceJoin(t *testing.T) {
+	broker := events.NewMemoryBroker(10)
+	defer broker.Close()
+
+	uc := newPresenceUseCase(t, broker, time.Minute)
+
+	_, err := uc.Join(context.Background(), postId2, authorId1)
+	assert.ErrorIs(t, err, usecase.ErrPostNotFound)
+
+	alice, err := uc.Join(context.Background(), postId1, authorId1)
+	require.NoError(t, err)
+	defer alice.Leave(context.Background())
+
+	bob, err := uc.Join(context.Background(), postId1, authorId2)
+	require.NoError(t, err)
+
+	// Alice learns about Bob from his announcement and answers it, which is
+	// how Bob learns about her.
+	drain(alice)
+	drain(bob)
+
+	expected := map[string]entity.PresenceState{"alice": entity.PresenceViewing, "bob": entity.PresenceViewing}
+	assert.Equal(t, expected, viewerStates(alice.Viewers()))
+	assert.Equal(t, expected, viewerStates(bob.Viewers()))
+
+	bob.Leave(context.Background())
+	drain(alice)
+	assert.Equal(t, map[string]entity.PresenceState{"alice": entity.PresenceViewing}, viewerStates(alice.Viewers()))
+
+	// Presence is never kept for resuming clients.
+	replayed, err := broker.Replay(context.Background(), 0, []string{entity.PostPresenceTopic(postId1)})
+	require.NoError(t, err)
+	assert.Empty(t, replayed)
+}
+
+func TestPresenceJoin_Draft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	broker := events.NewMemoryBroker(10)
+	defer broker.Close()
+
+	postRepo := mocksrepository.NewMockPostRepository(ctrl)
+	userRepo := mocksrepository.NewMockUserRepository(ctrl)
+	uc := usecase.NewPresenceUseCase(postRepo, userRepo, broker, logrus.New(), &config.Config{Presence: config.PresenceConfig{TTL: time.Minute}})
+
+	postRepo.EXPECT().
+		GetPostById(gomock.Any(), postId1).
+		Return(&entity.Post{Id: postId1, AuthorId: authorId1, Status: entity.PostStatusDraft}, nil).Times(2)
+	userRepo.EXPECT().
+		GetUserById(gomock.Any(), authorId1).
+		Return(&entity.User{Id: authorId1, Username: "alice"}, nil).Times(1)
+
+	// Only the author may watch a draft.
+	_, err := uc.Join(context.Background(), postId1, authorId2)
+	assert.ErrorIs(t, err, usecase.ErrPostNotFound)
+
+	alice, err := uc.Join(context.Background(), postId1, authorId1)
+	require.NoError(t, err)
+	alice.Leave(context.Background())
+}
+
+func TestPresenceSetState(t *testing.T) {
+	broker := events.NewMemoryBroker(10)
+	defer broker.Close()
+
+	uc := newPresenceUseCase(t, broker, time.Minute)
+	ctx := context.Background()
+
+	alice, err := uc.Join(ctx, postId1, authorId1)
+	require.NoError(t, err)
+	defer alice.Leave(ctx)
+
+	bob, err := uc.Join(ctx, postId1, authorId2)
+	require.NoError(t, err)
+	defer bob.Leave(ctx)
+
+	assert.ErrorIs(t, bob.SetState(ctx, entity.PresenceEditing), usecase.ErrUnauthorized)
+	assert.ErrorIs(t, alice.SetState(ctx, "typing"), usecase.ErrInvalidPresenceState)
+	require.NoError(t, alice.SetState(ctx, entity.PresenceEditing))
+
+	drain(alice)
+	drain(bob)
+
+	assert.Equal(t, entity.PresenceEditing, viewerStates(bob.Viewers())["alice"])
+}
+
+func TestPresenceHeartbeat_ExpiresSilentConnections(t *testing.T) {
+	broker := events.NewMemoryBroker(10)
+	defer broker.Close()
+
+	uc := newPresenceUseCase(t, broker, time.Millisecond)
+	ctx := context.Background()
+
+	alice, err := uc.Join(ctx, postId1, authorId1)
+	require.NoError(t, err)
+	defer alice.Leave(ctx)
+
+	// Bob's replica goes away without announcing that he left.
+	err = broker.Signal(ctx, entity.PostPresenceTopic(postId1), entity.EventPresenceUpdated, &entity.Presence{
+		ConnectionId: uuid.New(),
+		PostId:       postId1,
+		UserId:       authorId2,
+		Username:     "bob",
+		State:        entity.PresenceViewing,
+	})
+	require.NoError(t, err)
+	drain(alice)
+	assert.Len(t, alice.Viewers(), 2)
+
+	time.Sleep(5 * time.Millisecond)
+
+	assert.True(t, alice.Heartbeat(ctx))
+	assert.Len(t, alice.Viewers(), 1)
+}
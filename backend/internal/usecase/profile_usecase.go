@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+const maxSocialLinks = 10
+
+type profileUseCase struct {
+	profileRepo repository.ProfileRepository
+	userRepo    repository.UserRepository
+	postRepo    repository.PostRepository
+	followRepo  repository.FollowRepository
+	logger      *logrus.Logger
+}
+
+func NewProfileUseCase(
+	profileRepo repository.ProfileRepository,
+	userRepo repository.UserRepository,
+	postRepo repository.PostRepository,
+	followRepo repository.FollowRepository,
+	logger *logrus.Logger,
+) UseCaseProfile {
+	return &profileUseCase{
+		profileRepo: profileRepo,
+		userRepo:    userRepo,
+		postRepo:    postRepo,
+		followRepo:  followRepo,
+		logger:      logger,
+	}
+}
+
+func (uc *profileUseCase) GetAuthor(ctx context.Context, username string) (*entity.Author, error) {
+	user, err := uc.userRepo.GetUserByUsername(ctx, username)
+	if err != nil {
+		uc.logger.WithError(err).WithField("username", username).Info("Author not found")
+		return nil, ErrUserNotFound
+	}
+
+	profile, err := uc.profileRepo.GetProfile(ctx, user.Id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", user.Id).Error("Failed to get profile")
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	postCount, err := uc.postRepo.GetTotalPostsByAuthor(ctx, user.Id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", user.Id).Error("Failed to count author posts")
+		return nil, fmt.Errorf("failed to count author posts: %w", err)
+	}
+
+	followers, following, err := uc.followRepo.GetFollowCounts(ctx, user.Id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", user.Id).Error("Failed to get follow counts")
+		return nil, fmt.Errorf("failed to get follow counts: %w", err)
+	}
+
+	return &entity.Author{
+		Id:             user.Id,
+		Username:       user.Username,
+		Profile:        *profile,
+		PostCount:      int(postCount),
+		FollowerCount:  followers,
+		FollowingCount: following,
+		JoinedAt:       user.CreatedAt,
+	}, nil
+}
+
+func (uc *profileUseCase) GetProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	profile, err := uc.profileRepo.GetProfile(ctx, userID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to get profile")
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+// UpdateProfile replaces the whole profile; omitted fields are cleared.
+func (uc *profileUseCase) UpdateProfile(ctx context.Context, userID uuid.UUID, update *entity.UpdateProfile) (*entity.Profile, error) {
+	if update == nil {
+		return nil, ErrInvalidProfile
+	}
+
+	profile := entity.NewProfile(userID)
+	profile.DisplayName = strings.TrimSpace(update.DisplayName)
+	profile.Bio = strings.TrimSpace(update.Bio)
+	profile.AvatarURL = strings.TrimSpace(update.AvatarURL)
+	profile.Website = strings.TrimSpace(update.Website)
+	for network, link := range update.SocialLinks {
+		profile.SocialLinks[strings.ToLower(strings.TrimSpace(network))] = strings.TrimSpace(link)
+	}
+
+	if err := validateProfile(profile); err != nil {
+		return nil, err
+	}
+
+	if err := uc.profileRepo.UpsertProfile(ctx, profile); err != nil {
+		uc.logger.WithError(err).WithField("userID", userID).Error("Failed to update profile")
+		return nil, fmt.Errorf("failed to update profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+// validateProfile only accepts http(s) links, since every link on a profile
+// ends up rendered on the public author page.
+func validateProfile(profile *entity.Profile) error {
+	if len(profile.SocialLinks) > maxSocialLinks {
+		return ErrInvalidProfile
+	}
+
+	links := []string{profile.AvatarURL, profile.Website}
+	for network, link := range profile.SocialLinks {
+		if network == "" || link == "" {
+			return ErrInvalidProfile
+		}
+		links = append(links, link)
+	}
+
+	for _, link := range links {
+		if link == "" {
+			continue
+		}
+		parsed, err := url.Parse(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return ErrInvalidProfile
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_profile_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseProfile
+
+type UseCaseProfile interface {
+	GetAuthor(ctx context.Context, username string) (*entity.Author, error)
+	GetProfile(ctx context.Context, userID uuid.UUID) (*entity.Profile, error)
+	UpdateProfile(ctx context.Context, userID uuid.UUID, update *entity.UpdateProfile) (*entity.Profile, error)
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestGetAuthor(t *testing.T) {
+	joined := time.Now()
+
+	tests := []struct {
+		name           string
+		mockSetup      func(profileRepo *mocksrepository.MockProfileRepository, userRepo *mocksrepository.MockUserRepository, postRepo *mocksrepository.MockPostRepository, followRepo *mocksrepository.MockFollowRepository)
+		expectedAuthor *entity.Author
+		expectedError  error
+	}{
+		{
+			name: "Public author page",
+			mockSetup: func(profileRepo *mocksrepository.MockProfileRepository, userRepo *mocksrepository.MockUserRepository, postRepo *mocksrepository.MockPostRepository, followRepo *mocksrepository.MockFollowRepository) {
+				userRepo.EXPECT().
+					GetUserByUsername(gomock.Any(), "tom").
+					Return(&entity.User{Id: authorId1, Username: "tom", Email: "tom@mail.com", CreatedAt: joined}, nil).Times(1)
+				profileRepo.EXPECT().
+					GetProfile(gomock.Any(), authorId1).
+					Return(&entity.Profile{UserId: authorId1, DisplayName: "Tom", SocialLinks: map[string]string{}}, nil).Times(1)
+				postRepo.EXPECT().
+					GetTotalPostsByAuthor(gomock.Any(), authorId1).
+					Return(int64(3), nil).Times(1)
+				followRepo.EXPECT().
+					GetFollowCounts(gomock.Any(), authorId1).
+					Return(5, 2, nil).Times(1)
+			},
+			expectedAuthor: &entity.Author{
+				Id:             authorId1,
+				Username:       "tom",
+				Profile:        entity.Profile{UserId: authorId1, DisplayName: "Tom", SocialLinks: map[string]string{}},
+				PostCount:      3,
+				FollowerCount:  5,
+				FollowingCount: 2,
+				JoinedAt:       joined,
+			},
+		},
+		{
+			name: "Unknown username",
+			mockSetup: func(profileRepo *mocksrepository.MockProfileRepository, userRepo *mocksrepository.MockUserRepository, postRepo *mocksrepository.MockPostRepository, followRepo *mocksrepository.MockFollowRepository) {
+				userRepo.EXPECT().
+					GetUserByUsername(gomock.Any(), "tom").
+					Return(nil, errors.New("user not found")).Times(1)
+			},
+			expectedError: usecase.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			profileRepo := mocksrepository.NewMockProfileRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			followRepo := mocksrepository.NewMockFollowRepository(ctrl)
+			uc := usecase.NewProfileUseCase(profileRepo, userRepo, postRepo, followRepo, logrus.New())
+
+			tt.mockSetup(profileRepo, userRepo, postRepo, followRepo)
+
+			author, err := uc.GetAuthor(context.Background(), "tom")
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, author)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedAuthor, author)
+		})
+	}
+}
+
+func TestUpdateProfile(t *testing.T) {
+	tests := []struct {
+		name          string
+		update        *entity.UpdateProfile
+		expectSave    bool
+		expectedError error
+	}{
+		{
+			name: "Valid profile",
+			update: &entity.UpdateProfile{
+				DisplayName: " Tom ",
+				Website:     "https://tom.example.com",
+				SocialLinks: map[string]string{"GitHub": "https://github.com/tom"},
+			},
+			expectSave: true,
+		},
+		{
+			name:          "Script link",
+			update:        &entity.UpdateProfile{Website: "javascript:alert(1)"},
+			expectedError: usecase.ErrInvalidProfile,
+		},
+		{
+			name:          "Relative avatar",
+			update:        &entity.UpdateProfile{AvatarURL: "/avatars/tom.png"},
+			expectedError: usecase.ErrInvalidProfile,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			profileRepo := mocksrepository.NewMockProfileRepository(ctrl)
+			uc := usecase.NewProfileUseCase(profileRepo, nil, nil, nil, logrus.New())
+
+			if tt.expectSave {
+				profileRepo.EXPECT().UpsertProfile(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			}
+
+			profile, err := uc.UpdateProfile(context.Background(), authorId1, tt.update)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Tom", profile.DisplayName)
+			assert.Equal(t, map[string]string{"github": "https://github.com/tom"}, profile.SocialLinks)
+		})
+	}
+}
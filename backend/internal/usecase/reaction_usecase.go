@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+type reactionUseCase struct {
+	reactionRepo repository.ReactionRepository
+	postRepo     repository.PostRepository
+	commentRepo  repository.CommentRepository
+	logger       *logrus.Logger
+	kinds        map[string]struct{}
+}
+
+func NewReactionUseCase(
+	reactionRepo repository.ReactionRepository,
+	postRepo repository.PostRepository,
+	commentRepo repository.CommentRepository,
+	logger *logrus.Logger,
+	cfg *config.Config,
+) UseCaseReaction {
+	kinds := make(map[string]struct{}, len(cfg.Reactions.Kinds))
+	for _, kind := range cfg.Reactions.Kinds {
+		kinds[kind] = struct{}{}
+	}
+
+	return &reactionUseCase{
+		reactionRepo: reactionRepo,
+		postRepo:     postRepo,
+		commentRepo:  commentRepo,
+		logger:       logger,
+		kinds:        kinds,
+	}
+}
+
+func (uc *reactionUseCase) AddReaction(ctx context.Context, reaction *entity.Reaction) (*entity.ReactionSummary, error) {
+	if err := uc.validate(ctx, reaction); err != nil {
+		return nil, err
+	}
+
+	if err := uc.reactionRepo.AddReaction(ctx, reaction); err != nil {
+		uc.logger.WithError(err).WithField("targetID", reaction.TargetId).Error("Failed to add reaction")
+		return nil, fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	return uc.summary(ctx, reaction)
+}
+
+func (uc *reactionUseCase) RemoveReaction(ctx context.Context, reaction *entity.Reaction) (*entity.ReactionSummary, error) {
+	if err := uc.validate(ctx, reaction); err != nil {
+		return nil, err
+	}
+
+	if err := uc.reactionRepo.DeleteReaction(ctx, reaction); err != nil {
+		uc.logger.WithError(err).WithField("targetID", reaction.TargetId).Error("Failed to remove reaction")
+		return nil, fmt.Errorf("failed to remove reaction: %w", err)
+	}
+
+	return uc.summary(ctx, reaction)
+}
+
+// validate checks the kind is enabled and that the target exists and is
+// visible; comments still waiting for moderation cannot be reacted to.
+func (uc *reactionUseCase) validate(ctx context.Context, reaction *entity.Reaction) error {
+	if _, ok := uc.kinds[reaction.Kind]; !ok {
+		return ErrInvalidReaction
+	}
+
+	switch reaction.TargetType {
+	case entity.ReactionTargetPost:
+		post, err := uc.postRepo.GetPostById(ctx, reaction.TargetId)
+		if err != nil {
+			uc.logger.WithError(err).WithField("postID", reaction.TargetId).Error("Failed to get post")
+			return ErrPostNotFound
+		}
+		if !post.VisibleTo(reaction.UserId) {
+			return ErrPostNotFound
+		}
+	case entity.ReactionTargetComment:
+		comment, err := uc.commentRepo.GetCommentById(ctx, reaction.TargetId)
+		if err != nil {
+			uc.logger.WithError(err).WithField("commentID", reaction.TargetId).Error("Failed to get comment")
+			return ErrCommentNotFound
+		}
+		if comment.Status != entity.CommentStatusApproved {
+			return ErrCommentNotFound
+		}
+	default:
+		return ErrInvalidReaction
+	}
+
+	return nil
+}
+
+func (uc *reactionUseCase) summary(ctx context.Context, reaction *entity.Reaction) (*entity.ReactionSummary, error) {
+	summaries, err := loadReactions(ctx, uc.reactionRepo, reaction.TargetType, []uuid.UUID{reaction.TargetId}, reaction.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return summaries[reaction.TargetId], nil
+}
+
+// loadReactions fetches reaction summaries for a page of posts or comments in
+// a single query.
+func loadReactions(ctx context.Context, repo repository.ReactionRepository, target entity.ReactionTarget, ids []uuid.UUID, viewerID uuid.UUID) (map[uuid.UUID]*entity.ReactionSummary, error) {
+	summaries, err := repo.GetReactionSummaries(ctx, target, ids, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions: %w", err)
+	}
+
+	return summaries, nil
+}
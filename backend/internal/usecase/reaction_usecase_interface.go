@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_reaction_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseReaction
+
+type UseCaseReaction interface {
+	AddReaction(ctx context.Context, reaction *entity.Reaction) (*entity.ReactionSummary, error)
+	RemoveReaction(ctx context.Context, reaction *entity.Reaction) (*entity.ReactionSummary, error)
+}
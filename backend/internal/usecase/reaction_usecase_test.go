@@ -0,0 +1,110 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestAddReaction(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(reactionRepo *mocksrepository.MockReactionRepository, postRepo *mocksrepository.MockPostRepository, commentRepo *mocksrepository.MockCommentRepository)
+		reaction      *entity.Reaction
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name: "React to a post",
+			mockSetup: func(reactionRepo *mocksrepository.MockReactionRepository, postRepo *mocksrepository.MockPostRepository, commentRepo *mocksrepository.MockCommentRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId1).
+					Return(&entity.Post{Id: postId1}, nil).Times(1)
+				reactionRepo.EXPECT().
+					AddReaction(gomock.Any(), gomock.Any()).
+					Return(nil).Times(1)
+				reactionRepo.EXPECT().
+					GetReactionSummaries(gomock.Any(), entity.ReactionTargetPost, []uuid.UUID{postId1}, authorId1).
+					Return(map[uuid.UUID]*entity.ReactionSummary{
+						postId1: {Counts: map[string]int{"like": 1}, Mine: []string{"like"}},
+					}, nil).Times(1)
+			},
+			reaction:      &entity.Reaction{TargetType: entity.ReactionTargetPost, TargetId: postId1, UserId: authorId1, Kind: "like"},
+			expectedCount: 1,
+		},
+		{
+			name: "Unknown kind",
+			mockSetup: func(*mocksrepository.MockReactionRepository, *mocksrepository.MockPostRepository, *mocksrepository.MockCommentRepository) {
+			},
+			reaction:      &entity.Reaction{TargetType: entity.ReactionTargetPost, TargetId: postId1, UserId: authorId1, Kind: "angry"},
+			expectedError: usecase.ErrInvalidReaction,
+		},
+		{
+			name: "Post not found",
+			mockSetup: func(reactionRepo *mocksrepository.MockReactionRepository, postRepo *mocksrepository.MockPostRepository, commentRepo *mocksrepository.MockCommentRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId2).
+					Return(nil, errors.New("not found")).Times(1)
+			},
+			reaction:      &entity.Reaction{TargetType: entity.ReactionTargetPost, TargetId: postId2, UserId: authorId1, Kind: "like"},
+			expectedError: usecase.ErrPostNotFound,
+		},
+		{
+			name: "Draft of another author",
+			mockSetup: func(reactionRepo *mocksrepository.MockReactionRepository, postRepo *mocksrepository.MockPostRepository, commentRepo *mocksrepository.MockCommentRepository) {
+				postRepo.EXPECT().
+					GetPostById(gomock.Any(), postId2).
+					Return(&entity.Post{Id: postId2, AuthorId: authorId2, Status: entity.PostStatusDraft}, nil).Times(1)
+			},
+			reaction:      &entity.Reaction{TargetType: entity.ReactionTargetPost, TargetId: postId2, UserId: authorId1, Kind: "like"},
+			expectedError: usecase.ErrPostNotFound,
+		},
+		{
+			name: "Pending comment",
+			mockSetup: func(reactionRepo *mocksrepository.MockReactionRepository, postRepo *mocksrepository.MockPostRepository, commentRepo *mocksrepository.MockCommentRepository) {
+				commentRepo.EXPECT().
+					GetCommentById(gomock.Any(), commentId1).
+					Return(&entity.Comment{Id: commentId1, Status: entity.CommentStatusPending}, nil).Times(1)
+			},
+			reaction:      &entity.Reaction{TargetType: entity.ReactionTargetComment, TargetId: commentId1, UserId: authorId1, Kind: "like"},
+			expectedError: usecase.ErrCommentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reactionRepo := mocksrepository.NewMockReactionRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			commentRepo := mocksrepository.NewMockCommentRepository(ctrl)
+			cfg := &config.Config{Reactions: config.ReactionsConfig{Kinds: []string{"like", "love"}}}
+			uc := usecase.NewReactionUseCase(reactionRepo, postRepo, commentRepo, logrus.New(), cfg)
+
+			tt.mockSetup(reactionRepo, postRepo, commentRepo)
+
+			summary, err := uc.AddReaction(context.Background(), tt.reaction)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, summary)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCount, summary.Counts[tt.reaction.Kind])
+			assert.Contains(t, summary.Mine, tt.reaction.Kind)
+		})
+	}
+}
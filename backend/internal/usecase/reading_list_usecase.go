@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+const maxReadingListNameLength = 255
+
+type readingListUseCase struct {
+	listRepo repository.ReadingListRepository
+	postRepo repository.PostRepository
+	logger   *logrus.Logger
+}
+
+func NewReadingListUseCase(listRepo repository.ReadingListRepository, postRepo repository.PostRepository, logger *logrus.Logger) UseCaseReadingList {
+	return &readingListUseCase{
+		listRepo: listRepo,
+		postRepo: postRepo,
+		logger:   logger,
+	}
+}
+
+func (uc *readingListUseCase) CreateList(ctx context.Context, list *entity.NewReadingList) (*entity.ReadingList, error) {
+	if list == nil {
+		return nil, ErrInvalidReadingList
+	}
+
+	newList := &entity.ReadingList{
+		OwnerId:    list.OwnerId,
+		Name:       strings.TrimSpace(list.Name),
+		Visibility: list.Visibility,
+	}
+	if err := uc.prepare(newList); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.listRepo.CreateList(ctx, newList)
+	if err != nil {
+		uc.logger.WithError(err).WithField("ownerID", list.OwnerId).Error("Failed to create reading list")
+		return nil, fmt.Errorf("failed to create reading list: %w", err)
+	}
+	created.Items = []*entity.ReadingListItem{}
+
+	return created, nil
+}
+
+func (uc *readingListUseCase) GetLists(ctx context.Context, ownerID uuid.UUID) ([]*entity.ReadingList, error) {
+	lists, err := uc.listRepo.GetListsByOwner(ctx, ownerID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("ownerID", ownerID).Error("Failed to get reading lists")
+		return nil, fmt.Errorf("failed to get reading lists: %w", err)
+	}
+
+	return lists, nil
+}
+
+func (uc *readingListUseCase) GetList(ctx context.Context, id, ownerID uuid.UUID) (*entity.ReadingList, error) {
+	list, err := uc.ownedList(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withItems(ctx, list, list.OwnerId)
+}
+
+func (uc *readingListUseCase) GetSharedList(ctx context.Context, token string) (*entity.ReadingList, error) {
+	list, err := uc.listRepo.GetListByShareToken(ctx, token)
+	if err != nil {
+		uc.logger.WithError(err).Info("Shared reading list not found")
+		return nil, ErrReadingListNotFound
+	}
+
+	return uc.withItems(ctx, list, uuid.Nil)
+}
+
+func (uc *readingListUseCase) UpdateList(ctx context.Context, update *entity.UpdateReadingList) (*entity.ReadingList, error) {
+	if update == nil {
+		return nil, ErrInvalidReadingList
+	}
+
+	list, err := uc.ownedList(ctx, update.Id, update.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	list.Name = strings.TrimSpace(update.Name)
+	list.Visibility = update.Visibility
+	if err := uc.prepare(list); err != nil {
+		return nil, err
+	}
+
+	if err := uc.listRepo.UpdateList(ctx, list); err != nil {
+		uc.logger.WithError(err).WithField("listID", list.Id).Error("Failed to update reading list")
+		return nil, fmt.Errorf("failed to update reading list: %w", err)
+	}
+
+	return uc.withItems(ctx, list, list.OwnerId)
+}
+
+func (uc *readingListUseCase) DeleteList(ctx context.Context, id, ownerID uuid.UUID) error {
+	if _, err := uc.ownedList(ctx, id, ownerID); err != nil {
+		return err
+	}
+
+	if err := uc.listRepo.DeleteList(ctx, id); err != nil {
+		uc.logger.WithError(err).WithField("listID", id).Error("Failed to delete reading list")
+		return fmt.Errorf("failed to delete reading list: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *readingListUseCase) AddItem(ctx context.Context, id, ownerID, postID uuid.UUID) (*entity.ReadingList, error) {
+	list, err := uc.ownedList(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := uc.postRepo.GetPostById(ctx, postID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("postID", postID).Error("Failed to get post")
+		return nil, ErrPostNotFound
+	}
+	if !post.VisibleTo(ownerID) {
+		return nil, ErrPostNotFound
+	}
+
+	if err := uc.listRepo.AddItem(ctx, id, postID); err != nil {
+		uc.logger.WithError(err).WithField("listID", id).Error("Failed to add reading list item")
+		return nil, fmt.Errorf("failed to add reading list item: %w", err)
+	}
+
+	return uc.withItems(ctx, list, list.OwnerId)
+}
+
+func (uc *readingListUseCase) RemoveItem(ctx context.Context, id, ownerID, postID uuid.UUID) error {
+	if _, err := uc.ownedList(ctx, id, ownerID); err != nil {
+		return err
+	}
+
+	if err := uc.listRepo.RemoveItem(ctx, id, postID); err != nil {
+		uc.logger.WithError(err).WithField("listID", id).Error("Failed to remove reading list item")
+		return fmt.Errorf("failed to remove reading list item: %w", err)
+	}
+
+	return nil
+}
+
+// ReorderItems requires the complete new order: every post on the list
+// exactly once, so a stale client cannot silently drop items.
+func (uc *readingListUseCase) ReorderItems(ctx context.Context, id, ownerID uuid.UUID, postIDs []uuid.UUID) (*entity.ReadingList, error) {
+	list, err := uc.ownedList(ctx, id, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := uc.listRepo.GetItems(ctx, id, ownerID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("listID", id).Error("Failed to get reading list items")
+		return nil, fmt.Errorf("failed to get reading list items: %w", err)
+	}
+
+	if len(postIDs) != len(items) {
+		return nil, ErrInvalidReadingListOrder
+	}
+	onList := make(map[uuid.UUID]bool, len(items))
+	for _, item := range items {
+		onList[item.PostId] = true
+	}
+	for _, postID := range postIDs {
+		if !onList[postID] {
+			return nil, ErrInvalidReadingListOrder
+		}
+		delete(onList, postID)
+	}
+
+	if err := uc.listRepo.ReorderItems(ctx, id, postIDs); err != nil {
+		uc.logger.WithError(err).WithField("listID", id).Error("Failed to reorder reading list items")
+		return nil, fmt.Errorf("failed to reorder reading list items: %w", err)
+	}
+
+	return uc.withItems(ctx, list, list.OwnerId)
+}
+
+// ownedList loads a list for its owner. Other users' lists are reported as
+// missing so private lists do not leak their existence.
+func (uc *readingListUseCase) ownedList(ctx context.Context, id, ownerID uuid.UUID) (*entity.ReadingList, error) {
+	list, err := uc.listRepo.GetListById(ctx, id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("listID", id).Error("Failed to get reading list")
+		return nil, ErrReadingListNotFound
+	}
+
+	if list.OwnerId != ownerID {
+		return nil, ErrReadingListNotFound
+	}
+
+	return list, nil
+}
+
+// withItems loads the posts of a list that the viewer may see; drafts and
+// archived posts stay hidden from everyone but their author.
+func (uc *readingListUseCase) withItems(ctx context.Context, list *entity.ReadingList, viewerID uuid.UUID) (*entity.ReadingList, error) {
+	items, err := uc.listRepo.GetItems(ctx, list.Id, viewerID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("listID", list.Id).Error("Failed to get reading list items")
+		return nil, fmt.Errorf("failed to get reading list items: %w", err)
+	}
+	list.Items = items
+
+	return list, nil
+}
+
+// prepare validates a list and keeps its share token in step with its
+// visibility: sharing issues a token once, making it private revokes it.
+func (uc *readingListUseCase) prepare(list *entity.ReadingList) error {
+	if list.Visibility == "" {
+		list.Visibility = entity.ReadingListPrivate
+	}
+
+	if list.Name == "" || len(list.Name) > maxReadingListNameLength || !list.Visibility.Valid() {
+		return ErrInvalidReadingList
+	}
+
+	switch list.Visibility {
+	case entity.ReadingListShared:
+		if list.ShareToken == nil {
+			token, err := newShareToken()
+			if err != nil {
+				uc.logger.WithError(err).Error("Failed to generate share token")
+				return fmt.Errorf("failed to generate share token: %w", err)
+			}
+			list.ShareToken = &token
+		}
+	case entity.ReadingListPrivate:
+		list.ShareToken = nil
+	}
+
+	return nil
+}
+
+func newShareToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
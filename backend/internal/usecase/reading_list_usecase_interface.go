@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_reading_list_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseReadingList
+
+type UseCaseReadingList interface {
+	CreateList(ctx context.Context, list *entity.NewReadingList) (*entity.ReadingList, error)
+	GetLists(ctx context.Context, ownerID uuid.UUID) ([]*entity.ReadingList, error)
+	GetList(ctx context.Context, id, ownerID uuid.UUID) (*entity.ReadingList, error)
+	GetSharedList(ctx context.Context, token string) (*entity.ReadingList, error)
+	UpdateList(ctx context.Context, list *entity.UpdateReadingList) (*entity.ReadingList, error)
+	DeleteList(ctx context.Context, id, ownerID uuid.UUID) error
+	AddItem(ctx context.Context, id, ownerID, postID uuid.UUID) (*entity.ReadingList, error)
+	RemoveItem(ctx context.Context, id, ownerID, postID uuid.UUID) error
+	ReorderItems(ctx context.Context, id, ownerID uuid.UUID, postIDs []uuid.UUID) (*entity.ReadingList, error)
+}
@@ -0,0 +1,198 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestUpdateReadingList_Visibility(t *testing.T) {
+	listId := uuid.New()
+	token := "existing-token"
+
+	tests := []struct {
+		name          string
+		existing      *entity.ReadingList
+		update        *entity.UpdateReadingList
+		expectToken   bool
+		expectedToken string
+		expectedError error
+	}{
+		{
+			name:        "Sharing issues a token",
+			existing:    &entity.ReadingList{Id: listId, OwnerId: authorId1, Name: "Later", Visibility: entity.ReadingListPrivate},
+			update:      &entity.UpdateReadingList{Id: listId, OwnerId: authorId1, Name: "Later", Visibility: entity.ReadingListShared},
+			expectToken: true,
+		},
+		{
+			name:          "Shared list keeps its token",
+			existing:      &entity.ReadingList{Id: listId, OwnerId: authorId1, Name: "Later", Visibility: entity.ReadingListShared, ShareToken: &token},
+			update:        &entity.UpdateReadingList{Id: listId, OwnerId: authorId1, Name: "Renamed", Visibility: entity.ReadingListShared},
+			expectToken:   true,
+			expectedToken: token,
+		},
+		{
+			name:     "Making a list private revokes the token",
+			existing: &entity.ReadingList{Id: listId, OwnerId: authorId1, Name: "Later", Visibility: entity.ReadingListShared, ShareToken: &token},
+			update:   &entity.UpdateReadingList{Id: listId, OwnerId: authorId1, Name: "Later", Visibility: entity.ReadingListPrivate},
+		},
+		{
+			name:          "Other users' lists are not found",
+			existing:      &entity.ReadingList{Id: listId, OwnerId: authorId2, Name: "Later", Visibility: entity.ReadingListPrivate},
+			update:        &entity.UpdateReadingList{Id: listId, OwnerId: authorId1, Name: "Mine now", Visibility: entity.ReadingListShared},
+			expectedError: usecase.ErrReadingListNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			listRepo := mocksrepository.NewMockReadingListRepository(ctrl)
+			uc := usecase.NewReadingListUseCase(listRepo, nil, logrus.New())
+
+			listRepo.EXPECT().GetListById(gomock.Any(), listId).Return(tt.existing, nil).Times(1)
+			if tt.expectedError == nil {
+				listRepo.EXPECT().UpdateList(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				listRepo.EXPECT().GetItems(gomock.Any(), listId, authorId1).Return([]*entity.ReadingListItem{}, nil).Times(1)
+			}
+
+			list, err := uc.UpdateList(context.Background(), tt.update)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.update.Name, list.Name)
+			if !tt.expectToken {
+				assert.Nil(t, list.ShareToken)
+				return
+			}
+			assert.NotNil(t, list.ShareToken)
+			if tt.expectedToken != "" {
+				assert.Equal(t, tt.expectedToken, *list.ShareToken)
+			}
+		})
+	}
+}
+
+func TestReorderReadingList(t *testing.T) {
+	listId := uuid.New()
+	items := []*entity.ReadingListItem{{PostId: postId1, Position: 1}, {PostId: postId2, Position: 2}}
+
+	tests := []struct {
+		name          string
+		postIds       []uuid.UUID
+		expectedError error
+	}{
+		{
+			name:    "Complete new order",
+			postIds: []uuid.UUID{postId2, postId1},
+		},
+		{
+			name:          "Missing post",
+			postIds:       []uuid.UUID{postId2},
+			expectedError: usecase.ErrInvalidReadingListOrder,
+		},
+		{
+			name:          "Duplicated post",
+			postIds:       []uuid.UUID{postId2, postId2},
+			expectedError: usecase.ErrInvalidReadingListOrder,
+		},
+		{
+			name:          "Post not on the list",
+			postIds:       []uuid.UUID{postId1, uuid.New()},
+			expectedError: usecase.ErrInvalidReadingListOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			listRepo := mocksrepository.NewMockReadingListRepository(ctrl)
+			uc := usecase.NewReadingListUseCase(listRepo, nil, logrus.New())
+
+			listRepo.EXPECT().
+				GetListById(gomock.Any(), listId).
+				Return(&entity.ReadingList{Id: listId, OwnerId: authorId1}, nil).Times(1)
+			listRepo.EXPECT().GetItems(gomock.Any(), listId, authorId1).Return(items, nil).Times(1)
+			if tt.expectedError == nil {
+				listRepo.EXPECT().ReorderItems(gomock.Any(), listId, tt.postIds).Return(nil).Times(1)
+				listRepo.EXPECT().GetItems(gomock.Any(), listId, authorId1).Return(items, nil).Times(1)
+			}
+
+			_, err := uc.ReorderItems(context.Background(), listId, authorId1, tt.postIds)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAddReadingListItem(t *testing.T) {
+	listId := uuid.New()
+
+	tests := []struct {
+		name          string
+		post          *entity.Post
+		expectedError error
+	}{
+		{
+			name: "Published post",
+			post: &entity.Post{Id: postId1, AuthorId: authorId2, Status: entity.PostStatusPublished},
+		},
+		{
+			name: "Own draft",
+			post: &entity.Post{Id: postId1, AuthorId: authorId1, Status: entity.PostStatusDraft},
+		},
+		{
+			name:          "Someone else's draft",
+			post:          &entity.Post{Id: postId1, AuthorId: authorId2, Status: entity.PostStatusDraft},
+			expectedError: usecase.ErrPostNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			listRepo := mocksrepository.NewMockReadingListRepository(ctrl)
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			uc := usecase.NewReadingListUseCase(listRepo, postRepo, logrus.New())
+
+			listRepo.EXPECT().
+				GetListById(gomock.Any(), listId).
+				Return(&entity.ReadingList{Id: listId, OwnerId: authorId1}, nil).Times(1)
+			postRepo.EXPECT().GetPostById(gomock.Any(), postId1).Return(tt.post, nil).Times(1)
+			if tt.expectedError == nil {
+				listRepo.EXPECT().AddItem(gomock.Any(), listId, postId1).Return(nil).Times(1)
+				listRepo.EXPECT().GetItems(gomock.Any(), listId, authorId1).Return([]*entity.ReadingListItem{}, nil).Times(1)
+			}
+
+			_, err := uc.AddItem(context.Background(), listId, authorId1, postId1)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
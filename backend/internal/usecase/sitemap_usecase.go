@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+type sitemapUseCase struct {
+	postRepo  repository.PostRepository
+	logger    *logrus.Logger
+	chunkSize int
+}
+
+// NewSitemapUseCase creates the sitemap generator; chunkSize is how many
+// URLs go into one sitemap file.
+func NewSitemapUseCase(postRepo repository.PostRepository, logger *logrus.Logger, chunkSize int) UseCaseSitemap {
+	return &sitemapUseCase{
+		postRepo:  postRepo,
+		logger:    logger,
+		chunkSize: max(chunkSize, 1),
+	}
+}
+
+func (uc *sitemapUseCase) GetSitemapIndex(ctx context.Context) ([]entity.SitemapChunk, error) {
+	var chunks []entity.SitemapChunk
+	for _, kind := range entity.SitemapKinds {
+		total, err := uc.postRepo.CountSitemapEntries(ctx, kind)
+		if err != nil {
+			uc.logger.WithError(err).WithField("kind", kind).Error("Failed to count sitemap entries")
+			return nil, err
+		}
+		if total == 0 {
+			continue
+		}
+
+		chunks = append(chunks, entity.SitemapChunk{Kind: kind})
+		if total <= uc.chunkSize {
+			continue
+		}
+
+		boundaries, err := uc.postRepo.GetSitemapBoundaries(ctx, kind, uc.chunkSize)
+		if err != nil {
+			uc.logger.WithError(err).WithField("kind", kind).Error("Failed to get sitemap boundaries")
+			return nil, err
+		}
+		// The last boundary ends the sitemap when it fills its last chunk.
+		pages := (total + uc.chunkSize - 1) / uc.chunkSize
+		for _, after := range boundaries[:min(len(boundaries), pages-1)] {
+			chunks = append(chunks, entity.SitemapChunk{Kind: kind, After: &after})
+		}
+	}
+
+	// An index has to list at least one sitemap, even for an empty blog.
+	if len(chunks) == 0 {
+		chunks = append(chunks, entity.SitemapChunk{Kind: entity.SitemapPosts})
+	}
+
+	return chunks, nil
+}
+
+func (uc *sitemapUseCase) GetSitemap(ctx context.Context, chunk entity.SitemapChunk) ([]*entity.SitemapEntry, error) {
+	if !slices.Contains(entity.SitemapKinds, chunk.Kind) || !sitemapPosition(chunk.Kind, chunk.After) {
+		return nil, ErrSitemapNotFound
+	}
+
+	entries, err := uc.postRepo.GetSitemapEntries(ctx, chunk.Kind, chunk.After, uc.chunkSize)
+	if err != nil {
+		uc.logger.WithError(err).WithField("kind", chunk.Kind).Error("Failed to get sitemap entries")
+		return nil, err
+	}
+
+	// The first posts sitemap is listed even when it is empty.
+	if len(entries) == 0 && (chunk.After != nil || chunk.Kind != entity.SitemapPosts) {
+		return nil, ErrSitemapNotFound
+	}
+
+	return entries, nil
+}
+
+// sitemapPosition tells whether after is a position in sitemaps of the
+// kind: posts are placed by creation time and id, authors and tags by
+// their key.
+func sitemapPosition(kind entity.SitemapKind, after *entity.Cursor) bool {
+	if after == nil {
+		return true
+	}
+
+	if kind == entity.SitemapPosts {
+		return after.Id != uuid.Nil && len(after.Keys) == 0
+	}
+	return after.Id == uuid.Nil && len(after.Keys) == 1
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+)
+
+//go:generate mockgen -destination=mocks/mock_sitemap_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseSitemap
+
+type UseCaseSitemap interface {
+	// GetSitemapIndex lists the sitemap chunks that currently exist.
+	GetSitemapIndex(ctx context.Context) ([]entity.SitemapChunk, error)
+	// GetSitemap returns the entries of a chunk, or ErrSitemapNotFound for a
+	// chunk that doesn't exist.
+	GetSitemap(ctx context.Context, chunk entity.SitemapChunk) ([]*entity.SitemapEntry, error)
+}
@@ -0,0 +1,180 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestGetSitemapIndex(t *testing.T) {
+	boundary := entity.Cursor{CreatedAt: time.Now(), Id: postId1}
+
+	tests := []struct {
+		name          string
+		mockSetup     func(postRepo *mocksrepository.MockPostRepository)
+		expected      []entity.SitemapChunk
+		expectedError string
+	}{
+		{
+			name: "Chunks per kind",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().CountSitemapEntries(gomock.Any(), entity.SitemapPosts).Return(5, nil).Times(1)
+				postRepo.EXPECT().
+					GetSitemapBoundaries(gomock.Any(), entity.SitemapPosts, 2).
+					Return([]entity.Cursor{boundary, boundary}, nil).Times(1)
+				postRepo.EXPECT().CountSitemapEntries(gomock.Any(), entity.SitemapAuthors).Return(2, nil).Times(1)
+				postRepo.EXPECT().CountSitemapEntries(gomock.Any(), entity.SitemapTags).Return(0, nil).Times(1)
+			},
+			expected: []entity.SitemapChunk{
+				{Kind: entity.SitemapPosts},
+				{Kind: entity.SitemapPosts, After: &boundary},
+				{Kind: entity.SitemapPosts, After: &boundary},
+				{Kind: entity.SitemapAuthors},
+			},
+		},
+		{
+			name: "A full last chunk has no chunk after it",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().CountSitemapEntries(gomock.Any(), entity.SitemapPosts).Return(4, nil).Times(1)
+				postRepo.EXPECT().
+					GetSitemapBoundaries(gomock.Any(), entity.SitemapPosts, 2).
+					Return([]entity.Cursor{boundary, boundary}, nil).Times(1)
+				postRepo.EXPECT().CountSitemapEntries(gomock.Any(), gomock.Any()).Return(0, nil).Times(2)
+			},
+			expected: []entity.SitemapChunk{
+				{Kind: entity.SitemapPosts},
+				{Kind: entity.SitemapPosts, After: &boundary},
+			},
+		},
+		{
+			name: "Empty blog",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().CountSitemapEntries(gomock.Any(), gomock.Any()).Return(0, nil).Times(3)
+			},
+			expected: []entity.SitemapChunk{{Kind: entity.SitemapPosts}},
+		},
+		{
+			name: "Database error",
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().CountSitemapEntries(gomock.Any(), entity.SitemapPosts).Return(0, errors.New("database error")).Times(1)
+			},
+			expectedError: "database error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			uc := usecase.NewSitemapUseCase(postRepo, logrus.New(), 2)
+
+			tt.mockSetup(postRepo)
+
+			result, err := uc.GetSitemapIndex(context.Background())
+
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestGetSitemap(t *testing.T) {
+	after := &entity.Cursor{CreatedAt: time.Now(), Id: postId1}
+
+	tests := []struct {
+		name          string
+		chunk         entity.SitemapChunk
+		mockSetup     func(postRepo *mocksrepository.MockPostRepository)
+		expectedKeys  []string
+		expectedError error
+	}{
+		{
+			name:  "Second chunk",
+			chunk: entity.SitemapChunk{Kind: entity.SitemapPosts, After: after},
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().
+					GetSitemapEntries(gomock.Any(), entity.SitemapPosts, after, 2).
+					Return([]*entity.SitemapEntry{{Key: postId2.String(), LastMod: time.Now()}}, nil).Times(1)
+			},
+			expectedKeys: []string{postId2.String()},
+		},
+		{
+			name:  "Empty posts sitemap",
+			chunk: entity.SitemapChunk{Kind: entity.SitemapPosts},
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().GetSitemapEntries(gomock.Any(), entity.SitemapPosts, nil, 2).Return([]*entity.SitemapEntry{}, nil).Times(1)
+			},
+			expectedKeys: []string{},
+		},
+		{
+			name:  "Past the last chunk",
+			chunk: entity.SitemapChunk{Kind: entity.SitemapPosts, After: after},
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().GetSitemapEntries(gomock.Any(), entity.SitemapPosts, after, 2).Return([]*entity.SitemapEntry{}, nil).Times(1)
+			},
+			expectedError: usecase.ErrSitemapNotFound,
+		},
+		{
+			name:  "No tags yet",
+			chunk: entity.SitemapChunk{Kind: entity.SitemapTags},
+			mockSetup: func(postRepo *mocksrepository.MockPostRepository) {
+				postRepo.EXPECT().GetSitemapEntries(gomock.Any(), entity.SitemapTags, nil, 2).Return([]*entity.SitemapEntry{}, nil).Times(1)
+			},
+			expectedError: usecase.ErrSitemapNotFound,
+		},
+		{
+			name:          "Position of another kind",
+			chunk:         entity.SitemapChunk{Kind: entity.SitemapTags, After: after},
+			mockSetup:     func(*mocksrepository.MockPostRepository) {},
+			expectedError: usecase.ErrSitemapNotFound,
+		},
+		{
+			name:          "Unknown kind",
+			chunk:         entity.SitemapChunk{Kind: "comments"},
+			mockSetup:     func(*mocksrepository.MockPostRepository) {},
+			expectedError: usecase.ErrSitemapNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			postRepo := mocksrepository.NewMockPostRepository(ctrl)
+			uc := usecase.NewSitemapUseCase(postRepo, logrus.New(), 2)
+
+			tt.mockSetup(postRepo)
+
+			entries, err := uc.GetSitemap(context.Background(), tt.chunk)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			keys := []string{}
+			for _, entry := range entries {
+				keys = append(keys, entry.Key)
+			}
+			assert.Equal(t, tt.expectedKeys, keys)
+		})
+	}
+}
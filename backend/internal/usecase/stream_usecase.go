@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+)
+
+type streamUseCase struct {
+	broker    events.Broker
+	logger    *logrus.Logger
+	maxTopics int
+}
+
+func NewStreamUseCase(broker events.Broker, logger *logrus.Logger, cfg *config.Config) UseCaseStream {
+	return &streamUseCase{
+		broker:    broker,
+		logger:    logger,
+		maxTopics: cfg.Stream.MaxTopics,
+	}
+}
+
+func (uc *streamUseCase) Subscribe(ctx context.Context, viewerID uuid.UUID, topics []string, lastEventID int64) (*events.Subscription, []*entity.Event, error) {
+	resolved, err := uc.resolveTopics(viewerID, topics)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Subscribe before replaying so nothing published in between is lost.
+	// The caller skips live events it already got from the replay.
+	sub := uc.broker.Subscribe(resolved)
+
+	if lastEventID <= 0 {
+		return sub, nil, nil
+	}
+
+	missed, err := uc.broker.Replay(ctx, lastEventID, resolved)
+	if err != nil {
+		sub.Close()
+		uc.logger.WithError(err).Error("Failed to replay stream events")
+		return nil, nil, err
+	}
+
+	return sub, missed, nil
+}
+
+// resolveTopics validates the requested topics and replaces "me" in user
+// topics with the viewer's id. Users may only follow their own topics.
+func (uc *streamUseCase) resolveTopics(viewerID uuid.UUID, topics []string) ([]string, error) {
+	if len(topics) == 0 || (uc.maxTopics > 0 && len(topics) > uc.maxTopics) {
+		return nil, ErrInvalidTopic
+	}
+
+	seen := make(map[string]bool, len(topics))
+	resolved := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		topic, err := resolveTopic(viewerID, topic)
+		if err != nil {
+			return nil, err
+		}
+		if seen[topic] {
+			continue
+		}
+		seen[topic] = true
+		resolved = append(resolved, topic)
+	}
+
+	return resolved, nil
+}
+
+func resolveTopic(viewerID uuid.UUID, topic string) (string, error) {
+	if topic == entity.PostsTopic {
+		return topic, nil
+	}
+
+	parts := strings.Split(topic, ":")
+	switch {
+	case len(parts) == 2 && parts[0] == "post":
+		postID, err := uuid.Parse(parts[1])
+		if err != nil {
+			return "", ErrInvalidTopic
+		}
+		return entity.PostTopic(postID), nil
+	case len(parts) == 3 && parts[0] == "post" && parts[2] == "comments":
+		postID, err := uuid.Parse(parts[1])
+		if err != nil {
+			return "", ErrInvalidTopic
+		}
+		return entity.PostCommentsTopic(postID), nil
+	case len(parts) == 3 && parts[0] == "user" && parts[2] == "notifications":
+		if viewerID == uuid.Nil {
+			return "", ErrTopicForbidden
+		}
+		if parts[1] != "me" && parts[1] != viewerID.String() {
+			return "", ErrTopicForbidden
+		}
+		return entity.UserNotificationsTopic(viewerID), nil
+	}
+
+	return "", ErrInvalidTopic
+}
+
+// publish announces a change to stream subscribers. Like notify it tolerates
+// a missing publisher and only logs failures, because the change itself is
+// already stored.
+func publish(ctx context.Context, publisher events.Publisher, logger *logrus.Logger, topic, eventType string, payload any) {
+	if publisher == nil {
+		return
+	}
+
+	if err := publisher.Publish(ctx, topic, eventType, payload); err != nil {
+		logger.WithError(err).WithFields(logrus.Fields{
+			"topic": topic,
+			"type":  eventType,
+		}).Warn("Failed to publish event")
+	}
+}
@@ -0,0 +1,18 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+)
+
+//go:generate mockgen -destination=mocks/mock_stream_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseStream
+
+type UseCaseStream interface {
+	// Subscribe checks the requested topics against the viewer and returns the
+	// live subscription together with the events published after lastEventID.
+	// Pass zero to skip the replay and uuid.Nil for anonymous viewers.
+	Subscribe(ctx context.Context, viewerID uuid.UUID, topics []string, lastEventID int64) (*events.Subscription, []*entity.Event, error)
+}
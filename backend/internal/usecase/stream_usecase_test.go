@@ -0,0 +1,110 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/popeskul/awesome-blog/backend/internal/config"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+)
+
+func TestStreamSubscribe(t *testing.T) {
+	tests := []struct {
+		name          string
+		viewerID      uuid.UUID
+		topics        []string
+		expectedTopic string
+		expectedError error
+	}{
+		{
+			name:          "All posts",
+			topics:        []string{"posts"},
+			expectedTopic: entity.PostsTopic,
+		},
+		{
+			name:          "Post comments",
+			topics:        []string{"post:" + postId1.String() + ":comments"},
+			expectedTopic: entity.PostCommentsTopic(postId1),
+		},
+		{
+			name:          "Own notifications by alias",
+			viewerID:      authorId1,
+			topics:        []string{"user:me:notifications"},
+			expectedTopic: entity.UserNotificationsTopic(authorId1),
+		},
+		{
+			name:          "Someone else's notifications",
+			viewerID:      authorId1,
+			topics:        []string{"user:" + authorId2.String() + ":notifications"},
+			expectedError: usecase.ErrTopicForbidden,
+		},
+		{
+			name:          "Notifications without signing in",
+			topics:        []string{"user:me:notifications"},
+			expectedError: usecase.ErrTopicForbidden,
+		},
+		{
+			name:          "Malformed post topic",
+			topics:        []string{"post:not-a-uuid"},
+			expectedError: usecase.ErrInvalidTopic,
+		},
+		{
+			name:          "No topics",
+			expectedError: usecase.ErrInvalidTopic,
+		},
+		{
+			name:          "Too many topics",
+			topics:        []string{"posts", "posts", "posts"},
+			expectedError: usecase.ErrInvalidTopic,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := events.NewMemoryBroker(10)
+			defer broker.Close()
+
+			cfg := &config.Config{Stream: config.StreamConfig{MaxTopics: 2}}
+			uc := usecase.NewStreamUseCase(broker, logrus.New(), cfg)
+
+			sub, _, err := uc.Subscribe(context.Background(), tt.viewerID, tt.topics, 0)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			defer sub.Close()
+
+			require.NoError(t, broker.Publish(context.Background(), tt.expectedTopic, "test", nil))
+			event := <-sub.Events()
+			assert.Equal(t, tt.expectedTopic, event.Topic)
+		})
+	}
+}
+
+func TestStreamSubscribe_Replay(t *testing.T) {
+	broker := events.NewMemoryBroker(10)
+	defer broker.Close()
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, broker.Publish(ctx, entity.PostsTopic, entity.EventPostCreated, nil))
+	}
+
+	uc := usecase.NewStreamUseCase(broker, logrus.New(), &config.Config{})
+
+	sub, missed, err := uc.Subscribe(ctx, uuid.Nil, []string{entity.PostsTopic}, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	require.Len(t, missed, 2)
+	assert.Equal(t, int64(2), missed[0].Id)
+	assert.Equal(t, int64(3), missed[1].Id)
+}
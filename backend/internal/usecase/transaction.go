@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+)
+
+// transact runs fn in a transaction when a unit of work is configured and
+// directly otherwise.
+func transact(ctx context.Context, uow repository.UnitOfWork, fn func(ctx context.Context) error) error {
+	if uow == nil {
+		return fn(ctx)
+	}
+
+	return uow.Do(ctx, fn)
+}
+
+// record publishes an event as part of the change being made in ctx. Unlike
+// publish it returns the error, so that the change is rolled back when its
+// event cannot be stored.
+func record(ctx context.Context, publisher events.Publisher, topic, eventType string, payload any) error {
+	if publisher == nil {
+		return nil
+	}
+
+	return publisher.Publish(ctx, topic, eventType, payload)
+}
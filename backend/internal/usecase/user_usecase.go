@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
@@ -25,6 +26,27 @@ func NewUserUseCase(userRepo repository.UserRepository, logger *logrus.Logger, h
 	}
 }
 
+// CanManageUser reports whether the actor may see or change the account
+// details of userID: users manage their own account, admins manage all of
+// them. Pass uuid.Nil as userID for operations that span every account.
+func (uc *useCase) CanManageUser(ctx context.Context, actorID, userID uuid.UUID) error {
+	if actorID != uuid.Nil && actorID == userID {
+		return nil
+	}
+
+	actor, err := uc.userRepo.GetUserById(ctx, actorID)
+	if err != nil {
+		uc.logger.WithError(err).WithField("userID", actorID).Error("Failed to get user")
+		return ErrForbidden
+	}
+
+	if actor.Role != entity.RoleAdmin {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
 func (uc *useCase) CreateUser(ctx context.Context, user *entity.NewUser) (*entity.User, error) {
 	if user.Username == "" || user.PasswordHash == "" {
 		return nil, ErrEmptyCredentials
@@ -63,26 +85,35 @@ func (uc *useCase) GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User,
 }
 
 func (uc *useCase) GetAllUsers(ctx context.Context, pagination *entity.Pagination) (*entity.Response[entity.User], error) {
-	users, err := uc.userRepo.GetAllUsers(ctx, pagination)
+	rows, err := uc.userRepo.GetAllUsers(ctx, pagination.Lookahead())
 	if err != nil {
 		uc.logger.WithError(err).Error("Failed to get all users")
 		return nil, fmt.Errorf("failed to get all users: %w", err)
 	}
+	users, more := entity.TrimPage(rows, pagination)
 
-	total, err := uc.userRepo.GetTotalUsers(ctx)
-	if err != nil {
-		uc.logger.WithError(err).Error("Failed to get total users count")
-		return nil, fmt.Errorf("failed to get total users count: %w", err)
-	}
-
-	return &entity.Response[entity.User]{
-		Data: users,
-		Pagination: &entity.Pagination{
-			Total: total,
-			Limit: pagination.Limit,
-			Page:  pagination.Page,
-		},
-	}, nil
+	var total int
+	if pagination.WithTotal {
+		total, err = uc.userRepo.GetTotalUsers(ctx)
+		if err != nil {
+			uc.logger.WithError(err).Error("Failed to get total users count")
+			return nil, fmt.Errorf("failed to get total users count: %w", err)
+		}
+	}
+
+	response := entity.NewResponse(users, more, pagination, func(user *entity.User) entity.Cursor {
+		cursor := entity.Cursor{CreatedAt: user.CreatedAt, Id: user.Id}
+		switch {
+		case strings.HasPrefix(pagination.Sort, "username_"):
+			cursor.Keys = []string{user.Username}
+		case strings.HasPrefix(pagination.Sort, "email_"):
+			cursor.Keys = []string{user.Email}
+		}
+		return cursor
+	})
+	response.Pagination.Total = total
+
+	return response, nil
 }
 
 func (uc *useCase) DeleteUserByID(ctx context.Context, id uuid.UUID) error {
@@ -136,3 +167,36 @@ func (uc *useCase) UpdateUserByID(ctx context.Context, userID uuid.UUID, updateU
 
 	return existingUser, nil
 }
+
+// userPatchFields are the attributes of a user a patch may change.
+var userPatchFields = []string{"username", "email", "role"}
+
+func (uc *useCase) PatchUserByID(ctx context.Context, actorID, userID uuid.UUID, user *entity.User, changed []string) (*entity.User, error) {
+	if err := checkPatchFields(changed, userPatchFields); err != nil {
+		return nil, err
+	}
+
+	update := &entity.UpdateUser{Id: userID}
+	for _, field := range changed {
+		switch field {
+		case "username":
+			update.Username = user.Username
+		case "email":
+			update.Email = user.Email
+		case "role":
+			if err := requireAdmin(ctx, uc.userRepo, uc.logger, actorID); err != nil {
+				return nil, err
+			}
+			update.Role = user.Role
+		}
+	}
+
+	if len(changed) > 0 {
+		if err := uc.userRepo.UpdateUser(ctx, update); err != nil {
+			uc.logger.WithError(err).WithField("userID", userID).Error("Failed to update user")
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+	}
+
+	return uc.GetUserByID(ctx, userID)
+}
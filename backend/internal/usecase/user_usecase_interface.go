@@ -10,9 +10,14 @@ import (
 //go:generate mockgen -destination=mocks/mock_user_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseUser
 
 type UseCaseUser interface {
+	CanManageUser(ctx context.Context, actorID, userID uuid.UUID) error
 	CreateUser(ctx context.Context, user *entity.NewUser) (*entity.User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	GetAllUsers(ctx context.Context, params *entity.Pagination) (*entity.Response[entity.User], error)
 	DeleteUserByID(ctx context.Context, id uuid.UUID) error
 	UpdateUserByID(ctx context.Context, userID uuid.UUID, updateUser *entity.UpdateUser) (*entity.User, error)
+	// PatchUserByID saves a user a patch was applied to. The username and
+	// email may be changed by whoever manages the account, the role only by
+	// an admin.
+	PatchUserByID(ctx context.Context, actorID, userID uuid.UUID, user *entity.User, changed []string) (*entity.User, error)
 }
@@ -210,8 +210,9 @@ func TestGetAllUsers_Success(t *testing.T) {
 	uc := usecase.NewUserUseCase(userRepo, logger, nil) // No need for hash service in this test
 
 	pagination := &entity.Pagination{
-		Page:  1,
-		Limit: 10,
+		Page:      1,
+		Limit:     10,
+		WithTotal: true,
 	}
 
 	expectedUsers := []*entity.User{
@@ -220,17 +221,17 @@ func TestGetAllUsers_Success(t *testing.T) {
 	}
 
 	userRepo.EXPECT().
-		GetAllUsers(gomock.Any(), pagination).
+		GetAllUsers(gomock.Any(), pagination.Lookahead()).
 		Return(expectedUsers, nil).Times(1)
 
 	userRepo.EXPECT().
 		GetTotalUsers(gomock.Any()).
 		Return(2, nil).Times(1)
 
-	expectedUserList := &entity.UserList{
-		Users: expectedUsers,
+	expectedUserList := &entity.Response[entity.User]{
+		Data: expectedUsers,
 		Pagination: &entity.Pagination{
-			Total: int64(2),
+			Total: 2,
 			Limit: pagination.Limit,
 			Page:  pagination.Page,
 		},
@@ -247,7 +248,7 @@ func TestGetAllUsers_Fail(t *testing.T) {
 		name          string
 		mockSetup     func(userRepo *mocksrepository.MockUserRepository)
 		pagination    *entity.Pagination
-		expectedList  *entity.UserList
+		expectedList  *entity.Response[entity.User]
 		expectedError error
 	}{
 		{
@@ -271,7 +272,7 @@ func TestGetAllUsers_Fail(t *testing.T) {
 					GetTotalUsers(gomock.Any()).
 					Return(0, errors.New("db error")).Times(1)
 			},
-			pagination:    &entity.Pagination{Page: 1, Limit: 10},
+			pagination:    &entity.Pagination{Page: 1, Limit: 10, WithTotal: true},
 			expectedList:  nil,
 			expectedError: errors.New("failed to get total users count: db error"),
 		},
@@ -487,3 +488,158 @@ func TestUpdateUserByID_Fail(t *testing.T) {
 		})
 	}
 }
+
+func TestCanManageUser(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(userRepo *mocksrepository.MockUserRepository)
+		actorID       uuid.UUID
+		userID        uuid.UUID
+		expectedError error
+	}{
+		{
+			name:      "Users manage their own account",
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {},
+			actorID:   userId1,
+			userID:    userId1,
+		},
+		{
+			name: "Admins manage any account",
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), userId1).
+					Return(&entity.User{Id: userId1, Role: entity.RoleAdmin}, nil).Times(1)
+			},
+			actorID: userId1,
+			userID:  userId2,
+		},
+		{
+			name: "Users cannot see other accounts",
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), userId1).
+					Return(&entity.User{Id: userId1, Role: entity.RoleUser}, nil).Times(1)
+			},
+			actorID:       userId1,
+			userID:        userId2,
+			expectedError: usecase.ErrForbidden,
+		},
+		{
+			name: "Listing every account needs an admin",
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), userId1).
+					Return(&entity.User{Id: userId1, Role: entity.RoleModerator}, nil).Times(1)
+			},
+			actorID:       userId1,
+			userID:        uuid.Nil,
+			expectedError: usecase.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewUserUseCase(userRepo, logrus.New(), nil)
+
+			tt.mockSetup(userRepo)
+
+			err := uc.CanManageUser(context.Background(), tt.actorID, tt.userID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPatchUserByID(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(userRepo *mocksrepository.MockUserRepository)
+		actorID       uuid.UUID
+		user          *entity.User
+		changed       []string
+		expectedError error
+	}{
+		{
+			name: "Users change their own email",
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					UpdateUser(gomock.Any(), &entity.UpdateUser{Id: userId1, Email: "new@example.com"}).
+					Return(nil).Times(1)
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), userId1).
+					Return(&entity.User{Id: userId1, Email: "new@example.com"}, nil).Times(1)
+			},
+			actorID: userId1,
+			user:    &entity.User{Id: userId1, Username: "user", Email: "new@example.com", Role: entity.RoleUser},
+			changed: []string{"email"},
+		},
+		{
+			name: "Admins change roles",
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), userId2).
+					Return(&entity.User{Id: userId2, Role: entity.RoleAdmin}, nil).Times(1)
+				userRepo.EXPECT().
+					UpdateUser(gomock.Any(), &entity.UpdateUser{Id: userId1, Role: entity.RoleModerator}).
+					Return(nil).Times(1)
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), userId1).
+					Return(&entity.User{Id: userId1, Role: entity.RoleModerator}, nil).Times(1)
+			},
+			actorID: userId2,
+			user:    &entity.User{Id: userId1, Username: "user", Email: "user@example.com", Role: entity.RoleModerator},
+			changed: []string{"role"},
+		},
+		{
+			name: "Users cannot change their own role",
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), userId1).
+					Return(&entity.User{Id: userId1, Role: entity.RoleUser}, nil).Times(1)
+				userRepo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			actorID:       userId1,
+			user:          &entity.User{Id: userId1, Username: "user", Email: "user@example.com", Role: entity.RoleAdmin},
+			changed:       []string{"role"},
+			expectedError: usecase.ErrAdminRequired,
+		},
+		{
+			name: "The id cannot be patched",
+			mockSetup: func(userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			actorID:       userId1,
+			user:          &entity.User{Id: userId2},
+			changed:       []string{"id"},
+			expectedError: usecase.ErrFieldNotWritable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewUserUseCase(userRepo, logrus.New(), nil)
+
+			tt.mockSetup(userRepo)
+
+			_, err := uc.PatchUserByID(context.Background(), tt.actorID, userId1, tt.user, tt.changed)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -1,9 +1,16 @@
 package usecase
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 
 	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
 )
 
 func ValidatePost(post interface{}) error {
@@ -25,3 +32,70 @@ func ValidatePost(post interface{}) error {
 	}
 	return nil
 }
+
+func requireAdmin(ctx context.Context, userRepo repository.UserRepository, logger *logrus.Logger, userID uuid.UUID) error {
+	user, err := userRepo.GetUserById(ctx, userID)
+	if err != nil {
+		logger.WithError(err).WithField("userID", userID).Error("Failed to get user")
+		return ErrAdminRequired
+	}
+
+	if user.Role != entity.RoleAdmin {
+		return ErrAdminRequired
+	}
+
+	return nil
+}
+
+// loadAuthors fetches the summaries of the distinct authors of a page of
+// posts or comments in a single query.
+func loadAuthors(ctx context.Context, repo repository.UserRepository, authorIDs []uuid.UUID) (map[uuid.UUID]*entity.AuthorSummary, error) {
+	if len(authorIDs) == 0 {
+		return map[uuid.UUID]*entity.AuthorSummary{}, nil
+	}
+
+	seen := make(map[uuid.UUID]bool, len(authorIDs))
+	ids := make([]uuid.UUID, 0, len(authorIDs))
+	for _, id := range authorIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	authors, err := repo.GetAuthorsByIds(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authors: %w", err)
+	}
+
+	return authors, nil
+}
+
+// checkVersion fails with ErrVersionMismatch when a writer expects a version
+// other than the current one. entity.AnyVersion matches every version.
+func checkVersion(current, expected int) error {
+	if expected != entity.AnyVersion && expected != current {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+// versionError reports a write that lost a race with another one as
+// ErrVersionMismatch.
+func versionError(err error) error {
+	if errors.Is(err, entity.ErrVersionConflict) {
+		return ErrVersionMismatch
+	}
+	return err
+}
+
+// checkPatchFields fails with ErrFieldNotWritable when a patch changed an
+// attribute that isn't in writable.
+func checkPatchFields(changed, writable []string) error {
+	for _, field := range changed {
+		if !slices.Contains(writable, field) {
+			return fmt.Errorf("%w: %s", ErrFieldNotWritable, field)
+		}
+	}
+	return nil
+}
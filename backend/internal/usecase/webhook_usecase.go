@@ -0,0 +1,323 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+)
+
+// webhookEvents maps the events usecases publish to the webhook event types
+// subscriptions use. Events missing here, like notifications, are private and
+// never leave the server.
+var webhookEvents = map[string]entity.WebhookEventType{
+	entity.EventPostCreated:    entity.WebhookPostPublished,
+	entity.EventPostUpdated:    entity.WebhookPostUpdated,
+	entity.EventPostDeleted:    entity.WebhookPostDeleted,
+	entity.EventCommentCreated: entity.WebhookCommentCreated,
+	entity.EventCommentUpdated: entity.WebhookCommentUpdated,
+	entity.EventCommentDeleted: entity.WebhookCommentDeleted,
+}
+
+type webhookUseCase struct {
+	webhookRepo repository.WebhookRepository
+	userRepo    repository.UserRepository
+	unitOfWork  repository.UnitOfWork
+	deliverer   WebhookDeliverer
+	logger      *logrus.Logger
+}
+
+func NewWebhookUseCase(
+	webhookRepo repository.WebhookRepository,
+	userRepo repository.UserRepository,
+	unitOfWork repository.UnitOfWork,
+	deliverer WebhookDeliverer,
+	logger *logrus.Logger,
+) UseCaseWebhook {
+	return &webhookUseCase{
+		webhookRepo: webhookRepo,
+		userRepo:    userRepo,
+		unitOfWork:  unitOfWork,
+		deliverer:   deliverer,
+		logger:      logger,
+	}
+}
+
+func (uc *webhookUseCase) Publish(ctx context.Context, topic, eventType string, payload any) error {
+	webhookEvent, ok := webhookEvents[eventType]
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	var queued int
+	err = transact(ctx, uc.unitOfWork, func(ctx context.Context) error {
+		deliveries, err := uc.webhookRepo.CreateDeliveries(ctx, webhookEvent, data)
+		if err != nil {
+			return err
+		}
+
+		for _, delivery := range deliveries {
+			if err := uc.deliverer.Enqueue(ctx, delivery); err != nil {
+				return err
+			}
+		}
+		queued = len(deliveries)
+
+		return nil
+	})
+	if err != nil {
+		uc.logger.WithError(err).WithField("event", webhookEvent).Error("Failed to queue webhook deliveries")
+		return err
+	}
+
+	if queued > 0 {
+		uc.logger.WithFields(logrus.Fields{"event": webhookEvent, "deliveries": queued}).Debug("Queued webhook deliveries")
+	}
+
+	return nil
+}
+
+func (uc *webhookUseCase) CreateWebhook(ctx context.Context, adminID uuid.UUID, newWebhook *entity.NewWebhook) (*entity.Webhook, error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhook(newWebhook.URL, newWebhook.Events); err != nil {
+		return nil, err
+	}
+
+	webhook := &entity.Webhook{
+		URL:       newWebhook.URL,
+		Secret:    newWebhook.Secret,
+		Events:    newWebhook.Events,
+		Active:    newWebhook.Active == nil || *newWebhook.Active,
+		CreatedBy: &adminID,
+	}
+
+	if err := uc.webhookRepo.CreateWebhook(ctx, webhook); err != nil {
+		uc.logger.WithError(err).Error("Failed to create webhook")
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (uc *webhookUseCase) GetWebhooks(ctx context.Context, adminID uuid.UUID) ([]*entity.Webhook, error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	webhooks, err := uc.webhookRepo.GetWebhooks(ctx)
+	if err != nil {
+		uc.logger.WithError(err).Error("Failed to get webhooks")
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+func (uc *webhookUseCase) GetWebhook(ctx context.Context, adminID, id uuid.UUID) (*entity.Webhook, error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	return uc.getWebhook(ctx, id)
+}
+
+func (uc *webhookUseCase) UpdateWebhook(ctx context.Context, adminID, id uuid.UUID, update *entity.UpdateWebhook) (*entity.Webhook, error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhook(update.URL, update.Events); err != nil {
+		return nil, err
+	}
+
+	webhook, err := uc.getWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.URL = update.URL
+	webhook.Events = update.Events
+	webhook.Active = update.Active
+	if update.Secret != "" {
+		webhook.Secret = update.Secret
+	}
+
+	if err := uc.webhookRepo.UpdateWebhook(ctx, webhook); err != nil {
+		uc.logger.WithError(err).WithField("webhookID", id).Error("Failed to update webhook")
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func (uc *webhookUseCase) DeleteWebhook(ctx context.Context, adminID, id uuid.UUID) error {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return err
+	}
+
+	if err := uc.webhookRepo.DeleteWebhook(ctx, id); err != nil {
+		uc.logger.WithError(err).WithField("webhookID", id).Error("Failed to delete webhook")
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (uc *webhookUseCase) GetDeliveries(ctx context.Context, adminID, webhookID uuid.UUID, status entity.WebhookDeliveryStatus, pagination *entity.Pagination) (*entity.Response[entity.WebhookDelivery], error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	if status != "" && !status.Valid() {
+		return nil, ErrInvalidWebhook
+	}
+
+	if err := entity.ValidatePagination(pagination); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.getWebhook(ctx, webhookID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := uc.webhookRepo.GetDeliveries(ctx, webhookID, status, pagination)
+	if err != nil {
+		uc.logger.WithError(err).WithField("webhookID", webhookID).Error("Failed to get webhook deliveries")
+		return nil, err
+	}
+
+	total, err := uc.webhookRepo.GetTotalDeliveries(ctx, webhookID, status)
+	if err != nil {
+		uc.logger.WithError(err).WithField("webhookID", webhookID).Error("Failed to get total webhook deliveries")
+		return nil, err
+	}
+
+	return &entity.Response[entity.WebhookDelivery]{
+		Data: deliveries,
+		Pagination: &entity.Pagination{
+			Total:  total,
+			Page:   pagination.Page,
+			Limit:  pagination.Limit,
+			Offset: pagination.Offset,
+		},
+	}, nil
+}
+
+func (uc *webhookUseCase) Redeliver(ctx context.Context, adminID, webhookID, deliveryID uuid.UUID) (*entity.WebhookDelivery, error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	var delivery *entity.WebhookDelivery
+	err := transact(ctx, uc.unitOfWork, func(ctx context.Context) error {
+		var err error
+		delivery, err = uc.webhookRepo.Redeliver(ctx, webhookID, deliveryID)
+		if err != nil {
+			return ErrWebhookDeliveryNotFound
+		}
+
+		return uc.deliverer.Enqueue(ctx, delivery)
+	})
+	if err != nil {
+		uc.logger.WithError(err).WithField("deliveryID", deliveryID).Error("Failed to redeliver webhook delivery")
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (uc *webhookUseCase) TestWebhook(ctx context.Context, adminID, webhookID uuid.UUID) (*entity.WebhookDelivery, error) {
+	if err := requireAdmin(ctx, uc.userRepo, uc.logger, adminID); err != nil {
+		return nil, err
+	}
+
+	webhook, err := uc.getWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(map[string]uuid.UUID{"webhookId": webhook.Id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	delivery := &entity.WebhookDelivery{
+		WebhookId:     webhook.Id,
+		EventType:     entity.WebhookTest,
+		Payload:       payload,
+		Status:        entity.WebhookDeliveryPending,
+		NextAttemptAt: time.Now(),
+		Webhook:       webhook,
+	}
+
+	if err := uc.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+		uc.logger.WithError(err).WithField("webhookID", webhookID).Error("Failed to create test delivery")
+		return nil, err
+	}
+
+	if err := uc.deliverer.Deliver(ctx, delivery); err != nil {
+		uc.logger.WithError(err).WithField("webhookID", webhookID).Error("Failed to send test delivery")
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (uc *webhookUseCase) getWebhook(ctx context.Context, id uuid.UUID) (*entity.Webhook, error) {
+	webhook, err := uc.webhookRepo.GetWebhook(ctx, id)
+	if err != nil {
+		uc.logger.WithError(err).WithField("webhookID", id).Error("Failed to get webhook")
+		return nil, ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
+
+func validateWebhook(rawURL string, events []entity.WebhookEventType) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhook)
+	}
+
+	if len(events) == 0 {
+		return fmt.Errorf("%w: at least one event is required", ErrInvalidWebhook)
+	}
+
+	seen := make(map[entity.WebhookEventType]bool, len(events))
+	for _, event := range events {
+		if !event.Valid() {
+			return fmt.Errorf("%w: unknown event %q, expected one of %s", ErrInvalidWebhook, event, webhookEventNames())
+		}
+		if seen[event] {
+			return fmt.Errorf("%w: duplicate event %q", ErrInvalidWebhook, event)
+		}
+		seen[event] = true
+	}
+
+	return nil
+}
+
+// webhookEventNames lists the subscribable event types for error messages.
+func webhookEventNames() string {
+	names := make([]string, 0, len(entity.WebhookEventTypes))
+	for _, t := range entity.WebhookEventTypes {
+		names = append(names, string(t))
+	}
+	return strings.Join(names, ", ")
+}
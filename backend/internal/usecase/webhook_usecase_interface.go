@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/events"
+)
+
+//go:generate mockgen -destination=mocks/mock_webhook_usecase.go -package=mockusecase github.com/popeskul/awesome-blog/backend/internal/usecase UseCaseWebhook,WebhookDeliverer
+
+// WebhookDeliverer sends deliveries on the job runner.
+type WebhookDeliverer interface {
+	// Enqueue queues the next attempt at a pending delivery. Called within a
+	// unit of work, the attempt is only queued if the transaction commits.
+	Enqueue(ctx context.Context, delivery *entity.WebhookDelivery) error
+	// Deliver makes an attempt right away and records how it went, queuing a
+	// retry if it failed.
+	Deliver(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
+
+// UseCaseWebhook manages webhook subscriptions for admins. As a publisher it
+// queues deliveries for the events usecases announce.
+type UseCaseWebhook interface {
+	events.Publisher
+	CreateWebhook(ctx context.Context, adminID uuid.UUID, webhook *entity.NewWebhook) (*entity.Webhook, error)
+	GetWebhooks(ctx context.Context, adminID uuid.UUID) ([]*entity.Webhook, error)
+	GetWebhook(ctx context.Context, adminID, id uuid.UUID) (*entity.Webhook, error)
+	UpdateWebhook(ctx context.Context, adminID, id uuid.UUID, update *entity.UpdateWebhook) (*entity.Webhook, error)
+	DeleteWebhook(ctx context.Context, adminID, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, adminID, webhookID uuid.UUID, status entity.WebhookDeliveryStatus, pagination *entity.Pagination) (*entity.Response[entity.WebhookDelivery], error)
+	Redeliver(ctx context.Context, adminID, webhookID, deliveryID uuid.UUID) (*entity.WebhookDelivery, error)
+	// TestWebhook sends a webhook.test event right away and returns the
+	// recorded attempt.
+	TestWebhook(ctx context.Context, adminID, webhookID uuid.UUID) (*entity.WebhookDelivery, error)
+}
@@ -0,0 +1,299 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase"
+	"github.com/popeskul/awesome-blog/backend/internal/usecase/mocks"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockSetup     func(webhookRepo *mocksrepository.MockWebhookRepository, userRepo *mocksrepository.MockUserRepository)
+		webhook       *entity.NewWebhook
+		expectedError error
+	}{
+		{
+			name: "Admin creates an active webhook",
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+				webhookRepo.EXPECT().
+					CreateWebhook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, webhook *entity.Webhook) error {
+						assert.True(t, webhook.Active)
+						assert.Equal(t, authorId1, *webhook.CreatedBy)
+						return nil
+					}).Times(1)
+			},
+			webhook: &entity.NewWebhook{
+				URL:    "https://example.com/hooks",
+				Secret: "0123456789abcdef",
+				Events: []entity.WebhookEventType{entity.WebhookPostPublished},
+			},
+		},
+		{
+			name: "Regular users cannot manage webhooks",
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleModerator}, nil).Times(1)
+			},
+			webhook: &entity.NewWebhook{
+				URL:    "https://example.com/hooks",
+				Secret: "0123456789abcdef",
+				Events: []entity.WebhookEventType{entity.WebhookPostPublished},
+			},
+			expectedError: usecase.ErrAdminRequired,
+		},
+		{
+			name: "Unknown event",
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+			},
+			webhook: &entity.NewWebhook{
+				URL:    "https://example.com/hooks",
+				Secret: "0123456789abcdef",
+				Events: []entity.WebhookEventType{"notification.created"},
+			},
+			expectedError: usecase.ErrInvalidWebhook,
+		},
+		{
+			name: "Duplicate event",
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+			},
+			webhook: &entity.NewWebhook{
+				URL:    "https://example.com/hooks",
+				Secret: "0123456789abcdef",
+				Events: []entity.WebhookEventType{entity.WebhookPostUpdated, entity.WebhookPostUpdated},
+			},
+			expectedError: usecase.ErrInvalidWebhook,
+		},
+		{
+			name: "Non-HTTP URL",
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, userRepo *mocksrepository.MockUserRepository) {
+				userRepo.EXPECT().
+					GetUserById(gomock.Any(), authorId1).
+					Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+			},
+			webhook: &entity.NewWebhook{
+				URL:    "ftp://example.com/hooks",
+				Secret: "0123456789abcdef",
+				Events: []entity.WebhookEventType{entity.WebhookPostPublished},
+			},
+			expectedError: usecase.ErrInvalidWebhook,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookRepo := mocksrepository.NewMockWebhookRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			uc := usecase.NewWebhookUseCase(webhookRepo, userRepo, nil, nil, logrus.New())
+
+			tt.mockSetup(webhookRepo, userRepo)
+
+			webhook, err := uc.CreateWebhook(context.Background(), authorId1, tt.webhook)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, webhook)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.webhook.URL, webhook.URL)
+		})
+	}
+}
+
+func TestWebhookPublish(t *testing.T) {
+	tests := []struct {
+		name          string
+		eventType     string
+		mockSetup     func(webhookRepo *mocksrepository.MockWebhookRepository, deliverer *mockusecase.MockWebhookDeliverer)
+		expectedError string
+	}{
+		{
+			name:      "Post created is published",
+			eventType: entity.EventPostCreated,
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, deliverer *mockusecase.MockWebhookDeliverer) {
+				deliveries := []*entity.WebhookDelivery{{Id: uuid.New()}, {Id: uuid.New()}}
+				webhookRepo.EXPECT().
+					CreateDeliveries(gomock.Any(), entity.WebhookPostPublished, json.RawMessage(`{"id":"1"}`)).
+					Return(deliveries, nil).Times(1)
+				deliverer.EXPECT().Enqueue(gomock.Any(), deliveries[0]).Return(nil).Times(1)
+				deliverer.EXPECT().Enqueue(gomock.Any(), deliveries[1]).Return(nil).Times(1)
+			},
+		},
+		{
+			name:      "Comment deleted",
+			eventType: entity.EventCommentDeleted,
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, deliverer *mockusecase.MockWebhookDeliverer) {
+				webhookRepo.EXPECT().
+					CreateDeliveries(gomock.Any(), entity.WebhookCommentDeleted, gomock.Any()).
+					Return([]*entity.WebhookDelivery{}, nil).Times(1)
+			},
+		},
+		{
+			name:      "Failing to queue a job fails the event",
+			eventType: entity.EventPostUpdated,
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, deliverer *mockusecase.MockWebhookDeliverer) {
+				webhookRepo.EXPECT().
+					CreateDeliveries(gomock.Any(), entity.WebhookPostUpdated, gomock.Any()).
+					Return([]*entity.WebhookDelivery{{Id: uuid.New()}}, nil).Times(1)
+				deliverer.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("database error")).Times(1)
+			},
+			expectedError: "database error",
+		},
+		{
+			name:      "Private events are skipped",
+			eventType: entity.EventNotificationCreated,
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, deliverer *mockusecase.MockWebhookDeliverer) {
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookRepo := mocksrepository.NewMockWebhookRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			deliverer := mockusecase.NewMockWebhookDeliverer(ctrl)
+			uc := usecase.NewWebhookUseCase(webhookRepo, userRepo, nil, deliverer, logrus.New())
+
+			tt.mockSetup(webhookRepo, deliverer)
+
+			err := uc.Publish(context.Background(), entity.PostsTopic, tt.eventType, map[string]string{"id": "1"})
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTestWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	webhookRepo := mocksrepository.NewMockWebhookRepository(ctrl)
+	userRepo := mocksrepository.NewMockUserRepository(ctrl)
+	deliverer := mockusecase.NewMockWebhookDeliverer(ctrl)
+	uc := usecase.NewWebhookUseCase(webhookRepo, userRepo, nil, deliverer, logrus.New())
+
+	webhookId := uuid.New()
+	webhook := &entity.Webhook{Id: webhookId, URL: "https://example.com/hooks", Secret: "0123456789abcdef"}
+
+	userRepo.EXPECT().
+		GetUserById(gomock.Any(), authorId1).
+		Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+	webhookRepo.EXPECT().GetWebhook(gomock.Any(), webhookId).Return(webhook, nil).Times(1)
+	webhookRepo.EXPECT().
+		CreateDelivery(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, delivery *entity.WebhookDelivery) error {
+			assert.Equal(t, entity.WebhookTest, delivery.EventType)
+			assert.Equal(t, entity.WebhookDeliveryPending, delivery.Status)
+			delivery.Id = uuid.New()
+			return nil
+		}).Times(1)
+	deliverer.EXPECT().
+		Deliver(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, delivery *entity.WebhookDelivery) error {
+			assert.Same(t, webhook, delivery.Webhook)
+			delivery.Status = entity.WebhookDeliverySucceeded
+			delivery.Attempts = 1
+			return nil
+		}).Times(1)
+
+	delivery, err := uc.TestWebhook(context.Background(), authorId1, webhookId)
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookDeliverySucceeded, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+}
+
+func TestWebhookRedeliver(t *testing.T) {
+	webhookId := uuid.New()
+	deliveryId := uuid.New()
+
+	tests := []struct {
+		name          string
+		mockSetup     func(webhookRepo *mocksrepository.MockWebhookRepository, deliverer *mockusecase.MockWebhookDeliverer)
+		expectedError error
+	}{
+		{
+			name: "Delivery is queued again",
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, deliverer *mockusecase.MockWebhookDeliverer) {
+				delivery := &entity.WebhookDelivery{Id: deliveryId, WebhookId: webhookId, Status: entity.WebhookDeliveryPending}
+				webhookRepo.EXPECT().Redeliver(gomock.Any(), webhookId, deliveryId).Return(delivery, nil).Times(1)
+				deliverer.EXPECT().Enqueue(gomock.Any(), delivery).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Delivery not found",
+			mockSetup: func(webhookRepo *mocksrepository.MockWebhookRepository, deliverer *mockusecase.MockWebhookDeliverer) {
+				webhookRepo.EXPECT().Redeliver(gomock.Any(), webhookId, deliveryId).
+					Return(nil, errors.New("webhook delivery not found")).Times(1)
+			},
+			expectedError: usecase.ErrWebhookDeliveryNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			webhookRepo := mocksrepository.NewMockWebhookRepository(ctrl)
+			userRepo := mocksrepository.NewMockUserRepository(ctrl)
+			deliverer := mockusecase.NewMockWebhookDeliverer(ctrl)
+			unitOfWork := mocksrepository.NewMockUnitOfWork(ctrl)
+			uc := usecase.NewWebhookUseCase(webhookRepo, userRepo, unitOfWork, deliverer, logrus.New())
+
+			userRepo.EXPECT().
+				GetUserById(gomock.Any(), authorId1).
+				Return(&entity.User{Id: authorId1, Role: entity.RoleAdmin}, nil).Times(1)
+			unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tt.mockSetup(webhookRepo, deliverer)
+
+			delivery, err := uc.Redeliver(context.Background(), authorId1, webhookId, deliveryId)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, delivery)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, deliveryId, delivery.Id)
+		})
+	}
+}
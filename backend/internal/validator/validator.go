@@ -1,7 +1,31 @@
 package validator
 
+import (
+	"reflect"
+	"strings"
+
+	playground "github.com/go-playground/validator/v10"
+)
+
 //go:generate mockgen -destination=mocks/mock_validator.go -package=mocks github.com/popeskul/awesome-blog/backend/internal/validator Validator
 
 type Validator interface {
 	Struct(interface{}) error
 }
+
+// New returns a validator that names fields in its errors by their JSON
+// names, as clients know them.
+func New() *playground.Validate {
+	validate := playground.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+	return validate
+}
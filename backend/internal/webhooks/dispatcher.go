@@ -0,0 +1,220 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository"
+	"github.com/popeskul/awesome-blog/backend/internal/jobs"
+)
+
+// maxErrorLength caps how much of a failed response is kept in the log.
+const maxErrorLength = 500
+
+// KindDeliver is the job that makes one attempt at a delivery.
+const KindDeliver = "webhooks.deliver"
+
+type Options struct {
+	// MaxAttempts is how many attempts a delivery gets before it is dead.
+	MaxAttempts int
+	// BackoffBase is the wait after the first failure; it doubles with every
+	// further failure up to BackoffMax.
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// Timeout bounds a single attempt.
+	Timeout time.Duration
+}
+
+// envelope is the body posted to the webhook URL. It only holds stored
+// fields, so every attempt of a delivery sends the same bytes.
+type envelope struct {
+	Id        uuid.UUID               `json:"id"`
+	Type      entity.WebhookEventType `json:"type"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Data      json.RawMessage         `json:"data"`
+}
+
+// deliverJob is the payload of KindDeliver. Attempts is how many attempts
+// the delivery had when the job was queued, so that a job overtaken by a
+// redelivery does nothing.
+type deliverJob struct {
+	DeliveryId uuid.UUID `json:"deliveryId"`
+	Attempts   int       `json:"attempts"`
+}
+
+// Dispatcher sends webhook deliveries on the job runner, one job per
+// attempt. A failed attempt queues the next one with the webhook backoff
+// rather than failing the job, so the runner's own retries only cover
+// errors storing the outcome.
+type Dispatcher struct {
+	repo   repository.WebhookRepository
+	uow    repository.UnitOfWork
+	runner *jobs.Runner
+	client *http.Client
+	logger *logrus.Logger
+	opts   Options
+}
+
+func NewDispatcher(repo repository.WebhookRepository, uow repository.UnitOfWork, runner *jobs.Runner, logger *logrus.Logger, opts Options) *Dispatcher {
+	d := &Dispatcher{
+		repo:   repo,
+		uow:    uow,
+		runner: runner,
+		client: &http.Client{Timeout: opts.Timeout},
+		logger: logger,
+		opts:   opts,
+	}
+	runner.Register(KindDeliver, jobs.DefaultQueue, jobs.Typed(d.run))
+
+	return d
+}
+
+// Enqueue queues the next attempt at a pending delivery for its
+// NextAttemptAt. Called within a unit of work, the attempt is only queued if
+// the transaction commits.
+func (d *Dispatcher) Enqueue(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	job := deliverJob{DeliveryId: delivery.Id, Attempts: delivery.Attempts}
+	_, err := d.runner.EnqueueAt(ctx, KindDeliver, job, delivery.NextAttemptAt)
+	return err
+}
+
+func (d *Dispatcher) run(ctx context.Context, job deliverJob) error {
+	delivery, err := d.repo.GetPendingDelivery(ctx, job.DeliveryId)
+	if errors.Is(err, entity.ErrWebhookDeliveryGone) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if delivery.Attempts != job.Attempts {
+		return nil
+	}
+
+	// A paused webhook keeps its deliveries; they are looked at again once
+	// the longest backoff has passed.
+	if !delivery.Webhook.Active {
+		delivery.NextAttemptAt = time.Now().Add(d.opts.BackoffMax)
+		return d.Enqueue(ctx, delivery)
+	}
+
+	return d.Deliver(ctx, delivery)
+}
+
+// Deliver makes one attempt and stores its outcome on the delivery, which
+// must have its webhook set, queuing the next attempt if there is one. The
+// returned error is only about storing the outcome; a failed attempt is
+// recorded, not returned.
+func (d *Dispatcher) Deliver(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	status, attemptErr := d.send(ctx, delivery)
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseStatus = nil
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+
+	switch {
+	case attemptErr == nil:
+		delivery.Status = entity.WebhookDeliverySucceeded
+		delivery.LastError = ""
+	case delivery.Attempts >= d.opts.MaxAttempts:
+		delivery.Status = entity.WebhookDeliveryDead
+		delivery.LastError = attemptErr.Error()
+	default:
+		delivery.Status = entity.WebhookDeliveryPending
+		delivery.LastError = attemptErr.Error()
+		delivery.NextAttemptAt = now.Add(d.Backoff(delivery.Attempts))
+	}
+
+	logger := d.logger.WithFields(logrus.Fields{
+		"deliveryID": delivery.Id,
+		"webhookID":  delivery.WebhookId,
+		"attempt":    delivery.Attempts,
+	})
+	switch delivery.Status {
+	case entity.WebhookDeliveryDead:
+		logger.WithError(attemptErr).Warn("Webhook delivery failed for good")
+	case entity.WebhookDeliveryPending:
+		logger.WithError(attemptErr).Info("Webhook delivery failed, will retry")
+	}
+
+	// The outcome is stored even when the runner is shutting down.
+	return d.uow.Do(context.WithoutCancel(ctx), func(ctx context.Context) error {
+		if err := d.repo.SaveAttempt(ctx, delivery); err != nil {
+			return err
+		}
+
+		if delivery.Status != entity.WebhookDeliveryPending {
+			return nil
+		}
+
+		return d.Enqueue(ctx, delivery)
+	})
+}
+
+// Backoff is the wait before the next attempt after the given number of
+// failed ones.
+func (d *Dispatcher) Backoff(attempts int) time.Duration {
+	wait := d.opts.BackoffBase
+	for i := 1; i < attempts; i++ {
+		wait *= 2
+		if wait >= d.opts.BackoffMax {
+			return d.opts.BackoffMax
+		}
+	}
+
+	return wait
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery *entity.WebhookDelivery) (int, error) {
+	body, err := json.Marshal(envelope{
+		Id:        delivery.Id,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.CreatedAt,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "awesome-blog-webhooks")
+	req.Header.Set(HeaderDelivery, delivery.Id.String())
+	req.Header.Set(HeaderEvent, string(delivery.EventType))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Webhook.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorLength))
+		return resp.StatusCode, nil
+	}
+
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+	return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(excerpt))
+}
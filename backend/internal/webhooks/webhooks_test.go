@@ -0,0 +1,286 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/popeskul/awesome-blog/backend/internal/domain/entity"
+	"github.com/popeskul/awesome-blog/backend/internal/domain/repository/mocks"
+	"github.com/popeskul/awesome-blog/backend/internal/jobs"
+	"github.com/popeskul/awesome-blog/backend/internal/webhooks"
+)
+
+const secret = "0123456789abcdef"
+
+var options = webhooks.Options{
+	MaxAttempts: 3,
+	BackoffBase: time.Minute,
+	BackoffMax:  10 * time.Minute,
+	Timeout:     time.Second,
+}
+
+var jobOptions = jobs.Options{
+	PollInterval: time.Second,
+	Timeout:      time.Second,
+	MaxAttempts:  5,
+}
+
+// newDispatcher returns a dispatcher whose unit of work runs its function
+// right away.
+func newDispatcher(ctrl *gomock.Controller) (*webhooks.Dispatcher, *jobs.Runner, *mocksrepository.MockWebhookRepository, *mocksrepository.MockJobRepository) {
+	repo := mocksrepository.NewMockWebhookRepository(ctrl)
+	jobRepo := mocksrepository.NewMockJobRepository(ctrl)
+	uow := mocksrepository.NewMockUnitOfWork(ctrl)
+	uow.EXPECT().Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+
+	runner := jobs.NewRunner(jobRepo, logrus.New(), jobOptions)
+	dispatcher := webhooks.NewDispatcher(repo, uow, runner, logrus.New(), options)
+
+	return dispatcher, runner, repo, jobRepo
+}
+
+// expectAttempt expects the next attempt at a delivery to be queued.
+func expectAttempt(t *testing.T, jobRepo *mocksrepository.MockJobRepository, delivery *entity.WebhookDelivery) {
+	jobRepo.EXPECT().EnqueueJob(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *entity.Job) (bool, error) {
+		assert.Equal(t, webhooks.KindDeliver, job.Kind)
+		assert.Equal(t, jobs.DefaultQueue, job.Queue)
+		assert.JSONEq(t, `{"deliveryId":"`+delivery.Id.String()+`","attempts":`+strconv.Itoa(delivery.Attempts)+`}`, string(job.Payload))
+		assert.Equal(t, delivery.NextAttemptAt, job.RunAt)
+		return true, nil
+	})
+}
+
+func newDelivery(url string, attempts int) *entity.WebhookDelivery {
+	webhookID := uuid.New()
+	return &entity.WebhookDelivery{
+		Id:        uuid.New(),
+		WebhookId: webhookID,
+		EventType: entity.WebhookPostPublished,
+		Payload:   json.RawMessage(`{"title":"Hello"}`),
+		Status:    entity.WebhookDeliveryPending,
+		Attempts:  attempts,
+		CreatedAt: time.Now(),
+		Webhook:   &entity.Webhook{Id: webhookID, URL: url, Secret: secret},
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	signature := webhooks.Sign(secret, 1700000000, body)
+
+	assert.True(t, webhooks.Verify(secret, 1700000000, body, signature))
+	assert.False(t, webhooks.Verify(secret, 1700000001, body, signature))
+	assert.False(t, webhooks.Verify("another-secret-value", 1700000000, body, signature))
+	assert.False(t, webhooks.Verify(secret, 1700000000, []byte(`{"id":"2"}`), signature))
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		w.Write([]byte("nope"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name           string
+		status         int
+		attempts       int
+		expectedStatus entity.WebhookDeliveryStatus
+		expectRetry    bool
+	}{
+		{
+			name:           "Success",
+			status:         http.StatusNoContent,
+			expectedStatus: entity.WebhookDeliverySucceeded,
+		},
+		{
+			name:           "Failure is retried with backoff",
+			status:         http.StatusInternalServerError,
+			attempts:       1,
+			expectedStatus: entity.WebhookDeliveryPending,
+			expectRetry:    true,
+		},
+		{
+			name:           "Last failure is dead",
+			status:         http.StatusBadGateway,
+			attempts:       2,
+			expectedStatus: entity.WebhookDeliveryDead,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			dispatcher, _, repo, jobRepo := newDispatcher(ctrl)
+
+			status = tt.status
+			delivery := newDelivery(server.URL, tt.attempts)
+			repo.EXPECT().SaveAttempt(gomock.Any(), delivery).Return(nil)
+			if tt.expectRetry {
+				expectAttempt(t, jobRepo, delivery)
+			}
+
+			before := time.Now()
+			err := dispatcher.Deliver(context.Background(), delivery)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedStatus, delivery.Status)
+			assert.Equal(t, tt.attempts+1, delivery.Attempts)
+			require.NotNil(t, delivery.ResponseStatus)
+			assert.Equal(t, tt.status, *delivery.ResponseStatus)
+			if tt.expectedStatus == entity.WebhookDeliverySucceeded {
+				assert.Empty(t, delivery.LastError)
+			} else {
+				assert.Contains(t, delivery.LastError, "nope")
+			}
+			if tt.expectRetry {
+				assert.WithinDuration(t, before.Add(dispatcher.Backoff(delivery.Attempts)), delivery.NextAttemptAt, time.Second)
+			}
+
+			require.NotNil(t, received)
+			assert.Equal(t, delivery.Id.String(), received.Header.Get(webhooks.HeaderDelivery))
+			assert.Equal(t, string(entity.WebhookPostPublished), received.Header.Get(webhooks.HeaderEvent))
+			timestamp, err := strconv.ParseInt(received.Header.Get(webhooks.HeaderTimestamp), 10, 64)
+			require.NoError(t, err)
+			assert.True(t, webhooks.Verify(secret, timestamp, receivedBody, received.Header.Get(webhooks.HeaderSignature)))
+
+			var envelope struct {
+				Id   uuid.UUID       `json:"id"`
+				Type string          `json:"type"`
+				Data json.RawMessage `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(receivedBody, &envelope))
+			assert.Equal(t, delivery.Id, envelope.Id)
+			assert.JSONEq(t, `{"title":"Hello"}`, string(envelope.Data))
+		})
+	}
+}
+
+func TestDispatcher_DeliverUnreachable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dispatcher, _, repo, jobRepo := newDispatcher(ctrl)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	delivery := newDelivery(url, 0)
+	repo.EXPECT().SaveAttempt(gomock.Any(), delivery).Return(nil)
+	expectAttempt(t, jobRepo, delivery)
+
+	require.NoError(t, dispatcher.Deliver(context.Background(), delivery))
+	assert.Equal(t, entity.WebhookDeliveryPending, delivery.Status)
+	assert.Nil(t, delivery.ResponseStatus)
+	assert.NotEmpty(t, delivery.LastError)
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	runner := jobs.NewRunner(nil, logrus.New(), jobOptions)
+	dispatcher := webhooks.NewDispatcher(nil, nil, runner, logrus.New(), options)
+
+	assert.Equal(t, time.Minute, dispatcher.Backoff(1))
+	assert.Equal(t, 2*time.Minute, dispatcher.Backoff(2))
+	assert.Equal(t, 8*time.Minute, dispatcher.Backoff(4))
+	assert.Equal(t, 10*time.Minute, dispatcher.Backoff(5))
+	assert.Equal(t, 10*time.Minute, dispatcher.Backoff(50))
+}
+
+func TestDispatcher_Job(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		attempts  int
+		mockSetup func(t *testing.T, repo *mocksrepository.MockWebhookRepository, jobRepo *mocksrepository.MockJobRepository, delivery *entity.WebhookDelivery)
+	}{
+		{
+			name:     "Pending delivery is sent",
+			attempts: 1,
+			mockSetup: func(t *testing.T, repo *mocksrepository.MockWebhookRepository, jobRepo *mocksrepository.MockJobRepository, delivery *entity.WebhookDelivery) {
+				repo.EXPECT().GetPendingDelivery(gomock.Any(), delivery.Id).Return(delivery, nil)
+				repo.EXPECT().SaveAttempt(gomock.Any(), delivery).DoAndReturn(func(_ context.Context, delivery *entity.WebhookDelivery) error {
+					assert.Equal(t, entity.WebhookDeliverySucceeded, delivery.Status)
+					assert.Equal(t, 2, delivery.Attempts)
+					return nil
+				})
+			},
+		},
+		{
+			name:     "Delivery that is no longer pending is skipped",
+			attempts: 1,
+			mockSetup: func(t *testing.T, repo *mocksrepository.MockWebhookRepository, jobRepo *mocksrepository.MockJobRepository, delivery *entity.WebhookDelivery) {
+				repo.EXPECT().GetPendingDelivery(gomock.Any(), delivery.Id).Return(nil, entity.ErrWebhookDeliveryGone)
+			},
+		},
+		{
+			name:     "Job overtaken by a redelivery is skipped",
+			attempts: 1,
+			mockSetup: func(t *testing.T, repo *mocksrepository.MockWebhookRepository, jobRepo *mocksrepository.MockJobRepository, delivery *entity.WebhookDelivery) {
+				redelivered := *delivery
+				redelivered.Attempts = 0
+				repo.EXPECT().GetPendingDelivery(gomock.Any(), delivery.Id).Return(&redelivered, nil)
+			},
+		},
+		{
+			name:     "Delivery of a paused webhook waits",
+			attempts: 1,
+			mockSetup: func(t *testing.T, repo *mocksrepository.MockWebhookRepository, jobRepo *mocksrepository.MockJobRepository, delivery *entity.WebhookDelivery) {
+				delivery.Webhook.Active = false
+				repo.EXPECT().GetPendingDelivery(gomock.Any(), delivery.Id).Return(delivery, nil)
+				jobRepo.EXPECT().EnqueueJob(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *entity.Job) (bool, error) {
+					assert.Equal(t, webhooks.KindDeliver, job.Kind)
+					assert.WithinDuration(t, time.Now().Add(options.BackoffMax), job.RunAt, time.Second)
+					return true, nil
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			_, runner, repo, jobRepo := newDispatcher(ctrl)
+
+			delivery := newDelivery(server.URL, tt.attempts)
+			delivery.Webhook.Active = true
+			tt.mockSetup(t, repo, jobRepo, delivery)
+
+			job := &entity.Job{
+				Id:          uuid.New(),
+				Queue:       jobs.DefaultQueue,
+				Kind:        webhooks.KindDeliver,
+				Payload:     json.RawMessage(`{"deliveryId":"` + delivery.Id.String() + `","attempts":1}`),
+				Status:      entity.JobRunning,
+				Attempts:    1,
+				MaxAttempts: jobOptions.MaxAttempts,
+			}
+			jobRepo.EXPECT().SaveJobResult(gomock.Any(), job).Return(nil)
+
+			runner.Execute(context.Background(), job)
+
+			assert.Equal(t, entity.JobSucceeded, job.Status)
+			assert.Empty(t, job.LastError)
+		})
+	}
+}
@@ -0,0 +1,8 @@
+ALTER TABLE posts DROP COLUMN IF EXISTS comment_moderation;
+
+DROP INDEX IF EXISTS idx_comments_status_created_at;
+DROP INDEX IF EXISTS idx_comments_post_id_status;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS moderated_at;
+ALTER TABLE comments DROP COLUMN IF EXISTS moderated_by;
+ALTER TABLE comments DROP COLUMN IF EXISTS status;
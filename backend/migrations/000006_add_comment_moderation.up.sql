@@ -0,0 +1,12 @@
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'approved'
+    CHECK (status IN ('pending', 'approved', 'rejected', 'spam'));
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS moderated_by UUID
+    REFERENCES users(id)
+    ON DELETE SET NULL;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_comments_post_id_status ON comments (post_id, status);
+CREATE INDEX IF NOT EXISTS idx_comments_status_created_at ON comments (status, created_at);
+
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS comment_moderation VARCHAR(20)
+    CHECK (comment_moderation IN ('open', 'moderated', 'closed'));
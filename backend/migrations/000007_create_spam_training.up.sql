@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS spam_tokens (
+    token VARCHAR(64) PRIMARY KEY,
+    spam_count INTEGER NOT NULL DEFAULT 0 CHECK (spam_count >= 0),
+    ham_count INTEGER NOT NULL DEFAULT 0 CHECK (ham_count >= 0)
+);
+
+-- Samples outlive their comments: spam_tokens holds their sums, and deleting
+-- a sample without taking it out of the sums would make them drift. That is
+-- also why there is no foreign key to comments.
+CREATE TABLE IF NOT EXISTS spam_training (
+    comment_id UUID PRIMARY KEY,
+    is_spam BOOLEAN NOT NULL,
+    tokens TEXT[] NOT NULL,
+    trained_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS reactions (
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('post', 'comment')),
+    target_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (target_type, target_id, user_id, kind)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reactions_target_kind ON reactions (target_type, target_id, kind);
+
+-- Reactions point at posts or comments, so they cannot use a foreign key;
+-- these triggers remove them together with their target instead.
+CREATE OR REPLACE FUNCTION delete_target_reactions() RETURNS TRIGGER AS $$
+BEGIN
+    DELETE FROM reactions WHERE target_type = TG_ARGV[0] AND target_id = OLD.id;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER posts_delete_reactions
+    AFTER DELETE ON posts
+    FOR EACH ROW EXECUTE FUNCTION delete_target_reactions('post');
+
+CREATE TRIGGER comments_delete_reactions
+    AFTER DELETE ON comments
+    FOR EACH ROW EXECUTE FUNCTION delete_target_reactions('comment');
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS reading_list_items;
+
+DROP INDEX IF EXISTS idx_reading_lists_owner;
+DROP TABLE IF EXISTS reading_lists;
+
+DROP INDEX IF EXISTS idx_bookmarks_user_created;
+DROP TABLE IF EXISTS bookmarks;
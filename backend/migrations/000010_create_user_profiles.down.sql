@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_posts_author_created;
+DROP TABLE IF EXISTS user_profiles;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_posts_author_created_id;
+CREATE INDEX IF NOT EXISTS idx_posts_author_created ON posts (author_id, created_at DESC);
+
+DROP INDEX IF EXISTS idx_follows_followee;
+DROP TABLE IF EXISTS follows;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_follows_followee ON follows (followee_id);
+
+-- The feed pages through posts by (created_at, id), newest first.
+DROP INDEX IF EXISTS idx_posts_author_created;
+CREATE INDEX IF NOT EXISTS idx_posts_author_created_id ON posts (author_id, created_at DESC, id DESC);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS notification_preferences;
+
+DROP INDEX IF EXISTS idx_notifications_recipient_unread;
+DROP INDEX IF EXISTS idx_notifications_recipient_created;
+DROP TABLE IF EXISTS notifications;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_stream_events_created;
+DROP INDEX IF EXISTS idx_stream_events_topic_id;
+DROP TABLE IF EXISTS stream_events;
@@ -0,0 +1,12 @@
+-- Events stay here for a while after delivery so clients can resume a
+-- stream with Last-Event-ID, whichever replica they reconnect to.
+CREATE TABLE IF NOT EXISTS stream_events (
+    id BIGSERIAL PRIMARY KEY,
+    topic VARCHAR(255) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    data JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stream_events_topic_id ON stream_events (topic, id);
+CREATE INDEX IF NOT EXISTS idx_stream_events_created ON stream_events (created_at);
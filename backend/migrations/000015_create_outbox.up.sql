@@ -0,0 +1,21 @@
+-- Domain events are written here in the same transaction as the change they
+-- describe and relayed to each consumer afterwards.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    topic VARCHAR(255) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    data JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_created ON outbox_events (created_at);
+
+-- One row per event and consumer once the consumer has handled the event.
+CREATE TABLE IF NOT EXISTS outbox_consumed (
+    consumer VARCHAR(100) NOT NULL,
+    event_id BIGINT NOT NULL REFERENCES outbox_events(id) ON DELETE CASCADE,
+    consumed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (consumer, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_consumed_event ON outbox_consumed (event_id);
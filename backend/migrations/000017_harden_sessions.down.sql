@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_sessions_expires_at;
+DROP INDEX IF EXISTS idx_sessions_user_id_created_at;
+DROP INDEX IF EXISTS idx_sessions_session_id;
+
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS fk_sessions_user_id;
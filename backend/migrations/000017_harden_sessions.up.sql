@@ -0,0 +1,10 @@
+-- Sessions of deleted users could never be used again; drop them so the
+-- foreign key can be added.
+DELETE FROM sessions s WHERE NOT EXISTS (SELECT 1 FROM users u WHERE u.id = s.user_id);
+
+ALTER TABLE sessions ADD CONSTRAINT fk_sessions_user_id
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_session_id ON sessions (session_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id_created_at ON sessions (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sessions_expires_at ON sessions (expires_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS newsletter_subscriptions;
+DROP TABLE IF EXISTS newsletter_subscribers;
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS newsletter_subscribers (
+    id UUID PRIMARY KEY,
+    email VARCHAR(255) NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'confirmed', 'unsubscribed')),
+    confirmed_at TIMESTAMPTZ,
+    last_digest_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_newsletter_subscribers_confirmed ON newsletter_subscribers (id) WHERE status = 'confirmed';
+
+-- A subscription with neither an author nor a tag covers every post. New
+-- subscriptions only count once the subscriber confirms them.
+CREATE TABLE IF NOT EXISTS newsletter_subscriptions (
+    id UUID PRIMARY KEY,
+    subscriber_id UUID NOT NULL REFERENCES newsletter_subscribers(id) ON DELETE CASCADE,
+    author_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    tag VARCHAR(50),
+    confirmed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (author_id IS NULL OR tag IS NULL)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_newsletter_subscriptions_scope
+    ON newsletter_subscriptions (subscriber_id, COALESCE(author_id::text, ''), COALESCE(tag, ''));
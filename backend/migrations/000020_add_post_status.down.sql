@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_posts_title_prefix;
+DROP INDEX IF EXISTS idx_posts_author_id_created_at;
+DROP INDEX IF EXISTS idx_posts_status_created_at;
+
+ALTER TABLE posts DROP COLUMN IF EXISTS status;
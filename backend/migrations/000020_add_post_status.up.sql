@@ -0,0 +1,6 @@
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'published'
+    CHECK (status IN ('draft', 'published', 'archived'));
+
+CREATE INDEX IF NOT EXISTS idx_posts_status_created_at ON posts (status, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_posts_author_id_created_at ON posts (author_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_posts_title_prefix ON posts (lower(title) text_pattern_ops);
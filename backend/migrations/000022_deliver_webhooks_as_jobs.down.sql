@@ -0,0 +1,4 @@
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+DELETE FROM jobs WHERE kind = 'webhooks.deliver' AND status = 'queued';
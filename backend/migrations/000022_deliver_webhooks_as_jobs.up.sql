@@ -0,0 +1,10 @@
+-- Deliveries are sent by the job runner now. Queue a job for every delivery
+-- that was waiting for the old dispatcher.
+INSERT INTO jobs (id, queue, kind, payload, status, max_attempts, run_at, created_at, updated_at)
+SELECT gen_random_uuid(), 'default', 'webhooks.deliver', jsonb_build_object('deliveryId', id, 'attempts', attempts),
+       'queued', 10, next_attempt_at, NOW(), NOW()
+FROM webhook_deliveries
+WHERE status = 'pending';
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS locked_until;
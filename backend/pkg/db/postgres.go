@@ -3,10 +3,11 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/popeskul/awesome-blog/backend/internal/config"
 	"github.com/sirupsen/logrus"
 )
@@ -14,14 +15,16 @@ import (
 // SqlOpen is a variable that holds the sql.Open function
 var SqlOpen = sql.Open
 
+// txKey is the context key of the transaction started by WithinTx.
+type txKey struct{}
+
 type PostgresDB struct {
 	*sql.DB
 	Logger *logrus.Logger
 }
 
 func NewPostgresDB(cfg config.DatabaseConfig, logger *logrus.Logger) (*PostgresDB, error) {
-	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode)
+	dsn := connString(cfg)
 
 	db, err := SqlOpen("postgres", dsn)
 	if err != nil {
@@ -75,3 +78,90 @@ func (pdb *PostgresDB) BeginTx(ctx context.Context) (*sql.Tx, error) {
 
 	return tx, nil
 }
+
+// WithinTx runs fn in a transaction that is committed when fn returns nil
+// and rolled back otherwise. Queries made through pdb with the context fn
+// receives run in the transaction. Nested calls join the outer transaction,
+// which is only committed by the outermost call.
+func (pdb *PostgresDB) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := pdb.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+				pdb.Logger.WithError(err).Error("Failed to roll back transaction")
+			}
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		pdb.Logger.WithError(err).Error("Failed to commit transaction")
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	committed = true
+
+	return nil
+}
+
+// ExecContext runs the query in the transaction carried by ctx, if any.
+func (pdb *PostgresDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx.ExecContext(ctx, query, args...)
+	}
+
+	return pdb.DB.ExecContext(ctx, query, args...)
+}
+
+// QueryContext runs the query in the transaction carried by ctx, if any.
+func (pdb *PostgresDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx.QueryContext(ctx, query, args...)
+	}
+
+	return pdb.DB.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext runs the query in the transaction carried by ctx, if any.
+func (pdb *PostgresDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx.QueryRowContext(ctx, query, args...)
+	}
+
+	return pdb.DB.QueryRowContext(ctx, query, args...)
+}
+
+// NewListener opens a dedicated connection that LISTENs on the given channels.
+// It reconnects on its own and sends a nil notification after each reconnect.
+func NewListener(cfg config.DatabaseConfig, logger *logrus.Logger, channels ...string) (*pq.Listener, error) {
+	listener := pq.NewListener(connString(cfg), 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.WithError(err).WithField("channels", channels).Warn("Database listener connection problem")
+		}
+	})
+
+	for _, channel := range channels {
+		if err := listener.Listen(channel); err != nil {
+			listener.Close()
+			return nil, fmt.Errorf("error listening on %s: %w", channel, err)
+		}
+	}
+
+	return listener, nil
+}
+
+func connString(cfg config.DatabaseConfig) string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode)
+}
@@ -3,6 +3,7 @@ package db_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -123,3 +124,63 @@ var sqlOpen sqlOpenFunc = sql.Open
 func init() {
 	sqlOpen = sql.Open
 }
+
+func TestPostgresDB_WithinTx(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock)
+		fnErr     error
+	}{
+		{
+			name: "Committed",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE posts").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO outbox_events").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "Rolled back on error",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE posts").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO outbox_events").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectRollback()
+			},
+			fnErr: errors.New("boom"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+			defer mockDB.Close()
+
+			pgDB := &db.PostgresDB{DB: mockDB, Logger: logrus.New()}
+			tt.setupMock(mock)
+
+			err = pgDB.WithinTx(context.Background(), func(ctx context.Context) error {
+				if _, err := pgDB.ExecContext(ctx, "UPDATE posts SET title = 'x'"); err != nil {
+					return err
+				}
+
+				// A nested call joins the transaction instead of starting one.
+				return pgDB.WithinTx(ctx, func(ctx context.Context) error {
+					if _, err := pgDB.ExecContext(ctx, "INSERT INTO outbox_events DEFAULT VALUES"); err != nil {
+						return err
+					}
+					return tt.fnErr
+				})
+			})
+
+			if tt.fnErr != nil {
+				assert.ErrorIs(t, err, tt.fnErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}